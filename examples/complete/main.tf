@@ -1,9 +1,11 @@
 # Complete example showing typical Arcane provider usage
 #
 # This example demonstrates:
-# - Creating an environment
+# - Creating an environment and checking that its agent is connected
+# - Registering a container registry and a git repository
+# - Syncing a compose stack from git with GitOps
 # - Looking up existing projects
-# - Deploying projects
+# - Deploying projects and reading back their status
 
 terraform {
   required_providers {
@@ -37,10 +39,39 @@ provider "arcane" {
 # Create an environment for homelab
 resource "arcane_environment" "homelab" {
   name        = "homelab"
+  api_url     = "http://10.100.1.100:3553"
   description = "Homelab Docker environment managed by Terraform"
   use_api_key = true
 }
 
+# Make sure the agent is connected before relying on the environment
+data "arcane_environment_health" "homelab" {
+  environment_id = arcane_environment.homelab.id
+}
+
+# Registry used by the stacks below to pull private images
+resource "arcane_container_registry" "ghcr" {
+  name = "GitHub Container Registry"
+  url  = "https://ghcr.io"
+}
+
+# Repository holding the compose files synced with GitOps
+resource "arcane_git_repository" "infra" {
+  name   = "homelab-infra"
+  url    = "https://github.com/example/homelab-infra.git"
+  branch = "main"
+}
+
+# Keep the media stack in sync with the repository
+resource "arcane_gitops_sync" "media" {
+  environment_id = arcane_environment.homelab.id
+  repository_id  = arcane_git_repository.infra.id
+  path           = "stacks/media"
+  compose_file   = "docker-compose.yml"
+  auto_sync      = true
+  sync_interval  = "5m"
+}
+
 # Look up existing projects in the environment
 # Note: Projects are auto-discovered from docker compose stacks on the Docker host
 data "arcane_project" "monitoring" {
@@ -72,6 +103,12 @@ resource "arcane_project_deployment" "traefik" {
   force_recreate = true
 }
 
+# Read back the deployed monitoring stack, including its containers
+data "arcane_project_status" "monitoring" {
+  environment_id = arcane_environment.homelab.id
+  project_id     = arcane_project_deployment.monitoring.project_id
+}
+
 # Outputs
 output "environment_id" {
   description = "The ID of the created environment"
@@ -93,3 +130,18 @@ output "traefik_status" {
   description = "Status of the traefik deployment"
   value       = arcane_project_deployment.traefik.status
 }
+
+output "agent_connected" {
+  description = "Whether the environment's agent is connected"
+  value       = data.arcane_environment_health.homelab.is_connected
+}
+
+output "monitoring_containers" {
+  description = "Names of the monitoring stack's containers"
+  value       = [for c in data.arcane_project_status.monitoring.containers : c.name]
+}
+
+output "media_sync_id" {
+  description = "The ID of the GitOps sync for the media stack"
+  value       = arcane_gitops_sync.media.id
+}
@@ -0,0 +1,11 @@
+data "arcane_compose_preview" "webapp" {
+  environment_id  = arcane_environment.production.id
+  compose_content = file("deploy/docker-compose.yml")
+  variables = {
+    TAG = var.webapp_version
+  }
+}
+
+output "webapp_compose" {
+  value = nonsensitive(data.arcane_compose_preview.webapp.rendered)
+}
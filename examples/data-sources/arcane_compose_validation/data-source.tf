@@ -0,0 +1,12 @@
+data "arcane_compose_validation" "webapp" {
+  environment_id  = arcane_environment.production.id
+  compose_content = file("deploy/docker-compose.yml")
+}
+
+output "compose_valid" {
+  value = data.arcane_compose_validation.webapp.valid
+}
+
+output "compose_warnings" {
+  value = data.arcane_compose_validation.webapp.warnings
+}
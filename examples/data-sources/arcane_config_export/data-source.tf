@@ -0,0 +1,15 @@
+data "arcane_config_export" "production" {
+  environment_id = arcane_environment.production.id
+}
+
+# Commit the export to diff it against earlier ones
+resource "local_file" "production_export" {
+  filename = "${path.module}/exports/production.yaml"
+  content  = data.arcane_config_export.production.content
+}
+
+data "arcane_config_export" "registries" {
+  environment_id = arcane_environment.production.id
+  include        = ["container_registries"]
+  format         = "json"
+}
@@ -6,3 +6,16 @@ data "arcane_container" "postgres" {
 output "postgres_status" {
   value = data.arcane_container.postgres.status
 }
+
+output "postgres_restarts" {
+  value = data.arcane_container.postgres.restart_count
+}
+
+# Block until the container's health check passes, e.g. before resources
+# that connect to it
+data "arcane_container" "postgres_ready" {
+  environment_id  = arcane_environment.production.id
+  name            = "postgres"
+  wait_for_status = "healthy"
+  wait_timeout    = "5m"
+}
@@ -0,0 +1,8 @@
+data "arcane_doctor" "preflight" {
+  environment_ids = [arcane_environment.production.id]
+  fail_on_error   = true
+}
+
+output "preflight" {
+  value = data.arcane_doctor.preflight.summary
+}
@@ -0,0 +1,21 @@
+# Check an agent token kept in Vault before handing it to the agent
+data "vault_generic_secret" "agent" {
+  path = "secret/arcane/production"
+}
+
+data "arcane_environment_token_validation" "production" {
+  environment_id = arcane_environment.production.id
+  token          = data.vault_generic_secret.agent.data["token"]
+}
+
+resource "local_sensitive_file" "agent_env" {
+  filename = "${path.module}/agent.env"
+  content  = "AGENT_TOKEN=${data.vault_generic_secret.agent.data["token"]}\n"
+
+  lifecycle {
+    precondition {
+      condition     = data.arcane_environment_token_validation.production.valid
+      error_message = "The agent token in Vault is not valid for the production environment"
+    }
+  }
+}
@@ -0,0 +1,13 @@
+# Edge environments whose agent is connected
+data "arcane_environments" "edge" {
+  name_regex     = "^edge-"
+  connected_only = true
+}
+
+# Deploy the same project to each of them
+resource "arcane_project_deployment" "node_exporter" {
+  for_each = { for env in data.arcane_environments.edge.environments : env.name => env.id }
+
+  environment_id = each.value
+  project_id     = "node-exporter"
+}
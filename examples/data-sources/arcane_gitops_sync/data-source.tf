@@ -0,0 +1,15 @@
+# Look up a sync by ID
+data "arcane_gitops_sync" "webapp" {
+  environment_id = arcane_environment.production.id
+  id             = "sync-123"
+}
+
+# Look up the only sync of a repository in the environment
+data "arcane_gitops_sync" "infra" {
+  environment_id = arcane_environment.production.id
+  repository_id  = arcane_git_repository.infra.id
+}
+
+output "infra_synced_commit" {
+  value = data.arcane_gitops_sync.infra.last_sync_commit
+}
@@ -0,0 +1,9 @@
+data "arcane_gitops_sync_runs" "webapp" {
+  environment_id = arcane_environment.production.id
+  sync_id        = arcane_gitops_sync.webapp.id
+  limit          = 5
+}
+
+output "last_synced_commit" {
+  value = data.arcane_gitops_sync_runs.webapp.runs[0].commit
+}
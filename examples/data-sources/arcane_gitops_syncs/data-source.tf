@@ -0,0 +1,8 @@
+data "arcane_gitops_syncs" "production" {
+  environment_id = arcane_environment.production.id
+}
+
+# Commit last deployed by each sync, for a drift dashboard
+output "synced_commits" {
+  value = { for sync in data.arcane_gitops_syncs.production.syncs : sync.path => sync.last_sync_commit }
+}
@@ -0,0 +1,13 @@
+data "arcane_import_blocks" "production" {
+  environment_id = "env-123"
+
+  # Objects already in the configuration
+  exclude_ids = [arcane_container_registry.ghcr.id]
+}
+
+# Write the blocks to imports.tf, then run
+#   terraform plan -generate-config-out=generated.tf
+resource "local_file" "imports" {
+  filename = "${path.module}/imports.tf"
+  content  = data.arcane_import_blocks.production.content
+}
@@ -0,0 +1,8 @@
+data "arcane_job" "last_deploy" {
+  environment_id = arcane_environment.production.id
+  id             = arcane_project_deployment.webapp.last_deployment_id
+}
+
+output "last_deploy_status" {
+  value = data.arcane_job.last_deploy.status
+}
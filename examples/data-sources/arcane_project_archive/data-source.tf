@@ -0,0 +1,10 @@
+# Back up a project's compose files to the local machine
+data "arcane_project_archive" "webapp" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+  output_path    = "${path.module}/backups/webapp.tar.gz"
+}
+
+output "webapp_backup_sha256" {
+  value = data.arcane_project_archive.webapp.output_sha256
+}
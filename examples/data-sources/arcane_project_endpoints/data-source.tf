@@ -0,0 +1,8 @@
+data "arcane_project_endpoints" "webapp" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+}
+
+output "webapp_urls" {
+  value = [for e in data.arcane_project_endpoints.webapp.endpoints : e.url]
+}
@@ -0,0 +1,17 @@
+data "arcane_project_logs" "webapp" {
+  environment_id = arcane_project_deployment.webapp.environment_id
+  project_id     = arcane_project_deployment.webapp.project_id
+  tail           = 50
+  since          = arcane_project_deployment.webapp.last_deployed_at
+}
+
+output "webapp_startup_logs" {
+  value = data.arcane_project_logs.webapp.content
+}
+
+# Logs of a single container, with timestamps
+data "arcane_project_logs" "nginx" {
+  environment_id = arcane_environment.production.id
+  container_id   = data.arcane_container.nginx.id
+  timestamps     = true
+}
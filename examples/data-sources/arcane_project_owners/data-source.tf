@@ -0,0 +1,17 @@
+data "arcane_environment" "production" {
+  name = "production"
+}
+
+# Projects deployed with arcane_project_deployment's ownership, and those
+# last deployed from a workspace other than this one
+data "arcane_project_owners" "production" {
+  environment_id = data.arcane_environment.production.id
+  workspace      = terraform.workspace
+}
+
+check "project_ownership" {
+  assert {
+    condition     = length(data.arcane_project_owners.production.conflicts) == 0
+    error_message = "Projects owned by other workspaces: ${join(", ", data.arcane_project_owners.production.conflicts[*].name)}"
+  }
+}
@@ -0,0 +1,8 @@
+data "arcane_project_routes" "webapp" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+}
+
+output "webapp_hosts" {
+  value = data.arcane_project_routes.webapp.hosts
+}
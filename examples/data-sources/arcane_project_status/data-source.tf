@@ -6,3 +6,14 @@ data "arcane_project_status" "webapp" {
 output "container_health" {
   value = data.arcane_project_status.webapp.containers
 }
+
+# Healthy as long as most replicas are
+data "arcane_project_status" "workers" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.workers.id
+  health_policy  = "quorum"
+}
+
+output "workers_health" {
+  value = data.arcane_project_status.workers.health
+}
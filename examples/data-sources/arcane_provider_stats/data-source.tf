@@ -0,0 +1,12 @@
+# Read after the deployment so the figures cover the whole apply
+data "arcane_provider_stats" "apply" {
+  depends_on = [arcane_project_deployment.webapp]
+}
+
+output "arcane_api_requests" {
+  value = data.arcane_provider_stats.apply.requests
+}
+
+output "arcane_wait_time_ms" {
+  value = data.arcane_provider_stats.apply.wait_time_ms
+}
@@ -0,0 +1,17 @@
+data "arcane_registry_image" "webapp" {
+  registry_id = arcane_container_registry.ghcr.id
+  repository  = "example/webapp"
+  tag         = var.webapp_version
+}
+
+resource "arcane_project_deployment" "webapp" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+
+  lifecycle {
+    precondition {
+      condition     = data.arcane_registry_image.webapp.exists
+      error_message = "example/webapp:${var.webapp_version} has not been pushed yet."
+    }
+  }
+}
@@ -0,0 +1,10 @@
+data "arcane_restart_policy_check" "production" {
+  environment_id = arcane_environment.production.id
+}
+
+check "restart_policies" {
+  assert {
+    condition     = data.arcane_restart_policy_check.production.ok
+    error_message = "Containers without a restart policy: ${join(", ", data.arcane_restart_policy_check.production.violations[*].container)}"
+  }
+}
@@ -0,0 +1,9 @@
+data "arcane_server_info" "current" {}
+
+output "arcane_version" {
+  value = data.arcane_server_info.current.version
+}
+
+output "arcane_requests_remaining" {
+  value = data.arcane_server_info.current.ratelimit_remaining
+}
@@ -0,0 +1,9 @@
+# Preview environments past their ttl, or created more than a week ago
+data "arcane_stale_environments" "previews" {
+  name_prefix = "pr-"
+  max_age     = "168h"
+}
+
+output "stale_preview_ids" {
+  value = data.arcane_stale_environments.previews.ids
+}
@@ -0,0 +1,10 @@
+# Layer a per-environment override on top of a shared base stack
+resource "arcane_project_file" "compose" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+  path           = "compose.yaml"
+  content = provider::arcane::compose_merge(
+    file("${path.module}/compose.base.yaml"),
+    file("${path.module}/compose.production.yaml"),
+  )
+}
@@ -0,0 +1,6 @@
+# Normalize durations so "300s", "PT5M" and "5m" compare equal
+resource "arcane_gitops_sync" "webapp" {
+  environment_id = arcane_environment.production.id
+  repository_id  = arcane_git_repository.infra.id
+  sync_interval  = provider::arcane::duration_normalize(var.sync_interval)
+}
@@ -0,0 +1,25 @@
+# Write the project's .env from a map, and redeploy when it changes
+locals {
+  webapp_env = {
+    TZ           = "Europe/Paris"
+    LOG_LEVEL    = var.log_level
+    DATABASE_URL = var.database_url
+  }
+}
+
+resource "arcane_project_file" "webapp_env" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+  path           = ".env"
+  content        = sensitive(provider::arcane::env_file_encode(local.webapp_env))
+  mode           = "0600"
+}
+
+resource "arcane_project_deployment" "webapp" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+
+  triggers = {
+    env = sha256(provider::arcane::env_file_encode(local.webapp_env))
+  }
+}
@@ -15,4 +15,27 @@ provider "arcane" {
 
   # API key for authentication (can also be set via ARCANE_API_KEY environment variable)
   # api_key = var.arcane_api_key
+
+  # Additional keys resources can select with api_key_alias
+  # api_keys = {
+  #   deploy = var.arcane_deploy_api_key
+  # }
+
+  # Namespace environments, registries and git repositories created by this
+  # configuration, e.g. per pull request (can also be set via ARCANE_NAME_PREFIX)
+  # name_prefix = "pr-123-"
+
+  # Deploy options inherited by deployments that don't set them
+  # default_deploy_options {
+  #   pull           = true
+  #   remove_orphans = true
+  # }
+
+  # Experimental: HMAC-sign every request for a WAF that only accepts signed
+  # traffic. Arcane doesn't check signatures, so the WAF must verify them
+  # (the key can also be set via ARCANE_REQUEST_SIGNING_KEY)
+  # request_signing {
+  #   algorithm = "hmac-sha256"
+  #   headers   = ["Content-Type", "X-API-Key"]
+  # }
 }
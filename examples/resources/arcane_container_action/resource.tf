@@ -0,0 +1,22 @@
+data "arcane_container" "nginx" {
+  environment_id = arcane_environment.production.id
+  name           = "webapp-nginx-1"
+}
+
+resource "arcane_project_file" "nginx_conf" {
+  environment_id = arcane_environment.production.id
+  project_id     = arcane_project.webapp.id
+  path           = "nginx/nginx.conf"
+  content        = file("${path.module}/nginx.conf")
+}
+
+resource "arcane_container_action" "reload_nginx" {
+  environment_id = arcane_environment.production.id
+  container_id   = data.arcane_container.nginx.id
+  action         = "restart"
+
+  # Restart nginx whenever its configuration changes
+  triggers = {
+    config = sha256(arcane_project_file.nginx_conf.content)
+  }
+}
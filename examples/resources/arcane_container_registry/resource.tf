@@ -10,3 +10,13 @@ resource "arcane_container_registry" "dockerhub" {
   name = "Docker Hub"
   url  = "https://index.docker.io/v1/"
 }
+
+# Short-lived ECR token from the AWS credentials in the provider's environment,
+# rotated by an apply once it expires within refresh_before
+resource "arcane_container_registry" "ecr" {
+  name           = "ECR"
+  url            = "https://123456789012.dkr.ecr.eu-west-1.amazonaws.com"
+  auth_type      = "basic"
+  auth_helper    = "ecr"
+  refresh_before = "6h"
+}
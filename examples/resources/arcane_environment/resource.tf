@@ -3,6 +3,9 @@ resource "arcane_environment" "production" {
   name        = "production"
   description = "Production Docker environment"
   use_api_key = true
+
+  # Fail the apply if the agent is older than the features this config relies on
+  minimum_agent_version = "1.16.0"
 }
 
 # Create a development environment without API key
@@ -12,8 +15,44 @@ resource "arcane_environment" "development" {
   use_api_key = false
 }
 
+# Create one environment per edge site. Names must be unique: the plan fails
+# if two keys map to the same name, or a name is already taken in Arcane.
+locals {
+  edge_sites = {
+    paris  = "http://10.20.0.10:3553"
+    berlin = "http://10.30.0.10:3553"
+  }
+}
+
+resource "arcane_environment" "edge" {
+  for_each = local.edge_sites
+
+  name    = "edge-${each.key}"
+  api_url = each.value
+}
+
 # Output the access token (only available when use_api_key = true)
 output "production_access_token" {
   value     = arcane_environment.production.access_token
   sensitive = true
 }
+
+# Preview environment for a pull request, listed by arcane_stale_environments
+# once it is three days old
+variable "pr_number" {
+  type = number
+}
+
+resource "arcane_environment" "preview" {
+  name    = "pr-${var.pr_number}"
+  api_url = "http://10.100.3.10:3553"
+  ttl     = "72h"
+}
+
+# Wait until the agent connects before dependent resources deploy to it
+resource "arcane_environment" "staging" {
+  name                = "staging"
+  api_url             = "http://10.100.1.110:3553"
+  wait_for_connection = true
+  connection_timeout  = "10m"
+}
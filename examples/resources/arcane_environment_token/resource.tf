@@ -0,0 +1,21 @@
+# Let the token resource own the environment's access token
+resource "arcane_environment" "production" {
+  name                = "production"
+  description         = "Production Docker environment"
+  use_api_key         = true
+  manage_access_token = false
+}
+
+# Changing any keeper value replaces the resource and rotates the token
+resource "arcane_environment_token" "production" {
+  environment_id = arcane_environment.production.id
+
+  keepers = {
+    rotation = "2026-q1"
+  }
+}
+
+output "production_agent_token" {
+  value     = arcane_environment_token.production.token
+  sensitive = true
+}
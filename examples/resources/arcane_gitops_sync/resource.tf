@@ -7,3 +7,19 @@ resource "arcane_gitops_sync" "webapp" {
   auto_sync      = true
   sync_interval  = "5m"
 }
+
+# Sync during the apply and fail it when the sync did not succeed
+resource "arcane_gitops_sync" "api" {
+  environment_id   = arcane_environment.production.id
+  repository_id    = arcane_git_repository.infra.id
+  path             = "apps/api"
+  branch           = "main"
+  trigger_on_apply = true
+
+  lifecycle {
+    postcondition {
+      condition     = self.last_sync_status == "success"
+      error_message = "GitOps sync of apps/api failed: ${coalesce(self.last_sync_error, "unknown error")}"
+    }
+  }
+}
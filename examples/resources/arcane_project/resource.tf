@@ -0,0 +1,45 @@
+resource "arcane_project" "webapp" {
+  environment_id  = arcane_environment.production.id
+  name            = "webapp"
+  compose_content = file("${path.module}/docker-compose.yml")
+  env_content = provider::arcane::env_file_encode({
+    TAG = var.image_tag
+  })
+}
+
+resource "arcane_project" "monitoring" {
+  environment_id = arcane_environment.production.id
+  name           = "monitoring"
+  compose_file   = "${path.module}/monitoring/docker-compose.yml"
+}
+
+resource "arcane_project_deployment" "webapp" {
+  environment_id = arcane_environment.production.id
+  project_id     = arcane_project.webapp.project_id
+
+  triggers = {
+    compose = arcane_project.webapp.compose_sha256
+    env     = sha256(arcane_project.webapp.env_content)
+  }
+}
+
+check "webapp_compose_upload" {
+  assert {
+    condition     = arcane_project.webapp.content_sha256 == null || arcane_project.webapp.content_sha256 == arcane_project.webapp.compose_sha256
+    error_message = "Arcane stored a different compose file than was uploaded; check for proxies rewriting line endings."
+  }
+  assert {
+    condition     = arcane_project.webapp.managed_by_sync_id == null
+    error_message = "The webapp project is also managed by GitOps sync ${coalesce(arcane_project.webapp.managed_by_sync_id, "-")}."
+  }
+}
+
+# Compose variables without a default must be set in env_content, or listed in
+# external_variables when the agent sets them
+resource "arcane_project" "api" {
+  environment_id     = arcane_environment.production.id
+  name               = "api"
+  compose_content    = file("${path.module}/api/docker-compose.yml")
+  env_content        = provider::arcane::env_file_encode({ TAG = var.image_tag })
+  external_variables = ["HOSTNAME"]
+}
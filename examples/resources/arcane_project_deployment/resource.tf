@@ -28,9 +28,97 @@ resource "arcane_project_deployment" "api" {
 
   # Remove containers for services not in the compose file
   remove_orphans = true
+
+  # Build images of services with a build section on the agent
+  build = true
+
+  # Label the project with the workspace and resource deploying it
+  ownership = {
+    workspace        = terraform.workspace
+    resource_address = "arcane_project_deployment.api"
+  }
+
+  # Redeploy one service at a time, 30s apart, each healthy before the next
+  stagger = "30s"
+
+  # Layer per-environment compose overrides, like docker compose -f
+  override_files = [
+    { path = "docker-compose.prod.yml" },
+    { content = file("${path.module}/overrides/api-resources.yml") },
+  ]
+}
+
+# Zero-downtime updates: "web-blue" and "web-green" hold the same compose
+# content. Each change is deployed into the one not serving, which must become
+# healthy before the other is stopped.
+data "arcane_project" "web_blue" {
+  environment_id = data.arcane_environment.production.id
+  name           = "web-blue"
+}
+
+resource "arcane_project_deployment" "web" {
+  environment_id = data.arcane_environment.production.id
+  project_id     = data.arcane_project.web_blue.id
+  strategy       = "blue_green"
+  health_policy  = "all"
+  wait_timeout   = "5m"
+
+  triggers = {
+    image = "ghcr.io/example/web:1.4.2"
+  }
+}
+
+# Fail the apply unless the app answers on its health endpoint after deploying
+resource "arcane_project_deployment" "api_checked" {
+  environment_id = data.arcane_environment.production.id
+  project_id     = data.arcane_project.api.id
+  health_policy  = "all"
+
+  http_check = {
+    url           = "https://api.example.com/healthz"
+    expect_status = 200
+    timeout       = "2m"
+  }
+}
+
+# Fail the apply unless every container passes its health check after deploying
+data "arcane_project" "worker" {
+  environment_id = data.arcane_environment.production.id
+  name           = "worker"
+}
+
+resource "arcane_project_deployment" "worker" {
+  environment_id       = data.arcane_environment.production.id
+  project_id           = data.arcane_project.worker.id
+  wait_for_healthy     = true
+  health_check_timeout = "3m"
+}
+
+# Redeploy when the token of the registry the images are pulled from is rotated
+resource "arcane_project_deployment" "private_images" {
+  environment_id        = data.arcane_environment.production.id
+  project_id            = data.arcane_project.webapp.id
+  depends_on_registries = [arcane_container_registry.ghcr.id]
+}
+
+# Check the worker's images every 6 hours and redeploy it when one is updated,
+# instead of following Arcane's global update settings
+resource "arcane_project_deployment" "worker_updates" {
+  environment_id = data.arcane_environment.production.id
+  project_id     = data.arcane_project.worker.id
+
+  updater = {
+    check_interval = "6h"
+    auto_apply     = true
+  }
 }
 
 # Output the deployment status
 output "webapp_status" {
   value = arcane_project_deployment.webapp.status
 }
+
+# Containers the last deploy removed because of remove_orphans
+output "api_removed_orphans" {
+  value = arcane_project_deployment.api.removed_orphans
+}
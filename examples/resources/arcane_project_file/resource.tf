@@ -0,0 +1,15 @@
+resource "arcane_project_file" "nginx_conf" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+  path           = "nginx/nginx.conf"
+  content        = file("${path.module}/nginx.conf")
+}
+
+resource "arcane_project_file" "tls_key" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+  path           = "certs/tls.key"
+  content        = sensitive(tls_private_key.webapp.private_key_pem)
+  mode           = "0600"
+  owner          = "101"
+}
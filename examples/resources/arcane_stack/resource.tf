@@ -0,0 +1,21 @@
+resource "arcane_stack" "webapp" {
+  environment_id  = arcane_environment.production.id
+  name            = "webapp"
+  compose_content = file("${path.module}/docker-compose.yml")
+  env_content = provider::arcane::env_file_encode({
+    TAG = var.image_tag
+  })
+  pull = true
+}
+
+# Redeploy when a reused image tag is pushed again
+resource "arcane_stack" "worker" {
+  environment_id  = arcane_environment.production.id
+  name            = "worker"
+  compose_content = file("${path.module}/worker/docker-compose.yml")
+  wait_timeout    = "5m"
+
+  triggers = {
+    image = var.worker_image_digest
+  }
+}
@@ -0,0 +1,43 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Code is a stable identifier of a class of error. It is included in error
+// diagnostics and logs, so that support scripts and runbooks can match on it
+// instead of on message text. Codes are never renumbered or reused.
+type Code string
+
+// Error codes.
+const (
+	CodeInvalidURL       Code = "ARC001" // provider url missing, malformed or redirected
+	CodeAgentOffline     Code = "ARC002" // environment agent not connected
+	CodeUnreachable      Code = "ARC003" // Arcane could not be reached
+	CodeAuthFailed       Code = "ARC004" // API key rejected
+	CodePermissionDenied Code = "ARC005" // API key not allowed to perform the operation
+	CodeInvalidRequest   Code = "ARC006" // request rejected as invalid
+	CodeNotFound         Code = "ARC007" // object or operation not found
+	CodeConflict         Code = "ARC008" // conflicting object or operation in progress
+	CodeRateLimited      Code = "ARC009" // requests throttled
+	CodeUnavailable      Code = "ARC010" // Arcane or the agent temporarily unavailable
+	CodeServerError      Code = "ARC011" // Arcane failed to handle the request
+	CodeDeployFailed     Code = "ARC012" // server-side deployment job failed
+	CodeEnvNotAllowed    Code = "ARC013" // environment outside the provider's allowed environments
+)
+
+// AddError adds an error diagnostic whose detail ends with code, if set, and
+// logs it with the code in the error_code field.
+func AddError(ctx context.Context, diags *diag.Diagnostics, code Code, summary, detail string) {
+	fields := map[string]interface{}{"detail": detail}
+	if code != "" {
+		detail += fmt.Sprintf("\n\nError code: %s", code)
+		fields["error_code"] = string(code)
+	}
+	tflog.Error(ctx, summary, fields)
+	diags.AddError(summary, detail)
+}
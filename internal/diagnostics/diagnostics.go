@@ -0,0 +1,153 @@
+// Package diagnostics builds Terraform diagnostics from Arcane client errors,
+// so every resource and data source reports API failures the same way.
+package diagnostics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// docsURL is the provider documentation, linked from authentication errors.
+const docsURL = "https://registry.terraform.io/providers/darshan-rambhia/arcane/latest/docs"
+
+// classification is the short description and remediation hint for a class
+// of API error.
+type classification struct {
+	code   Code
+	reason string
+	hint   string
+}
+
+// classify maps err to a classification. The zero value is returned for
+// errors that carry no actionable information beyond their message.
+func classify(err error) classification {
+	if arcane.IsUnreachable(err) {
+		return classification{
+			code:   CodeUnreachable,
+			reason: "Arcane unreachable",
+			hint:   "Arcane stopped answering the provider's keep-alive pings during the run. Check that Arcane is running, then re-run.",
+		}
+	}
+
+	if arcane.IsRedirect(err) {
+		return classification{
+			code:   CodeInvalidURL,
+			reason: "redirected",
+			hint: "The Arcane API does not redirect, so a proxy in front of Arcane probably sent the request to a login page, or the provider url is not Arcane's. " +
+				"Check that the url points at Arcane and that the proxy lets API requests through with the API key, or set follow_redirects if the redirect is expected.",
+		}
+	}
+
+	var apiErr *arcane.APIError
+	if !errors.As(err, &apiErr) {
+		if arcane.IsTransient(err) {
+			return classification{
+				code:   CodeUnreachable,
+				reason: "Arcane unreachable",
+				hint:   "The provider could not reach Arcane. Check that the provider url is correct and that Arcane is running, then re-run.",
+			}
+		}
+		return classification{}
+	}
+
+	switch code := apiErr.StatusCode; {
+	case code == http.StatusBadRequest || code == http.StatusUnprocessableEntity:
+		return classification{
+			code:   CodeInvalidRequest,
+			reason: "invalid request",
+			hint:   "Arcane rejected the request. Check the configured arguments; some may not be supported by this Arcane version.",
+		}
+	case code == http.StatusUnauthorized:
+		return classification{
+			code:   CodeAuthFailed,
+			reason: "authentication failed",
+			hint: "Arcane did not accept the API key. Check that the provider api_key (or ARCANE_API_KEY) is set and has not been revoked. " +
+				"See " + docsURL + " for authentication options.",
+		}
+	case code == http.StatusForbidden:
+		return classification{
+			code:   CodePermissionDenied,
+			reason: "permission denied",
+			hint:   "The API key is valid but not allowed to perform this operation. Check the role of the user that owns the key.",
+		}
+	case code == http.StatusNotFound:
+		return classification{
+			code:   CodeNotFound,
+			reason: "not found",
+			hint:   "The object does not exist in Arcane, or this Arcane version does not support the operation.",
+		}
+	case code == http.StatusConflict:
+		return classification{
+			code:   CodeConflict,
+			reason: "conflict",
+			hint:   "Another operation on the same object is in progress or it conflicts with an existing object. Wait for it to finish and re-run.",
+		}
+	case code == http.StatusTooManyRequests:
+		return classification{
+			code:   CodeRateLimited,
+			reason: "rate limited",
+			hint:   "Arcane is throttling requests. Re-run later, or lower max_concurrent_operations_per_environment.",
+		}
+	case code == http.StatusBadGateway || code == http.StatusServiceUnavailable || code == http.StatusGatewayTimeout:
+		return classification{
+			code:   CodeUnavailable,
+			reason: "Arcane unavailable",
+			hint:   "Arcane or the environment agent is unavailable, possibly restarting. Re-run once it is back.",
+		}
+	case code >= 500:
+		return classification{
+			code:   CodeServerError,
+			reason: "server error",
+			hint:   "Arcane failed to handle the request. Check the Arcane server logs for details.",
+		}
+	}
+	return classification{}
+}
+
+// AddAPIError adds an error diagnostic for err, returned while performing the
+// operation described by summary (e.g. "Failed to create git repository").
+// Known API failures extend the summary with their class and get a
+// remediation hint and error code; the Arcane request ID is included when
+// available so the failure can be found in the server logs.
+func AddAPIError(ctx context.Context, diags *diag.Diagnostics, err error, summary string) {
+	c := classify(err)
+	if c.reason != "" {
+		summary += ": " + c.reason
+	}
+
+	var detail strings.Builder
+	detail.WriteString(err.Error())
+	if c.hint != "" {
+		fmt.Fprintf(&detail, "\n\n%s", c.hint)
+	}
+	var apiErr *arcane.APIError
+	if errors.As(err, &apiErr) && apiErr.RequestID != "" {
+		fmt.Fprintf(&detail, "\n\nRequest ID: %s", apiErr.RequestID)
+	}
+
+	AddError(ctx, diags, c.code, summary, detail.String())
+}
+
+// CollectServerWarnings returns a context that collects the warnings Arcane
+// sends with responses to requests made with it, and a function adding them
+// to diags as warning diagnostics. CRUD methods defer the function, so that
+// the warnings are attached to the resource or data source that caused them.
+func CollectServerWarnings(ctx context.Context, diags *diag.Diagnostics) (context.Context, func()) {
+	ctx, warnings := arcane.WithWarnings(ctx)
+	return ctx, func() {
+		for _, w := range warnings.List() {
+			summary := "Arcane warning"
+			if w.Code != "" {
+				summary += ": " + w.Code
+			}
+			diags.AddWarning(summary, w.Message)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+func TestAddAPIError(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		err           error
+		wantSummary   string
+		wantInDetail  []string
+		wantNoRequest bool
+	}{
+		{
+			name:         "unauthorized",
+			err:          &arcane.APIError{StatusCode: 401, Message: "invalid api key"},
+			wantSummary:  "Failed to read environment: authentication failed",
+			wantInDetail: []string{"invalid api key", "ARCANE_API_KEY", docsURL, "Error code: ARC004"},
+		},
+		{
+			name:         "forbidden",
+			err:          &arcane.APIError{StatusCode: 403},
+			wantSummary:  "Failed to read environment: permission denied",
+			wantInDetail: []string{"not allowed", "Error code: ARC005"},
+		},
+		{
+			name:         "validation",
+			err:          &arcane.APIError{StatusCode: 422, Message: "validation error", Detail: "name required"},
+			wantSummary:  "Failed to read environment: invalid request",
+			wantInDetail: []string{"name required", "Error code: ARC006"},
+		},
+		{
+			name:         "server error with request ID",
+			err:          &arcane.APIError{StatusCode: 500, Message: "internal error", RequestID: "req-42"},
+			wantSummary:  "Failed to read environment: server error",
+			wantInDetail: []string{"server logs", "Request ID: req-42", "Error code: ARC011"},
+		},
+		{
+			name:         "agent restarting",
+			err:          fmt.Errorf("wrapped: %w", &arcane.APIError{StatusCode: 503}),
+			wantSummary:  "Failed to read environment: Arcane unavailable",
+			wantInDetail: []string{"restarting", "Error code: ARC010"},
+		},
+		{
+			name:         "connection refused",
+			err:          fmt.Errorf("request failed: %w", syscall.ECONNREFUSED),
+			wantSummary:  "Failed to read environment: Arcane unreachable",
+			wantInDetail: []string{"provider url", "Error code: ARC003"},
+		},
+		{
+			name:         "keep-alive failed",
+			err:          &arcane.UnreachableError{Since: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), Err: syscall.ECONNREFUSED},
+			wantSummary:  "Failed to read environment: Arcane unreachable",
+			wantInDetail: []string{"manager unreachable since 2026-01-02T03:04:05Z", "keep-alive", "Error code: ARC003"},
+		},
+		{
+			name:         "redirected to login page",
+			err:          fmt.Errorf("wrapped: %w", &arcane.RedirectError{StatusCode: 302, Location: "https://sso.example.com/login"}),
+			wantSummary:  "Failed to read environment: redirected",
+			wantInDetail: []string{"received redirect to https://sso.example.com/login", "login page", "follow_redirects", "Error code: ARC001"},
+		},
+		{
+			name:          "unclassified",
+			err:           fmt.Errorf("failed to parse response: unexpected end of JSON input"),
+			wantSummary:   "Failed to read environment",
+			wantInDetail:  []string{"unexpected end of JSON input"},
+			wantNoRequest: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var diags diag.Diagnostics
+			AddAPIError(context.Background(), &diags, tc.err, "Failed to read environment")
+
+			if diags.ErrorsCount() != 1 {
+				t.Fatalf("expected 1 error diagnostic, got %d", diags.ErrorsCount())
+			}
+			d := diags.Errors()[0]
+			if d.Summary() != tc.wantSummary {
+				t.Errorf("summary = %q, want %q", d.Summary(), tc.wantSummary)
+			}
+			for _, want := range tc.wantInDetail {
+				if !strings.Contains(d.Detail(), want) {
+					t.Errorf("detail %q does not contain %q", d.Detail(), want)
+				}
+			}
+			if tc.wantNoRequest && strings.Contains(d.Detail(), "Request ID") {
+				t.Errorf("detail %q unexpectedly contains a request ID", d.Detail())
+			}
+			if tc.wantNoRequest && strings.Contains(d.Detail(), "Error code") {
+				t.Errorf("detail %q unexpectedly contains an error code", d.Detail())
+			}
+		})
+	}
+}
+
+func TestAddError(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+	AddError(context.Background(), &diags, CodeAgentOffline, "Agent not reachable", "agent of environment \"env-1\" is offline")
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error diagnostic, got %d", diags.ErrorsCount())
+	}
+	d := diags.Errors()[0]
+	if d.Summary() != "Agent not reachable" {
+		t.Errorf("summary = %q, want %q", d.Summary(), "Agent not reachable")
+	}
+	if want := "agent of environment \"env-1\" is offline\n\nError code: ARC002"; d.Detail() != want {
+		t.Errorf("detail = %q, want %q", d.Detail(), want)
+	}
+}
+
+func TestCollectServerWarnings(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"success":true,"warnings":["plain warning",{"code":"deprecated_field","message":"use_api_key is deprecated"}]}`))
+	}))
+	defer srv.Close()
+
+	c, err := arcane.New(arcane.Config{URL: srv.URL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var diags diag.Diagnostics
+	ctx, flush := CollectServerWarnings(context.Background(), &diags)
+	if err := c.Do(ctx, &arcane.Request{Method: http.MethodGet, Path: "/api/environments"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diags.WarningsCount() != 0 {
+		t.Fatalf("expected warnings to be added on flush only, got %v", diags)
+	}
+	flush()
+
+	warnings := diags.Warnings()
+	if len(warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %v", diags)
+	}
+	if warnings[0].Summary() != "Arcane warning" || warnings[0].Detail() != "plain warning" {
+		t.Errorf("unexpected first warning: %s: %s", warnings[0].Summary(), warnings[0].Detail())
+	}
+	if warnings[1].Summary() != "Arcane warning: deprecated_field" || warnings[1].Detail() != "use_api_key is deprecated" {
+		t.Errorf("unexpected second warning: %s: %s", warnings[1].Summary(), warnings[1].Detail())
+	}
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// apiKeyAliasAttribute is the api_key_alias attribute every resource has.
+func apiKeyAliasAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Alias of the provider `api_keys` entry to authenticate this resource's create, read, update " +
+			"and delete calls with, e.g. a key allowed to deploy while the provider's `api_key` is read-only. " +
+			"Uses `api_key` when unset.",
+		Optional: true,
+	}
+}
+
+// attributeGetter is implemented by tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+}
+
+// withAPIKeyAlias returns ctx set up to authenticate with the key selected by
+// the api_key_alias attribute of data.
+func withAPIKeyAlias(ctx context.Context, data attributeGetter) context.Context {
+	var alias types.String
+	if diags := data.GetAttribute(ctx, path.Root("api_key_alias"), &alias); diags.HasError() {
+		return ctx
+	}
+	return arcane.WithAPIKeyAlias(ctx, alias.ValueString())
+}
@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Client is the client shared by the provider's resources and data sources:
+// the Arcane API client, with the provider settings that shape how resources
+// use it and the state they share within a Terraform operation. Configure
+// creates one per operation, so that state spans a single plan or apply.
+//
+// Like arcane.Client, a Client is safe for concurrent use.
+type Client struct {
+	*arcane.Client
+
+	settings         ClientSettings
+	deployedProjects sync.Map // "envID/projectID" -> arcane.ProjectDeployRequest, see MarkProjectDeployed
+	plannedEnvNames  sync.Map // name -> owner, see ClaimEnvironmentName
+	managedEnvs      sync.Map // name -> ID, see MarkEnvironmentManaged
+}
+
+// ClientSettings holds the provider settings of a Client.
+type ClientSettings struct {
+	// Simulate enables a failure-injection mode (see simulateModes). Empty
+	// disables simulation.
+	Simulate string
+	// DeployDefaults are the deploy options used by deployments that don't
+	// set them explicitly.
+	DeployDefaults DeployDefaults
+	// RedactRuntimeDetails hides container runtime details such as port
+	// mappings from data source results.
+	RedactRuntimeDetails bool
+	// DisableLocalArtifacts forbids features that write files on the machine
+	// running Terraform, for restricted filesystems such as Terraform Cloud
+	// agents.
+	DisableLocalArtifacts bool
+	// RequireDestroyConfirmation makes resources refuse destructive deletes
+	// unless their confirm_destroy attribute names the deleted object.
+	RequireDestroyConfirmation bool
+	// NamePrefix is prepended to the names of environments, container
+	// registries and git repositories created by resources.
+	NamePrefix string
+	// AllowedEnvironments, when non-empty, lists the IDs or names of the only
+	// environments resources may manage.
+	AllowedEnvironments []string
+	// DeniedEnvironments lists IDs or names of environments resources must
+	// not manage, even when allowed.
+	DeniedEnvironments []string
+}
+
+// DeployDefaults holds provider-wide defaults for deploy options.
+type DeployDefaults struct {
+	Pull          bool
+	ForceRecreate bool
+	RemoveOrphans bool
+}
+
+// NewClient returns a Client using api with the given settings.
+func NewClient(api *arcane.Client, settings ClientSettings) (*Client, error) {
+	if settings.Simulate != "" && !slices.Contains(simulateModes, settings.Simulate) {
+		return nil, fmt.Errorf("unknown simulate mode %q, expected one of %s", settings.Simulate, strings.Join(simulateModes, ", "))
+	}
+	settings.AllowedEnvironments = slices.Clone(settings.AllowedEnvironments)
+	settings.DeniedEnvironments = slices.Clone(settings.DeniedEnvironments)
+	return &Client{Client: api, settings: settings}, nil
+}
+
+// DeployDefaults returns the deploy option defaults the client was configured
+// with.
+func (c *Client) DeployDefaults() DeployDefaults {
+	return c.settings.DeployDefaults
+}
+
+// RedactRuntimeDetails reports whether container runtime details should be
+// left out of data source results.
+func (c *Client) RedactRuntimeDetails() bool {
+	return c.settings.RedactRuntimeDetails
+}
+
+// LocalArtifactsDisabled reports whether features that write local files are
+// disabled.
+func (c *Client) LocalArtifactsDisabled() bool {
+	return c.settings.DisableLocalArtifacts
+}
+
+// Simulate returns the configured simulation mode (see simulateModes), or ""
+// when simulation is disabled.
+func (c *Client) Simulate() string {
+	return c.settings.Simulate
+}
+
+// RequiresDestroyConfirmation reports whether destructive deletes must be
+// confirmed with confirm_destroy.
+func (c *Client) RequiresDestroyConfirmation() bool {
+	return c.settings.RequireDestroyConfirmation
+}
+
+// PrefixedName returns the name under which a resource named name is created
+// in Arcane, i.e. with the configured NamePrefix.
+func (c *Client) PrefixedName(name string) string {
+	return c.settings.NamePrefix + name
+}
+
+// UnprefixedName returns the configured name of a resource created as name,
+// i.e. without NamePrefix. A name without the prefix is returned as is, so
+// that a rename outside Terraform shows up as drift.
+func (c *Client) UnprefixedName(name string) string {
+	if c.settings.NamePrefix == "" {
+		return name
+	}
+	if trimmed, ok := strings.CutPrefix(name, c.settings.NamePrefix); ok {
+		return trimmed
+	}
+	return name
+}
+
+// RestrictsEnvironments reports whether the client was configured with allowed
+// or denied environments.
+func (c *Client) RestrictsEnvironments() bool {
+	return len(c.settings.AllowedEnvironments) > 0 || len(c.settings.DeniedEnvironments) > 0
+}
+
+// EnvironmentAllowed reports whether resources may manage the environment
+// with the given ID and name; either may be empty when not known. An
+// environment is allowed unless it matches a denied environment, or allowed
+// environments are configured and it matches none of them.
+func (c *Client) EnvironmentAllowed(id, name string) bool {
+	matches := func(list []string) bool {
+		for _, entry := range list {
+			if entry != "" && (entry == id || entry == name) {
+				return true
+			}
+		}
+		return false
+	}
+	if matches(c.settings.DeniedEnvironments) {
+		return false
+	}
+	return len(c.settings.AllowedEnvironments) == 0 || matches(c.settings.AllowedEnvironments)
+}
+
+// ClaimEnvironmentName records that a resource plans an environment named
+// name, and reports false if another resource already claimed the name. A
+// client lives for a single Terraform operation, so this catches duplicate
+// environment names across a configuration at plan time, before the server
+// rejects the second create halfway through an apply. owner is the ID of the
+// environment the resource manages, or replaces, and empty for a new one; an
+// environment planned more than once, e.g. once per half of a replacement,
+// may claim its name again.
+func (c *Client) ClaimEnvironmentName(name, owner string) bool {
+	claimed, loaded := c.plannedEnvNames.LoadOrStore(name, owner)
+	return !loaded || (owner != "" && claimed == owner)
+}
+
+// MarkEnvironmentManaged records that a resource refreshed the environment
+// id named name from its state during this operation.
+func (c *Client) MarkEnvironmentManaged(name, id string) {
+	c.managedEnvs.Store(name, id)
+}
+
+// ManagedEnvironmentID returns the ID of the environment named name that
+// MarkEnvironmentManaged recorded, if any.
+func (c *Client) ManagedEnvironmentID(name string) (string, bool) {
+	id, ok := c.managedEnvs.Load(name)
+	if !ok {
+		return "", false
+	}
+	return id.(string), true
+}
+
+// EnvironmentClient is the provider's client for the endpoints of one
+// environment, adding the provider's behaviour to arcane.EnvironmentClient:
+// deploys honour the simulate setting, and deployments can be recorded to
+// coalesce duplicates.
+type EnvironmentClient struct {
+	*arcane.EnvironmentClient
+
+	client        *Client
+	environmentID string
+}
+
+// ForEnvironment returns a client scoped to a specific environment. It is
+// cheap to create and shares all state with c, so it may be created per call.
+func (c *Client) ForEnvironment(envID string) *EnvironmentClient {
+	return &EnvironmentClient{
+		EnvironmentClient: c.Client.ForEnvironment(envID),
+		client:            c,
+		environmentID:     envID,
+	}
+}
+
+// DeployProject deploys (starts) a project, failing without contacting
+// Arcane under the simulate setting.
+func (ec *EnvironmentClient) DeployProject(ctx context.Context, projectID string, req *arcane.ProjectDeployRequest) (string, error) {
+	if err := ec.client.simulatedDeployError(projectID); err != nil {
+		return "", err
+	}
+	return ec.EnvironmentClient.DeployProject(ctx, projectID, req)
+}
+
+// RedeployProject redeploys a project, failing without contacting Arcane
+// under the simulate setting.
+func (ec *EnvironmentClient) RedeployProject(ctx context.Context, projectID string, req *arcane.ProjectDeployRequest) (string, error) {
+	if err := ec.client.simulatedDeployError(projectID); err != nil {
+		return "", err
+	}
+	return ec.EnvironmentClient.RedeployProject(ctx, projectID, req)
+}
+
+// MarkProjectDeployed records that the project was successfully deployed
+// through this client with req (nil for the default options). A client lives
+// for a single Terraform operation, so this lets duplicate deployment
+// resources targeting the same project within one apply be coalesced into a
+// single deploy.
+func (ec *EnvironmentClient) MarkProjectDeployed(projectID string, req *arcane.ProjectDeployRequest) {
+	var deployed arcane.ProjectDeployRequest
+	if req != nil {
+		deployed = *req
+	}
+	ec.client.deployedProjects.Store(ec.environmentID+"/"+projectID, deployed)
+}
+
+// ProjectDeployed reports whether MarkProjectDeployed was called for the
+// project on this client.
+func (ec *EnvironmentClient) ProjectDeployed(projectID string) bool {
+	_, ok := ec.client.deployedProjects.Load(ec.environmentID + "/" + projectID)
+	return ok
+}
+
+// ProjectDeployedWith reports whether MarkProjectDeployed was called for the
+// project on this client with options equivalent to req, so that deploying
+// it again with req would change nothing.
+func (ec *EnvironmentClient) ProjectDeployedWith(projectID string, req *arcane.ProjectDeployRequest) bool {
+	deployed, ok := ec.client.deployedProjects.Load(ec.environmentID + "/" + projectID)
+	if !ok {
+		return false
+	}
+	var want arcane.ProjectDeployRequest
+	if req != nil {
+		want = *req
+	}
+	return deployRequestsEquivalent(deployed.(arcane.ProjectDeployRequest), want)
+}
+
+// deployRequestsEquivalent reports whether a and b deploy the same way.
+// Services are compared as a set, since their order doesn't matter to
+// compose.
+func deployRequestsEquivalent(a, b arcane.ProjectDeployRequest) bool {
+	if a.PullPolicy != b.PullPolicy || a.ForceRecreate != b.ForceRecreate || a.Build != b.Build || a.NoCache != b.NoCache {
+		return false
+	}
+	if !slices.Equal(a.OverrideFiles, b.OverrideFiles) {
+		return false
+	}
+	as, bs := slices.Clone(a.Services), slices.Clone(b.Services)
+	slices.Sort(as)
+	slices.Sort(bs)
+	return slices.Equal(slices.Compact(as), slices.Compact(bs))
+}
+
+// Simulation modes accepted by the provider's simulate attribute. They make
+// the client fail selected operations without contacting the API, so CI can
+// exercise a module's error handling without a misbehaving agent.
+const (
+	// simulateFailDeploys fails every deploy and redeploy with a 500.
+	simulateFailDeploys = "fail_deploys"
+	// simulateConflictDeploys fails every deploy and redeploy with a 409, as
+	// if another operation on the project were already in progress.
+	simulateConflictDeploys = "conflict_deploys"
+)
+
+// simulateModes lists the valid values of the simulate attribute.
+var simulateModes = []string{simulateFailDeploys, simulateConflictDeploys}
+
+// simulatedDeployError returns the error a deploy or redeploy should fail
+// with under the configured simulation mode, or nil to proceed normally.
+func (c *Client) simulatedDeployError(projectID string) error {
+	switch c.settings.Simulate {
+	case simulateFailDeploys:
+		return &arcane.APIError{
+			StatusCode: http.StatusInternalServerError,
+			Message:    fmt.Sprintf("simulated deploy failure for project %s", projectID),
+			Detail:     fmt.Sprintf("simulate = %q", c.settings.Simulate),
+		}
+	case simulateConflictDeploys:
+		return &arcane.APIError{
+			StatusCode: http.StatusConflict,
+			Message:    fmt.Sprintf("simulated conflict: an operation on project %s is already in progress", projectID),
+			Detail:     fmt.Sprintf("simulate = %q", c.settings.Simulate),
+		}
+	}
+	return nil
+}
+
+// tflogLogger is the arcane.Logger of the provider's client, writing the
+// client's log entries to the provider's Terraform log.
+type tflogLogger struct{}
+
+func (tflogLogger) Trace(ctx context.Context, msg string, fields map[string]interface{}) {
+	tflog.Trace(ctx, msg, fields)
+}
+
+func (tflogLogger) Debug(ctx context.Context, msg string, fields map[string]interface{}) {
+	tflog.Debug(ctx, msg, fields)
+}
+
+func (tflogLogger) Warn(ctx context.Context, msg string, fields map[string]interface{}) {
+	tflog.Warn(ctx, msg, fields)
+}
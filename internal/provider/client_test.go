@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// newTestClient returns a provider client for the API at url with the given
+// settings.
+func newTestClient(t *testing.T, url string, settings ClientSettings) *Client {
+	t.Helper()
+	api, err := arcane.New(arcane.Config{URL: url, APIKey: "test-key"})
+	if err != nil {
+		t.Fatalf("failed to create API client: %v", err)
+	}
+	c, err := NewClient(api, settings)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return c
+}
+
+func TestNewClient_GivenUnknownSimulateMode_ReturnsError(t *testing.T) {
+	t.Parallel()
+	api, err := arcane.New(arcane.Config{URL: "http://localhost"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := NewClient(api, ClientSettings{Simulate: "explode"}); err == nil {
+		t.Fatal("expected error for unknown simulate mode")
+	}
+}
+
+func TestNewClient_GivenSettingsChangedAfterwards_ThenClientUnaffected(t *testing.T) {
+	t.Parallel()
+	settings := ClientSettings{AllowedEnvironments: []string{"prod"}}
+	c := newTestClient(t, "http://localhost", settings)
+	settings.AllowedEnvironments[0] = "staging"
+
+	if !c.EnvironmentAllowed("env-1", "prod") || c.EnvironmentAllowed("env-2", "staging") {
+		t.Error("expected the allowed environments configured at NewClient")
+	}
+}
+
+func TestClaimEnvironmentName_GivenClaimedName_ReportsDuplicate(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t, "http://localhost", ClientSettings{})
+	if !c.ClaimEnvironmentName("edge", "") {
+		t.Error("expected the first claim to succeed")
+	}
+	if !c.ClaimEnvironmentName("core", "") {
+		t.Error("expected a claim of another name to succeed")
+	}
+	if c.ClaimEnvironmentName("edge", "") {
+		t.Error("expected the second claim of edge to fail")
+	}
+}
+
+func TestClaimEnvironmentName_GivenSameOwner_AllowsClaimAgain(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t, "http://localhost", ClientSettings{})
+	if !c.ClaimEnvironmentName("edge", "env-1") {
+		t.Error("expected the first claim to succeed")
+	}
+	if !c.ClaimEnvironmentName("edge", "env-1") {
+		t.Error("expected the owner to claim its name again")
+	}
+	if c.ClaimEnvironmentName("edge", "env-2") {
+		t.Error("expected a claim by another environment to fail")
+	}
+	if c.ClaimEnvironmentName("edge", "") {
+		t.Error("expected a claim by a new environment to fail")
+	}
+}
+
+func TestManagedEnvironmentID_ReturnsMarkedEnvironment(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t, "http://localhost", ClientSettings{})
+	if _, ok := c.ManagedEnvironmentID("edge"); ok {
+		t.Error("expected no managed environment before it is marked")
+	}
+	c.MarkEnvironmentManaged("edge", "env-1")
+	if id, ok := c.ManagedEnvironmentID("edge"); !ok || id != "env-1" {
+		t.Errorf("ManagedEnvironmentID() = %q, %t, want env-1, true", id, ok)
+	}
+}
+
+func TestPrefixedName_GivenNamePrefix_RoundTrips(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t, "http://localhost", ClientSettings{NamePrefix: "pr-7-"})
+	if got := c.PrefixedName("web"); got != "pr-7-web" {
+		t.Errorf("PrefixedName() = %q, want pr-7-web", got)
+	}
+	if got := c.UnprefixedName("pr-7-web"); got != "web" {
+		t.Errorf("UnprefixedName() = %q, want web", got)
+	}
+	if got := c.UnprefixedName("pr-8-web"); got != "pr-8-web" {
+		t.Errorf("UnprefixedName() of a name without the prefix = %q, want it unchanged", got)
+	}
+}
+
+func TestEnvironmentAllowed(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name            string
+		allowed, denied []string
+		id, envName     string
+		want            bool
+	}{
+		{name: "no restrictions", id: "env-1", envName: "production", want: true},
+		{name: "allowed by ID", allowed: []string{"env-1"}, id: "env-1", envName: "production", want: true},
+		{name: "allowed by name", allowed: []string{"staging"}, id: "env-2", envName: "staging", want: true},
+		{name: "not allowed", allowed: []string{"staging"}, id: "env-1", envName: "production", want: false},
+		{name: "name unknown", allowed: []string{"staging"}, id: "env-2", want: false},
+		{name: "denied by name", denied: []string{"production"}, id: "env-1", envName: "production", want: false},
+		{name: "denied wins over allowed", allowed: []string{"env-1"}, denied: []string{"production"}, id: "env-1", envName: "production", want: false},
+		{name: "empty entry matches nothing", allowed: []string{""}, envName: "staging", want: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			c := newTestClient(t, "http://localhost", ClientSettings{AllowedEnvironments: tc.allowed, DeniedEnvironments: tc.denied})
+			if got := c.EnvironmentAllowed(tc.id, tc.envName); got != tc.want {
+				t.Errorf("EnvironmentAllowed(%q, %q) = %v, want %v", tc.id, tc.envName, got, tc.want)
+			}
+			if got, want := c.RestrictsEnvironments(), len(tc.allowed)+len(tc.denied) > 0; got != want {
+				t.Errorf("RestrictsEnvironments() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestProjectDeployed_GivenMarkedProject_ReportsDeployedPerEnvironment(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t, "http://localhost", ClientSettings{})
+	if c.ForEnvironment("env-1").ProjectDeployed("proj-1") {
+		t.Fatal("expected project not to be deployed before it is marked")
+	}
+
+	c.ForEnvironment("env-1").MarkProjectDeployed("proj-1", nil)
+
+	if !c.ForEnvironment("env-1").ProjectDeployed("proj-1") {
+		t.Error("expected marked project to be reported as deployed")
+	}
+	if c.ForEnvironment("env-2").ProjectDeployed("proj-1") {
+		t.Error("expected the same project ID in another environment not to be deployed")
+	}
+}
+
+func TestProjectDeployedWith_GivenMarkedProject_ComparesDeployOptions(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t, "http://localhost", ClientSettings{})
+	ec := c.ForEnvironment("env-1")
+	if ec.ProjectDeployedWith("proj-1", nil) {
+		t.Fatal("expected project not to be deployed before it is marked")
+	}
+
+	ec.MarkProjectDeployed("proj-1", &arcane.ProjectDeployRequest{PullPolicy: "always", Services: []string{"web", "db"}})
+
+	cases := []struct {
+		name string
+		req  *arcane.ProjectDeployRequest
+		want bool
+	}{
+		{"same options", &arcane.ProjectDeployRequest{PullPolicy: "always", Services: []string{"web", "db"}}, true},
+		{"services in another order", &arcane.ProjectDeployRequest{PullPolicy: "always", Services: []string{"db", "web"}}, true},
+		{"other pull policy", &arcane.ProjectDeployRequest{PullPolicy: "missing", Services: []string{"web", "db"}}, false},
+		{"force recreate", &arcane.ProjectDeployRequest{PullPolicy: "always", ForceRecreate: true, Services: []string{"web", "db"}}, false},
+		{"fewer services", &arcane.ProjectDeployRequest{PullPolicy: "always", Services: []string{"web"}}, false},
+		{"all services", &arcane.ProjectDeployRequest{PullPolicy: "always"}, false},
+		{"default options", nil, false},
+	}
+	for _, tc := range cases {
+		if got := ec.ProjectDeployedWith("proj-1", tc.req); got != tc.want {
+			t.Errorf("%s: ProjectDeployedWith = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	ec.MarkProjectDeployed("proj-2", nil)
+	if !ec.ProjectDeployedWith("proj-2", &arcane.ProjectDeployRequest{}) {
+		t.Error("expected a nil request to match the default options")
+	}
+}
+
+// ─── Failure simulation ───────────────────────────────────────────────────────
+
+func TestDeployProject_GivenSimulateFailDeploys_FailsWithoutCallingAPI(t *testing.T) {
+	t.Parallel()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	ec := newTestClient(t, srv.URL, ClientSettings{Simulate: simulateFailDeploys}).ForEnvironment("env-1")
+
+	for name, call := range map[string]func() error{
+		"deploy": func() error {
+			_, err := ec.DeployProject(context.Background(), "proj-1", nil)
+			return err
+		},
+		"redeploy": func() error {
+			_, err := ec.RedeployProject(context.Background(), "proj-1", nil)
+			return err
+		},
+	} {
+		err := call()
+		apiErr, ok := err.(*arcane.APIError)
+		if !ok || apiErr.StatusCode != http.StatusInternalServerError {
+			t.Errorf("%s: expected simulated 500 APIError, got %v", name, err)
+		}
+	}
+	if calls.Load() != 0 {
+		t.Errorf("expected no API calls, got %d", calls.Load())
+	}
+
+	// Non-deploy operations are unaffected.
+	if err := ec.StopProject(context.Background(), "proj-1"); err != nil {
+		t.Errorf("expected stop to reach the API, got %v", err)
+	}
+}
+
+func TestDeployProject_GivenSimulateConflictDeploys_ReturnsConflict(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t, "http://localhost:1", ClientSettings{Simulate: simulateConflictDeploys})
+	_, err := c.ForEnvironment("env-1").DeployProject(context.Background(), "proj-1", nil)
+	if !arcane.IsConflict(err) {
+		t.Errorf("expected simulated conflict, got %v", err)
+	}
+}
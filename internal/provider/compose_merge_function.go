@@ -0,0 +1,383 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ComposeMergeFunction{}
+
+// NewComposeMergeFunction returns a new compose_merge function.
+func NewComposeMergeFunction() function.Function {
+	return &ComposeMergeFunction{}
+}
+
+// ComposeMergeFunction merges a compose override document into a base
+// document the way docker compose merges multiple -f files.
+type ComposeMergeFunction struct{}
+
+func (f *ComposeMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "compose_merge"
+}
+
+func (f *ComposeMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Merge a compose override document into a base document",
+		MarkdownDescription: "Deep-merges two compose documents the way `docker compose -f base.yaml -f override.yaml` does " +
+			"and returns the merged YAML, so modules can build layered stacks before writing them with `arcane_project_file`. " +
+			"Mappings are merged recursively and the override wins for scalars. In services, `command`, `entrypoint` and " +
+			"`healthcheck.test` are replaced; `environment`, `labels`, `extra_hosts`, `sysctls` and `annotations` are merged by " +
+			"key, in either list or mapping form; `volumes` and `devices` are merged by target path and `secrets` and " +
+			"`configs` by source; `ports`, `expose`, `dns`, `dns_search`, `external_links` and `tmpfs` keep unique entries; " +
+			"other lists are appended. The `!reset` and `!override` tags remove and replace a value. Anchors, aliases and " +
+			"`<<` merge keys are expanded.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "base",
+				MarkdownDescription: "The base compose document.",
+			},
+			function.StringParameter{
+				Name:                "override",
+				MarkdownDescription: "The compose document merged on top of `base`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ComposeMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var base, override string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &base, &override))
+	if resp.Error != nil {
+		return
+	}
+
+	baseNode, err := parseComposeDocument(base)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	overrideNode, err := parseComposeDocument(override)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	merged, err := encodeComposeDocument(mergeComposeNodes(baseNode, overrideNode, nil))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, merged))
+}
+
+// Tags that control how a value of an override document is merged.
+const (
+	composeResetTag    = "!reset"
+	composeOverrideTag = "!override"
+)
+
+// composeMappingFields are service fields that may be written as a list of
+// KEY=VALUE entries or as a mapping, and are merged by key.
+var composeMappingFields = []string{"environment", "labels", "extra_hosts", "sysctls", "annotations"}
+
+// composeUniqueFields are service fields whose lists keep one of each entry.
+var composeUniqueFields = []string{"ports", "expose", "dns", "dns_search", "external_links", "tmpfs"}
+
+// composeReplacedFields are service fields an override replaces as a whole.
+var composeReplacedFields = []string{"command", "entrypoint"}
+
+// parseComposeDocument parses a compose document into its top-level mapping,
+// with aliases and merge keys expanded. An empty document is an empty mapping.
+func parseComposeDocument(content string) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, fmt.Errorf("invalid compose YAML: %s", err)
+	}
+	if len(doc.Content) == 0 {
+		return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}, nil
+	}
+	root := expandComposeAliases(doc.Content[0])
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("invalid compose document: expected a mapping at the top level")
+	}
+	return root, nil
+}
+
+// encodeComposeDocument returns root as YAML indented by two spaces, without
+// the !reset entries and !override tags of the override document.
+func encodeComposeDocument(root *yaml.Node) (string, error) {
+	stripComposeMergeTags(root)
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(root); err != nil {
+		return "", fmt.Errorf("failed to encode merged compose document: %s", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode merged compose document: %s", err)
+	}
+	return buf.String(), nil
+}
+
+// expandComposeAliases returns a copy of n with aliases replaced by copies of
+// their anchored nodes and << merge keys replaced by the entries they merge.
+func expandComposeAliases(n *yaml.Node) *yaml.Node {
+	if n.Kind == yaml.AliasNode {
+		return expandComposeAliases(n.Alias)
+	}
+	out := *n
+	out.Anchor = ""
+	out.Content = nil
+	if n.Kind != yaml.MappingNode {
+		for _, c := range n.Content {
+			out.Content = append(out.Content, expandComposeAliases(c))
+		}
+		return &out
+	}
+
+	// Explicit entries win over merged ones, and earlier merged mappings over
+	// later ones.
+	var merged []*yaml.Node
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		key, value := n.Content[i], expandComposeAliases(n.Content[i+1])
+		if key.Tag != "!!merge" {
+			out.Content = append(out.Content, expandComposeAliases(key), value)
+			continue
+		}
+		sources := []*yaml.Node{value}
+		if value.Kind == yaml.SequenceNode {
+			sources = value.Content
+		}
+		for _, src := range sources {
+			for j := 0; j+1 < len(src.Content); j += 2 {
+				if composeMappingIndex(merged, src.Content[j].Value) < 0 {
+					merged = append(merged, src.Content[j], src.Content[j+1])
+				}
+			}
+		}
+	}
+	for j := 0; j+1 < len(merged); j += 2 {
+		if composeMappingIndex(out.Content, merged[j].Value) < 0 {
+			out.Content = append(out.Content, merged[j], merged[j+1])
+		}
+	}
+	return &out
+}
+
+// composeMappingIndex returns the index of the value of key in the content of
+// a mapping node, or -1.
+func composeMappingIndex(content []*yaml.Node, key string) int {
+	for i := 0; i+1 < len(content); i += 2 {
+		if content[i].Value == key {
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// mergeComposeNodes merges override into base, at path in the document.
+func mergeComposeNodes(base, override *yaml.Node, path []string) *yaml.Node {
+	switch {
+	case override.Tag == composeOverrideTag || override.Tag == composeResetTag:
+		return override
+	case composeFieldIs(path, composeReplacedFields...) || composeHealthcheckTest(path):
+		return override
+	case composeFieldIs(path, composeMappingFields...) || composeBuildMapping(path):
+		return mergeComposeMappings(composeListToMapping(base), composeListToMapping(override), path)
+	case composeFieldIs(path, "networks", "depends_on") && base.Kind != override.Kind:
+		return mergeComposeMappings(composeNamesToMapping(base, path[2]), composeNamesToMapping(override, path[2]), path)
+	case base.Kind == yaml.MappingNode && override.Kind == yaml.MappingNode:
+		return mergeComposeMappings(base, override, path)
+	case base.Kind != yaml.SequenceNode || override.Kind != yaml.SequenceNode:
+		return override
+	case composeFieldIs(path, "volumes", "devices"):
+		return mergeComposeSequences(base, override, composeMountTarget)
+	case composeFieldIs(path, "secrets", "configs"):
+		return mergeComposeSequences(base, override, composeFileSource)
+	case composeFieldIs(path, composeUniqueFields...) || composeFieldIs(path, "networks", "depends_on"):
+		return mergeComposeSequences(base, override, composeNodeKey)
+	default:
+		out := *base
+		out.Style = override.Style
+		out.Content = append(slices.Clone(base.Content), override.Content...)
+		return &out
+	}
+}
+
+// mergeComposeMappings merges the entries of override into base, keeping the
+// order of base and appending new keys.
+func mergeComposeMappings(base, override *yaml.Node, path []string) *yaml.Node {
+	out := *base
+	out.Content = slices.Clone(base.Content)
+	for i := 0; i+1 < len(override.Content); i += 2 {
+		key, value := override.Content[i], override.Content[i+1]
+		idx := composeMappingIndex(out.Content, key.Value)
+		if idx < 0 {
+			out.Content = append(out.Content, key, value)
+			continue
+		}
+		out.Content[idx] = mergeComposeNodes(out.Content[idx], value, append(slices.Clone(path), key.Value))
+	}
+	return &out
+}
+
+// mergeComposeSequences merges the entries of override into base by key: an
+// override entry replaces the base entry with the same key, in place, and
+// entries with new keys are appended. The result is written in the style of
+// override, which may have block entries where base is a flow list.
+func mergeComposeSequences(base, override *yaml.Node, key func(*yaml.Node) string) *yaml.Node {
+	out := *base
+	out.Style = override.Style
+	out.Content = slices.Clone(base.Content)
+	for _, entry := range override.Content {
+		k := key(entry)
+		idx := slices.IndexFunc(out.Content, func(n *yaml.Node) bool { return key(n) == k })
+		if idx < 0 {
+			out.Content = append(out.Content, entry)
+		} else {
+			out.Content[idx] = entry
+		}
+	}
+	return &out
+}
+
+// composeListToMapping converts the KEY=VALUE list form of a service field to
+// a mapping. extra_hosts entries may also be written host:address. Entries
+// without a value map to null.
+func composeListToMapping(n *yaml.Node) *yaml.Node {
+	if n.Kind != yaml.SequenceNode {
+		return n
+	}
+	out := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, entry := range n.Content {
+		k, v, ok := strings.Cut(entry.Value, "=")
+		if !ok {
+			k, v, ok = strings.Cut(entry.Value, ":")
+		}
+		value := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+		if ok {
+			value = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}
+		}
+		if idx := composeMappingIndex(out.Content, k); idx >= 0 {
+			out.Content[idx] = value
+			continue
+		}
+		out.Content = append(out.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: k}, value)
+	}
+	return out
+}
+
+// composeNamesToMapping converts the list form of a service's networks or
+// depends_on to the mapping form, with the defaults compose applies: no
+// network options, and waiting for dependencies to start.
+func composeNamesToMapping(n *yaml.Node, field string) *yaml.Node {
+	if n.Kind != yaml.SequenceNode {
+		return n
+	}
+	out := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, entry := range n.Content {
+		value := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+		if field == "depends_on" {
+			value = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: []*yaml.Node{
+				{Kind: yaml.ScalarNode, Tag: "!!str", Value: "condition"},
+				{Kind: yaml.ScalarNode, Tag: "!!str", Value: "service_started"},
+			}}
+		}
+		out.Content = append(out.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: entry.Value}, value)
+	}
+	return out
+}
+
+// composeMountTarget returns the container path of a volume or device, in
+// short (source:target[:mode]) or long syntax.
+func composeMountTarget(n *yaml.Node) string {
+	if n.Kind == yaml.MappingNode {
+		if idx := composeMappingIndex(n.Content, "target"); idx >= 0 {
+			return n.Content[idx].Value
+		}
+		return composeNodeKey(n)
+	}
+	parts := strings.Split(n.Value, ":")
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return parts[1]
+}
+
+// composeFileSource returns the name of a secret or config, in short or long
+// syntax.
+func composeFileSource(n *yaml.Node) string {
+	if n.Kind == yaml.MappingNode {
+		if idx := composeMappingIndex(n.Content, "source"); idx >= 0 {
+			return n.Content[idx].Value
+		}
+		return composeNodeKey(n)
+	}
+	return n.Value
+}
+
+// composeNodeKey identifies a sequence entry by its value.
+func composeNodeKey(n *yaml.Node) string {
+	if n.Kind == yaml.ScalarNode {
+		return n.Value
+	}
+	out, _ := yaml.Marshal(n)
+	return string(out)
+}
+
+// composeFieldIs reports whether path is a field of a service named one of
+// fields.
+func composeFieldIs(path []string, fields ...string) bool {
+	return len(path) == 3 && path[0] == "services" && slices.Contains(fields, path[2])
+}
+
+// composeHealthcheckTest reports whether path is the test of a service's
+// healthcheck.
+func composeHealthcheckTest(path []string) bool {
+	return len(path) == 4 && path[0] == "services" && path[2] == "healthcheck" && path[3] == "test"
+}
+
+// composeBuildMapping reports whether path is the args or labels of a
+// service's build.
+func composeBuildMapping(path []string) bool {
+	return len(path) == 4 && path[0] == "services" && path[2] == "build" && (path[3] == "args" || path[3] == "labels")
+}
+
+// stripComposeMergeTags removes entries tagged !reset and the !override tag
+// from n and its descendants.
+func stripComposeMergeTags(n *yaml.Node) {
+	if n.Tag == composeOverrideTag {
+		n.Tag = ""
+	}
+	var content []*yaml.Node
+	switch n.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if n.Content[i+1].Tag != composeResetTag {
+				content = append(content, n.Content[i], n.Content[i+1])
+			}
+		}
+	case yaml.SequenceNode:
+		for _, c := range n.Content {
+			if c.Tag != composeResetTag {
+				content = append(content, c)
+			}
+		}
+	default:
+		return
+	}
+	n.Content = content
+	for _, c := range n.Content {
+		stripComposeMergeTags(c)
+	}
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestMergeCompose validates the docker compose merge rules.
+func TestMergeCompose(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		base, override, want string
+	}{
+		"scalars and new keys": {
+			base: `
+services:
+  web:
+    image: nginx:1.25
+    restart: always
+`,
+			override: `
+services:
+  web:
+    image: nginx:1.27
+  worker:
+    image: app
+`,
+			want: `
+services:
+  web:
+    image: nginx:1.27
+    restart: always
+  worker:
+    image: app
+`,
+		},
+		"command replaced and other lists appended": {
+			base: `
+services:
+  web:
+    command: ["nginx", "-g", "daemon off;"]
+    cap_add: [NET_ADMIN]
+    healthcheck:
+      test: ["CMD", "true"]
+      interval: 10s
+`,
+			override: `
+services:
+  web:
+    command: ["nginx-debug"]
+    cap_add: [SYS_TIME]
+    healthcheck:
+      test: ["CMD", "curl", "-f", "http://localhost"]
+`,
+			want: `
+services:
+  web:
+    command: ["nginx-debug"]
+    cap_add: [NET_ADMIN, SYS_TIME]
+    healthcheck:
+      test: ["CMD", "curl", "-f", "http://localhost"]
+      interval: 10s
+`,
+		},
+		"environment list and mapping merged by key": {
+			base: `
+services:
+  web:
+    environment:
+      - TZ=UTC
+      - LOG_LEVEL=info
+      - DEBUG
+`,
+			override: `
+services:
+  web:
+    environment:
+      LOG_LEVEL: debug
+      PORT: "8080"
+`,
+			want: `
+services:
+  web:
+    environment:
+      TZ: UTC
+      LOG_LEVEL: debug
+      DEBUG: null
+      PORT: "8080"
+`,
+		},
+		"volumes by target and ports unique": {
+			base: `
+services:
+  db:
+    ports: ["5432:5432"]
+    volumes:
+      - data:/var/lib/postgresql/data
+      - ./init:/docker-entrypoint-initdb.d:ro
+`,
+			override: `
+services:
+  db:
+    ports: ["5432:5432", "9187:9187"]
+    volumes:
+      - type: bind
+        source: /srv/pg
+        target: /var/lib/postgresql/data
+`,
+			want: `
+services:
+  db:
+    ports: ["5432:5432", "9187:9187"]
+    volumes:
+      - type: bind
+        source: /srv/pg
+        target: /var/lib/postgresql/data
+      - ./init:/docker-entrypoint-initdb.d:ro
+`,
+		},
+		"secrets by source and depends_on forms": {
+			base: `
+services:
+  web:
+    depends_on: [db]
+    secrets: [api_key]
+`,
+			override: `
+services:
+  web:
+    depends_on:
+      cache:
+        condition: service_healthy
+    secrets:
+      - source: api_key
+        mode: 0400
+`,
+			want: `
+services:
+  web:
+    depends_on:
+      db:
+        condition: service_started
+      cache:
+        condition: service_healthy
+    secrets:
+      - source: api_key
+        mode: 0400
+`,
+		},
+		"reset and override tags": {
+			base: `
+services:
+  web:
+    ports: ["80:80"]
+    labels:
+      a: "1"
+      b: "2"
+`,
+			override: `
+services:
+  web:
+    ports: !reset []
+    labels: !override
+      c: "3"
+`,
+			want: `
+services:
+  web:
+    labels:
+      c: "3"
+`,
+		},
+		"anchors and merge keys expanded": {
+			base: `
+x-defaults: &defaults
+  restart: always
+  logging:
+    driver: json-file
+services:
+  web:
+    <<: *defaults
+    image: nginx
+`,
+			override: `
+services:
+  web:
+    restart: unless-stopped
+`,
+			want: `
+x-defaults:
+  restart: always
+  logging:
+    driver: json-file
+services:
+  web:
+    image: nginx
+    restart: unless-stopped
+    logging:
+      driver: json-file
+`,
+		},
+		"empty override": {
+			base:     "services:\n  web:\n    image: nginx\n",
+			override: "",
+			want:     "services:\n  web:\n    image: nginx\n",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			base, err := parseComposeDocument(tc.base)
+			if err != nil {
+				t.Fatalf("parse base: %v", err)
+			}
+			override, err := parseComposeDocument(tc.override)
+			if err != nil {
+				t.Fatalf("parse override: %v", err)
+			}
+			got, err := encodeComposeDocument(mergeComposeNodes(base, override, nil))
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+			if want := strings.TrimPrefix(tc.want, "\n"); got != want {
+				t.Errorf("merged =\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
+
+// TestParseComposeDocument_GivenInvalidDocument_ReturnsError validates rejected documents.
+func TestParseComposeDocument_GivenInvalidDocument_ReturnsError(t *testing.T) {
+	t.Parallel()
+	for _, input := range []string{"services: [", "- web\n- db\n", "just a string"} {
+		if _, err := parseComposeDocument(input); err == nil {
+			t.Errorf("parseComposeDocument(%q) expected error", input)
+		}
+	}
+}
+
+// TestComposeMergeFunction_GivenBaseAndOverride_WhenCalled_ThenMerged
+// validates the YAML returned by the provider function.
+func TestComposeMergeFunction_GivenBaseAndOverride_WhenCalled_ThenMerged(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  base = <<-EOT
+    services:
+      web:
+        image: nginx:1.25
+        ports: ["80:80"]
+  EOT
+
+  override = <<-EOT
+    services:
+      web:
+        image: nginx:1.27
+  EOT
+}
+
+output "compose" {
+  value = provider::arcane::compose_merge(local.base, local.override)
+}
+`,
+				Check: resource.TestCheckOutput("compose", "services:\n  web:\n    image: nginx:1.27\n    ports: [\"80:80\"]\n"),
+			},
+		},
+	})
+}
+
+// TestComposeMergeFunction_GivenInvalidOverride_WhenCalled_ThenError validates
+// that an invalid document is reported as an error of its argument.
+func TestComposeMergeFunction_GivenInvalidOverride_WhenCalled_ThenError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "bad" {
+  value = provider::arcane::compose_merge("services: {}", "- web")
+}
+`,
+				ExpectError: regexp.MustCompile(`expected a\s+mapping at the top level`),
+			},
+		},
+	})
+}
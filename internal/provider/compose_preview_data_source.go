@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ComposePreviewDataSource{}
+
+// NewComposePreviewDataSource returns a new compose preview data source.
+func NewComposePreviewDataSource() datasource.DataSource {
+	return &ComposePreviewDataSource{}
+}
+
+// ComposePreviewDataSource defines the compose preview data source implementation.
+type ComposePreviewDataSource struct {
+	client *Client
+}
+
+// ComposePreviewDataSourceModel describes the compose preview data source data model.
+type ComposePreviewDataSourceModel struct {
+	EnvironmentID  types.String `tfsdk:"environment_id"`
+	ComposeContent types.String `tfsdk:"compose_content"`
+	EnvContent     types.String `tfsdk:"env_content"`
+	Variables      types.Map    `tfsdk:"variables"`
+	Rendered       types.String `tfsdk:"rendered"`
+}
+
+func (d *ComposePreviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compose_preview"
+}
+
+func (d *ComposePreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to preview Docker Compose content with every variable interpolated, as the
+environment's agent would deploy it, for example to assert on the final configuration in
+` + "`terraform test`" + `. Nothing is created or deployed. Requires an Arcane version that exposes
+compose rendering.
+
+The rendered content contains interpolated values, possibly secrets from ` + "`env_content`" + `, so
+it is marked sensitive; use ` + "`nonsensitive()`" + ` to show it in outputs.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_compose_preview" "webapp" {
+  environment_id  = arcane_environment.production.id
+  compose_content = file("deploy/docker-compose.yml")
+  variables = {
+    TAG = var.webapp_version
+  }
+}
+
+# In a .tftest.hcl file:
+# assert {
+#   condition     = strcontains(data.arcane_compose_preview.webapp.rendered, "image: ghcr.io/example/webapp:${var.webapp_version}")
+#   error_message = "webapp image is not pinned to the release version"
+# }
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment whose agent renders the content.",
+				Required:            true,
+			},
+			"compose_content": schema.StringAttribute{
+				MarkdownDescription: "The Docker Compose file content to render.",
+				Required:            true,
+			},
+			"env_content": schema.StringAttribute{
+				MarkdownDescription: "Optional `.env` content used for variable interpolation.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"variables": schema.MapAttribute{
+				MarkdownDescription: "Variables used for interpolation. They take precedence over values from `env_content`, as shell variables do over a `.env` file.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"rendered": schema.StringAttribute{
+				MarkdownDescription: "The compose content with every variable interpolated, as YAML.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (d *ComposePreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ComposePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data ComposePreviewDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var variables map[string]string
+	if !data.Variables.IsNull() {
+		resp.Diagnostics.Append(data.Variables.ElementsAs(ctx, &variables, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	envClient := d.client.ForEnvironment(data.EnvironmentID.ValueString())
+
+	result, err := envClient.RenderCompose(ctx, &arcane.ComposeRenderRequest{
+		ComposeContent: normalizeLineEndings(data.ComposeContent.ValueString()),
+		EnvContent:     normalizeLineEndings(data.EnvContent.ValueString()),
+		Variables:      variables,
+	})
+	if err != nil {
+		if arcane.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Compose preview not supported",
+				fmt.Sprintf("Environment %q does not expose compose rendering. It may not exist, or this Arcane version does not support it: %s",
+					data.EnvironmentID.ValueString(), err),
+			)
+			return
+		}
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to render compose content")
+		return
+	}
+
+	data.Rendered = types.StringValue(result.Content)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
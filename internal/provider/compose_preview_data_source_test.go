@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestComposePreviewDataSource_GivenVariables_WhenRead_ThenContentInterpolated
+// validates that variables take precedence over env_content and that the
+// interpolated content is returned.
+func TestComposePreviewDataSource_GivenVariables_WhenRead_ThenContentInterpolated(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-render"] = &arcane.Environment{ID: "env-render", Name: "render-env"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testComposePreviewDataSourceConfig(mockServer.URL, "env-render",
+					"services:\n  web:\n    image: nginx:$${TAG}\n    ports:\n      - $PORT:80\n",
+					"TAG=latest\nPORT=8080\n", "1.27"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_compose_preview.test", "rendered",
+						"services:\n  web:\n    image: nginx:1.27\n    ports:\n      - 8080:80\n"),
+				),
+			},
+		},
+	})
+}
+
+// TestComposePreviewDataSource_GivenUnsupportedServer_WhenRead_ThenError
+// validates the error reported when the server has no render endpoint.
+func TestComposePreviewDataSource_GivenUnsupportedServer_WhenRead_ThenError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.InjectFault(MockFault{Path: "/api/environments/env-old/projects/render", Status: 404})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testComposePreviewDataSourceConfig(mockServer.URL, "env-old", "services: {}\n", "", "1.27"),
+				ExpectError: regexp.MustCompile(`Compose preview not supported`),
+			},
+		},
+	})
+}
+
+func testComposePreviewDataSourceConfig(url, envID, content, envContent, tag string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_compose_preview" "test" {
+  environment_id  = %[2]q
+  compose_content = %[3]q
+  env_content     = %[4]q
+  variables = {
+    TAG = %[5]q
+  }
+}
+`, url, envID, content, envContent, tag)
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ComposeValidationDataSource{}
+
+// NewComposeValidationDataSource returns a new compose validation data source.
+func NewComposeValidationDataSource() datasource.DataSource {
+	return &ComposeValidationDataSource{}
+}
+
+// ComposeValidationDataSource defines the compose validation data source implementation.
+type ComposeValidationDataSource struct {
+	client *Client
+}
+
+// ComposeValidationDataSourceModel describes the compose validation data source data model.
+type ComposeValidationDataSourceModel struct {
+	EnvironmentID  types.String `tfsdk:"environment_id"`
+	ComposeContent types.String `tfsdk:"compose_content"`
+	EnvContent     types.String `tfsdk:"env_content"`
+	Valid          types.Bool   `tfsdk:"valid"`
+	Errors         types.List   `tfsdk:"errors"`
+	Warnings       types.List   `tfsdk:"warnings"`
+}
+
+func (d *ComposeValidationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compose_validation"
+}
+
+func (d *ComposeValidationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to validate Docker Compose content with Arcane before anything is deployed.
+
+Validation runs on the environment's agent, so image and feature support match the host the stack
+will run on. Errors and warnings are returned as attributes and also surfaced as warnings in the
+plan output. Use ` + "`valid`" + ` in a precondition to stop the apply on invalid content. Requires an
+Arcane version that exposes compose validation.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_compose_validation" "webapp" {
+  environment_id  = arcane_environment.production.id
+  compose_content = file("deploy/docker-compose.yml")
+}
+
+resource "arcane_project_deployment" "webapp" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+
+  lifecycle {
+    precondition {
+      condition     = data.arcane_compose_validation.webapp.valid
+      error_message = join("\n", data.arcane_compose_validation.webapp.errors)
+    }
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment whose agent validates the content.",
+				Required:            true,
+			},
+			"compose_content": schema.StringAttribute{
+				MarkdownDescription: "The Docker Compose file content to validate.",
+				Required:            true,
+			},
+			"env_content": schema.StringAttribute{
+				MarkdownDescription: "Optional `.env` content used for variable interpolation during validation.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"valid": schema.BoolAttribute{
+				MarkdownDescription: "Whether the content passed validation (no errors).",
+				Computed:            true,
+			},
+			"errors": schema.ListAttribute{
+				MarkdownDescription: "Validation errors.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"warnings": schema.ListAttribute{
+				MarkdownDescription: "Validation warnings (lint findings that do not prevent deployment).",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ComposeValidationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ComposeValidationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data ComposeValidationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envClient := d.client.ForEnvironment(data.EnvironmentID.ValueString())
+
+	result, err := envClient.ValidateCompose(ctx, &arcane.ComposeValidateRequest{
+		ComposeContent: normalizeLineEndings(data.ComposeContent.ValueString()),
+		EnvContent:     normalizeLineEndings(data.EnvContent.ValueString()),
+	})
+	if err != nil {
+		if arcane.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Compose validation not supported",
+				fmt.Sprintf("Environment %q does not expose compose validation. It may not exist, or this Arcane version does not support it: %s",
+					data.EnvironmentID.ValueString(), err),
+			)
+			return
+		}
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to validate compose content")
+		return
+	}
+
+	// A result carrying errors is never valid, whatever the server's flag says.
+	data.Valid = types.BoolValue(result.Valid && len(result.Errors) == 0)
+
+	errorsList, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(result.Errors))
+	resp.Diagnostics.Append(diags...)
+	warningsList, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(result.Warnings))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Errors = errorsList
+	data.Warnings = warningsList
+
+	if len(result.Errors) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Compose content is invalid",
+			fmt.Sprintf("Arcane reported %d validation error(s):\n- %s", len(result.Errors), strings.Join(result.Errors, "\n- ")),
+		)
+	}
+	if len(result.Warnings) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Compose lint warnings",
+			fmt.Sprintf("Arcane reported %d warning(s):\n- %s", len(result.Warnings), strings.Join(result.Warnings, "\n- ")),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// nonNilStrings returns s, or an empty slice if s is nil, so list attributes
+// are empty rather than null.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
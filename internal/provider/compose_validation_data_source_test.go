@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestComposeValidationDataSource_GivenValidContent_WhenRead_ThenValidWithWarnings
+// validates that lint warnings are returned for otherwise valid content.
+func TestComposeValidationDataSource_GivenValidContent_WhenRead_ThenValidWithWarnings(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-lint"] = &arcane.Environment{ID: "env-lint", Name: "lint-env"}
+	mockServer.ValidateCompose = func(content string) arcane.ComposeValidationResult {
+		return arcane.ComposeValidationResult{Valid: true, Warnings: []string{"service web uses the latest tag"}}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testComposeValidationDataSourceConfig(mockServer.URL, "env-lint", "services:\n  web:\n    image: nginx:latest\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_compose_validation.test", "valid", "true"),
+					resource.TestCheckResourceAttr("data.arcane_compose_validation.test", "errors.#", "0"),
+					resource.TestCheckResourceAttr("data.arcane_compose_validation.test", "warnings.#", "1"),
+					resource.TestCheckResourceAttr("data.arcane_compose_validation.test", "warnings.0", "service web uses the latest tag"),
+				),
+			},
+		},
+	})
+}
+
+// TestComposeValidationDataSource_GivenInvalidContent_WhenRead_ThenInvalidWithErrors
+// validates that validation errors are exposed without failing the plan.
+func TestComposeValidationDataSource_GivenInvalidContent_WhenRead_ThenInvalidWithErrors(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-lint"] = &arcane.Environment{ID: "env-lint", Name: "lint-env"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testComposeValidationDataSourceConfig(mockServer.URL, "env-lint", "version: \"3\"\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_compose_validation.test", "valid", "false"),
+					resource.TestCheckResourceAttr("data.arcane_compose_validation.test", "errors.0", "no services defined"),
+				),
+			},
+		},
+	})
+}
+
+func testComposeValidationDataSourceConfig(url, envID, content string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_compose_validation" "test" {
+  environment_id  = %[2]q
+  compose_content = %[3]q
+}
+`, url, envID, content)
+}
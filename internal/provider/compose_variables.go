@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// unresolvedComposeVariables returns the variables, ordered by name, that
+// compose references as ${NAME}, ${NAME?error} or $NAME without a default
+// and that are not in defined. References with a default or an alternative
+// value, ${NAME-default}, ${NAME:-default} and ${NAME:+value}, resolve when
+// the variable is unset, as do escaped $$ and comment lines.
+func unresolvedComposeVariables(compose string, defined map[string]bool) []string {
+	var unresolved []string
+	for _, line := range strings.Split(compose, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		for i := 0; i < len(line); i++ {
+			if line[i] != '$' || i+1 == len(line) {
+				continue
+			}
+			braced := line[i+1] == '{'
+			start := i + 1
+			if braced {
+				start++
+			}
+			end := start
+			for end < len(line) && isVariableNameByte(line[end], end == start) {
+				end++
+			}
+			switch {
+			case line[i+1] == '$':
+				// An escaped $
+				i++
+				continue
+			case end == start:
+				continue
+			}
+			name := line[start:end]
+			i = end - 1
+			required := true
+			if braced && end < len(line) {
+				modifier := strings.TrimPrefix(line[end:], ":")
+				required = !strings.HasPrefix(modifier, "-") && !strings.HasPrefix(modifier, "+")
+				// Skip the default or alternative value, including any
+				// references it nests, up to the closing brace
+				for depth := 1; end < len(line) && depth > 0; end++ {
+					switch line[end] {
+					case '{':
+						depth++
+					case '}':
+						depth--
+					}
+				}
+				i = end - 1
+			}
+			if required && !defined[name] && !slices.Contains(unresolved, name) {
+				unresolved = append(unresolved, name)
+			}
+		}
+	}
+	slices.Sort(unresolved)
+	return unresolved
+}
+
+// isVariableNameByte reports whether b can appear in a compose variable name,
+// at its start if first.
+func isVariableNameByte(b byte, first bool) bool {
+	switch {
+	case b == '_', b >= 'A' && b <= 'Z', b >= 'a' && b <= 'z':
+		return true
+	default:
+		return !first && b >= '0' && b <= '9'
+	}
+}
+
+// envContentKeys returns the names of the variables set by .env content,
+// skipping blank lines and comments and accepting an export prefix.
+func envContentKeys(content string) map[string]bool {
+	keys := map[string]bool{}
+	for _, line := range strings.Split(normalizeLineEndings(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		if key, _, ok := strings.Cut(line, "="); ok {
+			keys[strings.TrimSpace(key)] = true
+		}
+	}
+	return keys
+}
+
+// checkComposeVariablesResolved adds a warning on attr, or an error if strict,
+// when compose references variables that neither env_content nor external
+// set, which Compose would silently replace with empty strings on deploy.
+func checkComposeVariablesResolved(attr path.Path, compose, envContent string, external []types.String, strict bool, diags *diag.Diagnostics) {
+	defined := envContentKeys(envContent)
+	for _, name := range external {
+		defined[name.ValueString()] = true
+	}
+	unresolved := unresolvedComposeVariables(compose, defined)
+	if len(unresolved) == 0 {
+		return
+	}
+	add := diags.AddAttributeWarning
+	if strict {
+		add = diags.AddAttributeError
+	}
+	add(
+		attr,
+		"Unresolved compose variables",
+		fmt.Sprintf("The compose content references variables that env_content does not set, so Compose would replace them "+
+			"with empty strings: %s. Set them in env_content, list them in external_variables if the agent sets them, "+
+			"or give them a default, e.g. ${%s:-}.",
+			strings.Join(unresolved, ", "), unresolved[0]),
+	)
+}
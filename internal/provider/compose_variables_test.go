@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnresolvedComposeVariables(t *testing.T) {
+	t.Parallel()
+
+	defined := map[string]bool{"TAG": true}
+	cases := map[string]struct {
+		compose string
+		want    []string
+	}{
+		"braced":              {compose: "image: nginx:${VERSION}", want: []string{"VERSION"}},
+		"unbraced":            {compose: "image: nginx:$VERSION", want: []string{"VERSION"}},
+		"defined":             {compose: "image: nginx:${TAG}", want: nil},
+		"required with error": {compose: "password: ${DB_PASSWORD:?set a password}", want: []string{"DB_PASSWORD"}},
+		"default":             {compose: "image: nginx:${VERSION:-latest} ${PORT-80}", want: nil},
+		"empty default":       {compose: "debug: ${DEBUG:-}", want: nil},
+		"alternative value":   {compose: "flags: ${DEBUG:+--verbose}", want: nil},
+		"nested default":      {compose: "image: ${IMAGE:-nginx:${VERSION}}", want: nil},
+		"escaped":             {compose: "command: echo $$HOME $${USER}", want: nil},
+		"comment":             {compose: "# image: nginx:${VERSION}\nimage: nginx", want: nil},
+		"sorted and unique":   {compose: "a: ${ZONE}\nb: $APP\nc: ${ZONE}", want: []string{"APP", "ZONE"}},
+		"not a name":          {compose: "price: $5 ${}", want: nil},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if got := unresolvedComposeVariables(tc.compose, defined); !slices.Equal(got, tc.want) {
+				t.Errorf("unresolvedComposeVariables(%q) = %q, want %q", tc.compose, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEnvContentKeys(t *testing.T) {
+	t.Parallel()
+
+	got := envContentKeys("# comment\r\nTAG=1\r\n\r\nexport DB_PASSWORD='secret'\r\n EMPTY =\r\nnot a variable\r\n")
+	for _, key := range []string{"TAG", "DB_PASSWORD", "EMPTY"} {
+		if !got[key] {
+			t.Errorf("envContentKeys() is missing %q", key)
+		}
+	}
+	if len(got) != 3 {
+		t.Errorf("envContentKeys() = %v, want 3 keys", got)
+	}
+}
+
+func TestCheckComposeVariablesResolved(t *testing.T) {
+	t.Parallel()
+
+	compose := "image: nginx:${TAG}\npassword: ${DB_PASSWORD}\n"
+	external := []types.String{types.StringValue("DB_PASSWORD")}
+
+	var diags diag.Diagnostics
+	checkComposeVariablesResolved(path.Root("compose_content"), compose, "TAG=1\n", external, true, &diags)
+	if len(diags) != 0 {
+		t.Errorf("expected no diagnostics when every variable is set, got %v", diags)
+	}
+
+	for _, strict := range []bool{false, true} {
+		var diags diag.Diagnostics
+		checkComposeVariablesResolved(path.Root("compose_content"), compose, "", nil, strict, &diags)
+		if len(diags) != 1 || diags.HasError() != strict || diags[0].Summary() != "Unresolved compose variables" {
+			t.Errorf("strict=%t: expected one diagnostic with error %t, got %v", strict, strict, diags)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Resources scoped to an environment are imported, and for
+// arcane_project_deployment also identified, by "environment_id/child_id".
+// Manual state surgery can leave that ID out of step with the environment_id
+// and child attributes; the checks below run in Read so that API calls never
+// mix the two.
+
+// formatCompositeID joins an environment ID and the ID of an object within it.
+func formatCompositeID(environmentID, childID string) string {
+	return environmentID + "/" + childID
+}
+
+// parseCompositeID splits an "environment_id/child_id" ID. The child ID may
+// itself contain slashes.
+func parseCompositeID(id string) (environmentID, childID string, ok bool) {
+	environmentID, childID, ok = strings.Cut(id, "/")
+	if !ok || environmentID == "" || childID == "" {
+		return "", "", false
+	}
+	return environmentID, childID, true
+}
+
+// checkCompositeID verifies that a composite id matches environmentID and
+// childID. The attributes are authoritative since they come from the
+// configuration: a mismatching or missing id is rebuilt from them with a
+// warning. Attributes lost from state, both or either one, are restored from
+// a well-formed id that agrees with the remaining one. When neither is usable
+// an error is added.
+func checkCompositeID(typeName string, id, environmentID, childID *types.String, diags *diag.Diagnostics) {
+	envOK := environmentID.ValueString() != ""
+	childOK := childID.ValueString() != ""
+	idEnv, idChild, idOK := parseCompositeID(id.ValueString())
+
+	switch {
+	case envOK && childOK:
+		want := formatCompositeID(environmentID.ValueString(), childID.ValueString())
+		if id.ValueString() == want {
+			return
+		}
+		diags.AddWarning(
+			"Inconsistent ID repaired",
+			fmt.Sprintf("%s ID %q did not match its attributes and was replaced with %q. "+
+				"This usually follows manual state edits.", typeName, id.ValueString(), want),
+		)
+		*id = types.StringValue(want)
+
+	case idOK && !envOK && !childOK:
+		diags.AddWarning(
+			"Inconsistent ID repaired",
+			fmt.Sprintf("%s %q was missing its environment and object IDs; they were restored from the ID. "+
+				"This usually follows manual state edits.", typeName, id.ValueString()),
+		)
+		*environmentID = types.StringValue(idEnv)
+		*childID = types.StringValue(idChild)
+
+	case idOK && !envOK && childID.ValueString() == idChild:
+		diags.AddWarning(
+			"Inconsistent ID repaired",
+			fmt.Sprintf("%s %q was missing its environment ID; it was restored from the ID. "+
+				"This usually follows manual state edits.", typeName, id.ValueString()),
+		)
+		*environmentID = types.StringValue(idEnv)
+
+	case idOK && !childOK && environmentID.ValueString() == idEnv:
+		diags.AddWarning(
+			"Inconsistent ID repaired",
+			fmt.Sprintf("%s %q was missing its object ID; it was restored from the ID. "+
+				"This usually follows manual state edits.", typeName, id.ValueString()),
+		)
+		*childID = types.StringValue(idChild)
+
+	default:
+		diags.AddError(
+			"Inconsistent ID",
+			fmt.Sprintf("%s ID %q can't be reconciled with environment %q and object %q. "+
+				"Remove the resource from state and import it again as environment_id/object_id.",
+				typeName, id.ValueString(), environmentID.ValueString(), childID.ValueString()),
+		)
+	}
+}
+
+// checkScopedID verifies the id of an object that is stored without its
+// environment (e.g. a GitOps sync ID). An id holding an import-style
+// "environment_id/child_id" value for the same environment is repaired to the
+// child ID with a warning; one for another environment is an error.
+func checkScopedID(typeName string, id *types.String, environmentID string, diags *diag.Diagnostics) {
+	if !strings.Contains(id.ValueString(), "/") {
+		return
+	}
+
+	idEnv, idChild, ok := parseCompositeID(id.ValueString())
+	if !ok || idEnv != environmentID {
+		diags.AddError(
+			"Inconsistent ID",
+			fmt.Sprintf("%s ID %q doesn't belong to environment %q. "+
+				"Remove the resource from state and import it again as environment_id/object_id.",
+				typeName, id.ValueString(), environmentID),
+		)
+		return
+	}
+
+	diags.AddWarning(
+		"Inconsistent ID repaired",
+		fmt.Sprintf("%s ID %q included its environment and was replaced with %q. "+
+			"This usually follows manual state edits.", typeName, id.ValueString(), idChild),
+	)
+	*id = types.StringValue(idChild)
+}
+
+// importByNamePrefix marks an import ID as an object name rather than an ID,
+// for objects whose IDs aren't shown in the Arcane UI.
+const importByNamePrefix = "name:"
+
+// parseNameImportID returns the name of a "name:<value>" import ID.
+func parseNameImportID(id string) (name string, ok bool) {
+	name, ok = strings.CutPrefix(id, importByNamePrefix)
+	return name, ok && name != ""
+}
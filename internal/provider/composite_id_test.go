@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckCompositeID(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		id          string
+		envID       string
+		childID     string
+		wantID      string
+		wantEnvID   string
+		wantChildID string
+		wantWarning bool
+		wantError   bool
+	}{
+		{
+			name: "consistent", id: "env-1/proj-1", envID: "env-1", childID: "proj-1",
+			wantID: "env-1/proj-1", wantEnvID: "env-1", wantChildID: "proj-1",
+		},
+		{
+			name: "mismatched id", id: "env-old/proj-1", envID: "env-1", childID: "proj-1",
+			wantID: "env-1/proj-1", wantEnvID: "env-1", wantChildID: "proj-1", wantWarning: true,
+		},
+		{
+			name: "missing id", id: "", envID: "env-1", childID: "proj-1",
+			wantID: "env-1/proj-1", wantEnvID: "env-1", wantChildID: "proj-1", wantWarning: true,
+		},
+		{
+			name: "missing attributes", id: "env-1/proj-1",
+			wantID: "env-1/proj-1", wantEnvID: "env-1", wantChildID: "proj-1", wantWarning: true,
+		},
+		{
+			name: "child with slash", id: "env-1/stacks/web", envID: "env-1", childID: "stacks/web",
+			wantID: "env-1/stacks/web", wantEnvID: "env-1", wantChildID: "stacks/web",
+		},
+		{
+			name: "missing environment", id: "env-1/proj-1", childID: "proj-1",
+			wantID: "env-1/proj-1", wantEnvID: "env-1", wantChildID: "proj-1", wantWarning: true,
+		},
+		{
+			name: "missing child", id: "env-1/proj-1", envID: "env-1",
+			wantID: "env-1/proj-1", wantEnvID: "env-1", wantChildID: "proj-1", wantWarning: true,
+		},
+		{name: "nothing usable", id: "proj-1", wantError: true},
+		{name: "child disagrees with id", id: "env-1/proj-1", childID: "proj-2", wantError: true},
+		{name: "one attribute missing", id: "env-old/proj-1", envID: "env-1", wantError: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			id, envID, childID := types.StringValue(tc.id), types.StringValue(tc.envID), types.StringValue(tc.childID)
+
+			var diags diag.Diagnostics
+			checkCompositeID("arcane_project_deployment", &id, &envID, &childID, &diags)
+
+			if got := diags.HasError(); got != tc.wantError {
+				t.Fatalf("HasError = %t, want %t: %v", got, tc.wantError, diags)
+			}
+			if tc.wantError {
+				return
+			}
+			if got := diags.WarningsCount() > 0; got != tc.wantWarning {
+				t.Errorf("warning = %t, want %t: %v", got, tc.wantWarning, diags)
+			}
+			if id.ValueString() != tc.wantID || envID.ValueString() != tc.wantEnvID || childID.ValueString() != tc.wantChildID {
+				t.Errorf("got %q (%q, %q), want %q (%q, %q)",
+					id.ValueString(), envID.ValueString(), childID.ValueString(), tc.wantID, tc.wantEnvID, tc.wantChildID)
+			}
+		})
+	}
+}
+
+func TestCheckScopedID(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		id          string
+		wantID      string
+		wantWarning bool
+		wantError   bool
+	}{
+		{name: "plain id", id: "sync-1", wantID: "sync-1"},
+		{name: "import-style id", id: "env-1/sync-1", wantID: "sync-1", wantWarning: true},
+		{name: "other environment", id: "env-2/sync-1", wantError: true},
+		{name: "malformed", id: "/sync-1", wantError: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			id := types.StringValue(tc.id)
+
+			var diags diag.Diagnostics
+			checkScopedID("arcane_gitops_sync", &id, "env-1", &diags)
+
+			if got := diags.HasError(); got != tc.wantError {
+				t.Fatalf("HasError = %t, want %t: %v", got, tc.wantError, diags)
+			}
+			if tc.wantError {
+				return
+			}
+			if got := diags.WarningsCount() > 0; got != tc.wantWarning {
+				t.Errorf("warning = %t, want %t: %v", got, tc.wantWarning, diags)
+			}
+			if id.ValueString() != tc.wantID {
+				t.Errorf("id = %q, want %q", id.ValueString(), tc.wantID)
+			}
+		})
+	}
+}
+
+func TestParseNameImportID(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		id       string
+		wantName string
+		wantOK   bool
+	}{
+		{id: "name:ghcr", wantName: "ghcr", wantOK: true},
+		{id: "name:with:colon", wantName: "with:colon", wantOK: true},
+		{id: "name:", wantOK: false},
+		{id: "reg-1", wantOK: false},
+	}
+
+	for _, tc := range cases {
+		name, ok := parseNameImportID(tc.id)
+		if ok != tc.wantOK || (ok && name != tc.wantName) {
+			t.Errorf("parseNameImportID(%q) = %q, %t; want %q, %t", tc.id, name, ok, tc.wantName, tc.wantOK)
+		}
+	}
+}
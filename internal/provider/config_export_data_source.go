@@ -0,0 +1,349 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &ConfigExportDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ConfigExportDataSource{}
+)
+
+// Object kinds of the include attribute.
+const (
+	configExportEnvironment         = "environment"
+	configExportGitOpsSyncs         = "gitops_syncs"
+	configExportGitRepositories     = "git_repositories"
+	configExportContainerRegistries = "container_registries"
+)
+
+var configExportKinds = []string{configExportEnvironment, configExportGitOpsSyncs, configExportGitRepositories, configExportContainerRegistries}
+
+// Values of the format attribute.
+var configExportFormats = []string{"yaml", "json"}
+
+// NewConfigExportDataSource returns a new configuration export data source.
+func NewConfigExportDataSource() datasource.DataSource {
+	return &ConfigExportDataSource{}
+}
+
+// ConfigExportDataSource defines the configuration export data source implementation.
+type ConfigExportDataSource struct {
+	client *Client
+}
+
+// ConfigExportDataSourceModel describes the configuration export data source data model.
+type ConfigExportDataSourceModel struct {
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	Include       types.List   `tfsdk:"include"`
+	Format        types.String `tfsdk:"format"`
+	Content       types.String `tfsdk:"content"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+}
+
+// configExport is the exported document. Timestamps, sync results and
+// secrets are left out so that the document only changes with the
+// configuration.
+type configExport struct {
+	Environment         *configExportEnvironmentEntry `yaml:"environment,omitempty" json:"environment,omitempty"`
+	GitOpsSyncs         []configExportSyncEntry       `yaml:"gitops_syncs,omitempty" json:"gitops_syncs,omitempty"`
+	GitRepositories     []configExportRepositoryEntry `yaml:"git_repositories,omitempty" json:"git_repositories,omitempty"`
+	ContainerRegistries []configExportRegistryEntry   `yaml:"container_registries,omitempty" json:"container_registries,omitempty"`
+}
+
+type configExportEnvironmentEntry struct {
+	ID          string `yaml:"id" json:"id"`
+	Name        string `yaml:"name" json:"name"`
+	APIURL      string `yaml:"api_url,omitempty" json:"api_url,omitempty"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	UseAPIKey   bool   `yaml:"use_api_key" json:"use_api_key"`
+}
+
+type configExportSyncEntry struct {
+	ID           string `yaml:"id" json:"id"`
+	RepositoryID string `yaml:"repository_id" json:"repository_id"`
+	Path         string `yaml:"path,omitempty" json:"path,omitempty"`
+	Branch       string `yaml:"branch,omitempty" json:"branch,omitempty"`
+	ComposeFile  string `yaml:"compose_file,omitempty" json:"compose_file,omitempty"`
+	SyncInterval string `yaml:"sync_interval,omitempty" json:"sync_interval,omitempty"`
+	AutoSync     bool   `yaml:"auto_sync" json:"auto_sync"`
+}
+
+type configExportRepositoryEntry struct {
+	ID       string `yaml:"id" json:"id"`
+	Name     string `yaml:"name" json:"name"`
+	URL      string `yaml:"url" json:"url"`
+	Branch   string `yaml:"branch,omitempty" json:"branch,omitempty"`
+	AuthType string `yaml:"auth_type,omitempty" json:"auth_type,omitempty"`
+}
+
+type configExportRegistryEntry struct {
+	ID       string `yaml:"id" json:"id"`
+	Name     string `yaml:"name" json:"name"`
+	URL      string `yaml:"url" json:"url"`
+	AuthType string `yaml:"auth_type,omitempty" json:"auth_type,omitempty"`
+	Username string `yaml:"username,omitempty" json:"username,omitempty"`
+}
+
+func (d *ConfigExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_export"
+}
+
+func (d *ConfigExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to export the configuration of an environment, its GitOps syncs and git
+repositories and the container registries as a normalized YAML or JSON document, e.g. to commit
+it to Git for audit and backup and diff it against earlier exports.
+
+The document only changes with the configuration: objects are ordered by name (syncs by path),
+sync intervals are normalized, and timestamps, sync results and secrets such as registry
+passwords, repository credentials and access tokens are left out.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_config_export" "production" {
+  environment_id = arcane_environment.production.id
+}
+
+resource "local_file" "production_export" {
+  filename = "${path.module}/exports/production.yaml"
+  content  = data.arcane_config_export.production.content
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment to export.",
+				Required:            true,
+			},
+			"include": schema.ListAttribute{
+				MarkdownDescription: "The kinds of objects to export: `environment`, `gitops_syncs`, `git_repositories` (those the syncs deploy from) " +
+					"and `container_registries`. Defaults to all of them.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "The format of `content`, `yaml` or `json`. Defaults to `yaml`.",
+				Optional:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The exported document.",
+				Computed:            true,
+			},
+			"content_sha256": schema.StringAttribute{
+				MarkdownDescription: "The hex-encoded SHA-256 hash of `content`, e.g. to detect changes since the last export.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ConfigExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ConfigExportDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data ConfigExportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Format.IsNull() && !data.Format.IsUnknown() && !slices.Contains(configExportFormats, data.Format.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("format"),
+			"Invalid format",
+			fmt.Sprintf("Expected one of %s, got %q.", strings.Join(configExportFormats, ", "), data.Format.ValueString()),
+		)
+	}
+	if data.Include.IsUnknown() {
+		return
+	}
+	var include []types.String
+	resp.Diagnostics.Append(data.Include.ElementsAs(ctx, &include, false)...)
+	for _, kind := range include {
+		if !kind.IsUnknown() && !slices.Contains(configExportKinds, kind.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("include"),
+				"Invalid include",
+				fmt.Sprintf("Expected one of %s, got %q.", strings.Join(configExportKinds, ", "), kind.ValueString()),
+			)
+		}
+	}
+}
+
+func (d *ConfigExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data ConfigExportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	include := configExportKinds
+	if !data.Include.IsNull() {
+		include = nil
+		resp.Diagnostics.Append(data.Include.ElementsAs(ctx, &include, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	environmentID := data.EnvironmentID.ValueString()
+	var export configExport
+
+	if slices.Contains(include, configExportEnvironment) {
+		env, err := d.client.GetEnvironment(ctx, environmentID)
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read environment")
+			return
+		}
+		export.Environment = &configExportEnvironmentEntry{
+			ID:          env.ID,
+			Name:        env.Name,
+			APIURL:      env.APIURL,
+			Description: env.Description,
+			UseAPIKey:   env.UseAPIKey,
+		}
+	}
+
+	var syncs []arcane.GitOpsSync
+	if slices.Contains(include, configExportGitOpsSyncs) || slices.Contains(include, configExportGitRepositories) {
+		var err error
+		syncs, err = d.client.ForEnvironment(environmentID).ListGitOpsSyncs(ctx)
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list GitOps syncs")
+			return
+		}
+	}
+	if slices.Contains(include, configExportGitOpsSyncs) {
+		for _, sync := range sortedGitOpsSyncs(syncs) {
+			export.GitOpsSyncs = append(export.GitOpsSyncs, configExportSyncEntry{
+				ID:           sync.ID,
+				RepositoryID: sync.RepositoryID,
+				Path:         sync.Path,
+				Branch:       sync.Branch,
+				ComposeFile:  sync.ComposeFile,
+				SyncInterval: normalizedSyncInterval(sync.SyncInterval),
+				AutoSync:     sync.AutoSync,
+			})
+		}
+	}
+
+	if slices.Contains(include, configExportGitRepositories) {
+		repositories, err := d.client.ListGitRepositories(ctx)
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list git repositories")
+			return
+		}
+		for _, repository := range sortedByName(repositories, func(r arcane.GitRepository) string { return r.Name }) {
+			if !slices.ContainsFunc(syncs, func(s arcane.GitOpsSync) bool { return s.RepositoryID == repository.ID }) {
+				continue
+			}
+			export.GitRepositories = append(export.GitRepositories, configExportRepositoryEntry{
+				ID:       repository.ID,
+				Name:     repository.Name,
+				URL:      repository.URL,
+				Branch:   repository.Branch,
+				AuthType: repository.AuthType,
+			})
+		}
+	}
+
+	if slices.Contains(include, configExportContainerRegistries) {
+		registries, err := d.client.ListContainerRegistries(ctx)
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list container registries")
+			return
+		}
+		for _, registry := range sortedByName(registries, func(r arcane.ContainerRegistry) string { return r.Name }) {
+			export.ContainerRegistries = append(export.ContainerRegistries, configExportRegistryEntry{
+				ID:       registry.ID,
+				Name:     registry.Name,
+				URL:      registry.URL,
+				AuthType: registry.AuthType,
+				Username: registry.Username,
+			})
+		}
+	}
+
+	content, err := encodeConfigExport(&export, data.Format.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to encode export", err.Error())
+		return
+	}
+	data.Content = types.StringValue(content)
+	data.ContentSHA256 = types.StringValue(sha256Hex([]byte(content)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// normalizedSyncInterval returns a sync interval in canonical Go duration
+// notation, so that 300, PT5M and 5m0s are all exported as 5m. Intervals
+// that don't parse are exported as reported.
+func normalizedSyncInterval(interval string) string {
+	if interval == "" {
+		return ""
+	}
+	d, err := parseFlexibleDuration(interval)
+	if err != nil {
+		return interval
+	}
+	return formatCanonicalDuration(d)
+}
+
+// encodeConfigExport returns export as YAML indented by two spaces, or as
+// indented JSON when format is json. Both end with a newline.
+func encodeConfigExport(export *configExport, format string) (string, error) {
+	if format == "json" {
+		b, err := json.MarshalIndent(export, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(b) + "\n", nil
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(export); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestConfigExportDataSource_GivenEnvironment_WhenRead_ThenNormalizedYAML
+// validates that the environment, its syncs, the repositories they deploy
+// from and the registries are exported in order, without timestamps or
+// secrets.
+func TestConfigExportDataSource_GivenEnvironment_WhenRead_ThenNormalizedYAML(t *testing.T) {
+	mockServer := newGitOpsSyncsMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-gitops"].APIURL = "http://agent:3553"
+	mockServer.GitRepositories["repo-infra"] = &arcane.GitRepository{
+		ID: "repo-infra", Name: "infra", URL: "https://git.example.com/infra.git", AuthType: "http", Credentials: "s3cret",
+	}
+	mockServer.GitRepositories["repo-unused"] = &arcane.GitRepository{ID: "repo-unused", Name: "unused", URL: "https://git.example.com/unused.git"}
+	mockServer.ContainerRegistries["reg-ghcr"] = &arcane.ContainerRegistry{
+		ID: "reg-ghcr", Name: "ghcr.io", URL: "ghcr.io", AuthType: "basic", Username: "deploy", Password: "s3cret",
+	}
+
+	want := `environment:
+  id: env-gitops
+  name: gitops-env
+  api_url: http://agent:3553
+  use_api_key: false
+gitops_syncs:
+  - id: sync-api
+    repository_id: repo-infra
+    path: apps/api
+    branch: main
+    compose_file: docker-compose.yml
+    auto_sync: false
+  - id: sync-webapp
+    repository_id: repo-infra
+    path: apps/webapp
+    branch: main
+    compose_file: docker-compose.yml
+    sync_interval: 5m
+    auto_sync: true
+  - id: sync-worker
+    repository_id: repo-worker
+    path: worker
+    branch: develop
+    compose_file: compose.yml
+    auto_sync: false
+git_repositories:
+  - id: repo-infra
+    name: infra
+    url: https://git.example.com/infra.git
+    auth_type: http
+container_registries:
+  - id: reg-ghcr
+    name: ghcr.io
+    url: ghcr.io
+    auth_type: basic
+    username: deploy
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfigExportDataSourceConfig(mockServer.URL, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_config_export.test", "content", want),
+					resource.TestCheckResourceAttr("data.arcane_config_export.test", "content_sha256", sha256Hex([]byte(want))),
+				),
+			},
+		},
+	})
+}
+
+// TestConfigExportDataSource_GivenIncludeAndJSON_WhenRead_ThenSelectedObjects
+// validates that include selects the exported objects and format selects
+// JSON.
+func TestConfigExportDataSource_GivenIncludeAndJSON_WhenRead_ThenSelectedObjects(t *testing.T) {
+	mockServer := newGitOpsSyncsMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfigExportDataSourceConfig(mockServer.URL, `
+  include = ["environment"]
+  format  = "json"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_config_export.test", "content",
+						"{\n  \"environment\": {\n    \"id\": \"env-gitops\",\n    \"name\": \"gitops-env\",\n    \"use_api_key\": false\n  }\n}\n"),
+				),
+			},
+		},
+	})
+}
+
+// TestConfigExportDataSource_GivenInvalidConfig_WhenValidated_ThenError
+// validates that unknown object kinds and formats are rejected.
+func TestConfigExportDataSource_GivenInvalidConfig_WhenValidated_ThenError(t *testing.T) {
+	mockServer := newGitOpsSyncsMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfigExportDataSourceConfig(mockServer.URL, `include = ["projects"]`),
+				ExpectError: regexp.MustCompile(`Invalid include`),
+			},
+			{
+				Config:      testConfigExportDataSourceConfig(mockServer.URL, `format = "toml"`),
+				ExpectError: regexp.MustCompile(`Invalid format`),
+			},
+		},
+	})
+}
+
+func TestNormalizedSyncInterval(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"":        "",
+		"5m":      "5m",
+		"5m0s":    "5m",
+		"300":     "5m",
+		"PT1H":    "1h",
+		"weekly":  "weekly",
+		"1h30m0s": "1h30m",
+	}
+	for in, want := range cases {
+		if got := normalizedSyncInterval(in); got != want {
+			t.Errorf("normalizedSyncInterval(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func testConfigExportDataSourceConfig(url, extra string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_config_export" "test" {
+  environment_id = "env-gitops"
+  %[2]s
+}
+`, url, extra)
+}
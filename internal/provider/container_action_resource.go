@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ContainerActionResource{}
+	_ resource.ResourceWithModifyPlan     = &ContainerActionResource{}
+	_ resource.ResourceWithValidateConfig = &ContainerActionResource{}
+)
+
+// NewContainerActionResource returns a new container action resource.
+func NewContainerActionResource() resource.Resource {
+	return &ContainerActionResource{}
+}
+
+// ContainerActionResource defines the container action resource implementation.
+type ContainerActionResource struct {
+	client *Client
+}
+
+// ContainerActionResourceModel describes the container action resource data model.
+type ContainerActionResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	ContainerID   types.String `tfsdk:"container_id"`
+	Action        types.String `tfsdk:"action"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	PerformedAt   types.String `tfsdk:"performed_at"`
+	APIKeyAlias   types.String `tfsdk:"api_key_alias"`
+}
+
+func (r *ContainerActionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_container_action"
+}
+
+func (r *ContainerActionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Starts, stops or restarts a single container of a project, e.g. to restart a service after a
+configuration file it reads changed, without redeploying the whole project.
+
+The action runs when the resource is created. Changing ` + "`triggers`" + ` replaces the resource,
+which runs the action again. Destroying the resource only removes it from state; the
+container is left as it is.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_container" "nginx" {
+  environment_id = arcane_environment.production.id
+  name           = "webapp-nginx-1"
+}
+
+resource "arcane_project_file" "nginx_conf" {
+  environment_id = arcane_environment.production.id
+  project_id     = arcane_project.webapp.id
+  path           = "nginx/nginx.conf"
+  content        = file("${path.module}/nginx.conf")
+}
+
+resource "arcane_container_action" "reload_nginx" {
+  environment_id = arcane_environment.production.id
+  container_id   = data.arcane_container.nginx.id
+  action         = "restart"
+
+  # Restart nginx whenever its configuration changes
+  triggers = {
+    config = sha256(arcane_project_file.nginx_conf.content)
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"api_key_alias": apiKeyAliasAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the container the action ran on.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment containing the container. Changing this runs the action again.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"container_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the container. Changing this runs the action on the new container.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "The action to run: `start`, `stop` or `restart`. Changing this runs the new action.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that, when changed, replace the resource and run the action again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"performed_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp (RFC3339) of when the action ran.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ContainerActionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *ContainerActionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ContainerActionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Action.IsNull() && !data.Action.IsUnknown() && !slices.Contains(arcane.ContainerActions, data.Action.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("action"),
+			"Invalid action",
+			fmt.Sprintf("Expected one of %s, got %q.", strings.Join(arcane.ContainerActions, ", "), data.Action.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan rejects environments excluded by the provider's
+// allowed_environments or denied_environments.
+func (r *ContainerActionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkPlannedEnvironmentAllowed(ctx, r.client, req, &resp.Diagnostics)
+}
+
+func (r *ContainerActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
+	var data ContainerActionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
+	if err := envClient.ContainerAction(ctx, data.ContainerID.ValueString(), data.Action.ValueString()); err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, fmt.Sprintf("Failed to %s container", data.Action.ValueString()))
+		return
+	}
+
+	data.ID = data.ContainerID
+	data.PerformedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ContainerActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
+	var data ContainerActionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The action left nothing to read back, so only check that the container
+	// still exists. A container recreated by a redeploy has a new ID, and the
+	// action is planned again for it.
+	_, err := r.client.ForEnvironment(data.EnvironmentID.ValueString()).GetContainer(ctx, data.ContainerID.ValueString())
+	if err != nil {
+		if arcane.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read container")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ContainerActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
+	// Every configurable attribute other than api_key_alias requires
+	// replacement, so there is nothing to update in place.
+	var data ContainerActionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ContainerActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The action can't be undone, and undoing it (e.g. stopping a container
+	// that was started) would surprise more often than help. The resource is
+	// only removed from state.
+}
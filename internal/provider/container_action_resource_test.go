@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// newContainerActionMockServer returns a mock server with a running nginx
+// container in env-web.
+func newContainerActionMockServer() *MockServer {
+	ms := NewMockServer()
+	ms.Environments["env-web"] = &arcane.Environment{ID: "env-web", Name: "web-env"}
+	ms.AddContainers("env-web", "proj-web", []arcane.ContainerDetail{
+		{ID: "c-nginx", Name: "webapp-nginx-1", Status: "running"},
+	})
+	return ms
+}
+
+// TestContainerActionResource_GivenRestart_WhenTriggersChange_ThenRestartedAgain
+// validates that the action runs on create and again each time triggers change,
+// and not when nothing changed.
+func TestContainerActionResource_GivenRestart_WhenTriggersChange_ThenRestartedAgain(t *testing.T) {
+	mockServer := newContainerActionMockServer()
+	defer mockServer.Close()
+
+	const restartPath = "/api/environments/env-web/containers/c-nginx/restart"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testContainerActionResourceConfig(mockServer.URL, "restart", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_container_action.test", "id", "c-nginx"),
+					resource.TestCheckResourceAttrSet("arcane_container_action.test", "performed_at"),
+					mockServer.CheckRequestCount(http.MethodPost, restartPath, 1),
+				),
+			},
+			{
+				Config:   testContainerActionResourceConfig(mockServer.URL, "restart", "v1"),
+				PlanOnly: true,
+			},
+			{
+				Config: testContainerActionResourceConfig(mockServer.URL, "restart", "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					mockServer.CheckRequestCount(http.MethodPost, restartPath, 2),
+					func(*terraform.State) error {
+						if got := mockServer.Containers["env-web"]["proj-web"][0].RestartCount; got != 2 {
+							return fmt.Errorf("expected the container to be restarted twice, got %d", got)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// TestContainerActionResource_GivenStop_WhenApplied_ThenContainerStopped
+// validates the stop action and that destroying the resource leaves the
+// container stopped.
+func TestContainerActionResource_GivenStop_WhenApplied_ThenContainerStopped(t *testing.T) {
+	mockServer := newContainerActionMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testContainerActionResourceConfig(mockServer.URL, "stop", "v1"),
+				Check: func(*terraform.State) error {
+					if got := mockServer.Containers["env-web"]["proj-web"][0].Status; got != "exited" {
+						return fmt.Errorf("expected the container to be exited, got %q", got)
+					}
+					return nil
+				},
+			},
+		},
+		CheckDestroy: func(*terraform.State) error {
+			if got := mockServer.Containers["env-web"]["proj-web"][0].Status; got != "exited" {
+				return fmt.Errorf("expected destroy to leave the container exited, got %q", got)
+			}
+			return nil
+		},
+	})
+}
+
+// TestContainerActionResource_GivenUnknownAction_WhenPlanned_ThenError
+// validates that only start, stop and restart are accepted.
+func TestContainerActionResource_GivenUnknownAction_WhenPlanned_ThenError(t *testing.T) {
+	mockServer := newContainerActionMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testContainerActionResourceConfig(mockServer.URL, "pause", "v1"),
+				ExpectError: regexp.MustCompile(`Expected one of start, stop, restart`),
+			},
+		},
+	})
+}
+
+// TestContainerActionResource_GivenContainerRemoved_WhenRefreshed_ThenPlannedAgain
+// validates that the action is planned again when its container is gone.
+func TestContainerActionResource_GivenContainerRemoved_WhenRefreshed_ThenPlannedAgain(t *testing.T) {
+	mockServer := newContainerActionMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testContainerActionResourceConfig(mockServer.URL, "restart", "v1"),
+			},
+			{
+				PreConfig: func() {
+					mockServer.Containers["env-web"]["proj-web"] = nil
+				},
+				Config:             testContainerActionResourceConfig(mockServer.URL, "restart", "v1"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testContainerActionResourceConfig(url, action, trigger string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_container_action" "test" {
+  environment_id = "env-web"
+  container_id   = "c-nginx"
+  action         = %[2]q
+
+  triggers = {
+    config = %[3]q
+  }
+}
+`, url, action, trigger)
+}
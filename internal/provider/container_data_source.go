@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -10,11 +11,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &ContainerDataSource{}
+var (
+	_ datasource.DataSource                   = &ContainerDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ContainerDataSource{}
+)
 
 // NewContainerDataSource returns a new container data source.
 func NewContainerDataSource() datasource.DataSource {
@@ -23,7 +28,7 @@ func NewContainerDataSource() datasource.DataSource {
 
 // ContainerDataSource defines the container data source implementation.
 type ContainerDataSource struct {
-	client *client.Client
+	client *Client
 }
 
 // ContainerDataSourceModel describes the container data source data model.
@@ -36,8 +41,26 @@ type ContainerDataSourceModel struct {
 	Status        types.String `tfsdk:"status"`
 	Health        types.String `tfsdk:"health"`
 	Ports         types.List   `tfsdk:"ports"`
+	Labels        types.Map    `tfsdk:"labels"`
+	IncludeEnv    types.Bool   `tfsdk:"include_env"`
+	Env           types.Map    `tfsdk:"env"`
+	RestartCount  types.Int64  `tfsdk:"restart_count"`
+	StartedAt     types.String `tfsdk:"started_at"`
+	WaitForStatus types.String `tfsdk:"wait_for_status"`
+	WaitTimeout   types.String `tfsdk:"wait_timeout"`
 }
 
+// Descriptions of the container attributes shared with arcane_project_status.
+const (
+	containerLabelsDescription = "The container labels. Null when the provider's `redact_runtime_details` is set."
+	containerEnvDescription    = "The container environment variables, as a map. Null unless `include_env` is set, " +
+		"and always null when the provider's `redact_runtime_details` is set."
+	includeEnvDescription = "Read container environment variables into `env`. They often hold secrets, which then end " +
+		"up in state, so this defaults to `false`."
+	restartCountDescription = "How many times Docker has restarted the container."
+	startedAtDescription    = "When the container last started, as an RFC 3339 timestamp in UTC. Null if it never started."
+)
+
 func (d *ContainerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_container"
 }
@@ -73,6 +96,21 @@ data "arcane_container" "app" {
   id             = "abc123"
 }
 ` + "```" + `
+
+## Waiting for a Container
+
+With ` + "`wait_for_status`" + ` set, reading the data source blocks until the container is running
+or healthy, so resources that reference it are only created once it is, without a
+deployment resource to wait on:
+
+` + "```hcl" + `
+data "arcane_container" "postgres" {
+  environment_id  = arcane_environment.production.id
+  name            = "postgres"
+  wait_for_status = "healthy"
+  wait_timeout    = "5m"
+}
+` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -106,7 +144,7 @@ data "arcane_container" "app" {
 				Computed:            true,
 			},
 			"ports": schema.ListNestedAttribute{
-				MarkdownDescription: "Port mappings for the container.",
+				MarkdownDescription: "Port mappings for the container. Null when the provider's `redact_runtime_details` is set.",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -125,6 +163,39 @@ data "arcane_container" "app" {
 					},
 				},
 			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: containerLabelsDescription,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"include_env": schema.BoolAttribute{
+				MarkdownDescription: includeEnvDescription,
+				Optional:            true,
+			},
+			"env": schema.MapAttribute{
+				MarkdownDescription: containerEnvDescription,
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+			"restart_count": schema.Int64Attribute{
+				MarkdownDescription: restartCountDescription,
+				Computed:            true,
+			},
+			"started_at": schema.StringAttribute{
+				MarkdownDescription: startedAtDescription,
+				Computed:            true,
+			},
+			"wait_for_status": schema.StringAttribute{
+				MarkdownDescription: "Wait until the container reaches this status before returning: `running`, or `healthy` for a " +
+					"passing health check (or running, for containers without a health check). Reading fails if it " +
+					"doesn't within `wait_timeout`.",
+				Optional: true,
+			},
+			"wait_timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long `wait_for_status` waits, as a Go duration (e.g. `90s`). Defaults to `%s`.", formatCanonicalDuration(defaultContainerWaitTimeout)),
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -134,11 +205,11 @@ func (d *ContainerDataSource) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	c, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
 		)
 		return
 	}
@@ -146,7 +217,20 @@ func (d *ContainerDataSource) Configure(ctx context.Context, req datasource.Conf
 	d.client = c
 }
 
+func (d *ContainerDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data ContainerDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateContainerWait(&data, &resp.Diagnostics)
+}
+
 func (d *ContainerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
 	var data ContainerDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -156,13 +240,13 @@ func (d *ContainerDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	envClient := d.client.ForEnvironment(data.EnvironmentID.ValueString())
 
-	var container *client.ContainerDetail
+	var container *arcane.ContainerDetail
 
 	switch {
 	case !data.ID.IsNull() && !data.ID.IsUnknown():
 		c, err := envClient.GetContainer(ctx, data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Failed to get container by ID", err.Error())
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to get container by ID")
 			return
 		}
 		container = c
@@ -170,7 +254,7 @@ func (d *ContainerDataSource) Read(ctx context.Context, req datasource.ReadReque
 	case !data.Name.IsNull() && !data.Name.IsUnknown():
 		c, err := envClient.GetContainerByName(ctx, data.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Failed to get container by name", err.Error())
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to get container by name")
 			return
 		}
 		container = c
@@ -183,6 +267,14 @@ func (d *ContainerDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	if !data.WaitForStatus.IsNull() {
+		var ok bool
+		container, ok = waitForContainerStatus(ctx, envClient, container, data.WaitForStatus.ValueString(), containerWaitTimeout(data.WaitTimeout), &resp.Diagnostics)
+		if !ok {
+			return
+		}
+	}
+
 	// Set all fields from the container response
 	data.ID = types.StringValue(container.ID)
 	data.Name = types.StringValue(container.Name)
@@ -200,29 +292,14 @@ func (d *ContainerDataSource) Read(ctx context.Context, req datasource.ReadReque
 		data.Health = types.StringValue("")
 	}
 
-	// Build ports list
-	if len(container.Ports) > 0 {
-		portValues := make([]attr.Value, len(container.Ports))
-		for i, p := range container.Ports {
-			portObj, diags := types.ObjectValue(containerPortObjectType.AttrTypes, map[string]attr.Value{
-				"host_port":      types.Int64Value(int64(p.HostPort)),
-				"container_port": types.Int64Value(int64(p.ContainerPort)),
-				"protocol":       types.StringValue(p.Protocol),
-			})
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			portValues[i] = portObj
-		}
-		var portsDiags diag.Diagnostics
-		data.Ports, portsDiags = types.ListValue(containerPortObjectType, portValues)
-		resp.Diagnostics.Append(portsDiags...)
-	} else {
-		emptyList, diags := types.ListValue(containerPortObjectType, []attr.Value{})
-		resp.Diagnostics.Append(diags...)
-		data.Ports = emptyList
-	}
+	var portsDiags diag.Diagnostics
+	data.Ports, portsDiags = containerPortsValue(container.Ports, d.client.RedactRuntimeDetails())
+	resp.Diagnostics.Append(portsDiags...)
+
+	data.Labels = containerLabelsValue(container.Labels, d.client.RedactRuntimeDetails())
+	data.Env = containerEnvValue(*container, includeEnv(data.IncludeEnv, d.client.RedactRuntimeDetails(), &resp.Diagnostics))
+	data.RestartCount = types.Int64Value(int64(container.RestartCount))
+	data.StartedAt = containerStartedAtValue(*container)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -230,3 +307,81 @@ func (d *ContainerDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// containerPortsValue converts port mappings to a list of containerPortObjectType.
+// With redact set (see the provider's redact_runtime_details) the list is null,
+// so that no port mappings end up in state.
+func containerPortsValue(ports []arcane.ContainerPort, redact bool) (types.List, diag.Diagnostics) {
+	if redact {
+		return types.ListNull(containerPortObjectType), nil
+	}
+
+	portValues := make([]attr.Value, 0, len(ports))
+	for _, p := range ports {
+		portObj, diags := types.ObjectValue(containerPortObjectType.AttrTypes, map[string]attr.Value{
+			"host_port":      types.Int64Value(int64(p.HostPort)),
+			"container_port": types.Int64Value(int64(p.ContainerPort)),
+			"protocol":       types.StringValue(p.Protocol),
+		})
+		if diags.HasError() {
+			return types.ListNull(containerPortObjectType), diags
+		}
+		portValues = append(portValues, portObj)
+	}
+	return types.ListValue(containerPortObjectType, portValues)
+}
+
+// containerLabelsValue converts container labels to a map, or null with
+// redact set (see the provider's redact_runtime_details).
+func containerLabelsValue(labels map[string]string, redact bool) types.Map {
+	if redact {
+		return types.MapNull(types.StringType)
+	}
+	return stringMapValue(labels)
+}
+
+// includeEnv reports whether container environment variables are read into
+// state: include_env is set and runtime details aren't redacted. It warns
+// when include_env is overridden by redaction.
+func includeEnv(include types.Bool, redact bool, diags *diag.Diagnostics) bool {
+	if !include.ValueBool() {
+		return false
+	}
+	if redact {
+		diags.AddWarning(
+			"Runtime details redacted",
+			"The provider is configured with redact_runtime_details, so container environment variables can't be read into state.",
+		)
+		return false
+	}
+	return true
+}
+
+// containerEnvValue converts the environment of a container to a map, or
+// null unless include is set.
+func containerEnvValue(c arcane.ContainerDetail, include bool) types.Map {
+	if !include {
+		return types.MapNull(types.StringType)
+	}
+	return stringMapValue(c.EnvMap())
+}
+
+// containerStartedAtValue returns when the container last started in UTC,
+// or null if it never started.
+func containerStartedAtValue(c arcane.ContainerDetail) types.String {
+	t, ok := c.StartedTime()
+	if !ok {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
+
+// stringMapValue converts a Go map to a map of strings, empty rather than
+// null when m is nil.
+func stringMapValue(m map[string]string) types.Map {
+	values := make(map[string]attr.Value, len(m))
+	for k, v := range m {
+		values[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, values)
+}
@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // TestContainerDataSource_GivenContainerExists_WhenLookedUpByID_ThenReturnsContainer
@@ -22,13 +25,13 @@ func TestContainerDataSource_GivenContainerExists_WhenLookedUpByID_ThenReturnsCo
 
 	// Pre-populate mock server with project and containers
 	// The environment will be created via HCL resource, generating ID "env-{name}"
-	mockServer.AddProject(envID, &client.Project{
+	mockServer.AddProject(envID, &arcane.Project{
 		ID:            projectID,
 		Name:          "webapp",
 		Status:        "running",
 		EnvironmentID: envID,
 	})
-	mockServer.AddContainers(envID, projectID, []client.ContainerDetail{
+	mockServer.AddContainers(envID, projectID, []arcane.ContainerDetail{
 		{
 			ID:     "abc123",
 			Name:   "nginx-web",
@@ -73,13 +76,13 @@ func TestContainerDataSource_GivenContainerExists_WhenLookedUpByName_ThenReturns
 	projectID := "proj-api"
 
 	// Pre-populate mock server with project and containers
-	mockServer.AddProject(envID, &client.Project{
+	mockServer.AddProject(envID, &arcane.Project{
 		ID:            projectID,
 		Name:          "api-service",
 		Status:        "running",
 		EnvironmentID: envID,
 	})
-	mockServer.AddContainers(envID, projectID, []client.ContainerDetail{
+	mockServer.AddContainers(envID, projectID, []arcane.ContainerDetail{
 		{
 			ID:     "cnt-001",
 			Name:   "redis-cache",
@@ -124,20 +127,20 @@ func TestContainerDataSource_GivenContainerWithPorts_WhenRead_ThenPortsPopulated
 	projectID := "proj-ports"
 
 	// Pre-populate mock server with project and a container that has ports
-	mockServer.AddProject(envID, &client.Project{
+	mockServer.AddProject(envID, &arcane.Project{
 		ID:            projectID,
 		Name:          "port-test",
 		Status:        "running",
 		EnvironmentID: envID,
 	})
-	mockServer.AddContainers(envID, projectID, []client.ContainerDetail{
+	mockServer.AddContainers(envID, projectID, []arcane.ContainerDetail{
 		{
 			ID:     "port-container-1",
 			Name:   "traefik",
 			Image:  "traefik:v3",
 			Status: "running",
 			Health: "healthy",
-			Ports: []client.ContainerPort{
+			Ports: []arcane.ContainerPort{
 				{HostPort: 80, ContainerPort: 80, Protocol: "tcp"},
 				{HostPort: 443, ContainerPort: 443, Protocol: "tcp"},
 				{HostPort: 8080, ContainerPort: 8080, Protocol: "tcp"},
@@ -169,6 +172,126 @@ func TestContainerDataSource_GivenContainerWithPorts_WhenRead_ThenPortsPopulated
 	})
 }
 
+// TestContainerDataSource_GivenRedactRuntimeDetails_WhenRead_ThenPortsOmitted
+// validates that redact_runtime_details keeps port mappings out of state.
+func TestContainerDataSource_GivenRedactRuntimeDetails_WhenRead_ThenPortsOmitted(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	envName := "container-redact-env"
+	envID := "env-" + envName
+	projectID := "proj-redact"
+
+	mockServer.AddProject(envID, &arcane.Project{
+		ID:            projectID,
+		Name:          "redact-test",
+		Status:        "running",
+		EnvironmentID: envID,
+	})
+	mockServer.AddContainers(envID, projectID, []arcane.ContainerDetail{
+		{
+			ID:     "redact-container-1",
+			Name:   "traefik",
+			Image:  "traefik:v3",
+			Status: "running",
+			Ports: []arcane.ContainerPort{
+				{HostPort: 443, ContainerPort: 443, Protocol: "tcp"},
+			},
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testContainerDataSourceRedactedConfig(mockServer.URL, envName, "redact-container-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_container.test", "name", "traefik"),
+					resource.TestCheckResourceAttr("data.arcane_container.test", "status", "running"),
+					resource.TestCheckNoResourceAttr("data.arcane_container.test", "ports.#"),
+				),
+			},
+		},
+	})
+}
+
+// TestContainerDataSource_GivenRuntimeMetadata_WhenRead_ThenLabelsEnvAndRestartsExposed
+// validates that labels, restart count and start time are read, and that env
+// is only read with include_env.
+func TestContainerDataSource_GivenRuntimeMetadata_WhenRead_ThenLabelsEnvAndRestartsExposed(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	envName := "container-meta-env"
+	envID := "env-" + envName
+	projectID := "proj-meta"
+
+	mockServer.AddProject(envID, &arcane.Project{
+		ID:            projectID,
+		Name:          "meta-test",
+		Status:        "running",
+		EnvironmentID: envID,
+	})
+	mockServer.AddContainers(envID, projectID, []arcane.ContainerDetail{
+		{
+			ID:           "meta-container-1",
+			Name:         "api",
+			Status:       "running",
+			Labels:       map[string]string{"com.docker.compose.service": "api"},
+			Env:          []string{"LOG_LEVEL=debug", "DATABASE_URL=postgres://u:p@db/app"},
+			RestartCount: 3,
+			StartedAt:    "2026-03-01T12:20:30.5+02:00",
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testContainerDataSourceIncludeEnvConfig(mockServer.URL, envName, "meta-container-1", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_container.test", "labels.com.docker.compose.service", "api"),
+					resource.TestCheckResourceAttr("data.arcane_container.test", "restart_count", "3"),
+					resource.TestCheckResourceAttr("data.arcane_container.test", "started_at", "2026-03-01T10:20:30Z"),
+					resource.TestCheckNoResourceAttr("data.arcane_container.test", "env.%"),
+				),
+			},
+			{
+				Config: testContainerDataSourceIncludeEnvConfig(mockServer.URL, envName, "meta-container-1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_container.test", "env.%", "2"),
+					resource.TestCheckResourceAttr("data.arcane_container.test", "env.LOG_LEVEL", "debug"),
+				),
+			},
+		},
+	})
+}
+
+func TestIncludeEnv(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		include     types.Bool
+		redact      bool
+		want        bool
+		wantWarning bool
+	}{
+		"unset":             {include: types.BoolNull(), want: false},
+		"disabled":          {include: types.BoolValue(false), redact: true, want: false},
+		"enabled":           {include: types.BoolValue(true), want: true},
+		"enabled, redacted": {include: types.BoolValue(true), redact: true, want: false, wantWarning: true},
+	}
+	for name, tc := range cases {
+		var diags diag.Diagnostics
+		if got := includeEnv(tc.include, tc.redact, &diags); got != tc.want {
+			t.Errorf("%s: includeEnv() = %t, want %t", name, got, tc.want)
+		}
+		if got := diags.WarningsCount() > 0; got != tc.wantWarning {
+			t.Errorf("%s: warning = %t, want %t", name, got, tc.wantWarning)
+		}
+	}
+}
+
 // TestContainerDataSource_GivenNoIDOrName_WhenRead_ThenError
 // validates that an error is returned when neither id nor name is specified.
 func TestContainerDataSource_GivenNoIDOrName_WhenRead_ThenError(t *testing.T) {
@@ -188,6 +311,124 @@ func TestContainerDataSource_GivenNoIDOrName_WhenRead_ThenError(t *testing.T) {
 	})
 }
 
+// TestContainerDataSource_GivenStartingContainer_WhenWaitForHealthy_ThenReadOnceHealthy
+// validates that wait_for_status re-reads the container until its health
+// check passes.
+func TestContainerDataSource_GivenStartingContainer_WhenWaitForHealthy_ThenReadOnceHealthy(t *testing.T) {
+	interval := containerStatusPollInterval
+	containerStatusPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { containerStatusPollInterval = interval })
+
+	mockServer := newWaitContainerMockServer("")
+	defer mockServer.Close()
+	mockServer.HealthyAfterGets["abc123"] = 3
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testContainerDataSourceWaitConfig(mockServer.URL, `wait_for_status = "healthy"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_container.test", "status", "running"),
+					resource.TestCheckResourceAttr("data.arcane_container.test", "health", "healthy"),
+				),
+			},
+		},
+	})
+}
+
+// TestContainerDataSource_GivenUnhealthyContainer_WhenWaitTimeoutElapses_ThenError
+// validates that reading fails when the container doesn't reach
+// wait_for_status within wait_timeout, while "running" is reached regardless
+// of health.
+func TestContainerDataSource_GivenUnhealthyContainer_WhenWaitTimeoutElapses_ThenError(t *testing.T) {
+	interval := containerStatusPollInterval
+	containerStatusPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { containerStatusPollInterval = interval })
+
+	mockServer := newWaitContainerMockServer("unhealthy")
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testContainerDataSourceWaitConfig(mockServer.URL, `
+  wait_for_status = "healthy"
+  wait_timeout    = "100ms"`),
+				ExpectError: regexp.MustCompile(`Container did not reach status`),
+			},
+			{
+				Config: testContainerDataSourceWaitConfig(mockServer.URL, `wait_for_status = "running"`),
+				Check:  resource.TestCheckResourceAttr("data.arcane_container.test", "health", "unhealthy"),
+			},
+		},
+	})
+}
+
+// TestContainerDataSource_GivenInvalidWait_WhenValidated_ThenError validates
+// that unknown statuses, invalid timeouts and a timeout without a status are
+// rejected.
+func TestContainerDataSource_GivenInvalidWait_WhenValidated_ThenError(t *testing.T) {
+	mockServer := newWaitContainerMockServer("")
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testContainerDataSourceWaitConfig(mockServer.URL, `wait_for_status = "exited"`),
+				ExpectError: regexp.MustCompile(`Invalid wait_for_status`),
+			},
+			{
+				Config: testContainerDataSourceWaitConfig(mockServer.URL, `
+  wait_for_status = "running"
+  wait_timeout    = "soon"`),
+				ExpectError: regexp.MustCompile(`Invalid wait_timeout`),
+			},
+			{
+				Config:      testContainerDataSourceWaitConfig(mockServer.URL, `wait_timeout = "1m"`),
+				ExpectError: regexp.MustCompile(`only applies together with wait_for_status`),
+			},
+		},
+	})
+}
+
+func TestContainerHasStatus(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		status, health, want string
+		ok                   bool
+	}{
+		{"running", "", containerWaitStatusRunning, true},
+		{"running", "", containerWaitStatusHealthy, true},
+		{"running", "starting", containerWaitStatusRunning, true},
+		{"running", "starting", containerWaitStatusHealthy, false},
+		{"running", "healthy", containerWaitStatusHealthy, true},
+		{"Up 5 minutes", "", containerWaitStatusRunning, true},
+		{"created", "", containerWaitStatusRunning, false},
+		{"exited", "", containerWaitStatusHealthy, false},
+	}
+	for _, tc := range cases {
+		c := arcane.ContainerDetail{Status: tc.status, Health: tc.health}
+		if got := containerHasStatus(c, tc.want); got != tc.ok {
+			t.Errorf("containerHasStatus(%q/%q, %q) = %v, want %v", tc.status, tc.health, tc.want, got, tc.ok)
+		}
+	}
+}
+
+// newWaitContainerMockServer returns a mock server with a running container
+// abc123 of the given health in the env-projects environment.
+func newWaitContainerMockServer(health string) *MockServer {
+	mockServer := newProjectMockServer()
+	mockServer.AddProject("env-projects", &arcane.Project{ID: "proj-webapp", Name: "webapp", Status: "running", EnvironmentID: "env-projects"})
+	mockServer.AddContainers("env-projects", "proj-webapp", []arcane.ContainerDetail{
+		{ID: "abc123", Name: "webapp-web-1", Image: "nginx:latest", Status: "running", Health: health},
+	})
+	return mockServer
+}
+
 func testContainerDataSourceByIDConfig(url, envName, containerID string) string {
 	return fmt.Sprintf(`
 provider "arcane" {
@@ -240,3 +481,55 @@ data "arcane_container" "test" {
 }
 `, url)
 }
+
+func testContainerDataSourceRedactedConfig(url, envName, containerID string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url                    = %[1]q
+  redact_runtime_details = true
+}
+
+resource "arcane_environment" "test" {
+  name    = %[2]q
+  api_url = "http://10.100.1.100:3553"
+}
+
+data "arcane_container" "test" {
+  environment_id = arcane_environment.test.id
+  id             = %[3]q
+}
+`, url, envName, containerID)
+}
+
+func testContainerDataSourceIncludeEnvConfig(url, envName, containerID string, includeEnv bool) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_environment" "test" {
+  name    = %[2]q
+  api_url = "http://10.100.1.100:3553"
+}
+
+data "arcane_container" "test" {
+  environment_id = arcane_environment.test.id
+  id             = %[3]q
+  include_env    = %[4]t
+}
+`, url, envName, containerID, includeEnv)
+}
+
+func testContainerDataSourceWaitConfig(url, extra string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_container" "test" {
+  environment_id = "env-projects"
+  id             = "abc123"
+  %[2]s
+}
+`, url, extra)
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Statuses wait_for_status waits for.
+const (
+	containerWaitStatusRunning = "running"
+	containerWaitStatusHealthy = "healthy"
+)
+
+// defaultContainerWaitTimeout is how long wait_for_status waits when
+// wait_timeout is unset.
+const defaultContainerWaitTimeout = 2 * time.Minute
+
+// containerStatusPollInterval is how often the container is re-read while
+// waiting for wait_for_status.
+var containerStatusPollInterval = 2 * time.Second
+
+// validateContainerWait rejects an unknown wait_for_status, and a
+// wait_timeout that isn't a positive Go duration or is set without
+// wait_for_status.
+func validateContainerWait(data *ContainerDataSourceModel, diags *diag.Diagnostics) {
+	if !data.WaitForStatus.IsNull() && !data.WaitForStatus.IsUnknown() {
+		switch data.WaitForStatus.ValueString() {
+		case containerWaitStatusRunning, containerWaitStatusHealthy:
+		default:
+			diags.AddAttributeError(
+				path.Root("wait_for_status"),
+				"Invalid wait_for_status",
+				fmt.Sprintf("Expected %q or %q, got %q.", containerWaitStatusRunning, containerWaitStatusHealthy, data.WaitForStatus.ValueString()),
+			)
+		}
+	}
+
+	if data.WaitTimeout.IsNull() || data.WaitTimeout.IsUnknown() {
+		return
+	}
+	if d, err := time.ParseDuration(data.WaitTimeout.ValueString()); err != nil || d <= 0 {
+		diags.AddAttributeError(
+			path.Root("wait_timeout"),
+			"Invalid wait_timeout",
+			fmt.Sprintf("Expected a positive Go duration such as 30s or 2m, got %q.", data.WaitTimeout.ValueString()),
+		)
+		return
+	}
+	if data.WaitForStatus.IsNull() {
+		diags.AddAttributeError(
+			path.Root("wait_timeout"),
+			"Invalid wait_timeout",
+			"wait_timeout only applies together with wait_for_status.",
+		)
+	}
+}
+
+// containerWaitTimeout returns wait_timeout, or the default when it is
+// unset. ValidateConfig has already rejected values that don't parse.
+func containerWaitTimeout(timeout types.String) time.Duration {
+	if timeout.IsNull() || timeout.IsUnknown() {
+		return defaultContainerWaitTimeout
+	}
+	d, _ := time.ParseDuration(timeout.ValueString())
+	return d
+}
+
+// containerHasStatus reports whether c has reached status: "running" when it
+// is running, "healthy" when its health check passes or, without a health
+// check, when it is running.
+func containerHasStatus(c arcane.ContainerDetail, status string) bool {
+	if status == containerWaitStatusHealthy {
+		return isHealthyContainer(c)
+	}
+	return isRunningStatus(c.Status)
+}
+
+// waitForContainerStatus re-reads container until it reaches status,
+// returning it as last read. It adds an error with the last status and
+// health and returns false when timeout elapses first.
+func waitForContainerStatus(ctx context.Context, envClient *EnvironmentClient, container *arcane.ContainerDetail, status string, timeout time.Duration, diags *diag.Diagnostics) (*arcane.ContainerDetail, bool) {
+	deadline := time.Now().Add(timeout)
+
+	var err error
+	for !containerHasStatus(*container, status) {
+		if time.Now().After(deadline) || ctx.Err() != nil {
+			detail := fmt.Sprintf("It is %s", container.Status)
+			if container.Health != "" {
+				detail += fmt.Sprintf(", %s", container.Health)
+			}
+			if err != nil {
+				detail += fmt.Sprintf(", and the last attempt to read it failed: %s", err)
+			}
+			diags.AddError(
+				"Container did not reach status",
+				fmt.Sprintf("Container %q was not %s within %s. %s.", container.Name, status, timeout, detail),
+			)
+			return container, false
+		}
+
+		tflog.Debug(ctx, "Waiting for container status", map[string]interface{}{
+			"container_id": container.ID,
+			"status":       container.Status,
+			"health":       container.Health,
+			"want":         status,
+		})
+
+		select {
+		case <-ctx.Done():
+			continue
+		case <-time.After(containerStatusPollInterval):
+		}
+
+		var c *arcane.ContainerDetail
+		if c, err = envClient.GetContainer(ctx, container.ID); err == nil {
+			container = c
+		}
+	}
+	return container, true
+}
@@ -3,23 +3,36 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/registryauth"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ContainerRegistryResource{}
-	_ resource.ResourceWithImportState = &ContainerRegistryResource{}
+	_ resource.Resource                   = &ContainerRegistryResource{}
+	_ resource.ResourceWithImportState    = &ContainerRegistryResource{}
+	_ resource.ResourceWithValidateConfig = &ContainerRegistryResource{}
+	_ resource.ResourceWithModifyPlan     = &ContainerRegistryResource{}
 )
 
+// defaultRegistryRefreshBefore is the default refresh_before.
+const defaultRegistryRefreshBefore = "15m"
+
 // NewContainerRegistryResource returns a new container registry resource.
 func NewContainerRegistryResource() resource.Resource {
 	return &ContainerRegistryResource{}
@@ -27,7 +40,7 @@ func NewContainerRegistryResource() resource.Resource {
 
 // ContainerRegistryResource defines the container registry resource implementation.
 type ContainerRegistryResource struct {
-	client *client.Client
+	client *Client
 }
 
 // ContainerRegistryResourceModel describes the container registry resource data model.
@@ -38,6 +51,11 @@ type ContainerRegistryResourceModel struct {
 	AuthType types.String `tfsdk:"auth_type"`
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
+
+	AuthHelper     types.String `tfsdk:"auth_helper"`
+	RefreshBefore  types.String `tfsdk:"refresh_before"`
+	TokenExpiresAt types.String `tfsdk:"token_expires_at"`
+	APIKeyAlias    types.String `tfsdk:"api_key_alias"`
 }
 
 func (r *ContainerRegistryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -70,18 +88,47 @@ resource "arcane_container_registry" "dockerhub" {
 }
 ` + "```" + `
 
+### Short-lived cloud registry tokens
+
+With ` + "`auth_helper`" + `, the provider exchanges cloud credentials from its environment for a
+registry token at apply time, like the docker credential helpers, and rotates it on a later apply
+once it is within ` + "`refresh_before`" + ` of expiring. Run ` + "`terraform apply`" + ` on a schedule
+shorter than the token lifetime (12 hours for ECR, 1 hour for GCR) to keep Arcane's token valid.
+
+` + "```hcl" + `
+# Uses the AWS default credential chain: environment variables, AWS_PROFILE, SSO, roles
+resource "arcane_container_registry" "ecr" {
+  name           = "ECR"
+  url            = "https://123456789012.dkr.ecr.eu-west-1.amazonaws.com"
+  auth_type      = "basic"
+  auth_helper    = "ecr"
+  refresh_before = "6h"
+}
+
+# Uses GOOGLE_OAUTH_ACCESS_TOKEN, or Application Default Credentials
+resource "arcane_container_registry" "artifact_registry" {
+  name        = "Artifact Registry"
+  url         = "https://europe-docker.pkg.dev"
+  auth_type   = "basic"
+  auth_helper = "gcr"
+}
+` + "```" + `
+
 ## Import
 
-Container registries can be imported using their ID:
+Container registries can be imported using their ID, or their name prefixed with ` + "`name:`" + `
+since IDs aren't shown in the Arcane UI:
 
 ` + "```shell" + `
 terraform import arcane_container_registry.ghcr <registry-id>
+terraform import arcane_container_registry.ghcr name:<registry-name>
 ` + "```" + `
 
 **Note:** When importing, the password is not retrieved from the API. You will need to
 re-supply the password in your configuration after import.
 `,
 		Attributes: map[string]schema.Attribute{
+			"api_key_alias": apiKeyAliasAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the container registry.",
 				Computed:            true,
@@ -90,7 +137,7 @@ re-supply the password in your configuration after import.
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the container registry. Must be unique.",
+				MarkdownDescription: "The name of the container registry. Must be unique. Created in Arcane with the provider's `name_prefix` prepended.",
 				Required:            true,
 			},
 			"url": schema.StringAttribute{
@@ -110,6 +157,23 @@ re-supply the password in your configuration after import.
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"auth_helper": schema.StringAttribute{
+				MarkdownDescription: "Obtain short-lived registry credentials from cloud credentials at apply time instead of `username` and `password`: " +
+					"`ecr` (Amazon ECR, using the AWS SDK's default credential chain: environment variables, shared config and credentials files including `AWS_PROFILE` and SSO, web identity, and container or instance roles) " +
+					"or `gcr` (Google Container Registry and Artifact Registry, using `GOOGLE_OAUTH_ACCESS_TOKEN`, or else Application Default Credentials: `GOOGLE_APPLICATION_CREDENTIALS`, gcloud user credentials or the GCE metadata server). " +
+					"Conflicts with `username` and `password`.",
+				Optional: true,
+			},
+			"refresh_before": schema.StringAttribute{
+				MarkdownDescription: "With `auth_helper`, how long before the token expires an apply rotates it, as a Go duration (e.g. `15m`, `6h`). Defaults to `15m`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultRegistryRefreshBefore),
+			},
+			"token_expires_at": schema.StringAttribute{
+				MarkdownDescription: "With `auth_helper`, when the token pushed to Arcane expires, in RFC3339 format.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -119,11 +183,11 @@ func (r *ContainerRegistryResource) Configure(ctx context.Context, req resource.
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	c, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
 		)
 		return
 	}
@@ -131,7 +195,108 @@ func (r *ContainerRegistryResource) Configure(ctx context.Context, req resource.
 	r.client = c
 }
 
+func (r *ContainerRegistryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ContainerRegistryResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.AuthHelper.IsNull() && !data.AuthHelper.IsUnknown() {
+		if !slices.Contains(registryauth.Names, data.AuthHelper.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth_helper"),
+				"Invalid auth_helper",
+				fmt.Sprintf("Expected one of %s, got %q.", strings.Join(registryauth.Names, ", "), data.AuthHelper.ValueString()),
+			)
+		}
+		if !data.Username.IsNull() || !data.Password.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth_helper"),
+				"Conflicting registry credentials",
+				"auth_helper obtains the username and password; remove username and password from the configuration.",
+			)
+		}
+	}
+
+	if !data.RefreshBefore.IsNull() && !data.RefreshBefore.IsUnknown() {
+		if _, err := time.ParseDuration(data.RefreshBefore.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("refresh_before"),
+				"Invalid refresh_before",
+				fmt.Sprintf("Expected a Go duration such as 15m or 6h: %s", err),
+			)
+		}
+	}
+}
+
+// ModifyPlan plans a token rotation, by marking token_expires_at unknown, when
+// the token obtained by auth_helper expires within refresh_before.
+func (r *ContainerRegistryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ContainerRegistryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AuthHelper.IsNull() || plan.RefreshBefore.IsUnknown() || state.TokenExpiresAt.IsNull() {
+		return
+	}
+	expiresAt, err := time.Parse(time.RFC3339, state.TokenExpiresAt.ValueString())
+	if err != nil {
+		return
+	}
+	refreshBefore, err := time.ParseDuration(plan.RefreshBefore.ValueString())
+	if err != nil || time.Until(expiresAt) > refreshBefore {
+		return
+	}
+
+	tflog.Info(ctx, "Registry token expires soon, planning rotation", map[string]interface{}{
+		"registry_id": state.ID.ValueString(),
+		"expires_at":  state.TokenExpiresAt.ValueString(),
+	})
+	resp.Plan.SetAttribute(ctx, path.Root("token_expires_at"), types.StringUnknown())
+}
+
+// credentials returns the username and password to send to Arcane: those in
+// the configuration, or short-lived ones from auth_helper, in which case
+// token_expires_at is set to their expiry.
+func (r *ContainerRegistryResource) credentials(ctx context.Context, data *ContainerRegistryResourceModel, diags *diag.Diagnostics) (string, string) {
+	if data.AuthHelper.IsNull() {
+		data.TokenExpiresAt = types.StringNull()
+		return data.Username.ValueString(), data.Password.ValueString()
+	}
+
+	helper, err := registryauth.New(data.AuthHelper.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("auth_helper"), "Invalid auth_helper", err.Error())
+		return "", ""
+	}
+	token, err := helper.Token(ctx, data.URL.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("auth_helper"),
+			"Failed to obtain registry token",
+			fmt.Sprintf("The %s auth helper could not obtain a token for %s: %s", data.AuthHelper.ValueString(), data.URL.ValueString(), err),
+		)
+		return "", ""
+	}
+
+	data.TokenExpiresAt = types.StringValue(token.ExpiresAt.UTC().Format(time.RFC3339))
+	return token.Username, token.Password
+}
+
 func (r *ContainerRegistryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
 	var data ContainerRegistryResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -139,28 +304,34 @@ func (r *ContainerRegistryResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	createReq := &client.ContainerRegistryCreateRequest{
-		Name:     data.Name.ValueString(),
+	username, password := r.credentials(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := &arcane.ContainerRegistryCreateRequest{
+		Name:     r.client.PrefixedName(data.Name.ValueString()),
 		URL:      data.URL.ValueString(),
 		AuthType: data.AuthType.ValueString(),
-		Username: data.Username.ValueString(),
-		Password: data.Password.ValueString(),
+		Username: username,
+		Password: password,
 	}
 
 	registry, err := r.client.CreateContainerRegistry(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to create container registry", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to create container registry")
 		return
 	}
 
 	// Update state from response
 	data.ID = types.StringValue(registry.ID)
-	data.Name = types.StringValue(registry.Name)
+	data.Name = types.StringValue(r.client.UnprefixedName(registry.Name))
 	data.URL = types.StringValue(registry.URL)
 	if registry.AuthType != "" {
 		data.AuthType = types.StringValue(registry.AuthType)
 	}
-	if registry.Username != "" {
+	// With auth_helper the username is not part of the configuration
+	if registry.Username != "" && data.AuthHelper.IsNull() {
 		data.Username = types.StringValue(registry.Username)
 	}
 	// Password is write-only; preserve from plan since API won't return it
@@ -169,42 +340,66 @@ func (r *ContainerRegistryResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *ContainerRegistryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
 	var data ContainerRegistryResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	prior := data
 
 	registry, err := r.client.GetContainerRegistry(ctx, data.ID.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if arcane.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Failed to read container registry", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read container registry")
 		return
 	}
 
 	// Update state from response
-	data.Name = types.StringValue(registry.Name)
+	data.Name = types.StringValue(r.client.UnprefixedName(registry.Name))
 	data.URL = types.StringValue(registry.URL)
 	if registry.AuthType != "" {
 		data.AuthType = types.StringValue(registry.AuthType)
 	} else {
 		data.AuthType = types.StringNull()
 	}
-	if registry.Username != "" {
+	if !data.AuthHelper.IsNull() {
+		// With auth_helper the username is not part of the configuration
+		data.Username = types.StringNull()
+	} else if registry.Username != "" {
 		data.Username = types.StringValue(registry.Username)
 	} else {
 		data.Username = types.StringNull()
 	}
 	// Password is write-only; preserve from state since API won't return it
 
+	// Registries imported or created before refresh_before existed
+	if data.RefreshBefore.IsNull() {
+		data.RefreshBefore = types.StringValue(defaultRegistryRefreshBefore)
+	}
+
+	drift := newDriftReport("arcane_container_registry", data.ID.ValueString())
+	drift.compare("name", prior.Name, data.Name)
+	drift.compare("url", prior.URL, data.URL)
+	drift.compare("auth_type", prior.AuthType, data.AuthType)
+	drift.compare("username", prior.Username, data.Username)
+	drift.addTo(&resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ContainerRegistryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
 	var data ContainerRegistryResourceModel
 	var state ContainerRegistryResourceModel
 
@@ -214,29 +409,37 @@ func (r *ContainerRegistryResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	updateReq := &client.ContainerRegistryUpdateRequest{
-		Name:     data.Name.ValueString(),
+	username, password := r.credentials(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := &arcane.ContainerRegistryUpdateRequest{
+		Name:     r.client.PrefixedName(data.Name.ValueString()),
 		URL:      data.URL.ValueString(),
 		AuthType: data.AuthType.ValueString(),
-		Username: data.Username.ValueString(),
-		Password: data.Password.ValueString(),
+		Username: username,
+		Password: password,
 	}
 
 	registry, err := r.client.UpdateContainerRegistry(ctx, data.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to update container registry", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to update container registry")
 		return
 	}
 
 	// Update state from response
-	data.Name = types.StringValue(registry.Name)
+	data.Name = types.StringValue(r.client.UnprefixedName(registry.Name))
 	data.URL = types.StringValue(registry.URL)
 	if registry.AuthType != "" {
 		data.AuthType = types.StringValue(registry.AuthType)
 	} else {
 		data.AuthType = types.StringNull()
 	}
-	if registry.Username != "" {
+	if !data.AuthHelper.IsNull() {
+		// With auth_helper the username is not part of the configuration
+		data.Username = types.StringNull()
+	} else if registry.Username != "" {
 		data.Username = types.StringValue(registry.Username)
 	} else {
 		data.Username = types.StringNull()
@@ -247,6 +450,10 @@ func (r *ContainerRegistryResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *ContainerRegistryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
 	var data ContainerRegistryResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -256,13 +463,24 @@ func (r *ContainerRegistryResource) Delete(ctx context.Context, req resource.Del
 
 	err := r.client.DeleteContainerRegistry(ctx, data.ID.ValueString())
 	if err != nil {
-		if !client.IsNotFound(err) {
-			resp.Diagnostics.AddError("Failed to delete container registry", err.Error())
+		if !arcane.IsNotFound(err) {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to delete container registry")
 			return
 		}
 	}
 }
 
 func (r *ContainerRegistryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	name, ok := parseNameImportID(req.ID)
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	registry, err := r.client.GetContainerRegistryByName(ctx, name)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, fmt.Sprintf("Failed to find container registry %q", name))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), registry.ID)...)
 }
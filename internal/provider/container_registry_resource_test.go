@@ -1,10 +1,16 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // TestContainerRegistryResource_GivenValidConfig_WhenCreated_ThenRegistryExists
@@ -100,7 +106,7 @@ func TestContainerRegistryResource_GivenExistingRegistry_WhenDeleted_ThenRemoved
 }
 
 // TestContainerRegistryResource_GivenExistingRegistry_WhenImported_ThenStateMatches
-// validates that a container registry can be imported by ID and state is verified.
+// validates that a container registry can be imported by ID or name and state is verified.
 func TestContainerRegistryResource_GivenExistingRegistry_WhenImported_ThenStateMatches(t *testing.T) {
 	mockServer := NewMockServer()
 	defer mockServer.Close()
@@ -123,10 +129,100 @@ func TestContainerRegistryResource_GivenExistingRegistry_WhenImported_ThenStateM
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"password"},
 			},
+			// Import by name
+			{
+				ResourceName:            "arcane_container_registry.test",
+				ImportState:             true,
+				ImportStateId:           "name:import-registry",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+// TestContainerRegistryResource_GivenAuthHelper_WhenTokenExpiresWithinRefreshBefore_ThenRotated
+// validates that auth_helper pushes a short-lived token to Arcane, and that an
+// apply rotates it once it expires within refresh_before.
+func TestContainerRegistryResource_GivenAuthHelper_WhenTokenExpiresWithinRefreshBefore_ThenRotated(t *testing.T) {
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "ya29.test-token")
+
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The GCR token lasts an hour, so it is always within a 2h refresh_before
+			// and the plan after each apply rotates it again
+			{
+				Config:             testContainerRegistryResourceConfigAuthHelper(mockServer.URL, "gcr", "2h"),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_container_registry.test", "auth_helper", "gcr"),
+					resource.TestCheckResourceAttrSet("arcane_container_registry.test", "token_expires_at"),
+					resource.TestCheckNoResourceAttr("arcane_container_registry.test", "username"),
+					checkRegistryCredentialsSent(mockServer, http.MethodPost, "oauth2accesstoken", "ya29.test-token"),
+				),
+			},
+			{
+				Config:             testContainerRegistryResourceConfigAuthHelper(mockServer.URL, "gcr", "2h"),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					mockServer.CheckRequestCount(http.MethodPut, "/api/container-registries/reg-helper-registry", 1),
+					checkRegistryCredentialsSent(mockServer, http.MethodPut, "oauth2accesstoken", "ya29.test-token"),
+				),
+			},
 		},
 	})
 }
 
+// TestContainerRegistryResource_GivenAuthHelperWithPassword_WhenValidated_ThenError
+// validates that auth_helper conflicts with static credentials.
+func TestContainerRegistryResource_GivenAuthHelperWithPassword_WhenValidated_ThenError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "arcane" {
+  url = "http://localhost:1"
+}
+
+resource "arcane_container_registry" "test" {
+  name        = "ecr"
+  url         = "https://123456789012.dkr.ecr.eu-west-1.amazonaws.com"
+  auth_helper = "ecr"
+  password    = "static"
+}
+`,
+				ExpectError: regexp.MustCompile(`Conflicting registry credentials`),
+			},
+		},
+	})
+}
+
+// checkRegistryCredentialsSent asserts the credentials in the last registry
+// request with method.
+func checkRegistryCredentialsSent(ms *MockServer, method, username, password string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		var body []byte
+		for _, req := range ms.Requests() {
+			if req.Method == method && len(req.Body) > 0 {
+				body = req.Body
+			}
+		}
+		var got arcane.ContainerRegistryCreateRequest
+		if err := json.Unmarshal(body, &got); err != nil {
+			return fmt.Errorf("decoding %s body %q: %w", method, body, err)
+		}
+		if got.Username != username || got.Password != password {
+			return fmt.Errorf("credentials sent with %s = %q/%q, want %q/%q", method, got.Username, got.Password, username, password)
+		}
+		return nil
+	}
+}
+
 // --- Config helpers ---
 
 func testContainerRegistryResourceConfig(url, name, regURL string) string {
@@ -157,3 +253,19 @@ resource "arcane_container_registry" "test" {
 }
 `, url, name, regURL, authType, username, password)
 }
+
+func testContainerRegistryResourceConfigAuthHelper(url, helper, refreshBefore string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_container_registry" "test" {
+  name           = "helper-registry"
+  url            = "https://europe-docker.pkg.dev"
+  auth_type      = "basic"
+  auth_helper    = %[2]q
+  refresh_before = %[3]q
+}
+`, url, helper, refreshBefore)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// confirmDestroyAttribute is the confirm_destroy attribute of resources whose
+// deletes the provider's require_destroy_confirmation guards. object names
+// what must be confirmed, e.g. "environment".
+func confirmDestroyAttribute(object string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The %[1]s name, confirming that this resource may delete the %[1]s when the provider "+
+			"sets `require_destroy_confirmation`. Set it and apply before destroying.", object),
+		Optional: true,
+	}
+}
+
+// checkDestroyConfirmed adds an error and returns false if the client
+// requires destroy confirmation and confirm, the resource's confirm_destroy
+// as last applied, isn't one of names. name is shown in the error.
+func checkDestroyConfirmed(c *Client, object, name string, confirm types.String, names []string, diags *diag.Diagnostics) bool {
+	if !c.RequiresDestroyConfirmation() || slices.Contains(names, confirm.ValueString()) {
+		return true
+	}
+	diags.AddAttributeError(
+		path.Root("confirm_destroy"),
+		"Destroy not confirmed",
+		fmt.Sprintf("The provider sets require_destroy_confirmation, so deleting %s %q must be confirmed. "+
+			"Set confirm_destroy = %q on the resource, apply, and then destroy again.", object, name, name),
+	)
+	return false
+}
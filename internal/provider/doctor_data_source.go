@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &DoctorDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &DoctorDataSource{}
+)
+
+// defaultMaxClockSkew is the clock difference with Arcane tolerated by the
+// clock_skew check and the provider's clock skew warning unless their
+// max_clock_skew is set.
+const defaultMaxClockSkew = time.Minute
+
+// Doctor check results.
+const (
+	doctorPass = "pass"
+	doctorFail = "fail"
+	doctorSkip = "skip"
+)
+
+// NewDoctorDataSource returns a new doctor data source.
+func NewDoctorDataSource() datasource.DataSource {
+	return &DoctorDataSource{}
+}
+
+// DoctorDataSource defines the doctor data source implementation.
+type DoctorDataSource struct {
+	client *Client
+}
+
+// DoctorDataSourceModel describes the doctor data source data model.
+type DoctorDataSourceModel struct {
+	EnvironmentIDs types.List   `tfsdk:"environment_ids"`
+	MaxClockSkew   types.String `tfsdk:"max_clock_skew"`
+	FailOnError    types.Bool   `tfsdk:"fail_on_error"`
+	OK             types.Bool   `tfsdk:"ok"`
+	Checks         types.List   `tfsdk:"checks"`
+	Summary        types.String `tfsdk:"summary"`
+}
+
+// doctorCheck is the result of one check.
+type doctorCheck struct {
+	Name   string
+	Target string
+	Status string
+	Detail string
+}
+
+var doctorCheckObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":   types.StringType,
+		"target": types.StringType,
+		"status": types.StringType,
+		"detail": types.StringType,
+	},
+}
+
+func (d *DoctorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_doctor"
+}
+
+func (d *DoctorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to check, before anything is changed, that the provider can work with
+Arcane. It runs these checks and returns a report:
+
+- ` + "`api_reachable`" + `: the Arcane API answers.
+- ` + "`api_key_valid`" + `: the API key is accepted.
+- ` + "`clock_skew`" + `: the local clock is within ` + "`max_clock_skew`" + ` of Arcane's.
+- ` + "`agent_connected`" + `: the agent of each environment in ` + "`environment_ids`" + ` is connected.
+
+Checks that depend on a failed check are skipped. With ` + "`fail_on_error`" + `, any failed check fails
+the plan with the summary, so pipelines stop before mutating anything.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_doctor" "preflight" {
+  environment_ids = [arcane_environment.production.id]
+  fail_on_error   = true
+}
+
+output "preflight" {
+  value = data.arcane_doctor.preflight.summary
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of environments whose agents must be connected.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"max_clock_skew": schema.StringAttribute{
+				MarkdownDescription: "The largest tolerated difference between the local clock and Arcane's, as a Go duration. Defaults to `1m`.",
+				Optional:            true,
+			},
+			"fail_on_error": schema.BoolAttribute{
+				MarkdownDescription: "Fail the plan with the summary when any check fails. Defaults to `false`.",
+				Optional:            true,
+			},
+			"ok": schema.BoolAttribute{
+				MarkdownDescription: "Whether no check failed.",
+				Computed:            true,
+			},
+			"checks": schema.ListNestedAttribute{
+				MarkdownDescription: "The result of each check, in the order they ran.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The check: `api_reachable`, `api_key_valid`, `clock_skew` or `agent_connected`.",
+							Computed:            true,
+						},
+						"target": schema.StringAttribute{
+							MarkdownDescription: "What was checked, e.g. the environment ID for `agent_connected`.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "`pass`, `fail` or `skip`.",
+							Computed:            true,
+						},
+						"detail": schema.StringAttribute{
+							MarkdownDescription: "A human-readable explanation of the result.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"summary": schema.StringAttribute{
+				MarkdownDescription: "A human-readable report with one line per check.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DoctorDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *DoctorDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var maxSkew types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_clock_skew"), &maxSkew)...)
+	if resp.Diagnostics.HasError() || maxSkew.IsNull() || maxSkew.IsUnknown() {
+		return
+	}
+	if _, err := time.ParseDuration(maxSkew.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_clock_skew"),
+			"Invalid max_clock_skew",
+			fmt.Sprintf("Expected a Go duration such as 30s or 2m: %s", err),
+		)
+	}
+}
+
+func (d *DoctorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data DoctorDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var environmentIDs []string
+	resp.Diagnostics.Append(data.EnvironmentIDs.ElementsAs(ctx, &environmentIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	maxSkew := defaultMaxClockSkew
+	if !data.MaxClockSkew.IsNull() {
+		maxSkew, _ = time.ParseDuration(data.MaxClockSkew.ValueString())
+	}
+
+	checks := d.runChecks(ctx, environmentIDs, maxSkew)
+
+	ok := true
+	values := make([]attr.Value, 0, len(checks))
+	for _, c := range checks {
+		ok = ok && c.Status != doctorFail
+		values = append(values, types.ObjectValueMust(doctorCheckObjectType.AttrTypes, map[string]attr.Value{
+			"name":   types.StringValue(c.Name),
+			"target": types.StringValue(c.Target),
+			"status": types.StringValue(c.Status),
+			"detail": types.StringValue(c.Detail),
+		}))
+	}
+	data.OK = types.BoolValue(ok)
+	data.Checks = types.ListValueMust(doctorCheckObjectType, values)
+	data.Summary = types.StringValue(doctorSummary(checks))
+
+	if !ok && data.FailOnError.ValueBool() {
+		resp.Diagnostics.AddError("Arcane checks failed", data.Summary.ValueString())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// runChecks runs the doctor checks in order. Checks that need the API are
+// skipped when it can't be reached, and agent checks when the key is rejected.
+func (d *DoctorDataSource) runChecks(ctx context.Context, environmentIDs []string, maxSkew time.Duration) []doctorCheck {
+	var checks []doctorCheck
+	target := d.client.BaseURL
+
+	requestStart := time.Now()
+	info, err := d.client.GetServerInfo(ctx)
+	requestEnd := time.Now()
+	if err != nil {
+		checks = append(checks, doctorCheck{"api_reachable", target, doctorFail, err.Error()})
+	} else {
+		detail := "Arcane answered"
+		if info.Version != "" {
+			detail += " (version " + info.Version + ")"
+		}
+		checks = append(checks, doctorCheck{"api_reachable", target, doctorPass, detail + "."})
+	}
+	reachable := err == nil
+
+	keyValid := false
+	if !reachable {
+		checks = append(checks, doctorCheck{"api_key_valid", target, doctorSkip, "The API is not reachable."})
+	} else if _, err := d.client.ListEnvironments(ctx); err != nil {
+		checks = append(checks, doctorCheck{"api_key_valid", target, doctorFail, err.Error()})
+	} else {
+		keyValid = true
+		checks = append(checks, doctorCheck{"api_key_valid", target, doctorPass, "The API key is accepted."})
+	}
+
+	switch {
+	case !reachable:
+		checks = append(checks, doctorCheck{"clock_skew", target, doctorSkip, "The API is not reachable."})
+	case info.Date.IsZero():
+		checks = append(checks, doctorCheck{"clock_skew", target, doctorSkip, "Arcane did not send a Date header."})
+	default:
+		skew := clockSkew(requestStart, requestEnd, info.Date)
+		status := doctorPass
+		if skew > maxSkew {
+			status = doctorFail
+		}
+		checks = append(checks, doctorCheck{"clock_skew", target, status,
+			fmt.Sprintf("The local clock differs from Arcane's by %s (at most %s tolerated).", skew, maxSkew)})
+	}
+
+	for _, id := range environmentIDs {
+		if !keyValid {
+			checks = append(checks, doctorCheck{"agent_connected", id, doctorSkip, "The API key could not be verified."})
+			continue
+		}
+		if err := d.client.TestEnvironment(ctx, id); err != nil {
+			checks = append(checks, doctorCheck{"agent_connected", id, doctorFail, err.Error()})
+			continue
+		}
+		checks = append(checks, doctorCheck{"agent_connected", id, doctorPass, "The agent is connected."})
+	}
+
+	return checks
+}
+
+// clockSkew returns how far serverTime, from the Date header of a response to
+// a request made between requestStart and requestEnd, is from the local clock,
+// in either direction.
+func clockSkew(requestStart, requestEnd, serverTime time.Time) time.Duration {
+	return arcane.ClockSkew(requestStart, requestEnd, serverTime).Abs()
+}
+
+// doctorSummary formats checks as one line each, e.g.
+// "FAIL agent_connected (env-1): agent offline".
+func doctorSummary(checks []doctorCheck) string {
+	lines := make([]string, 0, len(checks))
+	for _, c := range checks {
+		lines = append(lines, fmt.Sprintf("%s %s (%s): %s", strings.ToUpper(c.Status), c.Name, c.Target, c.Detail))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestDoctorDataSource_GivenHealthySetup_WhenRead_ThenAllChecksPass
+// validates that every check passes against a reachable server with a
+// connected agent.
+func TestDoctorDataSource_GivenHealthySetup_WhenRead_ThenAllChecksPass(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-doc"] = &arcane.Environment{ID: "env-doc", Name: "doc-env"}
+	mockServer.HealthyEnvs["env-doc"] = true
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDoctorDataSourceConfig(mockServer.URL, "env-doc", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_doctor.test", "ok", "true"),
+					resource.TestCheckResourceAttr("data.arcane_doctor.test", "checks.#", "4"),
+					resource.TestCheckResourceAttr("data.arcane_doctor.test", "checks.0.name", "api_reachable"),
+					resource.TestCheckResourceAttr("data.arcane_doctor.test", "checks.1.status", "pass"),
+					resource.TestCheckResourceAttr("data.arcane_doctor.test", "checks.2.name", "clock_skew"),
+					resource.TestCheckResourceAttr("data.arcane_doctor.test", "checks.3.target", "env-doc"),
+					resource.TestCheckResourceAttr("data.arcane_doctor.test", "checks.3.status", "pass"),
+				),
+			},
+		},
+	})
+}
+
+// TestDoctorDataSource_GivenAgentOfflineAndFailOnError_WhenRead_ThenPlanFails
+// validates that a failed check fails the plan with the summary.
+func TestDoctorDataSource_GivenAgentOfflineAndFailOnError_WhenRead_ThenPlanFails(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-doc"] = &arcane.Environment{ID: "env-doc", Name: "doc-env"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testDoctorDataSourceConfig(mockServer.URL, "env-doc", true),
+				ExpectError: regexp.MustCompile(`FAIL agent_connected \(env-doc\)`),
+			},
+		},
+	})
+}
+
+// TestDoctorDataSource_GivenInvalidAPIKey_WhenRead_ThenAgentChecksSkipped
+// validates that a rejected key fails its check and skips agent checks.
+func TestDoctorDataSource_GivenInvalidAPIKey_WhenRead_ThenAgentChecksSkipped(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.InjectFault(MockFault{Path: "/api/environments", Status: 401})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDoctorDataSourceConfig(mockServer.URL, "env-doc", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_doctor.test", "ok", "false"),
+					resource.TestCheckResourceAttr("data.arcane_doctor.test", "checks.1.status", "fail"),
+					resource.TestCheckResourceAttr("data.arcane_doctor.test", "checks.3.status", "skip"),
+				),
+			},
+		},
+	})
+}
+
+func TestClockSkew(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 12, 0, 0, 400_000_000, time.UTC)
+	end := start.Add(200 * time.Millisecond)
+
+	cases := []struct {
+		name       string
+		serverTime time.Time
+		want       time.Duration
+	}{
+		{name: "same second", serverTime: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC), want: 0},
+		{name: "server behind", serverTime: time.Date(2026, 1, 1, 11, 58, 0, 0, time.UTC), want: 2 * time.Minute},
+		{name: "server ahead", serverTime: time.Date(2026, 1, 1, 12, 0, 30, 0, time.UTC), want: 30*time.Second - 600*time.Millisecond},
+	}
+
+	for _, tc := range cases {
+		if got := clockSkew(start, end, tc.serverTime); got != tc.want {
+			t.Errorf("%s: clockSkew() = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestDoctorSummary(t *testing.T) {
+	t.Parallel()
+
+	got := doctorSummary([]doctorCheck{
+		{Name: "api_reachable", Target: "https://arcane", Status: doctorPass, Detail: "Arcane answered."},
+		{Name: "agent_connected", Target: "env-1", Status: doctorFail, Detail: "agent offline"},
+	})
+	want := "PASS api_reachable (https://arcane): Arcane answered.\nFAIL agent_connected (env-1): agent offline"
+	if got != want {
+		t.Errorf("doctorSummary() = %q, want %q", got, want)
+	}
+}
+
+func testDoctorDataSourceConfig(url, envID string, failOnError bool) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_doctor" "test" {
+  environment_ids = [%[2]q]
+  fail_on_error   = %[3]t
+}
+`, url, envID, failOnError)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// driftReport collects attributes of an Arcane object that were changed
+// outside Terraform, as seen when Read compares the prior state with the API.
+// The changes are reported as a single warning per object so that
+// `terraform plan -refresh-only` explains what changed, not just that it did.
+type driftReport struct {
+	resource string // resource type and ID, e.g. arcane_gitops_sync "sync-1"
+	changes  []string
+}
+
+// newDriftReport returns a report for the object id of resource type typeName.
+func newDriftReport(typeName, id string) *driftReport {
+	return &driftReport{resource: fmt.Sprintf("%s %q", typeName, id)}
+}
+
+// compare records a change of attribute from prior to current. Unknown or
+// null prior values (e.g. right after import) are not drift.
+func (d *driftReport) compare(attribute string, prior, current attr.Value) {
+	if prior.IsNull() || prior.IsUnknown() || prior.Equal(current) {
+		return
+	}
+	d.changes = append(d.changes, fmt.Sprintf("%s: %s → %s", attribute, prior, current))
+}
+
+// addTo adds the drift warning to diags if any change was recorded.
+func (d *driftReport) addTo(diags *diag.Diagnostics) {
+	if len(d.changes) == 0 {
+		return
+	}
+	diags.AddWarning(
+		"Arcane object changed outside Terraform",
+		fmt.Sprintf("%s was changed outside Terraform:\n- %s\n\n"+
+			"Review the plan to see whether applying would revert the change, or update the configuration to keep it.",
+			d.resource, strings.Join(d.changes, "\n- ")),
+	)
+}
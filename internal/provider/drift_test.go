@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDriftReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("changes reported in one warning", func(t *testing.T) {
+		t.Parallel()
+		drift := newDriftReport("arcane_gitops_sync", "sync-1")
+		drift.compare("branch", types.StringValue("main"), types.StringValue("develop"))
+		drift.compare("auto_sync", types.BoolValue(true), types.BoolValue(false))
+		drift.compare("path", types.StringValue("apps/web"), types.StringValue("apps/web"))
+
+		var diags diag.Diagnostics
+		drift.addTo(&diags)
+
+		if diags.WarningsCount() != 1 {
+			t.Fatalf("expected 1 warning, got %d", diags.WarningsCount())
+		}
+		detail := diags.Warnings()[0].Detail()
+		for _, want := range []string{`arcane_gitops_sync "sync-1"`, `branch: "main" → "develop"`, "auto_sync: true → false"} {
+			if !strings.Contains(detail, want) {
+				t.Errorf("detail %q does not contain %q", detail, want)
+			}
+		}
+		if strings.Contains(detail, "path") {
+			t.Errorf("unchanged attribute reported as drift: %q", detail)
+		}
+	})
+
+	t.Run("null or unknown prior is not drift", func(t *testing.T) {
+		t.Parallel()
+		drift := newDriftReport("arcane_environment", "env-1")
+		drift.compare("description", types.StringNull(), types.StringValue("imported"))
+		drift.compare("name", types.StringUnknown(), types.StringValue("prod"))
+
+		var diags diag.Diagnostics
+		drift.addTo(&diags)
+
+		if len(diags) != 0 {
+			t.Errorf("expected no diagnostics, got %v", diags)
+		}
+	})
+
+	t.Run("value removed outside Terraform", func(t *testing.T) {
+		t.Parallel()
+		drift := newDriftReport("arcane_container_registry", "reg-1")
+		drift.compare("username", types.StringValue("bot"), types.StringNull())
+
+		var diags diag.Diagnostics
+		drift.addTo(&diags)
+
+		if diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), `username: "bot" → <null>`) {
+			t.Errorf("expected removal to be reported, got %v", diags)
+		}
+	})
+}
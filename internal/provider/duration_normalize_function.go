@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &DurationNormalizeFunction{}
+
+// NewDurationNormalizeFunction returns a new duration_normalize function.
+func NewDurationNormalizeFunction() function.Function {
+	return &DurationNormalizeFunction{}
+}
+
+// DurationNormalizeFunction converts duration strings in the formats HCL
+// authors commonly mix ("5m", "300s", "PT5M", "300") to one canonical form.
+type DurationNormalizeFunction struct{}
+
+func (f *DurationNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration_normalize"
+}
+
+func (f *DurationNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a duration string to its canonical form",
+		MarkdownDescription: "Converts a duration to the canonical Go duration form used by `sync_interval` and `wait_timeout`, " +
+			"so equal durations compare equal regardless of how they were written. Accepts Go durations (`5m`, `300s`, `1h30m`), " +
+			"ISO 8601 durations (`PT5M`, `P1DT2H`) and a bare number of seconds (`300`). " +
+			"For example `\"300s\"`, `\"PT5M\"` and `\"300\"` all normalize to `\"5m\"`, and `\"PT1H30M\"` to `\"1h30m\"`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "duration",
+				MarkdownDescription: "The duration to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DurationNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	d, err := parseFlexibleDuration(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, formatCanonicalDuration(d)))
+}
+
+// iso8601DurationRe matches ISO 8601 durations with week, day and time parts.
+// Years and months are deliberately unsupported since their length varies.
+var iso8601DurationRe = regexp.MustCompile(`^P(?:(\d+(?:\.\d+)?)W)?(?:(\d+(?:\.\d+)?)D)?(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// parseFlexibleDuration parses a Go duration, an ISO 8601 duration or a bare
+// number of seconds.
+func parseFlexibleDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("duration must not be empty")
+	}
+
+	if secs, err := strconv.ParseFloat(s, 64); err == nil {
+		if math.IsNaN(secs) || math.IsInf(secs, 0) {
+			return 0, fmt.Errorf("invalid duration %q: expected a finite number of seconds", s)
+		}
+		if secs < 0 {
+			return 0, fmt.Errorf("duration %q must not be negative", s)
+		}
+		return floatDuration(s, secs*float64(time.Second))
+	}
+
+	if upper := strings.ToUpper(s); strings.HasPrefix(upper, "P") {
+		m := iso8601DurationRe.FindStringSubmatch(upper)
+		if m == nil || upper == "P" || strings.HasSuffix(upper, "T") {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q (years and months are not supported)", s)
+		}
+		units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+		var total float64
+		for i, unit := range units {
+			if m[i+1] == "" {
+				continue
+			}
+			v, _ := strconv.ParseFloat(m[i+1], 64)
+			total += v * float64(unit)
+		}
+		return floatDuration(s, total)
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: expected a Go duration (5m), ISO 8601 duration (PT5M) or number of seconds (300)", s)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("duration %q must not be negative", s)
+	}
+	return d, nil
+}
+
+// floatDuration converts ns nanoseconds, parsed from s, to a Duration,
+// rejecting values that don't fit in one (about 292 years).
+func floatDuration(s string, ns float64) (time.Duration, error) {
+	// float64(math.MaxInt64) rounds up to 2^63, which itself overflows.
+	if ns >= float64(math.MaxInt64) {
+		return 0, fmt.Errorf("duration %q is too long", s)
+	}
+	return time.Duration(ns), nil
+}
+
+// formatCanonicalDuration formats d like time.Duration.String but drops
+// trailing zero units, so 5m0s becomes 5m and 1h0m0s becomes 1h.
+func formatCanonicalDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
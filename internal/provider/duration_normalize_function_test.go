@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestParseFlexibleDuration validates parsing of Go, ISO 8601 and bare-second durations.
+func TestParseFlexibleDuration(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		input string
+		want  time.Duration
+	}{
+		{"5m", 5 * time.Minute},
+		{"300s", 5 * time.Minute},
+		{"300", 5 * time.Minute},
+		{"1.5", 1500 * time.Millisecond},
+		{"PT5M", 5 * time.Minute},
+		{"pt90s", 90 * time.Second},
+		{"P1DT2H", 26 * time.Hour},
+		{"P1W", 7 * 24 * time.Hour},
+		{"PT0.5S", 500 * time.Millisecond},
+		{" 1h30m ", 90 * time.Minute},
+		{"9223372036", 9223372036 * time.Second},
+	}
+	for _, tc := range cases {
+		got, err := parseFlexibleDuration(tc.input)
+		if err != nil {
+			t.Errorf("parseFlexibleDuration(%q) unexpected error: %v", tc.input, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("parseFlexibleDuration(%q) = %s, want %s", tc.input, got, tc.want)
+		}
+	}
+}
+
+// TestParseFlexibleDuration_GivenInvalidInput_ReturnsError validates rejected inputs.
+func TestParseFlexibleDuration_GivenInvalidInput_ReturnsError(t *testing.T) {
+	t.Parallel()
+	for _, input := range []string{"", "P", "PT", "P1M", "P1Y", "-5m", "-10", "five minutes",
+		"NaN", "nan", "Inf", "+Inf", "-Inf", "infinity",
+		"1e300", "9223372037", "P1000000W", "PT9223372037S", "2562048h"} {
+		if _, err := parseFlexibleDuration(input); err == nil {
+			t.Errorf("parseFlexibleDuration(%q) expected error", input)
+		}
+	}
+}
+
+// TestFormatCanonicalDuration validates that trailing zero units are dropped.
+func TestFormatCanonicalDuration(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		input time.Duration
+		want  string
+	}{
+		{0, "0s"},
+		{30 * time.Second, "30s"},
+		{5 * time.Minute, "5m"},
+		{90 * time.Second, "1m30s"},
+		{time.Hour, "1h"},
+		{90 * time.Minute, "1h30m"},
+		{time.Hour + 30*time.Second, "1h0m30s"},
+		{1500 * time.Millisecond, "1.5s"},
+	}
+	for _, tc := range cases {
+		if got := formatCanonicalDuration(tc.input); got != tc.want {
+			t.Errorf("formatCanonicalDuration(%s) = %q, want %q", tc.input, got, tc.want)
+		}
+	}
+}
+
+// TestDurationNormalizeFunction_GivenMixedFormats_WhenCalled_ThenCanonical
+// validates that the provider function returns the same value for equal durations.
+func TestDurationNormalizeFunction_GivenMixedFormats_WhenCalled_ThenCanonical(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "go" {
+  value = provider::arcane::duration_normalize("300s")
+}
+
+output "iso" {
+  value = provider::arcane::duration_normalize("PT5M")
+}
+
+output "seconds" {
+  value = provider::arcane::duration_normalize("300")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("go", "5m"),
+					resource.TestCheckOutput("iso", "5m"),
+					resource.TestCheckOutput("seconds", "5m"),
+				),
+			},
+		},
+	})
+}
+
+// TestDurationNormalizeFunction_GivenInvalidDuration_WhenCalled_ThenError
+// validates that invalid input is reported as an argument error.
+func TestDurationNormalizeFunction_GivenInvalidDuration_WhenCalled_ThenError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "bad" {
+  value = provider::arcane::duration_normalize("P1M")
+}
+`,
+				ExpectError: regexp.MustCompile(`years and months are not supported`),
+			},
+		},
+	})
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the duration string type fully satisfies framework interfaces.
+var (
+	_ basetypes.StringTypable                    = durationStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = durationStringValue{}
+)
+
+// durationStringType is a string type for durations that Arcane may echo back
+// in another notation, e.g. 300 for 5m. Values that parse to the same duration
+// are semantically equal, so the framework keeps the configured notation in
+// state instead of reporting a difference.
+type durationStringType struct {
+	basetypes.StringType
+}
+
+func (t durationStringType) Equal(o attr.Type) bool {
+	other, ok := o.(durationStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t durationStringType) String() string {
+	return "durationStringType"
+}
+
+func (t durationStringType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return durationStringValue{StringValue: in}, nil
+}
+
+func (t durationStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return durationStringValue{StringValue: stringValue}, nil
+}
+
+func (t durationStringType) ValueType(ctx context.Context) attr.Value {
+	return durationStringValue{}
+}
+
+// durationStringValue is a value of durationStringType.
+type durationStringValue struct {
+	basetypes.StringValue
+}
+
+// newDurationStringValue returns a known durationStringValue of s.
+func newDurationStringValue(s string) durationStringValue {
+	return durationStringValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+func (v durationStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(durationStringValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v durationStringValue) Type(ctx context.Context) attr.Type {
+	return durationStringType{}
+}
+
+// StringSemanticEquals reports whether both values parse to the same duration.
+// Values that do not parse are only equal when identical.
+func (v durationStringValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(durationStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	return v.semanticallyEqual(newValue), diags
+}
+
+// semanticallyEqual reports whether v and o are both known and parse to the
+// same duration, or are identical.
+func (v durationStringValue) semanticallyEqual(o durationStringValue) bool {
+	if v.Equal(o) {
+		return true
+	}
+	if v.IsNull() || v.IsUnknown() || o.IsNull() || o.IsUnknown() {
+		return false
+	}
+	d, err := parseFlexibleDuration(v.ValueString())
+	if err != nil {
+		return false
+	}
+	od, err := parseFlexibleDuration(o.ValueString())
+	return err == nil && d == od
+}
+
+// reconcileDurationString returns the duration echoed by the server, keeping
+// prior when it is the same duration in another notation.
+func reconcileDurationString(prior durationStringValue, echoed string) durationStringValue {
+	value := newDurationStringValue(echoed)
+	if prior.semanticallyEqual(value) {
+		return prior
+	}
+	return value
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// TestDurationStringValue_StringSemanticEquals validates that durations in
+// different notations are equal and that unparseable values are only equal
+// to themselves.
+func TestDurationStringValue_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		prior, current string
+		want           bool
+	}{
+		{"5m", "300", true},
+		{"5m", "300s", true},
+		{"1h", "PT1H", true},
+		{"5m", "5m", true},
+		{"5m", "10m", false},
+		{"5m", "301", false},
+		{"often", "often", true},
+		{"often", "300", false},
+	}
+	for _, tc := range cases {
+		got, diags := newDurationStringValue(tc.prior).StringSemanticEquals(context.Background(), newDurationStringValue(tc.current))
+		if diags.HasError() {
+			t.Fatalf("StringSemanticEquals(%q, %q) unexpected diagnostics: %v", tc.prior, tc.current, diags)
+		}
+		if got != tc.want {
+			t.Errorf("StringSemanticEquals(%q, %q) = %t, want %t", tc.prior, tc.current, got, tc.want)
+		}
+	}
+}
+
+// TestDurationStringValue_GivenNullPrior_WhenCompared_ThenNotEqual validates
+// that a null value is not equal to a known one.
+func TestDurationStringValue_GivenNullPrior_WhenCompared_ThenNotEqual(t *testing.T) {
+	t.Parallel()
+	null := durationStringValue{StringValue: basetypes.NewStringNull()}
+	if got, _ := null.StringSemanticEquals(context.Background(), newDurationStringValue("5m")); got {
+		t.Error("expected null and 5m not to be semantically equal")
+	}
+}
+
+// TestReconcileDurationString validates that the prior notation is kept for
+// the same duration and the echoed value is used otherwise.
+func TestReconcileDurationString(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		prior  durationStringValue
+		echoed string
+		want   string
+	}{
+		{newDurationStringValue("5m"), "300", "5m"},
+		{newDurationStringValue("5m"), "600", "600"},
+		{durationStringValue{StringValue: basetypes.NewStringNull()}, "300", "300"},
+	}
+	for _, tc := range cases {
+		if got := reconcileDurationString(tc.prior, tc.echoed); got.ValueString() != tc.want {
+			t.Errorf("reconcileDurationString(%s, %q) = %s, want %q", tc.prior, tc.echoed, got, tc.want)
+		}
+	}
+}
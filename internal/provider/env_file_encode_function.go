@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &EnvFileEncodeFunction{}
+
+// NewEnvFileEncodeFunction returns a new env_file_encode function.
+func NewEnvFileEncodeFunction() function.Function {
+	return &EnvFileEncodeFunction{}
+}
+
+// EnvFileEncodeFunction converts a map of variables to .env file content.
+type EnvFileEncodeFunction struct{}
+
+func (f *EnvFileEncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "env_file_encode"
+}
+
+func (f *EnvFileEncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Encode a map as .env file content",
+		MarkdownDescription: "Converts a map of variables to `.env` content as read by Docker Compose, one `KEY=value` line per " +
+			"variable ordered by key, so equal maps always encode to the same content, e.g. for `triggers`. " +
+			"Values are written bare when that is unambiguous, in single quotes when they contain spaces, `#`, `$`, quotes " +
+			"or backslashes, and in double quotes with `\\n`, `\\\"`, `\\\\` and `$$` escapes when they contain a single " +
+			"quote or a line break. For example `{ B = \"x y\", A = \"1\" }` encodes to `\"A=1\\nB='x y'\\n\"`. " +
+			"Keys must start with a letter or underscore and contain only letters, digits, `_`, `.` and `-`.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "variables",
+				MarkdownDescription: "The variables to encode.",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EnvFileEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vars map[string]types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &vars))
+	if resp.Error != nil {
+		return
+	}
+
+	content, err := encodeEnvFile(vars)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, content))
+}
+
+var (
+	// envKeyRe matches the variable names Docker Compose accepts in .env files.
+	envKeyRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+	// envBareValueRe matches values that need no quoting.
+	envBareValueRe = regexp.MustCompile(`^[A-Za-z0-9_./:@%+,=-]*$`)
+)
+
+// encodeEnvFile returns vars as .env content, one line per variable ordered
+// by key.
+func encodeEnvFile(vars map[string]types.String) (string, error) {
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var b strings.Builder
+	for _, key := range keys {
+		if !envKeyRe.MatchString(key) {
+			return "", fmt.Errorf("invalid variable name %q: names must start with a letter or underscore and contain only letters, digits, _, . and -", key)
+		}
+		value := vars[key]
+		if value.IsNull() {
+			return "", fmt.Errorf("value of variable %q must not be null", key)
+		}
+		fmt.Fprintf(&b, "%s=%s\n", key, quoteEnvValue(value.ValueString()))
+	}
+	return b.String(), nil
+}
+
+// quoteEnvValue quotes value for a .env file: bare when it is unambiguous,
+// single-quoted, which Compose reads literally, unless it contains a single
+// quote or line break, and double-quoted with escapes otherwise.
+func quoteEnvValue(value string) string {
+	if envBareValueRe.MatchString(value) {
+		return value
+	}
+	if !strings.ContainsAny(value, "'\r\n") {
+		return "'" + value + "'"
+	}
+	return `"` + strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"$", "$$",
+	).Replace(value) + `"`
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestQuoteEnvValue validates that values are quoted only as much as needed.
+func TestQuoteEnvValue(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		input string
+		want  string
+	}{
+		{"", ""},
+		{"production", "production"},
+		{"postgres://db:5432/app?sslmode=disable", "'postgres://db:5432/app?sslmode=disable'"},
+		{"https://example.com/a,b", "https://example.com/a,b"},
+		{"hello world", "'hello world'"},
+		{"p@ss#word", "'p@ss#word'"},
+		{"$HOME", "'$HOME'"},
+		{`say "hi"`, `'say "hi"'`},
+		{`C:\data`, `'C:\data'`},
+		{"it's", `"it's"`},
+		{"line1\nline2", `"line1\nline2"`},
+		{"it's $5 \"off\" \\o/\r\n", `"it's $$5 \"off\" \\o/\r\n"`},
+	}
+	for _, tc := range cases {
+		if got := quoteEnvValue(tc.input); got != tc.want {
+			t.Errorf("quoteEnvValue(%q) = %s, want %s", tc.input, got, tc.want)
+		}
+	}
+}
+
+// TestEncodeEnvFile validates key ordering and rejected keys and values.
+func TestEncodeEnvFile(t *testing.T) {
+	t.Parallel()
+
+	got, err := encodeEnvFile(map[string]types.String{
+		"PORT":         types.StringValue("8080"),
+		"APP_NAME":     types.StringValue("my app"),
+		"log.level":    types.StringValue("debug"),
+		"_EMPTY":       types.StringValue(""),
+		"FEATURE-FLAG": types.StringValue("on"),
+	})
+	if err != nil {
+		t.Fatalf("encodeEnvFile() unexpected error: %v", err)
+	}
+	want := "APP_NAME='my app'\nFEATURE-FLAG=on\nPORT=8080\n_EMPTY=\nlog.level=debug\n"
+	if got != want {
+		t.Errorf("encodeEnvFile() = %q, want %q", got, want)
+	}
+
+	if got, err := encodeEnvFile(nil); err != nil || got != "" {
+		t.Errorf("encodeEnvFile(nil) = %q, %v, want empty content", got, err)
+	}
+
+	for name, vars := range map[string]map[string]types.String{
+		"leading digit": {"1PORT": types.StringValue("80")},
+		"space in key":  {"MY VAR": types.StringValue("x")},
+		"equals in key": {"A=B": types.StringValue("x")},
+		"empty key":     {"": types.StringValue("x")},
+		"null value":    {"PORT": types.StringNull()},
+	} {
+		if _, err := encodeEnvFile(vars); err == nil {
+			t.Errorf("%s: encodeEnvFile() expected error", name)
+		}
+	}
+}
+
+// TestEnvFileEncodeFunction_GivenMap_WhenCalled_ThenSortedDotenv validates the
+// content returned by the provider function.
+func TestEnvFileEncodeFunction_GivenMap_WhenCalled_ThenSortedDotenv(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "env" {
+  value = provider::arcane::env_file_encode({
+    TZ       = "Europe/Paris"
+    GREETING = "hello world"
+  })
+}
+`,
+				Check: resource.TestCheckOutput("env", "GREETING='hello world'\nTZ=Europe/Paris\n"),
+			},
+		},
+	})
+}
+
+// TestEnvFileEncodeFunction_GivenInvalidName_WhenCalled_ThenError validates
+// that invalid variable names are reported as an argument error.
+func TestEnvFileEncodeFunction_GivenInvalidName_WhenCalled_ThenError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "bad" {
+  value = provider::arcane::env_file_encode({ "MY VAR" = "x" })
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid variable name "MY VAR"`),
+			},
+		},
+	})
+}
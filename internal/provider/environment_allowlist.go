@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// checkPlannedEnvironmentAllowed fails a plan that creates, changes or
+// destroys a resource in an environment excluded by the provider's
+// allowed_environments or denied_environments. The environment is read from
+// the environment_id attribute of the plan, or of the state on destroy. An
+// environment_id that is not known yet belongs to an environment created in
+// the same apply, which the arcane_environment resource checks by name.
+func checkPlannedEnvironmentAllowed(ctx context.Context, c *Client, req resource.ModifyPlanRequest, diags *diag.Diagnostics) {
+	if c == nil || !c.RestrictsEnvironments() {
+		return
+	}
+
+	var environmentID types.String
+	if req.Plan.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("environment_id"), &environmentID)...)
+	} else {
+		diags.Append(req.Plan.GetAttribute(ctx, path.Root("environment_id"), &environmentID)...)
+	}
+	if diags.HasError() || environmentID.IsNull() || environmentID.IsUnknown() {
+		return
+	}
+
+	// Entries may be names, so resolve the environment's name as well. An
+	// environment that no longer exists can only match by ID.
+	var name string
+	env, err := c.GetEnvironment(ctx, environmentID.ValueString())
+	switch {
+	case err == nil:
+		name = env.Name
+	case !arcane.IsNotFound(err):
+		diagnostics.AddAPIError(ctx, diags, err, "Failed to check allowed_environments")
+		return
+	}
+
+	if !c.EnvironmentAllowed(environmentID.ValueString(), name) {
+		addEnvironmentNotAllowedError(ctx, diags, environmentID.ValueString(), name)
+	}
+}
+
+// addEnvironmentNotAllowedError reports that the environment with the given
+// ID and name (either may be empty) may not be managed.
+func addEnvironmentNotAllowedError(ctx context.Context, diags *diag.Diagnostics, id, name string) {
+	env := fmt.Sprintf("%q", id)
+	switch {
+	case id == "":
+		env = fmt.Sprintf("%q", name)
+	case name != "":
+		env = fmt.Sprintf("%q (%s)", name, id)
+	}
+	diagnostics.AddError(ctx, diags, diagnostics.CodeEnvNotAllowed,
+		"Environment not allowed",
+		fmt.Sprintf("Environment %s is excluded by the provider's allowed_environments or denied_environments, "+
+			"so resources in it can't be managed from this configuration.", env),
+	)
+}
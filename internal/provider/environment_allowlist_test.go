@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestProvider_GivenAllowedEnvironments_WhenDeployingElsewhere_ThenPlanFails
+// validates that a deployment to an environment outside allowed_environments
+// fails at plan time, before any deploy call.
+func TestProvider_GivenAllowedEnvironments_WhenDeployingElsewhere_ThenPlanFails(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-prod"] = &arcane.Environment{ID: "env-prod", Name: "production"}
+	mockServer.HealthyEnvs["env-prod"] = true
+	mockServer.AddProject("env-prod", &arcane.Project{ID: "proj-1", Name: "web", Status: "stopped", EnvironmentID: "env-prod"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAllowlistDeploymentConfig(mockServer.URL, `allowed_environments = ["staging"]`, "env-prod"),
+				ExpectError: regexp.MustCompile(`(?s)Environment not allowed.*"production" \(env-prod\).*ARC013`),
+			},
+		},
+	})
+
+	if got := mockServer.RequestCount(http.MethodPost, "/api/environments/env-prod/projects/proj-1/up"); got != 0 {
+		t.Errorf("expected no deploy requests, got %d", got)
+	}
+}
+
+// TestProvider_GivenAllowedEnvironmentName_WhenDeploying_ThenDeploySucceeds
+// validates that allowed_environments entries match environment names.
+func TestProvider_GivenAllowedEnvironmentName_WhenDeploying_ThenDeploySucceeds(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-stg"] = &arcane.Environment{ID: "env-stg", Name: "staging"}
+	mockServer.HealthyEnvs["env-stg"] = true
+	mockServer.AddProject("env-stg", &arcane.Project{ID: "proj-1", Name: "web", Status: "stopped", EnvironmentID: "env-stg"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAllowlistDeploymentConfig(mockServer.URL, `allowed_environments = ["staging"]`, "env-stg"),
+				Check:  resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
+			},
+		},
+	})
+}
+
+// TestProvider_GivenDeniedEnvironments_WhenCreatingEnvironment_ThenPlanFails
+// validates that an arcane_environment named in denied_environments can't be
+// created.
+func TestProvider_GivenDeniedEnvironments_WhenCreatingEnvironment_ThenPlanFails(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "arcane" {
+  url                 = %[1]q
+  denied_environments = ["production"]
+}
+
+resource "arcane_environment" "test" {
+  name    = "production"
+  api_url = "http://10.100.1.100:3553"
+}
+`, mockServer.URL),
+				ExpectError: regexp.MustCompile(`Environment not allowed`),
+			},
+		},
+	})
+}
+
+// TestProvider_GivenNamePrefix_WhenCreatingEnvironment_ThenPrefixedNameChecked
+// validates that arcane_environment matches allowed_environments and
+// denied_environments against its name in Arcane, including name_prefix, as
+// other resources do for the environments they target.
+func TestProvider_GivenNamePrefix_WhenCreatingEnvironment_ThenPrefixedNameChecked(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAllowlistPrefixedEnvironmentConfig(mockServer.URL, `denied_environments = ["pr-production"]`, "production"),
+				ExpectError: regexp.MustCompile(`(?s)Environment not allowed.*"pr-production"`),
+			},
+			{
+				Config:      testAllowlistPrefixedEnvironmentConfig(mockServer.URL, `allowed_environments = ["staging"]`, "staging"),
+				ExpectError: regexp.MustCompile(`(?s)Environment not allowed.*"pr-staging"`),
+			},
+			{
+				Config: testAllowlistPrefixedEnvironmentConfig(mockServer.URL, `allowed_environments = ["pr-staging"]`, "staging"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_environment.test", "name", "staging"),
+				),
+			},
+		},
+	})
+}
+
+func testAllowlistPrefixedEnvironmentConfig(url, restriction, name string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url         = %[1]q
+  name_prefix = "pr-"
+  %[2]s
+}
+
+resource "arcane_environment" "test" {
+  name    = %[3]q
+  api_url = "http://10.100.1.100:3553"
+}
+`, url, restriction, name)
+}
+
+func testAllowlistDeploymentConfig(url, restriction, envID string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+  %[2]s
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = %[3]q
+  project_id     = "proj-1"
+}
+`, url, restriction, envID)
+}
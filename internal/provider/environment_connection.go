@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultConnectionTimeout is how long wait_for_connection waits when
+// connection_timeout is unset.
+const defaultConnectionTimeout = 5 * time.Minute
+
+// environmentConnectionPollInterval is how often the agent connection is
+// re-tested while waiting for it.
+var environmentConnectionPollInterval = 5 * time.Second
+
+// validateConnectionTimeout rejects a connection_timeout that isn't a
+// positive Go duration.
+func validateConnectionTimeout(timeout types.String, diags *diag.Diagnostics) {
+	if timeout.IsNull() || timeout.IsUnknown() {
+		return
+	}
+	d, err := time.ParseDuration(timeout.ValueString())
+	if err == nil && d <= 0 {
+		err = fmt.Errorf("must be positive")
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("connection_timeout"),
+			"Invalid connection_timeout",
+			fmt.Sprintf("Expected a positive Go duration such as 5m or 90s: %s", err),
+		)
+	}
+}
+
+// environmentConnectionTimeout returns connection_timeout, or the default
+// when it is unset. ValidateConfig has already rejected values that don't
+// parse.
+func environmentConnectionTimeout(timeout types.String) time.Duration {
+	if timeout.IsNull() || timeout.IsUnknown() {
+		return defaultConnectionTimeout
+	}
+	d, _ := time.ParseDuration(timeout.ValueString())
+	return d
+}
+
+// environmentConnected reports whether the environment's agent passes a
+// connection test.
+func environmentConnected(ctx context.Context, c *Client, envID string) types.Bool {
+	if err := c.TestEnvironment(ctx, envID); err != nil {
+		tflog.Debug(ctx, "Environment connection test failed", map[string]interface{}{
+			"environment_id": envID,
+			"error":          err.Error(),
+		})
+		return types.BoolValue(false)
+	}
+	return types.BoolValue(true)
+}
+
+// waitForEnvironmentConnection tests the connection to the environment's
+// agent until it succeeds, returning the last error if timeout elapses
+// first.
+func waitForEnvironmentConnection(ctx context.Context, c *Client, envID string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		err := c.TestEnvironment(ctx, envID)
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for the agent of environment %q to connect after %s: %w", envID, timeout, err)
+		}
+
+		tflog.Debug(ctx, "Agent not connected, retrying", map[string]interface{}{
+			"environment_id": envID,
+			"error":          err.Error(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(environmentConnectionPollInterval):
+		}
+	}
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -21,15 +23,31 @@ func NewEnvironmentDataSource() datasource.DataSource {
 
 // EnvironmentDataSource defines the environment data source implementation.
 type EnvironmentDataSource struct {
-	client *client.Client
+	client *Client
 }
 
 // EnvironmentDataSourceModel describes the environment data source data model.
 type EnvironmentDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	UseAPIKey   types.Bool   `tfsdk:"use_api_key"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	APIURL              types.String `tfsdk:"api_url"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+	UseAPIKey           types.Bool   `tfsdk:"use_api_key"`
+	IncludeProjects     types.Bool   `tfsdk:"include_projects"`
+	Projects            types.List   `tfsdk:"projects"`
+	ProjectCount        types.Int64  `tfsdk:"project_count"`
+	RunningProjectCount types.Int64  `tfsdk:"running_project_count"`
+	Connected           types.Bool   `tfsdk:"connected"`
+}
+
+var environmentProjectObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":     types.StringType,
+		"name":   types.StringType,
+		"status": types.StringType,
+	},
 }
 
 func (d *EnvironmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -60,6 +78,19 @@ data "arcane_environment" "example" {
   name = "production"
 }
 ` + "```" + `
+
+### With Project Summaries
+
+` + "```hcl" + `
+data "arcane_environment" "example" {
+  name             = "production"
+  include_projects = true
+}
+
+output "project_names" {
+  value = data.arcane_environment.example.projects[*].name
+}
+` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -76,10 +107,58 @@ data "arcane_environment" "example" {
 				MarkdownDescription: "The description of the environment.",
 				Computed:            true,
 			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the environment's agent API.",
+				Computed:            true,
+			},
 			"use_api_key": schema.BoolAttribute{
 				MarkdownDescription: "Whether the environment requires API key authentication.",
 				Computed:            true,
 			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the environment was created, as reported by Arcane. Unset when Arcane doesn't report it.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the environment was last updated, as reported by Arcane. Unset when Arcane doesn't report it.",
+				Computed:            true,
+			},
+			"include_projects": schema.BoolAttribute{
+				MarkdownDescription: "Set to `true` to populate `projects` with a summary of every project in the environment. Requires the environment's agent to be reachable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"project_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of projects in the environment. Unset while the agent is unreachable (see `connected`), in which case it isn't queried unless `include_projects` is set.",
+				Computed:            true,
+			},
+			"running_project_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of projects in the environment whose status is `running`. Unset while the agent is unreachable (see `connected`), in which case it isn't queried unless `include_projects` is set.",
+				Computed:            true,
+			},
+			"connected": schema.BoolAttribute{
+				MarkdownDescription: "Whether the environment's agent passes a connection test.",
+				Computed:            true,
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "Summaries of the projects in the environment. Only populated when `include_projects` is `true`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the project.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The current status of the project (e.g., `running`, `exited`).",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -89,11 +168,11 @@ func (d *EnvironmentDataSource) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	c, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
 		)
 		return
 	}
@@ -102,6 +181,9 @@ func (d *EnvironmentDataSource) Configure(ctx context.Context, req datasource.Co
 }
 
 func (d *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
 	var data EnvironmentDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -118,7 +200,7 @@ func (d *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	var env *client.Environment
+	var env *arcane.Environment
 	var err error
 
 	if !data.ID.IsNull() {
@@ -130,7 +212,7 @@ func (d *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read environment", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read environment")
 		return
 	}
 
@@ -142,7 +224,44 @@ func (d *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadReq
 	} else {
 		data.Description = types.StringNull()
 	}
+	data.APIURL = optionalString(env.APIURL)
 	data.UseAPIKey = types.BoolValue(env.UseAPIKey)
+	data.CreatedAt = optionalString(env.CreatedAt)
+	data.UpdatedAt = optionalString(env.UpdatedAt)
+	data.Connected = environmentConnected(ctx, d.client, env.ID)
+
+	data.Projects = types.ListNull(environmentProjectObjectType)
+	if !data.IncludeProjects.ValueBool() {
+		data.ProjectCount, data.RunningProjectCount = environmentProjectCounts(ctx, d.client, env.ID, data.Connected, &resp.Diagnostics)
+	} else {
+		projects, err := d.client.ForEnvironment(env.ID).ListProjects(ctx)
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list environment projects")
+			return
+		}
+		data.ProjectCount, data.RunningProjectCount = countProjects(projects)
+
+		projectValues := make([]attr.Value, len(projects))
+		for i, p := range projects {
+			objVal, diags := types.ObjectValue(environmentProjectObjectType.AttrTypes, map[string]attr.Value{
+				"id":     types.StringValue(p.ID),
+				"name":   types.StringValue(p.Name),
+				"status": types.StringValue(p.Status),
+			})
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			projectValues[i] = objVal
+		}
+
+		projectList, diags := types.ListValue(environmentProjectObjectType, projectValues)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Projects = projectList
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
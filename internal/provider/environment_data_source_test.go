@@ -6,7 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // TestEnvironmentDataSource_GivenExistingEnvironment_WhenLookedUpByID_ThenReturnsEnvironment
@@ -16,7 +16,7 @@ func TestEnvironmentDataSource_GivenExistingEnvironment_WhenLookedUpByID_ThenRet
 	defer mockServer.Close()
 
 	// Pre-populate mock server with an environment
-	mockServer.Environments["env-test"] = &client.Environment{
+	mockServer.Environments["env-test"] = &arcane.Environment{
 		ID:          "env-test",
 		Name:        "test-environment",
 		Description: "A test environment",
@@ -33,6 +33,7 @@ func TestEnvironmentDataSource_GivenExistingEnvironment_WhenLookedUpByID_ThenRet
 					resource.TestCheckResourceAttr("data.arcane_environment.test", "name", "test-environment"),
 					resource.TestCheckResourceAttr("data.arcane_environment.test", "description", "A test environment"),
 					resource.TestCheckResourceAttr("data.arcane_environment.test", "use_api_key", "false"),
+					resource.TestCheckResourceAttr("data.arcane_environment.test", "connected", "false"),
 				),
 			},
 		},
@@ -46,11 +47,13 @@ func TestEnvironmentDataSource_GivenExistingEnvironment_WhenLookedUpByName_ThenR
 	defer mockServer.Close()
 
 	// Pre-populate mock server with an environment
-	mockServer.Environments["env-named"] = &client.Environment{
+	mockServer.Environments["env-named"] = &arcane.Environment{
 		ID:          "env-named",
 		Name:        "named-environment",
 		Description: "Environment looked up by name",
+		APIURL:      "http://10.0.0.5:3553",
 		UseAPIKey:   true,
+		CreatedAt:   "2026-01-02T03:04:05Z",
 	}
 
 	resource.Test(t, resource.TestCase{
@@ -62,6 +65,9 @@ func TestEnvironmentDataSource_GivenExistingEnvironment_WhenLookedUpByName_ThenR
 					resource.TestCheckResourceAttr("data.arcane_environment.test", "id", "env-named"),
 					resource.TestCheckResourceAttr("data.arcane_environment.test", "name", "named-environment"),
 					resource.TestCheckResourceAttr("data.arcane_environment.test", "use_api_key", "true"),
+					resource.TestCheckResourceAttr("data.arcane_environment.test", "api_url", "http://10.0.0.5:3553"),
+					resource.TestCheckResourceAttr("data.arcane_environment.test", "created_at", "2026-01-02T03:04:05Z"),
+					resource.TestCheckNoResourceAttr("data.arcane_environment.test", "updated_at"),
 				),
 			},
 		},
@@ -88,12 +94,69 @@ func TestEnvironmentDataSource_GivenResourceDependency_WhenLookedUp_ThenReturnsC
 						"data.arcane_environment.test", "name",
 						"arcane_environment.source", "name",
 					),
+					resource.TestCheckResourceAttr("data.arcane_environment.test", "connected", "true"),
+					resource.TestCheckResourceAttr("arcane_environment.source", "connected", "true"),
 				),
 			},
 		},
 	})
 }
 
+// TestEnvironmentDataSource_GivenIncludeProjects_WhenLookedUp_ThenProjectsEmbedded
+// validates that include_projects embeds the environment's project summaries.
+func TestEnvironmentDataSource_GivenIncludeProjects_WhenLookedUp_ThenProjectsEmbedded(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-projects"] = &arcane.Environment{
+		ID:   "env-projects",
+		Name: "projects-environment",
+	}
+	mockServer.HealthyEnvs["env-projects"] = true
+	mockServer.AddProject("env-projects", &arcane.Project{
+		ID:     "proj-web",
+		Name:   "web",
+		Status: "running",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentDataSourceConfigWithProjects(mockServer.URL, "env-projects", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_environment.test", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.arcane_environment.test", "projects.0.id", "proj-web"),
+					resource.TestCheckResourceAttr("data.arcane_environment.test", "projects.0.name", "web"),
+					resource.TestCheckResourceAttr("data.arcane_environment.test", "projects.0.status", "running"),
+					resource.TestCheckResourceAttr("data.arcane_environment.test", "project_count", "1"),
+					resource.TestCheckResourceAttr("data.arcane_environment.test", "running_project_count", "1"),
+				),
+			},
+			{
+				Config: testEnvironmentDataSourceConfigWithProjects(mockServer.URL, "env-projects", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("data.arcane_environment.test", "projects.#"),
+					resource.TestCheckResourceAttr("data.arcane_environment.test", "project_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testEnvironmentDataSourceConfigWithProjects(url, id string, include bool) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_environment" "test" {
+  id               = %[2]q
+  include_projects = %[3]t
+}
+`, url, id, include)
+}
+
 func testEnvironmentDataSourceConfigByID(url, id string) string {
 	return fmt.Sprintf(`
 provider "arcane" {
@@ -128,6 +191,7 @@ resource "arcane_environment" "source" {
   name        = "source-env"
   api_url     = "http://10.100.1.100:3553"
   description = "Source environment"
+  live_status = true
 }
 
 data "arcane_environment" "test" {
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// validateEnvironmentTTL rejects a ttl that isn't a positive Go duration.
+func validateEnvironmentTTL(ttl types.String, diags *diag.Diagnostics) {
+	if ttl.IsNull() || ttl.IsUnknown() {
+		return
+	}
+	d, err := time.ParseDuration(ttl.ValueString())
+	if err == nil && d <= 0 {
+		err = fmt.Errorf("must be positive")
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ttl"),
+			"Invalid ttl",
+			fmt.Sprintf("Expected a positive Go duration such as 72h or 30m: %s", err),
+		)
+	}
+}
+
+// environmentExpiresAt returns now plus ttl as an RFC 3339 timestamp in UTC,
+// or "" when ttl is unset. ValidateConfig has already rejected values that
+// don't parse.
+func environmentExpiresAt(ttl types.String, now time.Time) string {
+	if ttl.IsNull() || ttl.IsUnknown() {
+		return ""
+	}
+	d, _ := time.ParseDuration(ttl.ValueString())
+	return now.Add(d).UTC().Format(time.RFC3339)
+}
+
+// planEnvironmentExpiry plans expires_at: unset without a ttl, unknown when
+// the environment is created or its ttl changes, since the expiry counts from
+// the apply, and the value in state otherwise.
+func planEnvironmentExpiry(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var ttl, stateTTL, expiresAt types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ttl"), &ttl)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ttl"), &stateTTL)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case ttl.IsNull():
+		expiresAt = types.StringNull()
+	case req.State.Raw.IsNull() || !ttl.Equal(stateTTL) || expiresAt.IsNull():
+		expiresAt = types.StringUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), expiresAt)...)
+}
+
+// environmentExpiresAtValue returns the expiry Arcane reports for env, or
+// fallback when Arcane doesn't store expiries and so leaves it out.
+func environmentExpiresAtValue(env *arcane.Environment, fallback types.String) types.String {
+	if env.ExpiresAt != "" {
+		return types.StringValue(env.ExpiresAt)
+	}
+	return fallback
+}
+
+// addExpiryNotStoredWarning reports that Arcane ignored the expiry sent for an
+// environment, so only Terraform state records it.
+func addExpiryNotStoredWarning(diags *diag.Diagnostics, name string) {
+	diags.AddWarning(
+		"Environment expiry not stored",
+		fmt.Sprintf("Arcane did not store the expiry of environment %q, so it won't be cleaned up server-side and "+
+			"arcane_stale_environments can only find it by max_age. expires_at is kept in Terraform state.", name),
+	)
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -21,7 +21,7 @@ func NewEnvironmentHealthDataSource() datasource.DataSource {
 
 // EnvironmentHealthDataSource defines the environment health data source implementation.
 type EnvironmentHealthDataSource struct {
-	client *client.Client
+	client *Client
 }
 
 // EnvironmentHealthDataSourceModel describes the data model.
@@ -84,11 +84,11 @@ func (d *EnvironmentHealthDataSource) Configure(ctx context.Context, req datasou
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	c, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
 		)
 		return
 	}
@@ -97,6 +97,9 @@ func (d *EnvironmentHealthDataSource) Configure(ctx context.Context, req datasou
 }
 
 func (d *EnvironmentHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
 	var data EnvironmentHealthDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -6,7 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // TestEnvironmentHealthDataSource_GivenHealthyEnvironment_WhenRead_ThenIsConnectedTrue
@@ -18,7 +18,7 @@ func TestEnvironmentHealthDataSource_GivenHealthyEnvironment_WhenRead_ThenIsConn
 	envID := "env-health-1"
 
 	// Pre-populate mock server with a healthy environment
-	mockServer.Environments[envID] = &client.Environment{
+	mockServer.Environments[envID] = &arcane.Environment{
 		ID:   envID,
 		Name: "healthy-env",
 	}
@@ -48,7 +48,7 @@ func TestEnvironmentHealthDataSource_GivenUnhealthyEnvironment_WhenRead_ThenIsCo
 	envID := "env-health-2"
 
 	// Pre-populate mock server with an unhealthy environment
-	mockServer.Environments[envID] = &client.Environment{
+	mockServer.Environments[envID] = &arcane.Environment{
 		ID:   envID,
 		Name: "unhealthy-env",
 	}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// checkEnvironmentNameAvailable fails the plan of an environment whose name
+// is planned by another arcane_environment in the same configuration, e.g.
+// two for_each keys mapping to the same name, or, when the environment is
+// new or renamed, already used by an environment in Arcane. Without it the
+// server rejects the second create halfway through an apply. id is the ID of
+// the environment the resource manages or replaces, empty for a new
+// environment. When the environments can't be listed the server check is
+// skipped and the server remains the final authority.
+func checkEnvironmentNameAvailable(ctx context.Context, c *Client, id, name string, renamed bool, diags *diag.Diagnostics) {
+	if name == "" {
+		return
+	}
+
+	if !c.ClaimEnvironmentName(name, id) {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Duplicate environment name",
+			fmt.Sprintf("More than one arcane_environment in this configuration is named %q. "+
+				"Environment names must be unique; with for_each, derive the name from each.key.", name),
+		)
+		return
+	}
+
+	if id != "" && !renamed {
+		return
+	}
+	existing, err := c.GetEnvironmentByName(ctx, name)
+	if err != nil {
+		if !arcane.IsNotFound(err) {
+			tflog.Debug(ctx, "Could not list environments, skipping environment name check", map[string]interface{}{
+				"name":  name,
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+	if existing.ID == id {
+		return
+	}
+	// A tainted environment is planned for replacement without its state,
+	// but was refreshed from it first. Another resource with its name has
+	// already failed the claim above.
+	if managedID, ok := c.ManagedEnvironmentID(name); ok && id == "" && existing.ID == managedID {
+		return
+	}
+
+	diags.AddAttributeError(
+		path.Root("name"),
+		"Environment already exists",
+		fmt.Sprintf("Arcane already has an environment named %q (ID %q). "+
+			"Import it to manage it with Terraform:\n\n"+
+			"  terraform import <resource address> %s\n\n"+
+			"or choose another name.",
+			name, existing.ID, existing.ID),
+	)
+}
+
+// replacedEnvironmentKey is the private plan data recording the ID of the
+// environment a plan manages. Terraform plans a replacement twice, the second
+// time without state but with the private data of the first plan, so the new
+// environment can take over the name of the one it replaces.
+const replacedEnvironmentKey = "environment_id"
+
+// plannedEnvironmentID returns the ID of the environment a plan manages: the
+// ID in state, or for the second plan of a replacement, the ID of the
+// environment it replaces. It records the ID in the planned private data.
+func plannedEnvironmentID(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) string {
+	var id string
+	if !req.State.Raw.IsNull() {
+		var stateID types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &stateID)...)
+		id = stateID.ValueString()
+	} else if req.Private != nil {
+		raw, d := req.Private.GetKey(ctx, replacedEnvironmentKey)
+		resp.Diagnostics.Append(d...)
+		if len(raw) > 0 && json.Unmarshal(raw, &id) != nil {
+			id = ""
+		}
+	}
+	if id != "" {
+		raw, _ := json.Marshal(id)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, replacedEnvironmentKey, raw)...)
+	}
+	return id
+}
@@ -3,7 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -11,8 +14,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // accessTokenPlanModifier handles access_token plan modification based on regenerate_access_token.
@@ -56,8 +61,10 @@ func (m accessTokenPlanModifier) PlanModifyString(ctx context.Context, req planm
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &EnvironmentResource{}
-	_ resource.ResourceWithImportState = &EnvironmentResource{}
+	_ resource.Resource                   = &EnvironmentResource{}
+	_ resource.ResourceWithImportState    = &EnvironmentResource{}
+	_ resource.ResourceWithModifyPlan     = &EnvironmentResource{}
+	_ resource.ResourceWithValidateConfig = &EnvironmentResource{}
 )
 
 // NewEnvironmentResource returns a new environment resource.
@@ -67,7 +74,7 @@ func NewEnvironmentResource() resource.Resource {
 
 // EnvironmentResource defines the environment resource implementation.
 type EnvironmentResource struct {
-	client *client.Client
+	client *Client
 }
 
 // EnvironmentResourceModel describes the environment resource data model.
@@ -79,6 +86,127 @@ type EnvironmentResourceModel struct {
 	UseAPIKey             types.Bool   `tfsdk:"use_api_key"`
 	AccessToken           types.String `tfsdk:"access_token"`
 	RegenerateAccessToken types.Bool   `tfsdk:"regenerate_access_token"`
+	ManageAccessToken     types.Bool   `tfsdk:"manage_access_token"`
+	ProjectCount          types.Int64  `tfsdk:"project_count"`
+	RunningProjectCount   types.Int64  `tfsdk:"running_project_count"`
+	MinimumAgentVersion   types.String `tfsdk:"minimum_agent_version"`
+	AgentVersion          types.String `tfsdk:"agent_version"`
+	APIKeyAlias           types.String `tfsdk:"api_key_alias"`
+	ConfirmDestroy        types.String `tfsdk:"confirm_destroy"`
+	TTL                   types.String `tfsdk:"ttl"`
+	ExpiresAt             types.String `tfsdk:"expires_at"`
+	WaitForConnection     types.Bool   `tfsdk:"wait_for_connection"`
+	ConnectionTimeout     types.String `tfsdk:"connection_timeout"`
+	Connected             types.Bool   `tfsdk:"connected"`
+	LiveStatus            types.Bool   `tfsdk:"live_status"`
+}
+
+// environmentProjectCounts returns the total and running project counts for an
+// environment. Both are null, without querying the agent, when its connection
+// test failed, so that refreshing an environment whose agent is offline
+// doesn't wait for the request timeout. Projects that can't be listed
+// otherwise leave them null with a warning.
+func environmentProjectCounts(ctx context.Context, c *Client, envID string, connected types.Bool, diags *diag.Diagnostics) (types.Int64, types.Int64) {
+	if !connected.ValueBool() {
+		return types.Int64Null(), types.Int64Null()
+	}
+	projects, err := c.ForEnvironment(envID).ListProjects(ctx)
+	if err != nil {
+		diags.AddWarning(
+			"Project counts unavailable",
+			fmt.Sprintf("The projects of environment %q could not be listed, so project_count and running_project_count are unset: %s", envID, err),
+		)
+		return types.Int64Null(), types.Int64Null()
+	}
+	return countProjects(projects)
+}
+
+// countProjects returns the total and running counts for a list of projects.
+func countProjects(projects []arcane.Project) (types.Int64, types.Int64) {
+	var running int64
+	for _, p := range projects {
+		if isRunningStatus(p.Status) {
+			running++
+		}
+	}
+	return types.Int64Value(int64(len(projects))), types.Int64Value(running)
+}
+
+// refreshEnvironmentStatus sets connected, the project counts and
+// agent_version of data. The agent is only queried when live_status is set,
+// or for agent_version when minimum_agent_version is, so that a refresh
+// doesn't test the connection and list every project by default. Version
+// checks that fail are errors if enforce is true and warnings otherwise.
+func refreshEnvironmentStatus(ctx context.Context, c *Client, data *EnvironmentResourceModel, enforce bool, diags *diag.Diagnostics) {
+	envID := data.ID.ValueString()
+	data.Connected = types.BoolNull()
+	data.ProjectCount, data.RunningProjectCount = types.Int64Null(), types.Int64Null()
+	data.AgentVersion = types.StringNull()
+	if data.LiveStatus.ValueBool() {
+		data.Connected = environmentConnected(ctx, c, envID)
+		data.ProjectCount, data.RunningProjectCount = environmentProjectCounts(ctx, c, envID, data.Connected, diags)
+	}
+	if data.LiveStatus.ValueBool() || !data.MinimumAgentVersion.IsNull() {
+		data.AgentVersion = checkAgentVersion(ctx, c, envID, data.MinimumAgentVersion, enforce, diags)
+	}
+}
+
+// checkAgentVersion fetches the version reported by the environment's agent
+// and compares it with minimum (if set). When the agent reports an older
+// version, an error is added if enforce is true and a warning otherwise; when
+// the agent can't be queried the check is skipped with a warning. Returns the
+// reported version, or null if unavailable.
+func checkAgentVersion(ctx context.Context, c *Client, envID string, minimum types.String, enforce bool, diags *diag.Diagnostics) types.String {
+	var required *version.Version
+	if !minimum.IsNull() && !minimum.IsUnknown() {
+		v, err := version.NewVersion(minimum.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("minimum_agent_version"),
+				"Invalid minimum_agent_version",
+				fmt.Sprintf("%q is not a valid version: %s", minimum.ValueString(), err),
+			)
+			return types.StringNull()
+		}
+		required = v
+	}
+
+	reported, err := c.ForEnvironment(envID).GetAgentVersion(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Could not get agent version", map[string]interface{}{
+			"environment_id": envID,
+			"error":          err.Error(),
+		})
+		if required != nil {
+			diags.AddWarning(
+				"Agent version not verified",
+				fmt.Sprintf("Could not get the agent version of environment %q to check minimum_agent_version %s: %s. "+
+					"The check will run again on the next refresh.", envID, required, err),
+			)
+		}
+		return types.StringNull()
+	}
+
+	if required != nil {
+		current, err := version.NewVersion(reported)
+		switch {
+		case err != nil:
+			diags.AddWarning(
+				"Agent version not verified",
+				fmt.Sprintf("Environment %q reports an agent version %q that can't be compared with minimum_agent_version %s.", envID, reported, required),
+			)
+		case current.LessThan(required):
+			summary := "Agent version too old"
+			detail := fmt.Sprintf("Environment %q runs agent version %s, but minimum_agent_version requires %s or newer. "+
+				"Upgrade the agent before deploying projects that rely on newer agent features.", envID, current, required)
+			if enforce {
+				diags.AddAttributeError(path.Root("minimum_agent_version"), summary, detail)
+			} else {
+				diags.AddAttributeWarning(path.Root("minimum_agent_version"), summary, detail)
+			}
+		}
+	}
+	return types.StringValue(reported)
 }
 
 func (r *EnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -134,6 +262,38 @@ resource "arcane_environment" "production" {
 After apply, the new token will be in ` + "`access_token`" + ` and you should set
 ` + "`regenerate_access_token`" + ` back to ` + "`false`" + `.
 
+To rotate tokens through Terraform's replace semantics instead, manage the token with
+an ` + "`arcane_environment_token`" + ` resource and set ` + "`manage_access_token = false`" + `.
+
+## Preview Environments
+
+Set ` + "`ttl`" + ` on short-lived environments, such as the ones CI creates per pull request. The
+expiry is sent to Arcane and recorded in ` + "`expires_at`" + `, and the
+` + "`arcane_stale_environments`" + ` data source lists the environments past it for a cleanup job:
+
+` + "```hcl" + `
+resource "arcane_environment" "preview" {
+  name    = "pr-${var.pr_number}"
+  api_url = "http://10.100.3.10:3553"
+  ttl     = "72h"
+}
+` + "```" + `
+
+## Waiting for the Agent
+
+Set ` + "`wait_for_connection = true`" + ` to wait after create until the agent connects, e.g. when
+the agent is provisioned in the same apply. Resources referencing ` + "`connected`" + ` then only
+run once the environment is reachable:
+
+` + "```hcl" + `
+resource "arcane_environment" "production" {
+  name                = "production"
+  api_url             = "http://10.100.1.100:3553"
+  wait_for_connection = true
+  connection_timeout  = "10m"
+}
+` + "```" + `
+
 ## Import
 
 Environments can be imported using their ID:
@@ -147,6 +307,8 @@ to either regenerate it using ` + "`regenerate_access_token = true`" + ` or prov
 fallback token from 1Password.
 `,
 		Attributes: map[string]schema.Attribute{
+			"api_key_alias":   apiKeyAliasAttribute(),
+			"confirm_destroy": confirmDestroyAttribute("environment"),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the environment.",
 				Computed:            true,
@@ -155,7 +317,7 @@ fallback token from 1Password.
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the environment. Must be unique.",
+				MarkdownDescription: "The name of the environment. Must be unique; duplicates within the configuration and names already taken in Arcane fail the plan. Created in Arcane with the provider's `name_prefix` prepended.",
 				Required:            true,
 			},
 			"api_url": schema.StringAttribute{
@@ -186,6 +348,59 @@ fallback token from 1Password.
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"manage_access_token": schema.BoolAttribute{
+				MarkdownDescription: "Whether this resource generates the access token on create. Set to `false` when the token is managed by an `arcane_environment_token` resource; `access_token` is then unset. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"project_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of projects in the environment. Unset unless `live_status` is set, and while the agent is unreachable (see `connected`), in which case it isn't queried.",
+				Computed:            true,
+			},
+			"running_project_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of projects in the environment whose status is `running`. Unset unless `live_status` is set, and while the agent is unreachable (see `connected`), in which case it isn't queried.",
+				Computed:            true,
+			},
+			"minimum_agent_version": schema.StringAttribute{
+				MarkdownDescription: "The oldest agent version this configuration supports (e.g. `1.16.0`). Create and update fail when the agent reports an older version; refresh reports a warning. The check is skipped with a warning while the agent is unreachable.",
+				Optional:            true,
+			},
+			"agent_version": schema.StringAttribute{
+				MarkdownDescription: "The version reported by the environment's agent. Unset unless `live_status` or `minimum_agent_version` is set, and while the agent is unreachable.",
+				Computed:            true,
+			},
+			"ttl": schema.StringAttribute{
+				MarkdownDescription: "How long the environment should live, as a Go duration (e.g. `72h`). The expiry counts from the apply that creates the environment or changes `ttl`. Arcane schedules cleanup where it supports expiries; either way the environment is listed by `arcane_stale_environments` once it has expired.",
+				Optional:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the environment expires, as an RFC 3339 timestamp. Unset without a `ttl`.",
+				Computed:            true,
+			},
+			"wait_for_connection": schema.BoolAttribute{
+				MarkdownDescription: "Wait after create until the agent passes a connection test, failing the apply after `connection_timeout`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"connection_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long `wait_for_connection` waits for the agent, as a Go duration (e.g. `10m`). Defaults to `5m`.",
+				Optional:            true,
+			},
+			"connected": schema.BoolAttribute{
+				MarkdownDescription: "Whether the agent passed a connection test on the last apply or refresh. Unset unless `live_status` is set.",
+				Computed:            true,
+			},
+			"live_status": schema.BoolAttribute{
+				MarkdownDescription: "Query the agent on every apply and refresh to set `connected`, `project_count`, `running_project_count` " +
+					"and `agent_version`. This sends a connection test and lists every project of the environment each time, and the " +
+					"values follow the agent's state, so it is off by default; the `arcane_environment` data source reports them as well. " +
+					"Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -195,11 +410,11 @@ func (r *EnvironmentResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	c, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
 		)
 		return
 	}
@@ -207,7 +422,114 @@ func (r *EnvironmentResource) Configure(ctx context.Context, req resource.Config
 	r.client = c
 }
 
+func (r *EnvironmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var ttl types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ttl"), &ttl)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateEnvironmentTTL(ttl, &resp.Diagnostics)
+
+	var connectionTimeout types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("connection_timeout"), &connectionTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateConnectionTimeout(connectionTimeout, &resp.Diagnostics)
+
+	var minimumAgentVersion types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("minimum_agent_version"), &minimumAgentVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateMinimumAgentVersion(minimumAgentVersion, &resp.Diagnostics)
+}
+
+// validateMinimumAgentVersion rejects a minimum_agent_version that isn't a
+// version checkAgentVersion can compare with.
+func validateMinimumAgentVersion(minimum types.String, diags *diag.Diagnostics) {
+	if minimum.IsNull() || minimum.IsUnknown() {
+		return
+	}
+	if _, err := version.NewVersion(minimum.ValueString()); err != nil {
+		diags.AddAttributeError(
+			path.Root("minimum_agent_version"),
+			"Invalid minimum_agent_version",
+			fmt.Sprintf("%q is not a valid version: %s", minimum.ValueString(), err),
+		)
+	}
+}
+
+// ModifyPlan plans expires_at, and rejects environments excluded by the
+// provider's allowed_environments or denied_environments and names that are
+// already taken; see checkEnvironmentNameAvailable.
+func (r *EnvironmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		planEnvironmentExpiry(ctx, req, resp)
+	}
+	if r.client == nil || resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client.RestrictsEnvironments() {
+		r.checkEnvironmentAllowed(ctx, req, resp)
+	}
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var name, stateName types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...)
+	}
+	id := plannedEnvironmentID(ctx, req, resp)
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+	checkEnvironmentNameAvailable(ctx, r.client, id, r.client.PrefixedName(name.ValueString()), !name.Equal(stateName), &resp.Diagnostics)
+}
+
+// checkEnvironmentAllowed rejects environments excluded by the provider's
+// allowed_environments or denied_environments, by the name and ID in state and
+// the planned name, so that an environment can't be renamed into or out of
+// the allowed set either.
+func (r *EnvironmentResource) checkEnvironmentAllowed(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var id types.String
+	if !req.State.Raw.IsNull() {
+		var name types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &name)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Names are compared as they are in Arcane, with name_prefix, like
+		// checkPlannedEnvironmentAllowed does for other resources
+		prefixed := r.client.PrefixedName(name.ValueString())
+		if !r.client.EnvironmentAllowed(id.ValueString(), prefixed) {
+			addEnvironmentNotAllowedError(ctx, &resp.Diagnostics, id.ValueString(), prefixed)
+			return
+		}
+	}
+
+	if !req.Plan.Raw.IsNull() {
+		var name types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+		if resp.Diagnostics.HasError() || name.IsUnknown() {
+			return
+		}
+		prefixed := r.client.PrefixedName(name.ValueString())
+		if !r.client.EnvironmentAllowed(id.ValueString(), prefixed) {
+			addEnvironmentNotAllowedError(ctx, &resp.Diagnostics, id.ValueString(), prefixed)
+		}
+	}
+}
+
 func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
 	var data EnvironmentResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -216,37 +538,51 @@ func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Create the environment
-	createReq := &client.EnvironmentCreateRequest{
-		Name:        data.Name.ValueString(),
+	createReq := &arcane.EnvironmentCreateRequest{
+		Name:        r.client.PrefixedName(data.Name.ValueString()),
 		APIURL:      data.APIURL.ValueString(),
 		Description: data.Description.ValueString(),
 		UseAPIKey:   data.UseAPIKey.ValueBool(),
+		ExpiresAt:   environmentExpiresAt(data.TTL, time.Now()),
 	}
 
 	env, err := r.client.CreateEnvironment(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to create environment", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to create environment")
 		return
 	}
 
 	// Automatically regenerate the API key to get a valid arc_ prefixed token
-	// This is required for agents to authenticate with the manager
-	envWithKey, err := r.client.RegenerateEnvironmentAPIKey(ctx, env.ID)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to generate API key for environment", err.Error())
-		return
+	// This is required for agents to authenticate with the manager, unless the
+	// token is managed by an arcane_environment_token resource instead
+	envWithKey := &arcane.Environment{}
+	if data.ManageAccessToken.ValueBool() {
+		envWithKey, err = r.client.RegenerateEnvironmentAPIKey(ctx, env.ID)
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to generate API key for environment")
+			return
+		}
 	}
 
 	// Update state
 	data.ID = types.StringValue(env.ID)
-	data.Name = types.StringValue(env.Name)
+	data.Name = types.StringValue(r.client.UnprefixedName(env.Name))
 	if env.Description != "" {
 		data.Description = types.StringValue(env.Description)
 	}
 	data.UseAPIKey = types.BoolValue(env.UseAPIKey)
+	data.ExpiresAt = types.StringNull()
+	if createReq.ExpiresAt != "" {
+		if env.ExpiresAt == "" {
+			addExpiryNotStoredWarning(&resp.Diagnostics, data.Name.ValueString())
+		}
+		data.ExpiresAt = environmentExpiresAtValue(env, types.StringValue(createReq.ExpiresAt))
+	}
 
 	// Use the API key from the regenerate response
-	if envWithKey.APIKey != "" {
+	if !data.ManageAccessToken.ValueBool() {
+		data.AccessToken = types.StringNull()
+	} else if envWithKey.APIKey != "" {
 		data.AccessToken = types.StringValue(envWithKey.APIKey)
 	} else if env.AccessToken != "" {
 		data.AccessToken = types.StringValue(env.AccessToken)
@@ -254,30 +590,51 @@ func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateReq
 		data.AccessToken = types.StringNull()
 	}
 
+	// Wait for the agent before querying it, recording the environment even
+	// if it doesn't connect so that it is tainted rather than left behind
+	if data.WaitForConnection.ValueBool() {
+		timeout := environmentConnectionTimeout(data.ConnectionTimeout)
+		if err := waitForEnvironmentConnection(ctx, r.client, env.ID, timeout); err != nil {
+			diagnostics.AddError(ctx, &resp.Diagnostics, diagnostics.CodeAgentOffline, "Agent not reachable", err.Error())
+			data.Connected = types.BoolValue(false)
+			data.ProjectCount, data.RunningProjectCount = types.Int64Null(), types.Int64Null()
+			data.AgentVersion = types.StringNull()
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+	refreshEnvironmentStatus(ctx, r.client, &data, true, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
 	var data EnvironmentResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	prior := data
 
 	// Get the environment
 	env, err := r.client.GetEnvironment(ctx, data.ID.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if arcane.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Failed to read environment", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read environment")
 		return
 	}
 
 	// Update state
-	data.Name = types.StringValue(env.Name)
+	r.client.MarkEnvironmentManaged(env.Name, env.ID)
+	data.Name = types.StringValue(r.client.UnprefixedName(env.Name))
 	if env.APIURL != "" {
 		data.APIURL = types.StringValue(env.APIURL)
 	}
@@ -287,13 +644,37 @@ func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest
 		data.Description = types.StringNull()
 	}
 	data.UseAPIKey = types.BoolValue(env.UseAPIKey)
+	if !data.TTL.IsNull() {
+		data.ExpiresAt = environmentExpiresAtValue(env, data.ExpiresAt)
+	}
+	if data.ManageAccessToken.IsNull() {
+		data.ManageAccessToken = types.BoolValue(true)
+	}
+	if data.WaitForConnection.IsNull() {
+		data.WaitForConnection = types.BoolValue(false)
+	}
+	if data.LiveStatus.IsNull() {
+		data.LiveStatus = types.BoolValue(false)
+	}
 	// Note: access_token is typically not returned on read operations
 	// Keep the existing value from state
+	refreshEnvironmentStatus(ctx, r.client, &data, false, &resp.Diagnostics)
+
+	drift := newDriftReport("arcane_environment", data.ID.ValueString())
+	drift.compare("name", prior.Name, data.Name)
+	drift.compare("api_url", prior.APIURL, data.APIURL)
+	drift.compare("description", prior.Description, data.Description)
+	drift.compare("use_api_key", prior.UseAPIKey, data.UseAPIKey)
+	drift.addTo(&resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
 	var data EnvironmentResourceModel
 	var state EnvironmentResourceModel
 
@@ -308,7 +689,7 @@ func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateReq
 	if data.RegenerateAccessToken.ValueBool() && !state.RegenerateAccessToken.ValueBool() {
 		envWithKey, err := r.client.RegenerateEnvironmentAPIKey(ctx, data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Failed to regenerate API key", err.Error())
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to regenerate API key")
 			return
 		}
 		if envWithKey.APIKey != "" {
@@ -320,12 +701,11 @@ func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// Update the environment if any fields changed
-	updateReq := &client.EnvironmentUpdateRequest{}
+	updateReq := &arcane.EnvironmentUpdateRequest{}
 	needsUpdate := false
 
 	if !data.Name.Equal(state.Name) {
-		name := data.Name.ValueString()
-		updateReq.Name = name
+		updateReq.Name = r.client.PrefixedName(data.Name.ValueString())
 		needsUpdate = true
 	}
 
@@ -341,32 +721,55 @@ func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateReq
 		needsUpdate = true
 	}
 
+	if !data.TTL.Equal(state.TTL) {
+		expiresAt := environmentExpiresAt(data.TTL, time.Now())
+		updateReq.ExpiresAt = &expiresAt
+		needsUpdate = true
+		data.ExpiresAt = types.StringNull()
+		if expiresAt != "" {
+			data.ExpiresAt = types.StringValue(expiresAt)
+		}
+	} else {
+		data.ExpiresAt = state.ExpiresAt
+	}
+
 	if needsUpdate {
 		env, err := r.client.UpdateEnvironment(ctx, data.ID.ValueString(), updateReq)
 		if err != nil {
-			resp.Diagnostics.AddError("Failed to update environment", err.Error())
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to update environment")
 			return
 		}
 
 		// Update state from response
-		data.Name = types.StringValue(env.Name)
+		data.Name = types.StringValue(r.client.UnprefixedName(env.Name))
 		if env.Description != "" {
 			data.Description = types.StringValue(env.Description)
 		} else {
 			data.Description = types.StringNull()
 		}
 		data.UseAPIKey = types.BoolValue(env.UseAPIKey)
+		if updateReq.ExpiresAt != nil && *updateReq.ExpiresAt != "" {
+			if env.ExpiresAt == "" {
+				addExpiryNotStoredWarning(&resp.Diagnostics, data.Name.ValueString())
+			}
+			data.ExpiresAt = environmentExpiresAtValue(env, data.ExpiresAt)
+		}
 	}
 
 	// Preserve existing access_token if not regenerated
 	if data.AccessToken.IsNull() || data.AccessToken.IsUnknown() {
 		data.AccessToken = state.AccessToken
 	}
+	refreshEnvironmentStatus(ctx, r.client, &data, true, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *EnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
 	var data EnvironmentResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -374,10 +777,14 @@ func (r *EnvironmentResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	if !checkDestroyConfirmed(r.client, "environment", data.Name.ValueString(), data.ConfirmDestroy, []string{data.Name.ValueString()}, &resp.Diagnostics) {
+		return
+	}
+
 	err := r.client.DeleteEnvironment(ctx, data.ID.ValueString())
 	if err != nil {
-		if !client.IsNotFound(err) {
-			resp.Diagnostics.AddError("Failed to delete environment", err.Error())
+		if !arcane.IsNotFound(err) {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to delete environment")
 			return
 		}
 	}
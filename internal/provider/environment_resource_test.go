@@ -1,14 +1,30 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // TestEnvironmentResource_GivenValidConfig_WhenCreated_ThenEnvironmentExists
-// validates that an environment resource can be created with name, api_url, and description.
+// validates that an environment resource can be created with name, api_url, and description,
+// without querying its agent unless live_status is set.
 func TestEnvironmentResource_GivenValidConfig_WhenCreated_ThenEnvironmentExists(t *testing.T) {
 	mockServer := NewMockServer()
 	defer mockServer.Close()
@@ -24,6 +40,11 @@ func TestEnvironmentResource_GivenValidConfig_WhenCreated_ThenEnvironmentExists(
 					resource.TestCheckResourceAttr("arcane_environment.test", "description", "Test environment"),
 					resource.TestCheckResourceAttr("arcane_environment.test", "use_api_key", "false"),
 					resource.TestCheckResourceAttrSet("arcane_environment.test", "id"),
+					resource.TestCheckNoResourceAttr("arcane_environment.test", "connected"),
+					resource.TestCheckNoResourceAttr("arcane_environment.test", "project_count"),
+					resource.TestCheckNoResourceAttr("arcane_environment.test", "agent_version"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-test-env/test", 0),
+					mockServer.CheckRequestCount(http.MethodGet, "/api/environments/env-test-env/projects", 0),
 				),
 			},
 		},
@@ -128,6 +149,564 @@ func TestEnvironmentResource_GivenExistingEnvironment_WhenImported_ThenStateMatc
 	})
 }
 
+// TestEnvironmentResource_GivenAgentMeetsMinimumVersion_WhenCreated_ThenAgentVersionRecorded
+// validates that the agent-reported version is recorded when it satisfies
+// minimum_agent_version.
+func TestEnvironmentResource_GivenAgentMeetsMinimumVersion_WhenCreated_ThenAgentVersionRecorded(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AgentVersions["env-versioned-env"] = "1.16.2"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentResourceConfigMinimumAgentVersion(mockServer.URL, "versioned-env", "1.16.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_environment.test", "minimum_agent_version", "1.16.0"),
+					resource.TestCheckResourceAttr("arcane_environment.test", "agent_version", "1.16.2"),
+				),
+			},
+		},
+	})
+}
+
+// TestEnvironmentResource_GivenAgentBelowMinimumVersion_WhenCreated_ThenError
+// validates that create fails when the agent reports an older version than
+// minimum_agent_version.
+func TestEnvironmentResource_GivenAgentBelowMinimumVersion_WhenCreated_ThenError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AgentVersions["env-old-agent-env"] = "1.9.4"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testEnvironmentResourceConfigMinimumAgentVersion(mockServer.URL, "old-agent-env", "1.16.0"),
+				ExpectError: regexp.MustCompile(`Agent version too old`),
+			},
+		},
+	})
+}
+
+// TestEnvironmentResource_GivenDestroyConfirmationRequired_WhenDestroyed_ThenConfirmDestroyNeeded
+// validates that with require_destroy_confirmation the environment is only
+// deleted once confirm_destroy names it.
+func TestEnvironmentResource_GivenDestroyConfirmationRequired_WhenDestroyed_ThenConfirmDestroyNeeded(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentResourceConfigConfirmDestroy(mockServer.URL, "homelab", ""),
+			},
+			{
+				Config:      testEnvironmentResourceConfigConfirmDestroy(mockServer.URL, "homelab", ""),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Destroy not confirmed`),
+			},
+			{
+				Config:      testEnvironmentResourceConfigConfirmDestroy(mockServer.URL, "homelab", "staging"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Destroy not confirmed`),
+			},
+			{
+				Config: testEnvironmentResourceConfigConfirmDestroy(mockServer.URL, "homelab", "homelab"),
+				Check:  resource.TestCheckResourceAttr("arcane_environment.test", "confirm_destroy", "homelab"),
+			},
+		},
+		CheckDestroy: func(*terraform.State) error {
+			if len(mockServer.Environments) != 0 {
+				return fmt.Errorf("expected the environment to be deleted, got %d environments", len(mockServer.Environments))
+			}
+			return nil
+		},
+	})
+}
+
+// TestEnvironmentResource_GivenDuplicateNamesInForEach_WhenPlanned_ThenError
+// validates that two environments planned with the same name fail the plan
+// before either is created.
+func TestEnvironmentResource_GivenDuplicateNamesInForEach_WhenPlanned_ThenError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentResourceConfigForEach(mockServer.URL, map[string]string{
+					"edge-1": "edge",
+					"edge-2": "edge",
+				}),
+				ExpectError: regexp.MustCompile(`Duplicate environment name`),
+			},
+		},
+	})
+
+	if got := mockServer.RequestCount(http.MethodPost, "/api/environments"); got != 0 {
+		t.Errorf("expected no create requests, got %d", got)
+	}
+}
+
+// TestEnvironmentResource_GivenNameTakenInArcane_WhenPlanned_ThenError
+// validates that a new environment named like an existing one fails the plan
+// with an import hint, while the managed environments plan cleanly.
+func TestEnvironmentResource_GivenNameTakenInArcane_WhenPlanned_ThenError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-legacy"] = &arcane.Environment{ID: "env-legacy", Name: "legacy"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentResourceConfigForEach(mockServer.URL, map[string]string{
+					"a": "edge-a",
+					"b": "edge-b",
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(`arcane_environment.test["b"]`, tfjsonpath.New("name"), knownvalue.StringExact("edge-b")),
+				},
+			},
+			{
+				Config: testEnvironmentResourceConfigForEach(mockServer.URL, map[string]string{
+					"a": "edge-a",
+					"b": "edge-b",
+					"c": "legacy",
+				}),
+				ExpectError: regexp.MustCompile(`(?s)Environment already exists.*terraform import <resource address> env-legacy`),
+			},
+			testEnvironmentForEachCleanupStep(mockServer.URL),
+		},
+	})
+}
+
+// TestEnvironmentResource_GivenNewInstanceNamedLikeExisting_WhenPlanned_ThenError
+// validates that a new environment can't claim the name of one in state.
+func TestEnvironmentResource_GivenNewInstanceNamedLikeExisting_WhenPlanned_ThenError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentResourceConfigForEach(mockServer.URL, map[string]string{"a": "edge"}),
+			},
+			{
+				Config:      testEnvironmentResourceConfigForEach(mockServer.URL, map[string]string{"a": "edge", "b": "edge"}),
+				ExpectError: regexp.MustCompile(`Duplicate environment name`),
+			},
+			testEnvironmentForEachCleanupStep(mockServer.URL),
+		},
+	})
+}
+
+// TestEnvironmentResource_GivenTaintedForEachInstance_WhenReplaced_ThenNameKept
+// validates that replacing an environment doesn't report its own name as a
+// duplicate or as taken in Arcane: Terraform plans a replacement twice, the
+// second time without state.
+func TestEnvironmentResource_GivenTaintedForEachInstance_WhenReplaced_ThenNameKept(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	names := map[string]string{"a": "edge-a", "b": "edge-b"}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentResourceConfigForEach(mockServer.URL, names),
+			},
+			{
+				Taint:  []string{`arcane_environment.test["a"]`},
+				Config: testEnvironmentResourceConfigForEach(mockServer.URL, names),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(`arcane_environment.test["a"]`, tfjsonpath.New("name"), knownvalue.StringExact("edge-a")),
+					statecheck.ExpectKnownValue(`arcane_environment.test["b"]`, tfjsonpath.New("name"), knownvalue.StringExact("edge-b")),
+				},
+			},
+			testEnvironmentForEachCleanupStep(mockServer.URL),
+		},
+	})
+
+	if got := mockServer.RequestCount(http.MethodPost, "/api/environments"); got != 3 {
+		t.Errorf("expected 3 create requests, including the replacement, got %d", got)
+	}
+}
+
+// TestEnvironmentResource_GivenNamePrefix_WhenCreatedAndPrefixChanged_ThenPrefixedInArcaneOnly
+// validates that name_prefix is prepended in Arcane but not in state, and that
+// changing it renames the environment.
+func TestEnvironmentResource_GivenNamePrefix_WhenCreatedAndPrefixChanged_ThenPrefixedInArcaneOnly(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	checkArcaneName := func(want string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			id := s.RootModule().Resources["arcane_environment.test"].Primary.ID
+			if got := mockServer.Environments[id].Name; got != want {
+				return fmt.Errorf("environment name in Arcane = %q, want %q", got, want)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentResourceConfigNamePrefix(mockServer.URL, "pr-7-", "web"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_environment.test", "name", "web"),
+					checkArcaneName("pr-7-web"),
+				),
+			},
+			{
+				Config: testEnvironmentResourceConfigNamePrefix(mockServer.URL, "pr-8-", "web"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_environment.test", "name", "web"),
+					checkArcaneName("pr-8-web"),
+				),
+			},
+		},
+	})
+}
+
+// TestEnvironmentResource_GivenTTL_WhenCreatedAndRemoved_ThenExpirySetAndCleared
+// validates that ttl sends an expiry to Arcane and records it in expires_at,
+// and that removing ttl clears it.
+func TestEnvironmentResource_GivenTTL_WhenCreatedAndRemoved_ThenExpirySetAndCleared(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	checkArcaneExpiry := func(set bool) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			rs := s.RootModule().Resources["arcane_environment.test"].Primary
+			got := mockServer.Environments[rs.ID].ExpiresAt
+			if got != rs.Attributes["expires_at"] {
+				return fmt.Errorf("expiry in Arcane = %q, expires_at = %q", got, rs.Attributes["expires_at"])
+			}
+			if (got != "") != set {
+				return fmt.Errorf("expiry in Arcane = %q, want set = %t", got, set)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentResourceConfigTTL(mockServer.URL, "pr-12", `ttl = "72h"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_environment.test", "ttl", "72h"),
+					resource.TestCheckResourceAttrSet("arcane_environment.test", "expires_at"),
+					checkArcaneExpiry(true),
+				),
+			},
+			{
+				Config: testEnvironmentResourceConfigTTL(mockServer.URL, "pr-12", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("arcane_environment.test", "expires_at"),
+					checkArcaneExpiry(false),
+				),
+			},
+		},
+	})
+}
+
+// TestEnvironmentResource_GivenInvalidTTL_WhenValidated_ThenError validates
+// that ttl only accepts positive durations.
+func TestEnvironmentResource_GivenInvalidTTL_WhenValidated_ThenError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testEnvironmentResourceConfigTTL("http://localhost:1", "pr-12", `ttl = "3 days"`),
+				ExpectError: regexp.MustCompile(`Invalid ttl`),
+			},
+			{
+				Config:      testEnvironmentResourceConfigTTL("http://localhost:1", "pr-12", `ttl = "-1h"`),
+				ExpectError: regexp.MustCompile(`Invalid ttl`),
+			},
+		},
+	})
+}
+
+// TestEnvironmentResource_GivenInvalidMinimumAgentVersion_WhenValidated_ThenError
+// validates that minimum_agent_version is rejected before anything is created.
+func TestEnvironmentResource_GivenInvalidMinimumAgentVersion_WhenValidated_ThenError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testEnvironmentResourceConfigMinimumAgentVersion("http://localhost:1", "homelab", "latest"),
+				ExpectError: regexp.MustCompile(`Invalid minimum_agent_version`),
+			},
+		},
+	})
+}
+
+// TestEnvironmentResource_GivenWaitForConnection_WhenAgentConnectsLate_ThenCreatedConnected
+// validates that create waits until the agent passes a connection test.
+func TestEnvironmentResource_GivenWaitForConnection_WhenAgentConnectsLate_ThenCreatedConnected(t *testing.T) {
+	prev := environmentConnectionPollInterval
+	environmentConnectionPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { environmentConnectionPollInterval = prev })
+
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.ConnectAfterTests["env-late-env"] = 3
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentResourceConfigWaitForConnection(mockServer.URL, "late-env", "1m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_environment.test", "wait_for_connection", "true"),
+					resource.TestCheckResourceAttr("arcane_environment.test", "connected", "true"),
+					resource.TestCheckResourceAttr("arcane_environment.test", "project_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+// TestEnvironmentResource_GivenWaitForConnection_WhenAgentNeverConnects_ThenError
+// validates that create fails once connection_timeout elapses.
+func TestEnvironmentResource_GivenWaitForConnection_WhenAgentNeverConnects_ThenError(t *testing.T) {
+	prev := environmentConnectionPollInterval
+	environmentConnectionPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { environmentConnectionPollInterval = prev })
+
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.ConnectAfterTests["env-offline-env"] = 1 << 20
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testEnvironmentResourceConfigWaitForConnection(mockServer.URL, "offline-env", "200ms"),
+				ExpectError: regexp.MustCompile(`Agent not reachable`),
+			},
+		},
+	})
+}
+
+// TestEnvironmentResource_GivenInvalidConnectionTimeout_WhenValidated_ThenError
+// validates that connection_timeout only accepts positive durations.
+func TestEnvironmentResource_GivenInvalidConnectionTimeout_WhenValidated_ThenError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testEnvironmentResourceConfigWaitForConnection("http://localhost:1", "late-env", "soon"),
+				ExpectError: regexp.MustCompile(`Invalid connection_timeout`),
+			},
+		},
+	})
+}
+
+// TestEnvironmentExpiresAt validates the expiry computed from ttl.
+func TestEnvironmentExpiresAt(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+	cases := map[string]struct {
+		ttl  types.String
+		want string
+	}{
+		"unset":   {ttl: types.StringNull(), want: ""},
+		"unknown": {ttl: types.StringUnknown(), want: ""},
+		"hours":   {ttl: types.StringValue("72h"), want: "2026-03-04T11:00:00Z"},
+		"minutes": {ttl: types.StringValue("90m"), want: "2026-03-01T12:30:00Z"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := environmentExpiresAt(tc.ttl, now); got != tc.want {
+				t.Errorf("environmentExpiresAt(%s) = %q, want %q", tc.ttl, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCheckDestroyConfirmed(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		require bool
+		confirm types.String
+		wantOK  bool
+	}{
+		{name: "not required", require: false, confirm: types.StringNull(), wantOK: true},
+		{name: "missing", require: true, confirm: types.StringNull(), wantOK: false},
+		{name: "mismatch", require: true, confirm: types.StringValue("staging"), wantOK: false},
+		{name: "match", require: true, confirm: types.StringValue("homelab"), wantOK: true},
+		{name: "match by id", require: true, confirm: types.StringValue("env-1"), wantOK: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			c := newTestClient(t, "http://arcane.local", ClientSettings{RequireDestroyConfirmation: tc.require})
+			var diags diag.Diagnostics
+			ok := checkDestroyConfirmed(c, "environment", "homelab", tc.confirm, []string{"homelab", "env-1"}, &diags)
+			if ok != tc.wantOK || diags.HasError() == tc.wantOK {
+				t.Errorf("ok = %v, diags = %v, want ok = %v", ok, diags, tc.wantOK)
+			}
+		})
+	}
+}
+
+// TestWaitForEnvironmentConnection validates that the connection is tested
+// until the agent connects or the timeout elapses.
+func TestWaitForEnvironmentConnection(t *testing.T) {
+	prev := environmentConnectionPollInterval
+	environmentConnectionPollInterval = time.Millisecond
+	t.Cleanup(func() { environmentConnectionPollInterval = prev })
+
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-late"] = &arcane.Environment{ID: "env-late", Name: "late"}
+	mockServer.Environments["env-down"] = &arcane.Environment{ID: "env-down", Name: "down"}
+	mockServer.ConnectAfterTests["env-late"] = 2
+
+	c := newMockClient(t, mockServer)
+	ctx := context.Background()
+
+	if err := waitForEnvironmentConnection(ctx, c, "env-late", time.Minute); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := mockServer.RequestCount(http.MethodPost, "/api/environments/env-late/test"); got != 3 {
+		t.Errorf("expected 3 connection tests, got %d", got)
+	}
+	if err := waitForEnvironmentConnection(ctx, c, "env-down", 20*time.Millisecond); err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("expected a timeout error, got %v", err)
+	}
+	if got := environmentConnected(ctx, c, "env-down"); got.ValueBool() {
+		t.Error("expected env-down not to be connected")
+	}
+}
+
+func TestEnvironmentProjectCounts(t *testing.T) {
+	t.Parallel()
+
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddProject("env-counts", &arcane.Project{ID: "proj-web", Name: "web", Status: "running"})
+	mockServer.AddProject("env-counts", &arcane.Project{ID: "proj-db", Name: "db", Status: "exited"})
+	mockServer.InjectFault(MockFault{
+		Method: http.MethodGet,
+		Path:   "/api/environments/env-broken/projects",
+		Status: http.StatusInternalServerError,
+	})
+
+	c := newMockClient(t, mockServer)
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+	total, running := environmentProjectCounts(ctx, c, "env-counts", types.BoolValue(true), &diags)
+	if total.ValueInt64() != 2 || running.ValueInt64() != 1 || diags.HasError() || diags.WarningsCount() != 0 {
+		t.Errorf("connected: got %v/%v with %v, want 2/1 without diagnostics", total, running, diags)
+	}
+
+	total, running = environmentProjectCounts(ctx, c, "env-offline", types.BoolValue(false), &diags)
+	if !total.IsNull() || !running.IsNull() || diags.WarningsCount() != 0 {
+		t.Errorf("disconnected: got %v/%v with %v, want null without diagnostics", total, running, diags)
+	}
+	if got := mockServer.RequestCount(http.MethodGet, "/api/environments/env-offline/projects"); got != 0 {
+		t.Errorf("expected the projects of a disconnected environment not to be listed, got %d request(s)", got)
+	}
+	total, _ = environmentProjectCounts(ctx, c, "env-broken", types.BoolValue(true), &diags)
+	if !total.IsNull() || diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("list failure: got %v with %v, want null with a warning", total, diags)
+	}
+}
+
+func TestValidateMinimumAgentVersion(t *testing.T) {
+	t.Parallel()
+
+	for minimum, wantError := range map[types.String]bool{
+		types.StringNull():           false,
+		types.StringUnknown():        false,
+		types.StringValue("1.16"):    false,
+		types.StringValue("v1.16.0"): false,
+		types.StringValue("latest"):  true,
+		types.StringValue(""):        true,
+	} {
+		var diags diag.Diagnostics
+		validateMinimumAgentVersion(minimum, &diags)
+		if diags.HasError() != wantError {
+			t.Errorf("validateMinimumAgentVersion(%s): error = %v, want %v", minimum, diags.HasError(), wantError)
+		}
+	}
+}
+
+// TestCheckAgentVersion validates the comparison against minimum_agent_version,
+// including the unreachable-agent and refresh (non-enforcing) cases.
+func TestCheckAgentVersion(t *testing.T) {
+	t.Parallel()
+
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-new"] = &arcane.Environment{ID: "env-new", Name: "new"}
+	mockServer.Environments["env-old"] = &arcane.Environment{ID: "env-old", Name: "old"}
+	mockServer.Environments["env-down"] = &arcane.Environment{ID: "env-down", Name: "down"}
+	mockServer.AgentVersions["env-new"] = "v1.16.0"
+	mockServer.AgentVersions["env-old"] = "1.15.9"
+
+	c := newMockClient(t, mockServer)
+
+	cases := []struct {
+		name         string
+		envID        string
+		minimum      types.String
+		enforce      bool
+		wantVersion  types.String
+		wantErrors   int
+		wantWarnings int
+	}{
+		{name: "no minimum", envID: "env-old", minimum: types.StringNull(), enforce: true, wantVersion: types.StringValue("1.15.9")},
+		{name: "minimum met with v prefix", envID: "env-new", minimum: types.StringValue("1.16"), enforce: true, wantVersion: types.StringValue("v1.16.0")},
+		{name: "minimum not met on apply", envID: "env-old", minimum: types.StringValue("1.16.0"), enforce: true, wantVersion: types.StringValue("1.15.9"), wantErrors: 1},
+		{name: "minimum not met on refresh", envID: "env-old", minimum: types.StringValue("1.16.0"), enforce: false, wantVersion: types.StringValue("1.15.9"), wantWarnings: 1},
+		{name: "agent unreachable", envID: "env-down", minimum: types.StringValue("1.16.0"), enforce: true, wantVersion: types.StringNull(), wantWarnings: 1},
+		{name: "agent unreachable without minimum", envID: "env-down", minimum: types.StringNull(), enforce: true, wantVersion: types.StringNull()},
+		{name: "invalid minimum", envID: "env-new", minimum: types.StringValue("latest"), enforce: true, wantVersion: types.StringNull(), wantErrors: 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := checkAgentVersion(context.Background(), c, tc.envID, tc.minimum, tc.enforce, &diags)
+			if !got.Equal(tc.wantVersion) {
+				t.Errorf("agent version = %s, want %s", got, tc.wantVersion)
+			}
+			if n := diags.ErrorsCount(); n != tc.wantErrors {
+				t.Errorf("errors = %d, want %d: %v", n, tc.wantErrors, diags)
+			}
+			if n := diags.WarningsCount(); n != tc.wantWarnings {
+				t.Errorf("warnings = %d, want %d: %v", n, tc.wantWarnings, diags)
+			}
+		})
+	}
+}
+
 func testEnvironmentResourceConfig(url, name, apiURL, description string, useAPIKey bool) string {
 	return fmt.Sprintf(`
 provider "arcane" {
@@ -155,3 +734,115 @@ resource "arcane_environment" "test" {
 }
 `, url, name, apiURL)
 }
+
+func testEnvironmentResourceConfigMinimumAgentVersion(url, name, minimum string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_environment" "test" {
+  name                  = %[2]q
+  api_url               = "http://10.100.1.110:3553"
+  minimum_agent_version = %[3]q
+}
+`, url, name, minimum)
+}
+
+func testEnvironmentResourceConfigConfirmDestroy(url, name, confirm string) string {
+	confirmLine := ""
+	if confirm != "" {
+		confirmLine = fmt.Sprintf("confirm_destroy = %q", confirm)
+	}
+	return fmt.Sprintf(`
+provider "arcane" {
+  url                          = %[1]q
+  require_destroy_confirmation = true
+}
+
+resource "arcane_environment" "test" {
+  name    = %[2]q
+  api_url = "http://agent:3553"
+  %[3]s
+}
+`, url, name, confirmLine)
+}
+
+func testEnvironmentResourceConfigForEach(url string, names map[string]string) string {
+	var entries strings.Builder
+	for _, key := range slices.Sorted(maps.Keys(names)) {
+		fmt.Fprintf(&entries, "    %q = %q\n", key, names[key])
+	}
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_environment" "test" {
+  for_each = {
+%[2]s  }
+
+  name    = each.value
+  api_url = "http://agent:3553"
+}
+`, url, entries.String())
+}
+
+// testEnvironmentForEachCleanupStep destroys the environments of
+// testEnvironmentResourceConfigForEach in a last step. The testing framework
+// reads state through a shim that rejects for_each instances, so they are
+// checked with ConfigStateChecks instead of Check, and must be gone before
+// the test's own destroy reads state.
+func testEnvironmentForEachCleanupStep(url string) resource.TestStep {
+	return resource.TestStep{
+		Config: fmt.Sprintf(`
+provider "arcane" {
+  url = %q
+}
+`, url),
+	}
+}
+
+func testEnvironmentResourceConfigNamePrefix(url, prefix, name string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url         = %[1]q
+  name_prefix = %[2]q
+}
+
+resource "arcane_environment" "test" {
+  name    = %[3]q
+  api_url = "http://agent:3553"
+}
+`, url, prefix, name)
+}
+
+func testEnvironmentResourceConfigTTL(url, name, ttl string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_environment" "test" {
+  name    = %[2]q
+  api_url = "http://agent:3553"
+  %[3]s
+}
+`, url, name, ttl)
+}
+
+func testEnvironmentResourceConfigWaitForConnection(url, name, timeout string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_environment" "test" {
+  name                = %[2]q
+  api_url             = "http://10.100.1.120:3553"
+  wait_for_connection = true
+  connection_timeout  = %[3]q
+  live_status         = true
+}
+`, url, name, timeout)
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource               = &EnvironmentTokenResource{}
+	_ resource.ResourceWithModifyPlan = &EnvironmentTokenResource{}
+)
+
+// NewEnvironmentTokenResource returns a new environment token resource.
+func NewEnvironmentTokenResource() resource.Resource {
+	return &EnvironmentTokenResource{}
+}
+
+// EnvironmentTokenResource defines the environment token resource implementation.
+type EnvironmentTokenResource struct {
+	client *Client
+}
+
+// EnvironmentTokenResourceModel describes the environment token resource data model.
+type EnvironmentTokenResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	Keepers       types.Map    `tfsdk:"keepers"`
+	Token         types.String `tfsdk:"token"`
+	GeneratedAt   types.String `tfsdk:"generated_at"`
+	Hints         types.Object `tfsdk:"lifecycle_hints"`
+	APIKeyAlias   types.String `tfsdk:"api_key_alias"`
+}
+
+func (r *EnvironmentTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_token"
+}
+
+func (r *EnvironmentTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages the access token (API key) agents use to authenticate an Arcane environment.
+
+Creating this resource generates a new ` + "`arc_`" + ` prefixed token for the environment.
+Rotation uses Terraform's replace semantics: changing ` + "`keepers`" + ` replaces the
+resource, which generates a new token and invalidates the previous one. Token rotation
+never modifies the environment itself.
+
+When the token is managed by this resource, set ` + "`manage_access_token = false`" + ` on the
+` + "`arcane_environment`" + ` so it does not generate a token of its own on create.
+
+Destroying this resource only removes the token from state; the last generated token
+stays valid until it is rotated.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "arcane_environment" "production" {
+  name                = "production"
+  api_url             = "http://10.100.2.203:3553"
+  use_api_key         = true
+  manage_access_token = false
+}
+
+resource "arcane_environment_token" "production" {
+  environment_id = arcane_environment.production.id
+
+  # Rotate the token by changing any keeper value
+  keepers = {
+    rotation = "2026-q1"
+  }
+}
+
+output "agent_token" {
+  value     = arcane_environment_token.production.token
+  sensitive = true
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"api_key_alias": apiKeyAliasAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment the token belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment to generate the token for. Changing this generates a token for the new environment.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that, when changed, replace the resource and rotate the token.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The generated access token. Agents use it to authenticate with the Arcane manager.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"generated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp (RFC3339) of when the token was generated.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"lifecycle_hints": lifecycleHintsSchema(),
+		},
+	}
+}
+
+func (r *EnvironmentTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan rejects environments excluded by the provider's
+// allowed_environments or denied_environments.
+func (r *EnvironmentTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkPlannedEnvironmentAllowed(ctx, r.client, req, &resp.Diagnostics)
+}
+
+func (r *EnvironmentTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
+	var data EnvironmentTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	env, err := r.client.RegenerateEnvironmentAPIKey(ctx, data.EnvironmentID.ValueString())
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to generate environment token")
+		return
+	}
+	if env.APIKey == "" {
+		resp.Diagnostics.AddError(
+			"Failed to generate environment token",
+			fmt.Sprintf("Arcane did not return a token for environment %q.", data.EnvironmentID.ValueString()),
+		)
+		return
+	}
+
+	data.ID = data.EnvironmentID
+	data.Token = types.StringValue(env.APIKey)
+	data.GeneratedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	data.Hints = environmentTokenHints(data.EnvironmentID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
+	var data EnvironmentTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The token itself is never returned by the API, so only check that the
+	// environment still exists; a deleted environment takes its token with it.
+	_, err := r.client.GetEnvironment(ctx, data.EnvironmentID.ValueString())
+	if err != nil {
+		if arcane.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read environment")
+		return
+	}
+	data.Hints = environmentTokenHints(data.EnvironmentID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
+	// Every configurable attribute other than api_key_alias requires
+	// replacement, so there is nothing to update in place.
+	var data EnvironmentTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
+	// Arcane has no way to revoke a token without issuing a new one, and
+	// rotating here would break agents during a replace. The token is only
+	// removed from state.
+}
+
+// environmentTokenHints returns the lifecycle_hints of a token generated for
+// environmentID.
+func environmentTokenHints(environmentID string) types.Object {
+	return lifecycleHintsValue(
+		[]string{
+			fmt.Sprintf("Configure the agent of environment %q with the new token (the token attribute) and restart it.", environmentID),
+			"Agents still using the previous token are rejected until they are updated.",
+		},
+		[]string{
+			"Changing keepers replaces this resource, which invalidates the current token as soon as the new one is generated.",
+			"Destroying this resource does not revoke the token; it stays valid until it is rotated.",
+		},
+	)
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestEnvironmentTokenResource_GivenUnmanagedEnvironmentToken_WhenCreated_ThenOnlyTokenResourceGenerates
+// validates that with manage_access_token = false the environment does not
+// generate a token and the token resource generates exactly one.
+func TestEnvironmentTokenResource_GivenUnmanagedEnvironmentToken_WhenCreated_ThenOnlyTokenResourceGenerates(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentTokenResourceConfig(mockServer.URL, "token-env", "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_environment.test", "manage_access_token", "false"),
+					resource.TestCheckNoResourceAttr("arcane_environment.test", "access_token"),
+					resource.TestCheckResourceAttrPair("arcane_environment_token.test", "environment_id", "arcane_environment.test", "id"),
+					resource.TestCheckResourceAttr("arcane_environment_token.test", "token", "arc_regenerated_token-env_1"),
+					resource.TestCheckResourceAttrSet("arcane_environment_token.test", "generated_at"),
+					resource.TestCheckResourceAttr("arcane_environment_token.test", "lifecycle_hints.post_create_steps.#", "2"),
+					resource.TestCheckResourceAttr("arcane_environment_token.test", "lifecycle_hints.caveats.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+// TestEnvironmentTokenResource_GivenKeepersChanged_WhenApplied_ThenTokenRotated
+// validates that changing keepers replaces the resource and rotates the token,
+// while unchanged keepers leave the token alone.
+func TestEnvironmentTokenResource_GivenKeepersChanged_WhenApplied_ThenTokenRotated(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentTokenResourceConfig(mockServer.URL, "rotate-env", "2026-q1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_environment_token.test", "token", "arc_regenerated_rotate-env_1"),
+				),
+			},
+			{
+				Config:   testEnvironmentTokenResourceConfig(mockServer.URL, "rotate-env", "2026-q1"),
+				PlanOnly: true,
+			},
+			{
+				Config: testEnvironmentTokenResourceConfig(mockServer.URL, "rotate-env", "2026-q2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_environment_token.test", "keepers.rotation", "2026-q2"),
+					resource.TestCheckResourceAttr("arcane_environment_token.test", "token", "arc_regenerated_rotate-env_2"),
+				),
+			},
+		},
+	})
+}
+
+func testEnvironmentTokenResourceConfig(url, name, rotation string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_environment" "test" {
+  name                = %[2]q
+  api_url             = "http://10.100.1.120:3553"
+  use_api_key         = true
+  manage_access_token = false
+}
+
+resource "arcane_environment_token" "test" {
+  environment_id = arcane_environment.test.id
+
+  keepers = {
+    rotation = %[3]q
+  }
+}
+`, url, name, rotation)
+}
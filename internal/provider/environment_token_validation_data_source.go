@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EnvironmentTokenValidationDataSource{}
+
+// NewEnvironmentTokenValidationDataSource returns a new environment token validation data source.
+func NewEnvironmentTokenValidationDataSource() datasource.DataSource {
+	return &EnvironmentTokenValidationDataSource{}
+}
+
+// EnvironmentTokenValidationDataSource defines the environment token validation data source implementation.
+type EnvironmentTokenValidationDataSource struct {
+	client *Client
+}
+
+// EnvironmentTokenValidationDataSourceModel describes the data model.
+type EnvironmentTokenValidationDataSourceModel struct {
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	Token         types.String `tfsdk:"token"`
+	Valid         types.Bool   `tfsdk:"valid"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+}
+
+func (d *EnvironmentTokenValidationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_token_validation"
+}
+
+func (d *EnvironmentTokenValidationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to check whether an ` + "`arc_`" + ` access token authenticates the agents of an
+Arcane environment, e.g. a token read from an external secret store before it is handed to an agent.
+
+The token is only validated: it is neither stored in Arcane nor rotated. An invalid token sets
+` + "`valid`" + ` to ` + "`false`" + ` rather than failing the read, so use a precondition to stop the run.
+
+## Example Usage
+
+` + "```hcl" + `
+data "vault_generic_secret" "agent" {
+  path = "secret/arcane/production"
+}
+
+data "arcane_environment_token_validation" "production" {
+  environment_id = arcane_environment.production.id
+  token          = data.vault_generic_secret.agent.data["token"]
+}
+
+resource "local_sensitive_file" "agent_env" {
+  filename = "${path.module}/agent.env"
+  content  = "AGENT_TOKEN=${data.vault_generic_secret.agent.data["token"]}\n"
+
+  lifecycle {
+    precondition {
+      condition     = data.arcane_environment_token_validation.production.valid
+      error_message = "The agent token in Vault is not valid for the production environment"
+    }
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment to validate the token against.",
+				Required:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The access token to validate.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"valid": schema.BoolAttribute{
+				MarkdownDescription: "Whether the token authenticates agents of the environment.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the token expires, as an RFC 3339 timestamp. Unset when the token is invalid or does not expire.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *EnvironmentTokenValidationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *EnvironmentTokenValidationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data EnvironmentTokenValidationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.ValidateEnvironmentToken(ctx, data.EnvironmentID.ValueString(), data.Token.ValueString())
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to validate environment token")
+		return
+	}
+
+	data.Valid = types.BoolValue(result.Valid)
+	data.ExpiresAt = types.StringNull()
+	if result.Valid {
+		data.ExpiresAt = optionalString(result.ExpiresAt)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
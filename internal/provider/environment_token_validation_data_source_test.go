@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestEnvironmentTokenValidationDataSource_GivenValidToken_WhenRead_ThenValidWithExpiry
+// validates that the environment's token is reported valid with its expiry.
+func TestEnvironmentTokenValidationDataSource_GivenValidToken_WhenRead_ThenValidWithExpiry(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-token"] = &arcane.Environment{ID: "env-token", Name: "token-env", AccessToken: "arc_valid"}
+	mockServer.TokenExpiresAt = map[string]string{"arc_valid": "2027-01-01T00:00:00Z"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentTokenValidationDataSourceConfig(mockServer.URL, "env-token", "arc_valid"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_environment_token_validation.test", "valid", "true"),
+					resource.TestCheckResourceAttr("data.arcane_environment_token_validation.test", "expires_at", "2027-01-01T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+// TestEnvironmentTokenValidationDataSource_GivenOtherToken_WhenRead_ThenInvalid
+// validates that a token of another environment is reported invalid without
+// failing the read, and that it is not stored.
+func TestEnvironmentTokenValidationDataSource_GivenOtherToken_WhenRead_ThenInvalid(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-token"] = &arcane.Environment{ID: "env-token", Name: "token-env", AccessToken: "arc_valid"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentTokenValidationDataSourceConfig(mockServer.URL, "env-token", "arc_other"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_environment_token_validation.test", "valid", "false"),
+					resource.TestCheckNoResourceAttr("data.arcane_environment_token_validation.test", "expires_at"),
+					mockServer.CheckRequestCount("PUT", "/api/environments/env-token", 0),
+				),
+			},
+		},
+	})
+}
+
+// TestEnvironmentTokenValidationDataSource_GivenMissingEnvironment_WhenRead_ThenError
+// validates that validating against an unknown environment fails the read.
+func TestEnvironmentTokenValidationDataSource_GivenMissingEnvironment_WhenRead_ThenError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testEnvironmentTokenValidationDataSourceConfig(mockServer.URL, "env-missing", "arc_valid"),
+				ExpectError: regexp.MustCompile(`Failed to validate environment token: not found`),
+			},
+		},
+	})
+}
+
+func testEnvironmentTokenValidationDataSourceConfig(url, envID, token string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_environment_token_validation" "test" {
+  environment_id = %[2]q
+  token          = %[3]q
+}
+`, url, envID, token)
+}
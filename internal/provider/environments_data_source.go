@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &EnvironmentsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &EnvironmentsDataSource{}
+)
+
+// NewEnvironmentsDataSource returns a new environments data source.
+func NewEnvironmentsDataSource() datasource.DataSource {
+	return &EnvironmentsDataSource{}
+}
+
+// EnvironmentsDataSource defines the environments data source implementation.
+type EnvironmentsDataSource struct {
+	client *Client
+}
+
+// EnvironmentsDataSourceModel describes the environments data source data model.
+type EnvironmentsDataSourceModel struct {
+	NameRegex     types.String          `tfsdk:"name_regex"`
+	ConnectedOnly types.Bool            `tfsdk:"connected_only"`
+	Environments  []environmentsElement `tfsdk:"environments"`
+	IDs           []types.String        `tfsdk:"ids"`
+}
+
+// environmentsElement describes an element of environments.
+type environmentsElement struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	APIURL      types.String `tfsdk:"api_url"`
+	UseAPIKey   types.Bool   `tfsdk:"use_api_key"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+func (d *EnvironmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environments"
+}
+
+func (d *EnvironmentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to list the environments of an Arcane instance, optionally filtered by name
+or to those whose agent is connected, e.g. to deploy a project to every environment with
+` + "`for_each`" + `.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_environments" "edge" {
+  name_regex     = "^edge-"
+  connected_only = true
+}
+
+resource "arcane_project_deployment" "node_exporter" {
+  for_each = { for env in data.arcane_environments.edge.environments : env.name => env.id }
+
+  environment_id = each.value
+  project_id     = "node-exporter"
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list environments whose name in Arcane matches this [RE2 regular expression](https://github.com/google/re2/wiki/Syntax). Names include the provider's `name_prefix`, if any.",
+				Optional:            true,
+			},
+			"connected_only": schema.BoolAttribute{
+				MarkdownDescription: "Only list environments whose agent is connected, testing each environment's connection. Defaults to `false`.",
+				Optional:            true,
+			},
+			"environments": schema.ListNestedAttribute{
+				MarkdownDescription: "The environments, ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the environment.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the environment in Arcane.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the environment. Unset when it has none.",
+							Computed:            true,
+						},
+						"api_url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the environment's agent API.",
+							Computed:            true,
+						},
+						"use_api_key": schema.BoolAttribute{
+							MarkdownDescription: "Whether the environment requires API key authentication.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the environment was created, as reported by Arcane.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "When the environment was last updated, as reported by Arcane.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the environments, in the same order as `environments`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *EnvironmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *EnvironmentsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+	if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid name_regex",
+			fmt.Sprintf("Expected a regular expression, got %q: %s", nameRegex.ValueString(), err),
+		)
+	}
+}
+
+func (d *EnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data EnvironmentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRe, err := regexp.Compile(data.NameRegex.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		return
+	}
+
+	envs, err := d.client.ListEnvironments(ctx)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list environments")
+		return
+	}
+
+	data.Environments = []environmentsElement{}
+	data.IDs = []types.String{}
+	for _, env := range sortedEnvironments(envs) {
+		if !nameRe.MatchString(env.Name) {
+			continue
+		}
+		if data.ConnectedOnly.ValueBool() {
+			if err := d.client.TestEnvironment(ctx, env.ID); err != nil {
+				tflog.Debug(ctx, "Skipping environment whose agent is not connected", map[string]interface{}{
+					"environment_id": env.ID,
+					"error":          err.Error(),
+				})
+				continue
+			}
+		}
+		data.Environments = append(data.Environments, environmentsElement{
+			ID:          types.StringValue(env.ID),
+			Name:        types.StringValue(env.Name),
+			Description: optionalString(env.Description),
+			APIURL:      optionalString(env.APIURL),
+			UseAPIKey:   types.BoolValue(env.UseAPIKey),
+			CreatedAt:   optionalString(env.CreatedAt),
+			UpdatedAt:   optionalString(env.UpdatedAt),
+		})
+		data.IDs = append(data.IDs, types.StringValue(env.ID))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
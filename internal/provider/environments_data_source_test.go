@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestEnvironmentsDataSource_GivenEnvironments_WhenFiltered_ThenMatchingListed
+// validates that every environment is listed by name, and that name_regex
+// and connected_only narrow the list.
+func TestEnvironmentsDataSource_GivenEnvironments_WhenFiltered_ThenMatchingListed(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-edge-2"] = &arcane.Environment{ID: "env-edge-2", Name: "edge-2", APIURL: "http://10.0.0.2:3553"}
+	mockServer.Environments["env-edge-1"] = &arcane.Environment{ID: "env-edge-1", Name: "edge-1", APIURL: "http://10.0.0.1:3553", Description: "Rack 1"}
+	mockServer.Environments["env-core"] = &arcane.Environment{ID: "env-core", Name: "core", UseAPIKey: true}
+	mockServer.HealthyEnvs["env-edge-1"] = true
+	mockServer.HealthyEnvs["env-core"] = true
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvironmentsDataSourceConfig(mockServer.URL, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_environments.test", "environments.#", "3"),
+					resource.TestCheckResourceAttr("data.arcane_environments.test", "environments.0.name", "core"),
+					resource.TestCheckResourceAttr("data.arcane_environments.test", "environments.0.use_api_key", "true"),
+					resource.TestCheckNoResourceAttr("data.arcane_environments.test", "environments.0.api_url"),
+					resource.TestCheckResourceAttr("data.arcane_environments.test", "environments.1.description", "Rack 1"),
+					resource.TestCheckResourceAttr("data.arcane_environments.test", "ids.2", "env-edge-2"),
+				),
+			},
+			{
+				Config: testEnvironmentsDataSourceConfig(mockServer.URL, `name_regex = "^edge-"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_environments.test", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.arcane_environments.test", "environments.0.api_url", "http://10.0.0.1:3553"),
+				),
+			},
+			{
+				Config: testEnvironmentsDataSourceConfig(mockServer.URL, "name_regex = \"^edge-\"\n  connected_only = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_environments.test", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.arcane_environments.test", "ids.0", "env-edge-1"),
+				),
+			},
+		},
+	})
+}
+
+// TestEnvironmentsDataSource_GivenInvalidNameRegex_WhenValidated_ThenError
+// validates that name_regex must compile.
+func TestEnvironmentsDataSource_GivenInvalidNameRegex_WhenValidated_ThenError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testEnvironmentsDataSourceConfig(mockServer.URL, `name_regex = "edge-("`),
+				ExpectError: regexp.MustCompile(`Invalid name_regex`),
+			},
+		},
+	})
+}
+
+func testEnvironmentsDataSourceConfig(url, filters string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_environments" "test" {
+  %[2]s
+}
+`, url, filters)
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// terraformBlockRe matches the top-level `terraform { ... }` block of an
+// example. It pins the registry source, which the test harness replaces with
+// the in-process provider.
+var terraformBlockRe = regexp.MustCompile(`(?ms)^terraform \{.*?^\}\n`)
+
+// readExampleConfig returns the main.tf of examples/<name> with its terraform
+// block removed so it can be applied by the acceptance test framework.
+func readExampleConfig(t *testing.T, name string) string {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join("..", "..", "examples", name, "main.tf"))
+	if err != nil {
+		t.Fatalf("failed to read example %s: %v", name, err)
+	}
+	return terraformBlockRe.ReplaceAllString(string(b), "")
+}
+
+// TestExamples_GivenCompleteExample_WhenRead_ThenProviderBlockKept validates
+// that stripping the terraform block leaves the rest of the example intact.
+func TestExamples_GivenCompleteExample_WhenRead_ThenProviderBlockKept(t *testing.T) {
+	t.Parallel()
+	cfg := readExampleConfig(t, "complete")
+	if strings.Contains(cfg, "required_providers") {
+		t.Error("expected terraform block to be removed")
+	}
+	for _, want := range []string{`provider "arcane"`, `resource "arcane_environment" "homelab"`, `resource "arcane_gitops_sync" "media"`} {
+		if !strings.Contains(cfg, want) {
+			t.Errorf("expected example to contain %s", want)
+		}
+	}
+}
+
+// TestExamples_GivenCompleteExample_WhenApplied_ThenHappyPathSucceeds applies
+// examples/complete against the mock server so the documented end-to-end
+// configuration keeps working.
+func TestExamples_GivenCompleteExample_WhenApplied_ThenHappyPathSucceeds(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	// The example creates the "homelab" environment (env-homelab in the mock)
+	// and looks up stacks that already exist on its Docker host.
+	mockServer.AddProject("env-homelab", &arcane.Project{
+		ID:            "proj-monitoring",
+		Name:          "monitoring",
+		Status:        "stopped",
+		EnvironmentID: "env-homelab",
+	})
+	mockServer.AddProject("env-homelab", &arcane.Project{
+		ID:            "proj-traefik",
+		Name:          "traefik",
+		Status:        "stopped",
+		EnvironmentID: "env-homelab",
+	})
+	mockServer.AddContainers("env-homelab", "proj-monitoring", []arcane.ContainerDetail{
+		{ID: "c-grafana", Name: "monitoring-grafana-1", Image: "grafana/grafana:latest", Status: "running"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: readExampleConfig(t, "complete"),
+				ConfigVariables: config.Variables{
+					"arcane_url": config.StringVariable(mockServer.URL),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_environment.homelab", "id", "env-homelab"),
+					resource.TestCheckResourceAttrSet("arcane_environment.homelab", "access_token"),
+					resource.TestCheckResourceAttr("data.arcane_environment_health.homelab", "is_connected", "true"),
+					resource.TestCheckResourceAttrSet("arcane_container_registry.ghcr", "id"),
+					resource.TestCheckResourceAttrPair("arcane_gitops_sync.media", "repository_id", "arcane_git_repository.infra", "id"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.monitoring", "status", "running"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.traefik", "status", "running"),
+					resource.TestCheckResourceAttr("data.arcane_project_status.monitoring", "containers.0.name", "monitoring-grafana-1"),
+				),
+			},
+		},
+	})
+}
@@ -11,7 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -27,7 +28,7 @@ func NewGitRepositoryResource() resource.Resource {
 
 // GitRepositoryResource defines the git repository resource implementation.
 type GitRepositoryResource struct {
-	client *client.Client
+	client *Client
 }
 
 // GitRepositoryResourceModel describes the git repository resource data model.
@@ -38,6 +39,7 @@ type GitRepositoryResourceModel struct {
 	Branch      types.String `tfsdk:"branch"`
 	AuthType    types.String `tfsdk:"auth_type"`
 	Credentials types.String `tfsdk:"credentials"`
+	APIKeyAlias types.String `tfsdk:"api_key_alias"`
 }
 
 func (r *GitRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -74,16 +76,19 @@ resource "arcane_gitops_sync" "webapp" {
 
 ## Import
 
-Git repositories can be imported using their ID:
+Git repositories can be imported using their ID, or their name prefixed with ` + "`name:`" + `
+since IDs aren't shown in the Arcane UI:
 
 ` + "```shell" + `
 terraform import arcane_git_repository.infra <repository-id>
+terraform import arcane_git_repository.infra name:<repository-name>
 ` + "```" + `
 
 **Note:** When importing, the credentials field is not retrieved from the API.
 You will need to re-specify credentials in your configuration after import.
 `,
 		Attributes: map[string]schema.Attribute{
+			"api_key_alias": apiKeyAliasAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the git repository.",
 				Computed:            true,
@@ -92,7 +97,7 @@ You will need to re-specify credentials in your configuration after import.
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the git repository. Must be unique.",
+				MarkdownDescription: "The name of the git repository. Must be unique. Created in Arcane with the provider's `name_prefix` prepended.",
 				Required:            true,
 			},
 			"url": schema.StringAttribute{
@@ -125,11 +130,11 @@ func (r *GitRepositoryResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	c, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
 		)
 		return
 	}
@@ -138,6 +143,10 @@ func (r *GitRepositoryResource) Configure(ctx context.Context, req resource.Conf
 }
 
 func (r *GitRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
 	var data GitRepositoryResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -145,8 +154,8 @@ func (r *GitRepositoryResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	createReq := &client.GitRepositoryCreateRequest{
-		Name:        data.Name.ValueString(),
+	createReq := &arcane.GitRepositoryCreateRequest{
+		Name:        r.client.PrefixedName(data.Name.ValueString()),
 		URL:         data.URL.ValueString(),
 		Branch:      data.Branch.ValueString(),
 		AuthType:    data.AuthType.ValueString(),
@@ -155,13 +164,13 @@ func (r *GitRepositoryResource) Create(ctx context.Context, req resource.CreateR
 
 	repo, err := r.client.CreateGitRepository(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to create git repository", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to create git repository")
 		return
 	}
 
 	// Update state from response
 	data.ID = types.StringValue(repo.ID)
-	data.Name = types.StringValue(repo.Name)
+	data.Name = types.StringValue(r.client.UnprefixedName(repo.Name))
 	data.URL = types.StringValue(repo.URL)
 	if repo.Branch != "" {
 		data.Branch = types.StringValue(repo.Branch)
@@ -177,25 +186,30 @@ func (r *GitRepositoryResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *GitRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
 	var data GitRepositoryResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	prior := data
 
 	repo, err := r.client.GetGitRepository(ctx, data.ID.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if arcane.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Failed to read git repository", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read git repository")
 		return
 	}
 
 	// Update state from response
-	data.Name = types.StringValue(repo.Name)
+	data.Name = types.StringValue(r.client.UnprefixedName(repo.Name))
 	data.URL = types.StringValue(repo.URL)
 	if repo.Branch != "" {
 		data.Branch = types.StringValue(repo.Branch)
@@ -207,10 +221,21 @@ func (r *GitRepositoryResource) Read(ctx context.Context, req resource.ReadReque
 	}
 	// Preserve credentials from state (API does not return credentials)
 
+	drift := newDriftReport("arcane_git_repository", data.ID.ValueString())
+	drift.compare("name", prior.Name, data.Name)
+	drift.compare("url", prior.URL, data.URL)
+	drift.compare("branch", prior.Branch, data.Branch)
+	drift.compare("auth_type", prior.AuthType, data.AuthType)
+	drift.addTo(&resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GitRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
 	var data GitRepositoryResourceModel
 	var state GitRepositoryResourceModel
 
@@ -220,8 +245,8 @@ func (r *GitRepositoryResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	updateReq := &client.GitRepositoryUpdateRequest{
-		Name:        data.Name.ValueString(),
+	updateReq := &arcane.GitRepositoryUpdateRequest{
+		Name:        r.client.PrefixedName(data.Name.ValueString()),
 		URL:         data.URL.ValueString(),
 		Branch:      data.Branch.ValueString(),
 		AuthType:    data.AuthType.ValueString(),
@@ -230,12 +255,12 @@ func (r *GitRepositoryResource) Update(ctx context.Context, req resource.UpdateR
 
 	repo, err := r.client.UpdateGitRepository(ctx, data.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to update git repository", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to update git repository")
 		return
 	}
 
 	// Update state from response
-	data.Name = types.StringValue(repo.Name)
+	data.Name = types.StringValue(r.client.UnprefixedName(repo.Name))
 	data.URL = types.StringValue(repo.URL)
 	if repo.Branch != "" {
 		data.Branch = types.StringValue(repo.Branch)
@@ -251,6 +276,10 @@ func (r *GitRepositoryResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *GitRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
 	var data GitRepositoryResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -260,13 +289,24 @@ func (r *GitRepositoryResource) Delete(ctx context.Context, req resource.DeleteR
 
 	err := r.client.DeleteGitRepository(ctx, data.ID.ValueString())
 	if err != nil {
-		if !client.IsNotFound(err) {
-			resp.Diagnostics.AddError("Failed to delete git repository", err.Error())
+		if !arcane.IsNotFound(err) {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to delete git repository")
 			return
 		}
 	}
 }
 
 func (r *GitRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	name, ok := parseNameImportID(req.ID)
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	repository, err := r.client.GetGitRepositoryByName(ctx, name)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, fmt.Sprintf("Failed to find git repository %q", name))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), repository.ID)...)
 }
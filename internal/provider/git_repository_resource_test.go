@@ -102,7 +102,7 @@ func TestGitRepositoryResource_GivenExistingRepo_WhenDeleted_ThenRemoved(t *test
 }
 
 // TestGitRepositoryResource_GivenExistingRepo_WhenImported_ThenStateMatches
-// validates that a git repository can be imported by ID and that state is verified.
+// validates that a git repository can be imported by ID or name and that state is verified.
 // Credentials are excluded from import verification since the API does not return them.
 func TestGitRepositoryResource_GivenExistingRepo_WhenImported_ThenStateMatches(t *testing.T) {
 	mockServer := NewMockServer()
@@ -126,6 +126,14 @@ func TestGitRepositoryResource_GivenExistingRepo_WhenImported_ThenStateMatches(t
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"credentials"},
 			},
+			// Import by name
+			{
+				ResourceName:            "arcane_git_repository.test",
+				ImportState:             true,
+				ImportStateId:           "name:import-repo",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials"},
+			},
 		},
 	})
 }
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &GitOpsSyncDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &GitOpsSyncDataSource{}
+)
+
+// NewGitOpsSyncDataSource returns a new GitOps sync data source.
+func NewGitOpsSyncDataSource() datasource.DataSource {
+	return &GitOpsSyncDataSource{}
+}
+
+// GitOpsSyncDataSource defines the GitOps sync data source implementation.
+type GitOpsSyncDataSource struct {
+	client *Client
+}
+
+// GitOpsSyncDataSourceModel describes the GitOps sync data source data model.
+type GitOpsSyncDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	EnvironmentID  types.String `tfsdk:"environment_id"`
+	RepositoryID   types.String `tfsdk:"repository_id"`
+	Path           types.String `tfsdk:"path"`
+	Branch         types.String `tfsdk:"branch"`
+	ComposeFile    types.String `tfsdk:"compose_file"`
+	SyncInterval   types.String `tfsdk:"sync_interval"`
+	AutoSync       types.Bool   `tfsdk:"auto_sync"`
+	LastSyncAt     types.String `tfsdk:"last_sync_at"`
+	LastSyncCommit types.String `tfsdk:"last_sync_commit"`
+}
+
+func (d *GitOpsSyncDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gitops_sync"
+}
+
+func (d *GitOpsSyncDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to read the configuration of an existing GitOps sync, e.g. one managed in
+another workspace or created in the Arcane UI.
+
+Look the sync up by its ID, or by the git repository it syncs when that repository has a single
+sync in the environment. To list every sync of an environment, use ` + "`arcane_gitops_syncs`" + `.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_gitops_sync" "webapp" {
+  environment_id = arcane_environment.production.id
+  repository_id  = arcane_git_repository.infra.id
+}
+
+output "webapp_synced_commit" {
+  value = data.arcane_gitops_sync.webapp.last_sync_commit
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the sync. Exactly one of `id` or `repository_id` must be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment containing the sync.",
+				Required:            true,
+			},
+			"repository_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the git repository the sync deploys from. Exactly one of `id` or `repository_id` must be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path within the repository containing the compose file.",
+				Computed:            true,
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "The branch the sync deploys.",
+				Computed:            true,
+			},
+			"compose_file": schema.StringAttribute{
+				MarkdownDescription: "The name of the compose file.",
+				Computed:            true,
+			},
+			"sync_interval": schema.StringAttribute{
+				MarkdownDescription: "How often the sync checks for changes, as reported by Arcane. Unset when none is configured.",
+				Computed:            true,
+			},
+			"auto_sync": schema.BoolAttribute{
+				MarkdownDescription: "Whether changes in the repository are synced automatically.",
+				Computed:            true,
+			},
+			"last_sync_at": schema.StringAttribute{
+				MarkdownDescription: "When the sync last ran. Unset if it never ran.",
+				Computed:            true,
+			},
+			"last_sync_commit": schema.StringAttribute{
+				MarkdownDescription: "The commit deployed by the last sync. Unset if it never ran.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GitOpsSyncDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *GitOpsSyncDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data GitOpsSyncDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.ID.IsUnknown() || data.RepositoryID.IsUnknown() {
+		return
+	}
+	if data.ID.IsNull() == data.RepositoryID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid GitOps sync lookup",
+			"Exactly one of id or repository_id must be specified to look up a GitOps sync.",
+		)
+	}
+}
+
+func (d *GitOpsSyncDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data GitOpsSyncDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envClient := d.client.ForEnvironment(data.EnvironmentID.ValueString())
+
+	var sync *arcane.GitOpsSync
+	if !data.ID.IsNull() {
+		var err error
+		sync, err = envClient.GetGitOpsSync(ctx, data.ID.ValueString())
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read GitOps sync")
+			return
+		}
+	} else {
+		syncs, err := envClient.ListGitOpsSyncs(ctx)
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list GitOps syncs")
+			return
+		}
+		var matches []arcane.GitOpsSync
+		for _, s := range syncs {
+			if s.RepositoryID == data.RepositoryID.ValueString() {
+				matches = append(matches, s)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("repository_id"),
+				"GitOps sync not found",
+				fmt.Sprintf("No GitOps sync of environment %q deploys from repository %q.", data.EnvironmentID.ValueString(), data.RepositoryID.ValueString()),
+			)
+			return
+		case 1:
+			sync = &matches[0]
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("repository_id"),
+				"Multiple GitOps syncs found",
+				fmt.Sprintf("%d GitOps syncs of environment %q deploy from repository %q. Look the sync up by id instead.",
+					len(matches), data.EnvironmentID.ValueString(), data.RepositoryID.ValueString()),
+			)
+			return
+		}
+	}
+
+	element := newGitOpsSyncElement(*sync)
+	data.ID = element.ID
+	data.RepositoryID = element.RepositoryID
+	data.Path = element.Path
+	data.Branch = element.Branch
+	data.ComposeFile = element.ComposeFile
+	data.SyncInterval = element.SyncInterval
+	data.AutoSync = element.AutoSync
+	data.LastSyncAt = element.LastSyncAt
+	data.LastSyncCommit = element.LastSyncCommit
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
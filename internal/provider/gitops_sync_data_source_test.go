@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// newGitOpsSyncsMockServer returns a mock server whose env-gitops environment
+// has a synced webapp sync and a never-run api sync of the same repository,
+// and a worker sync of another repository.
+func newGitOpsSyncsMockServer() *MockServer {
+	ms := NewMockServer()
+	ms.Environments["env-gitops"] = &arcane.Environment{ID: "env-gitops", Name: "gitops-env"}
+	ms.AddGitOpsSync("env-gitops", &arcane.GitOpsSync{
+		ID: "sync-webapp", RepositoryID: "repo-infra", Path: "apps/webapp", Branch: "main", ComposeFile: "docker-compose.yml",
+		SyncInterval: "5m", AutoSync: true, LastSyncAt: "2026-01-01T00:00:00Z", LastSyncCommit: "0123abc",
+	})
+	ms.AddGitOpsSync("env-gitops", &arcane.GitOpsSync{
+		ID: "sync-api", RepositoryID: "repo-infra", Path: "apps/api", Branch: "main", ComposeFile: "docker-compose.yml",
+	})
+	ms.AddGitOpsSync("env-gitops", &arcane.GitOpsSync{
+		ID: "sync-worker", RepositoryID: "repo-worker", Path: "worker", Branch: "develop", ComposeFile: "compose.yml",
+	})
+	return ms
+}
+
+// TestGitOpsSyncDataSource_GivenID_WhenRead_ThenAllFieldsSet validates that a
+// sync looked up by ID exposes its configuration and last sync.
+func TestGitOpsSyncDataSource_GivenID_WhenRead_ThenAllFieldsSet(t *testing.T) {
+	mockServer := newGitOpsSyncsMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testGitOpsSyncDataSourceConfig(mockServer.URL, `id = "sync-webapp"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync.test", "repository_id", "repo-infra"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync.test", "path", "apps/webapp"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync.test", "branch", "main"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync.test", "compose_file", "docker-compose.yml"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync.test", "sync_interval", "5m"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync.test", "auto_sync", "true"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync.test", "last_sync_at", "2026-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync.test", "last_sync_commit", "0123abc"),
+				),
+			},
+		},
+	})
+}
+
+// TestGitOpsSyncDataSource_GivenRepositoryID_WhenRead_ThenSingleSyncFound
+// validates the lookup by the repository of the environment's only sync of it.
+func TestGitOpsSyncDataSource_GivenRepositoryID_WhenRead_ThenSingleSyncFound(t *testing.T) {
+	mockServer := newGitOpsSyncsMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testGitOpsSyncDataSourceConfig(mockServer.URL, `repository_id = "repo-worker"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync.test", "id", "sync-worker"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync.test", "branch", "develop"),
+					resource.TestCheckNoResourceAttr("data.arcane_gitops_sync.test", "sync_interval"),
+					resource.TestCheckNoResourceAttr("data.arcane_gitops_sync.test", "last_sync_commit"),
+				),
+			},
+		},
+	})
+}
+
+// TestGitOpsSyncDataSource_GivenAmbiguousOrMissingLookup_WhenRead_ThenError
+// validates the errors for a repository with several syncs or none, and for
+// a lookup setting neither or both of id and repository_id.
+func TestGitOpsSyncDataSource_GivenAmbiguousOrMissingLookup_WhenRead_ThenError(t *testing.T) {
+	mockServer := newGitOpsSyncsMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testGitOpsSyncDataSourceConfig(mockServer.URL, `repository_id = "repo-infra"`),
+				ExpectError: regexp.MustCompile(`Multiple GitOps syncs found`),
+			},
+			{
+				Config:      testGitOpsSyncDataSourceConfig(mockServer.URL, `repository_id = "repo-other"`),
+				ExpectError: regexp.MustCompile(`GitOps sync not found`),
+			},
+			{
+				Config:      testGitOpsSyncDataSourceConfig(mockServer.URL, ``),
+				ExpectError: regexp.MustCompile(`Exactly one of id or repository_id`),
+			},
+			{
+				Config:      testGitOpsSyncDataSourceConfig(mockServer.URL, "id = \"sync-webapp\"\n  repository_id = \"repo-infra\""),
+				ExpectError: regexp.MustCompile(`Exactly one of id or repository_id`),
+			},
+		},
+	})
+}
+
+func testGitOpsSyncDataSourceConfig(url, lookup string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_gitops_sync" "test" {
+  environment_id = "env-gitops"
+  %[2]s
+}
+`, url, lookup)
+}
@@ -4,23 +4,29 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &GitOpsSyncResource{}
 	_ resource.ResourceWithImportState = &GitOpsSyncResource{}
+	_ resource.ResourceWithModifyPlan  = &GitOpsSyncResource{}
 )
 
 // NewGitOpsSyncResource returns a new GitOps sync resource.
@@ -30,21 +36,27 @@ func NewGitOpsSyncResource() resource.Resource {
 
 // GitOpsSyncResource defines the GitOps sync resource implementation.
 type GitOpsSyncResource struct {
-	client *client.Client
+	client *Client
 }
 
 // GitOpsSyncResourceModel describes the GitOps sync resource data model.
 type GitOpsSyncResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	EnvironmentID  types.String `tfsdk:"environment_id"`
-	RepositoryID   types.String `tfsdk:"repository_id"`
-	Path           types.String `tfsdk:"path"`
-	Branch         types.String `tfsdk:"branch"`
-	ComposeFile    types.String `tfsdk:"compose_file"`
-	SyncInterval   types.String `tfsdk:"sync_interval"`
-	AutoSync       types.Bool   `tfsdk:"auto_sync"`
-	LastSyncAt     types.String `tfsdk:"last_sync_at"`
-	LastSyncCommit types.String `tfsdk:"last_sync_commit"`
+	ID                types.String        `tfsdk:"id"`
+	EnvironmentID     types.String        `tfsdk:"environment_id"`
+	RepositoryID      types.String        `tfsdk:"repository_id"`
+	RepositoryMissing types.Bool          `tfsdk:"repository_missing"`
+	Path              types.String        `tfsdk:"path"`
+	Branch            types.String        `tfsdk:"branch"`
+	ComposeFile       types.String        `tfsdk:"compose_file"`
+	SyncInterval      durationStringValue `tfsdk:"sync_interval"`
+	AutoSync          types.Bool          `tfsdk:"auto_sync"`
+	LastSyncAt        types.String        `tfsdk:"last_sync_at"`
+	LastSyncCommit    types.String        `tfsdk:"last_sync_commit"`
+	LastSyncStatus    types.String        `tfsdk:"last_sync_status"`
+	LastSyncError     types.String        `tfsdk:"last_sync_error"`
+	TriggerOnApply    types.Bool          `tfsdk:"trigger_on_apply"`
+	Hints             types.Object        `tfsdk:"lifecycle_hints"`
+	APIKeyAlias       types.String        `tfsdk:"api_key_alias"`
 }
 
 func (r *GitOpsSyncResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -94,6 +106,7 @@ terraform import arcane_gitops_sync.webapp env-id/sync-id
 ` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
+			"api_key_alias": apiKeyAliasAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the GitOps sync.",
 				Computed:            true,
@@ -112,6 +125,14 @@ terraform import arcane_gitops_sync.webapp env-id/sync-id
 				MarkdownDescription: "The ID of the git repository to sync from.",
 				Required:            true,
 			},
+			"repository_missing": schema.BoolAttribute{
+				MarkdownDescription: "Whether the repository no longer exists, e.g. because it was deleted outside of Terraform. " +
+					"The sync then fails whenever it runs, so refreshing it also warns.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"path": schema.StringAttribute{
 				MarkdownDescription: "The path within the repository containing the compose file.",
 				Optional:            true,
@@ -128,8 +149,10 @@ terraform import arcane_gitops_sync.webapp env-id/sync-id
 				Default:             stringdefault.StaticString("docker-compose.yml"),
 			},
 			"sync_interval": schema.StringAttribute{
-				MarkdownDescription: "How often to check for changes (e.g. `5m`, `1h`). Only used when `auto_sync` is enabled.",
-				Optional:            true,
+				MarkdownDescription: "How often to check for changes (e.g. `5m`, `1h`). Only used when `auto_sync` is enabled. " +
+					"Arcane may report the interval in another notation, such as `300` for `5m`; the same duration is not a change.",
+				Optional:   true,
+				CustomType: durationStringType{},
 			},
 			"auto_sync": schema.BoolAttribute{
 				MarkdownDescription: "Whether to automatically sync changes from the repository. Defaults to `false`.",
@@ -137,6 +160,13 @@ terraform import arcane_gitops_sync.webapp env-id/sync-id
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"trigger_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Trigger a sync after every create and update, waiting for it to finish, so the stack is deployed by the same apply. " +
+					"A failed sync is reported as a warning and in `last_sync_status` and `last_sync_error`. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"last_sync_at": schema.StringAttribute{
 				MarkdownDescription: "The timestamp of the last successful sync in RFC3339 format.",
 				Computed:            true,
@@ -145,6 +175,16 @@ terraform import arcane_gitops_sync.webapp env-id/sync-id
 				MarkdownDescription: "The commit SHA of the last successful sync.",
 				Computed:            true,
 			},
+			"last_sync_status": schema.StringAttribute{
+				MarkdownDescription: "The result of the most recent sync run (e.g. `success`, `failed`). Unset before the first run.",
+				Computed:            true,
+			},
+			"last_sync_error": schema.StringAttribute{
+				MarkdownDescription: "The message of the most recent sync run when it did not succeed, such as the error of a failed sync. " +
+					"Unset when it succeeded. Use it in a postcondition to fail an apply on a broken sync.",
+				Computed: true,
+			},
+			"lifecycle_hints": lifecycleHintsSchema(),
 		},
 	}
 }
@@ -154,11 +194,11 @@ func (r *GitOpsSyncResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	c, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
 		)
 		return
 	}
@@ -166,7 +206,31 @@ func (r *GitOpsSyncResource) Configure(ctx context.Context, req resource.Configu
 	r.client = c
 }
 
+// ModifyPlan rejects environments excluded by the provider's
+// allowed_environments or denied_environments. When repository_id changes,
+// repository_missing is unknown.
+func (r *GitOpsSyncResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkPlannedEnvironmentAllowed(ctx, r.client, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state GitOpsSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.RepositoryID.Equal(state.RepositoryID) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("repository_missing"), types.BoolUnknown())...)
+	}
+}
+
 func (r *GitOpsSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
 	var data GitOpsSyncResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -176,7 +240,7 @@ func (r *GitOpsSyncResource) Create(ctx context.Context, req resource.CreateRequ
 
 	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
 
-	createReq := &client.GitOpsSyncCreateRequest{
+	createReq := &arcane.GitOpsSyncCreateRequest{
 		RepositoryID: data.RepositoryID.ValueString(),
 		Path:         data.Path.ValueString(),
 		Branch:       data.Branch.ValueString(),
@@ -187,7 +251,7 @@ func (r *GitOpsSyncResource) Create(ctx context.Context, req resource.CreateRequ
 
 	sync, err := envClient.CreateGitOpsSync(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to create GitOps sync", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to create GitOps sync")
 		return
 	}
 
@@ -205,7 +269,7 @@ func (r *GitOpsSyncResource) Create(ctx context.Context, req resource.CreateRequ
 		data.ComposeFile = types.StringValue(sync.ComposeFile)
 	}
 	if sync.SyncInterval != "" {
-		data.SyncInterval = types.StringValue(sync.SyncInterval)
+		data.SyncInterval = reconcileDurationString(data.SyncInterval, sync.SyncInterval)
 	}
 	data.AutoSync = types.BoolValue(sync.AutoSync)
 	if sync.LastSyncAt != "" {
@@ -219,26 +283,44 @@ func (r *GitOpsSyncResource) Create(ctx context.Context, req resource.CreateRequ
 		data.LastSyncCommit = types.StringNull()
 	}
 
+	if !syncAfterApply(ctx, envClient, &data, &resp.Diagnostics) {
+		return
+	}
+	if data.RepositoryMissing.IsUnknown() {
+		data.RepositoryMissing = checkSyncRepository(ctx, r.client, &data, &resp.Diagnostics)
+	}
+	data.Hints = gitOpsSyncHints(&data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GitOpsSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
 	var data GitOpsSyncResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	prior := data
+
+	checkScopedID("arcane_gitops_sync", &data.ID, data.EnvironmentID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
 
 	sync, err := envClient.GetGitOpsSync(ctx, data.ID.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if arcane.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Failed to read GitOps sync", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read GitOps sync")
 		return
 	}
 
@@ -254,7 +336,7 @@ func (r *GitOpsSyncResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.ComposeFile = types.StringValue(sync.ComposeFile)
 	}
 	if sync.SyncInterval != "" {
-		data.SyncInterval = types.StringValue(sync.SyncInterval)
+		data.SyncInterval = reconcileDurationString(data.SyncInterval, sync.SyncInterval)
 	}
 	data.AutoSync = types.BoolValue(sync.AutoSync)
 	if sync.LastSyncAt != "" {
@@ -268,10 +350,34 @@ func (r *GitOpsSyncResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.LastSyncCommit = types.StringNull()
 	}
 
+	if data.TriggerOnApply.IsNull() {
+		data.TriggerOnApply = types.BoolValue(false)
+	}
+	if err := setLastSyncRun(ctx, envClient, &data); err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list GitOps sync runs")
+		return
+	}
+	data.RepositoryMissing = checkSyncRepository(ctx, r.client, &data, &resp.Diagnostics)
+
+	drift := newDriftReport("arcane_gitops_sync", data.ID.ValueString())
+	drift.compare("repository_id", prior.RepositoryID, data.RepositoryID)
+	drift.compare("path", prior.Path, data.Path)
+	drift.compare("branch", prior.Branch, data.Branch)
+	drift.compare("compose_file", prior.ComposeFile, data.ComposeFile)
+	drift.compare("sync_interval", prior.SyncInterval, data.SyncInterval)
+	drift.compare("auto_sync", prior.AutoSync, data.AutoSync)
+	drift.addTo(&resp.Diagnostics)
+
+	data.Hints = gitOpsSyncHints(&data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GitOpsSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
 	var data GitOpsSyncResourceModel
 	var state GitOpsSyncResourceModel
 
@@ -284,7 +390,7 @@ func (r *GitOpsSyncResource) Update(ctx context.Context, req resource.UpdateRequ
 	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
 
 	autoSync := data.AutoSync.ValueBool()
-	updateReq := &client.GitOpsSyncUpdateRequest{
+	updateReq := &arcane.GitOpsSyncUpdateRequest{
 		RepositoryID: data.RepositoryID.ValueString(),
 		Path:         data.Path.ValueString(),
 		Branch:       data.Branch.ValueString(),
@@ -295,7 +401,7 @@ func (r *GitOpsSyncResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	sync, err := envClient.UpdateGitOpsSync(ctx, state.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to update GitOps sync", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to update GitOps sync")
 		return
 	}
 
@@ -319,10 +425,22 @@ func (r *GitOpsSyncResource) Update(ctx context.Context, req resource.UpdateRequ
 		data.LastSyncCommit = types.StringNull()
 	}
 
+	if !syncAfterApply(ctx, envClient, &data, &resp.Diagnostics) {
+		return
+	}
+	if data.RepositoryMissing.IsUnknown() {
+		data.RepositoryMissing = checkSyncRepository(ctx, r.client, &data, &resp.Diagnostics)
+	}
+	data.Hints = gitOpsSyncHints(&data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GitOpsSyncResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
 	var data GitOpsSyncResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -334,16 +452,16 @@ func (r *GitOpsSyncResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	err := envClient.DeleteGitOpsSync(ctx, data.ID.ValueString())
 	if err != nil {
-		if !client.IsNotFound(err) {
-			resp.Diagnostics.AddError("Failed to delete GitOps sync", err.Error())
+		if !arcane.IsNotFound(err) {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to delete GitOps sync")
 			return
 		}
 	}
 }
 
 func (r *GitOpsSyncResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.SplitN(req.ID, "/", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+	environmentID, syncID, ok := parseCompositeID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Invalid import ID",
 			fmt.Sprintf("Expected format: environment_id/sync_id, got: %s", req.ID),
@@ -351,6 +469,134 @@ func (r *GitOpsSyncResource) ImportState(ctx context.Context, req resource.Impor
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), syncID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentID)...)
+}
+
+// gitOpsSyncTriggerTimeout is how long trigger_on_apply waits for a sync that
+// Arcane runs in the background.
+const gitOpsSyncTriggerTimeout = 10 * time.Minute
+
+// syncAfterApply triggers a sync of data when trigger_on_apply is set, waits
+// for it and refreshes the last sync attributes. A sync that runs but fails is
+// a warning, since the sync configuration itself was applied. It returns false
+// when an error was added.
+func syncAfterApply(ctx context.Context, envClient *EnvironmentClient, data *GitOpsSyncResourceModel, diags *diag.Diagnostics) bool {
+	if data.TriggerOnApply.ValueBool() {
+		jobID, err := envClient.TriggerGitOpsSync(ctx, data.ID.ValueString())
+		if err != nil {
+			diagnostics.AddAPIError(ctx, diags, err, "Failed to trigger GitOps sync")
+			return false
+		}
+		if jobID != "" {
+			job, err := envClient.WaitForJob(ctx, jobID, gitOpsSyncTriggerTimeout)
+			if err != nil {
+				diagnostics.AddAPIError(ctx, diags, err, "Failed to get GitOps sync status")
+				return false
+			}
+			if !job.Done() {
+				diags.AddWarning(
+					"GitOps sync still running",
+					fmt.Sprintf("Sync job %q still reports status %q after %s. Its result is recorded on the next refresh.",
+						jobID, job.Status, gitOpsSyncTriggerTimeout),
+				)
+			}
+		}
+
+		sync, err := envClient.GetGitOpsSync(ctx, data.ID.ValueString())
+		if err != nil {
+			diagnostics.AddAPIError(ctx, diags, err, "Failed to read GitOps sync")
+			return false
+		}
+		data.LastSyncAt = optionalString(sync.LastSyncAt)
+		data.LastSyncCommit = optionalString(sync.LastSyncCommit)
+	}
+
+	if err := setLastSyncRun(ctx, envClient, data); err != nil {
+		diagnostics.AddAPIError(ctx, diags, err, "Failed to list GitOps sync runs")
+		return false
+	}
+	if data.TriggerOnApply.ValueBool() && !data.LastSyncError.IsNull() {
+		diags.AddWarning(
+			"GitOps sync failed",
+			fmt.Sprintf("The sync triggered by trigger_on_apply finished with result %q: %s",
+				data.LastSyncStatus.ValueString(), data.LastSyncError.ValueString()),
+		)
+	}
+	return true
+}
+
+// setLastSyncRun sets last_sync_status and last_sync_error of data from the
+// newest run of the sync. Both are null when it has never run, or when Arcane
+// doesn't record runs.
+func setLastSyncRun(ctx context.Context, envClient *EnvironmentClient, data *GitOpsSyncResourceModel) error {
+	data.LastSyncStatus = types.StringNull()
+	data.LastSyncError = types.StringNull()
+
+	runs, err := envClient.ListGitOpsSyncRuns(ctx, data.ID.ValueString(), 1)
+	if err != nil {
+		if arcane.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if len(runs) == 0 {
+		return nil
+	}
+	data.LastSyncStatus = optionalString(runs[0].Result)
+	if !strings.EqualFold(runs[0].Result, "success") {
+		data.LastSyncError = optionalString(runs[0].Message)
+	}
+	return nil
+}
+
+// checkSyncRepository reports whether the repository of the sync is missing,
+// warning that the sync fails until repository_id points at an existing
+// one. A repository that can't be read for another reason, such as an API key
+// without access to repositories, is assumed to exist.
+func checkSyncRepository(ctx context.Context, c *Client, data *GitOpsSyncResourceModel, diags *diag.Diagnostics) types.Bool {
+	_, err := c.GetGitRepository(ctx, data.RepositoryID.ValueString())
+	if err == nil {
+		return types.BoolValue(false)
+	}
+	if !arcane.IsNotFound(err) {
+		tflog.Debug(ctx, "Failed to check the repository of GitOps sync", map[string]interface{}{
+			"sync_id":       data.ID.ValueString(),
+			"repository_id": data.RepositoryID.ValueString(),
+			"error":         err.Error(),
+		})
+		return types.BoolValue(false)
+	}
+	diags.AddAttributeWarning(
+		path.Root("repository_id"),
+		"GitOps sync repository missing",
+		fmt.Sprintf("GitOps sync %q deploys from git repository %q, which no longer exists, so every sync will fail. "+
+			"Point repository_id at an existing repository or remove the sync.",
+			data.ID.ValueString(), data.RepositoryID.ValueString()),
+	)
+	return types.BoolValue(true)
+}
+
+// gitOpsSyncHints returns the lifecycle_hints of a GitOps sync.
+func gitOpsSyncHints(data *GitOpsSyncResourceModel) types.Object {
+	var firstSync string
+	if data.AutoSync.ValueBool() {
+		firstSync = "The first sync runs automatically"
+		if interval := data.SyncInterval.ValueString(); interval != "" {
+			firstSync += " within " + interval
+		}
+		firstSync += "; check its result with the arcane_gitops_sync_runs data source."
+	} else if data.TriggerOnApply.ValueBool() {
+		firstSync = "auto_sync is disabled: trigger_on_apply syncs whenever this sync is created or changed; trigger later syncs in Arcane (or enable auto_sync)."
+	} else {
+		firstSync = "auto_sync is disabled: trigger the first sync in Arcane (or enable auto_sync, or set trigger_on_apply); nothing is deployed until then."
+	}
+
+	return lifecycleHintsValue(
+		[]string{firstSync},
+		[]string{
+			"A project already deployed in the environment under the compose project's name is adopted by the first sync; don't also manage it with arcane_project_deployment.",
+			"Destroying the sync or changing environment_id leaves the projects it deployed running.",
+		},
+	)
 }
@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // TestGitOpsSyncResource_GivenValidConfig_WhenCreated_ThenSyncExists
@@ -26,6 +32,7 @@ func TestGitOpsSyncResource_GivenValidConfig_WhenCreated_ThenSyncExists(t *testi
 					resource.TestCheckResourceAttrSet("arcane_gitops_sync.test", "repository_id"),
 					resource.TestCheckResourceAttr("arcane_gitops_sync.test", "compose_file", "docker-compose.yml"),
 					resource.TestCheckResourceAttr("arcane_gitops_sync.test", "auto_sync", "false"),
+					resource.TestMatchResourceAttr("arcane_gitops_sync.test", "lifecycle_hints.post_create_steps.0", regexp.MustCompile(`^auto_sync is disabled`)),
 				),
 			},
 		},
@@ -63,6 +70,7 @@ func TestGitOpsSyncResource_GivenFullConfig_WhenCreated_ThenAllFieldsSet(t *test
 					resource.TestCheckResourceAttr("arcane_gitops_sync.test", "compose_file", "compose.prod.yml"),
 					resource.TestCheckResourceAttr("arcane_gitops_sync.test", "sync_interval", "5m"),
 					resource.TestCheckResourceAttr("arcane_gitops_sync.test", "auto_sync", "true"),
+					resource.TestMatchResourceAttr("arcane_gitops_sync.test", "lifecycle_hints.post_create_steps.0", regexp.MustCompile(`runs automatically within 5m`)),
 				),
 			},
 		},
@@ -152,6 +160,186 @@ func TestGitOpsSyncResource_GivenCompositeID_WhenImported_ThenStatePopulated(t *
 	})
 }
 
+// TestGitOpsSyncResource_GivenTriggerOnApply_WhenApplied_ThenSynced
+// validates that trigger_on_apply runs a sync after create and update and
+// records its result.
+func TestGitOpsSyncResource_GivenTriggerOnApply_WhenApplied_ThenSynced(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testGitOpsSyncResourceConfigTriggerOnApply(mockServer.URL, "main"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_gitops_sync.test", "trigger_on_apply", "true"),
+					resource.TestCheckResourceAttr("arcane_gitops_sync.test", "last_sync_status", "success"),
+					resource.TestCheckNoResourceAttr("arcane_gitops_sync.test", "last_sync_error"),
+					resource.TestCheckResourceAttr("arcane_gitops_sync.test", "last_sync_commit", "0123abc"),
+					testCheckGitOpsSyncRuns(mockServer, 1),
+				),
+			},
+			{
+				Config: testGitOpsSyncResourceConfigTriggerOnApply(mockServer.URL, "release"),
+				Check:  testCheckGitOpsSyncRuns(mockServer, 2),
+			},
+		},
+	})
+}
+
+// TestGitOpsSyncResource_GivenFailedRun_WhenRefreshed_ThenErrorExposed
+// validates that the result and message of a failed newest run are exposed
+// as last_sync_status and last_sync_error.
+func TestGitOpsSyncResource_GivenFailedRun_WhenRefreshed_ThenErrorExposed(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testGitOpsSyncResourceConfig(mockServer.URL, "failed-env", "failed-repo", "https://github.com/example/failed.git"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_gitops_sync.test", "trigger_on_apply", "false"),
+					resource.TestCheckNoResourceAttr("arcane_gitops_sync.test", "last_sync_status"),
+				),
+			},
+			{
+				PreConfig: func() {
+					mockServer.mu.Lock()
+					defer mockServer.mu.Unlock()
+					for _, syncs := range mockServer.GitOpsSyncs {
+						for id := range syncs {
+							mockServer.GitOpsSyncRuns[id] = []arcane.GitOpsSyncRun{
+								{ID: "run-2", SyncID: id, Result: "failed", Message: "compose file not found"},
+								{ID: "run-1", SyncID: id, Result: "success"},
+							}
+						}
+					}
+				},
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_gitops_sync.test", "last_sync_status", "failed"),
+					resource.TestCheckResourceAttr("arcane_gitops_sync.test", "last_sync_error", "compose file not found"),
+				),
+			},
+		},
+	})
+}
+
+// TestGitOpsSyncResource_GivenNormalizedSyncInterval_WhenPlanned_ThenNoChanges
+// validates that a sync_interval the server reports in seconds keeps the
+// configured notation in state and does not show up as a change.
+func TestGitOpsSyncResource_GivenNormalizedSyncInterval_WhenPlanned_ThenNoChanges(t *testing.T) {
+	mockServer := NewMockServer()
+	mockServer.NormalizeSyncIntervals = true
+	defer mockServer.Close()
+
+	config := testGitOpsSyncResourceConfigFull(
+		mockServer.URL,
+		"interval-env",
+		"interval-repo",
+		"https://github.com/example/interval.git",
+		"apps/webapp",
+		"main",
+		"docker-compose.yml",
+		"5m",
+		true,
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_gitops_sync.test", "sync_interval", "5m"),
+					func(*terraform.State) error {
+						mockServer.mu.Lock()
+						defer mockServer.mu.Unlock()
+						for _, syncs := range mockServer.GitOpsSyncs {
+							for _, sync := range syncs {
+								if sync.SyncInterval != "300" {
+									return fmt.Errorf("expected the server to store sync_interval 300, got %q", sync.SyncInterval)
+								}
+							}
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestGitOpsSyncResource_GivenRepositoryDeleted_WhenRefreshed_ThenRepositoryMissing
+// validates that a sync whose repository was deleted outside of Terraform is
+// flagged as repository_missing on refresh.
+func TestGitOpsSyncResource_GivenRepositoryDeleted_WhenRefreshed_ThenRepositoryMissing(t *testing.T) {
+	mockServer := newGitOpsSyncsMockServer()
+	defer mockServer.Close()
+
+	mockServer.GitRepositories["repo-orphan"] = &arcane.GitRepository{ID: "repo-orphan", Name: "orphan", URL: "https://git.example.com/orphan.git"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testGitOpsSyncResourceConfigRepositoryID(mockServer.URL, "repo-orphan"),
+				Check:  resource.TestCheckResourceAttr("arcane_gitops_sync.test", "repository_missing", "false"),
+			},
+			{
+				PreConfig: func() {
+					mockServer.mu.Lock()
+					defer mockServer.mu.Unlock()
+					delete(mockServer.GitRepositories, "repo-orphan")
+				},
+				RefreshState: true,
+				Check:        resource.TestCheckResourceAttr("arcane_gitops_sync.test", "repository_missing", "true"),
+			},
+		},
+	})
+}
+
+func TestCheckSyncRepository(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+	mockServer.GitRepositories["repo-infra"] = &arcane.GitRepository{ID: "repo-infra", Name: "infra"}
+	c := newMockClient(t, mockServer)
+
+	var diags diag.Diagnostics
+	data := &GitOpsSyncResourceModel{ID: types.StringValue("sync-webapp"), RepositoryID: types.StringValue("repo-infra")}
+	if got := checkSyncRepository(context.Background(), c, data, &diags); got.ValueBool() || diags.WarningsCount() != 0 {
+		t.Errorf("existing repository: got %v with %d warning(s), want false without warnings", got, diags.WarningsCount())
+	}
+
+	data.RepositoryID = types.StringValue("repo-deleted")
+	if got := checkSyncRepository(context.Background(), c, data, &diags); !got.ValueBool() || diags.WarningsCount() != 1 {
+		t.Errorf("deleted repository: got %v with %d warning(s), want true with a warning", got, diags.WarningsCount())
+	}
+}
+
+// testCheckGitOpsSyncRuns checks that the only sync of the mock server has
+// run want times.
+func testCheckGitOpsSyncRuns(ms *MockServer, want int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		ms.mu.Lock()
+		defer ms.mu.Unlock()
+		for _, runs := range ms.GitOpsSyncRuns {
+			if len(runs) != want {
+				return fmt.Errorf("expected %d sync run(s), got %d", want, len(runs))
+			}
+			return nil
+		}
+		return fmt.Errorf("expected %d sync run(s), got none", want)
+	}
+}
+
 // --- Config helpers ---
 
 func testGitOpsSyncResourceConfig(url, envName, repoName, repoURL string) string {
@@ -229,6 +417,31 @@ resource "arcane_gitops_sync" "test" {
 `, url, envName, repoName, repoURL, autoSync)
 }
 
+func testGitOpsSyncResourceConfigTriggerOnApply(url, branch string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_environment" "test" {
+  name    = "trigger-env"
+  api_url = "http://10.100.1.100:3553"
+}
+
+resource "arcane_git_repository" "test" {
+  name = "trigger-repo"
+  url  = "https://github.com/example/trigger.git"
+}
+
+resource "arcane_gitops_sync" "test" {
+  environment_id   = arcane_environment.test.id
+  repository_id    = arcane_git_repository.test.id
+  branch           = %[2]q
+  trigger_on_apply = true
+}
+`, url, branch)
+}
+
 func testGitOpsSyncResourceConfigEmpty(url string) string {
 	return fmt.Sprintf(`
 provider "arcane" {
@@ -236,3 +449,16 @@ provider "arcane" {
 }
 `, url)
 }
+
+func testGitOpsSyncResourceConfigRepositoryID(url, repositoryID string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_gitops_sync" "test" {
+  environment_id = "env-gitops"
+  repository_id  = %[2]q
+}
+`, url, repositoryID)
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitOpsSyncRunsDataSource{}
+
+// defaultGitOpsSyncRunsLimit is the number of runs returned when limit is unset.
+const defaultGitOpsSyncRunsLimit = 10
+
+// NewGitOpsSyncRunsDataSource returns a new GitOps sync runs data source.
+func NewGitOpsSyncRunsDataSource() datasource.DataSource {
+	return &GitOpsSyncRunsDataSource{}
+}
+
+// GitOpsSyncRunsDataSource defines the GitOps sync runs data source implementation.
+type GitOpsSyncRunsDataSource struct {
+	client *Client
+}
+
+// GitOpsSyncRunsDataSourceModel describes the GitOps sync runs data source data model.
+type GitOpsSyncRunsDataSourceModel struct {
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	SyncID        types.String `tfsdk:"sync_id"`
+	Limit         types.Int64  `tfsdk:"limit"`
+	Runs          types.List   `tfsdk:"runs"`
+}
+
+var gitOpsSyncRunObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":          types.StringType,
+		"commit":      types.StringType,
+		"started_at":  types.StringType,
+		"duration_ms": types.Int64Type,
+		"result":      types.StringType,
+		"message":     types.StringType,
+	},
+}
+
+func (d *GitOpsSyncRunsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gitops_sync_runs"
+}
+
+func (d *GitOpsSyncRunsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to list the most recent runs of a GitOps sync.
+
+Each run records which commit was synced, when it started, how long it took and whether it
+succeeded, so deployment provenance can be exported into reports. Requires an Arcane version
+that records sync runs.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_gitops_sync_runs" "webapp" {
+  environment_id = arcane_environment.production.id
+  sync_id        = arcane_gitops_sync.webapp.id
+  limit          = 5
+}
+
+output "last_synced_commit" {
+  value = data.arcane_gitops_sync_runs.webapp.runs[0].commit
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment containing the sync.",
+				Required:            true,
+			},
+			"sync_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the GitOps sync.",
+				Required:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of runs to return, newest first. Defaults to `%d`.", defaultGitOpsSyncRunsLimit),
+				Optional:            true,
+			},
+			"runs": schema.ListNestedAttribute{
+				MarkdownDescription: "The most recent sync runs, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The run ID.",
+							Computed:            true,
+						},
+						"commit": schema.StringAttribute{
+							MarkdownDescription: "The commit that was synced.",
+							Computed:            true,
+						},
+						"started_at": schema.StringAttribute{
+							MarkdownDescription: "When the run started.",
+							Computed:            true,
+						},
+						"duration_ms": schema.Int64Attribute{
+							MarkdownDescription: "How long the run took, in milliseconds.",
+							Computed:            true,
+						},
+						"result": schema.StringAttribute{
+							MarkdownDescription: "The outcome of the run (e.g., success, failed).",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "A message describing the run, such as the error of a failed sync.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitOpsSyncRunsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *GitOpsSyncRunsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data GitOpsSyncRunsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := int64(defaultGitOpsSyncRunsLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+	if limit < 1 {
+		resp.Diagnostics.AddError("Invalid limit", fmt.Sprintf("limit must be at least 1, got %d.", limit))
+		return
+	}
+
+	envClient := d.client.ForEnvironment(data.EnvironmentID.ValueString())
+
+	runs, err := envClient.ListGitOpsSyncRuns(ctx, data.SyncID.ValueString(), int(limit))
+	if err != nil {
+		if arcane.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"GitOps sync runs not found",
+				fmt.Sprintf("No run history for sync %q. Either the sync does not exist or this Arcane version does not record sync runs: %s",
+					data.SyncID.ValueString(), err),
+			)
+			return
+		}
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read GitOps sync runs")
+		return
+	}
+	if int64(len(runs)) > limit {
+		runs = runs[:limit]
+	}
+
+	runValues := make([]attr.Value, len(runs))
+	for i, run := range runs {
+		objVal, diags := types.ObjectValue(gitOpsSyncRunObjectType.AttrTypes, map[string]attr.Value{
+			"id":          types.StringValue(run.ID),
+			"commit":      types.StringValue(run.Commit),
+			"started_at":  types.StringValue(run.StartedAt),
+			"duration_ms": types.Int64Value(run.DurationMs),
+			"result":      types.StringValue(run.Result),
+			"message":     types.StringValue(run.Message),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		runValues[i] = objVal
+	}
+
+	runList, diags := types.ListValue(gitOpsSyncRunObjectType, runValues)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Runs = runList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
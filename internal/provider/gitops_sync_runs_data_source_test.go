@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestGitOpsSyncRunsDataSource_GivenRuns_WhenRead_ThenReturnsNewestUpToLimit
+// validates that runs are returned newest first and truncated to limit.
+func TestGitOpsSyncRunsDataSource_GivenRuns_WhenRead_ThenReturnsNewestUpToLimit(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-runs"] = &arcane.Environment{ID: "env-runs", Name: "runs-env"}
+	mockServer.AddGitOpsSync("env-runs", &arcane.GitOpsSync{
+		ID:            "sync-runs",
+		EnvironmentID: "env-runs",
+		RepositoryID:  "repo-1",
+	})
+	mockServer.GitOpsSyncRuns["sync-runs"] = []arcane.GitOpsSyncRun{
+		{ID: "run-3", Commit: "ccc", StartedAt: "2026-03-03T00:00:00Z", DurationMs: 2100, Result: "success"},
+		{ID: "run-2", Commit: "bbb", StartedAt: "2026-03-02T00:00:00Z", DurationMs: 900, Result: "failed", Message: "compose file not found"},
+		{ID: "run-1", Commit: "aaa", StartedAt: "2026-03-01T00:00:00Z", DurationMs: 1200, Result: "success"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testGitOpsSyncRunsDataSourceConfig(mockServer.URL, "env-runs", "sync-runs", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync_runs.test", "runs.#", "2"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync_runs.test", "runs.0.commit", "ccc"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync_runs.test", "runs.0.duration_ms", "2100"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync_runs.test", "runs.1.result", "failed"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_sync_runs.test", "runs.1.message", "compose file not found"),
+				),
+			},
+		},
+	})
+}
+
+// TestGitOpsSyncRunsDataSource_GivenUnknownSync_WhenRead_ThenError validates
+// that a missing sync (or an Arcane version without run history) is reported.
+func TestGitOpsSyncRunsDataSource_GivenUnknownSync_WhenRead_ThenError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-runs"] = &arcane.Environment{ID: "env-runs", Name: "runs-env"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testGitOpsSyncRunsDataSourceConfig(mockServer.URL, "env-runs", "sync-missing", 5),
+				ExpectError: regexp.MustCompile(`GitOps sync runs not found`),
+			},
+		},
+	})
+}
+
+func testGitOpsSyncRunsDataSourceConfig(url, envID, syncID string, limit int) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_gitops_sync_runs" "test" {
+  environment_id = %[2]q
+  sync_id        = %[3]q
+  limit          = %[4]d
+}
+`, url, envID, syncID, limit)
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitOpsSyncsDataSource{}
+
+// NewGitOpsSyncsDataSource returns a new GitOps syncs data source.
+func NewGitOpsSyncsDataSource() datasource.DataSource {
+	return &GitOpsSyncsDataSource{}
+}
+
+// GitOpsSyncsDataSource defines the GitOps syncs data source implementation.
+type GitOpsSyncsDataSource struct {
+	client *Client
+}
+
+// GitOpsSyncsDataSourceModel describes the GitOps syncs data source data model.
+type GitOpsSyncsDataSourceModel struct {
+	EnvironmentID types.String        `tfsdk:"environment_id"`
+	Syncs         []gitOpsSyncElement `tfsdk:"syncs"`
+	IDs           []types.String      `tfsdk:"ids"`
+}
+
+// gitOpsSyncElement describes an element of syncs.
+type gitOpsSyncElement struct {
+	ID             types.String `tfsdk:"id"`
+	RepositoryID   types.String `tfsdk:"repository_id"`
+	Path           types.String `tfsdk:"path"`
+	Branch         types.String `tfsdk:"branch"`
+	ComposeFile    types.String `tfsdk:"compose_file"`
+	SyncInterval   types.String `tfsdk:"sync_interval"`
+	AutoSync       types.Bool   `tfsdk:"auto_sync"`
+	LastSyncAt     types.String `tfsdk:"last_sync_at"`
+	LastSyncCommit types.String `tfsdk:"last_sync_commit"`
+}
+
+// newGitOpsSyncElement returns the attributes of sync, with fields Arcane
+// left empty unset.
+func newGitOpsSyncElement(sync arcane.GitOpsSync) gitOpsSyncElement {
+	return gitOpsSyncElement{
+		ID:             types.StringValue(sync.ID),
+		RepositoryID:   types.StringValue(sync.RepositoryID),
+		Path:           optionalString(sync.Path),
+		Branch:         optionalString(sync.Branch),
+		ComposeFile:    optionalString(sync.ComposeFile),
+		SyncInterval:   optionalString(sync.SyncInterval),
+		AutoSync:       types.BoolValue(sync.AutoSync),
+		LastSyncAt:     optionalString(sync.LastSyncAt),
+		LastSyncCommit: optionalString(sync.LastSyncCommit),
+	}
+}
+
+func (d *GitOpsSyncsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gitops_syncs"
+}
+
+func (d *GitOpsSyncsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to list the GitOps syncs of an environment with the commit each last
+deployed, e.g. to report which syncs lag behind their branch on a drift dashboard.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_gitops_syncs" "production" {
+  environment_id = arcane_environment.production.id
+}
+
+output "synced_commits" {
+  value = { for sync in data.arcane_gitops_syncs.production.syncs : sync.path => sync.last_sync_commit }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment whose syncs to list.",
+				Required:            true,
+			},
+			"syncs": schema.ListNestedAttribute{
+				MarkdownDescription: "The syncs, ordered by path.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the sync.",
+							Computed:            true,
+						},
+						"repository_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the git repository the sync deploys from.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "The path within the repository containing the compose file.",
+							Computed:            true,
+						},
+						"branch": schema.StringAttribute{
+							MarkdownDescription: "The branch the sync deploys.",
+							Computed:            true,
+						},
+						"compose_file": schema.StringAttribute{
+							MarkdownDescription: "The name of the compose file.",
+							Computed:            true,
+						},
+						"sync_interval": schema.StringAttribute{
+							MarkdownDescription: "How often the sync checks for changes, as reported by Arcane. Unset when none is configured.",
+							Computed:            true,
+						},
+						"auto_sync": schema.BoolAttribute{
+							MarkdownDescription: "Whether changes in the repository are synced automatically.",
+							Computed:            true,
+						},
+						"last_sync_at": schema.StringAttribute{
+							MarkdownDescription: "When the sync last ran. Unset if it never ran.",
+							Computed:            true,
+						},
+						"last_sync_commit": schema.StringAttribute{
+							MarkdownDescription: "The commit deployed by the last sync. Unset if it never ran.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the syncs, in the same order as `syncs`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *GitOpsSyncsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *GitOpsSyncsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data GitOpsSyncsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	syncs, err := d.client.ForEnvironment(data.EnvironmentID.ValueString()).ListGitOpsSyncs(ctx)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list GitOps syncs")
+		return
+	}
+
+	data.Syncs = []gitOpsSyncElement{}
+	data.IDs = []types.String{}
+	for _, sync := range sortedGitOpsSyncs(syncs) {
+		data.Syncs = append(data.Syncs, newGitOpsSyncElement(sync))
+		data.IDs = append(data.IDs, types.StringValue(sync.ID))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sortedGitOpsSyncs returns syncs ordered by path, then ID, so the list is
+// stable across reads.
+func sortedGitOpsSyncs(syncs []arcane.GitOpsSync) []arcane.GitOpsSync {
+	sorted := slices.Clone(syncs)
+	slices.SortFunc(sorted, func(a, b arcane.GitOpsSync) int {
+		if c := strings.Compare(a.Path, b.Path); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return sorted
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestGitOpsSyncsDataSource_GivenSyncs_WhenRead_ThenListedByPath validates that
+// every sync of the environment is listed, ordered by path, with its last sync.
+func TestGitOpsSyncsDataSource_GivenSyncs_WhenRead_ThenListedByPath(t *testing.T) {
+	mockServer := newGitOpsSyncsMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testGitOpsSyncsDataSourceConfig(mockServer.URL, "env-gitops"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_gitops_syncs.test", "syncs.#", "3"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_syncs.test", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_syncs.test", "ids.0", "sync-api"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_syncs.test", "ids.1", "sync-webapp"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_syncs.test", "ids.2", "sync-worker"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_syncs.test", "syncs.1.path", "apps/webapp"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_syncs.test", "syncs.1.last_sync_at", "2026-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_syncs.test", "syncs.1.last_sync_commit", "0123abc"),
+					resource.TestCheckNoResourceAttr("data.arcane_gitops_syncs.test", "syncs.0.last_sync_commit"),
+				),
+			},
+		},
+	})
+}
+
+// TestGitOpsSyncsDataSource_GivenNoSyncs_WhenRead_ThenEmptyLists validates
+// that an environment without syncs yields empty lists rather than null.
+func TestGitOpsSyncsDataSource_GivenNoSyncs_WhenRead_ThenEmptyLists(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-empty"] = &arcane.Environment{ID: "env-empty", Name: "empty-env"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testGitOpsSyncsDataSourceConfig(mockServer.URL, "env-empty"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_gitops_syncs.test", "syncs.#", "0"),
+					resource.TestCheckResourceAttr("data.arcane_gitops_syncs.test", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testGitOpsSyncsDataSourceConfig(url, envID string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_gitops_syncs" "test" {
+  environment_id = %[2]q
+}
+`, url, envID)
+}
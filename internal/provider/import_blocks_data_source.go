@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ImportBlocksDataSource{}
+
+// NewImportBlocksDataSource returns a new import blocks data source.
+func NewImportBlocksDataSource() datasource.DataSource {
+	return &ImportBlocksDataSource{}
+}
+
+// ImportBlocksDataSource defines the import blocks data source implementation.
+type ImportBlocksDataSource struct {
+	client *Client
+}
+
+// ImportBlocksDataSourceModel describes the import blocks data source data model.
+type ImportBlocksDataSourceModel struct {
+	EnvironmentID types.String         `tfsdk:"environment_id"`
+	ExcludeIDs    []types.String       `tfsdk:"exclude_ids"`
+	Blocks        []importBlockElement `tfsdk:"blocks"`
+	Content       types.String         `tfsdk:"content"`
+}
+
+// importBlockElement describes an element of blocks.
+type importBlockElement struct {
+	To       types.String `tfsdk:"to"`
+	ImportID types.String `tfsdk:"import_id"`
+	Content  types.String `tfsdk:"content"`
+}
+
+func (d *ImportBlocksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_import_blocks"
+}
+
+func (d *ImportBlocksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to adopt an Arcane installation that is already running: it generates
+` + "`import`" + ` blocks for the projects of an environment and for every container registry and
+git repository, ready to paste into the configuration.
+
+Arcane doesn't know which objects Terraform manages, so list the IDs of those already in the
+configuration in ` + "`exclude_ids`" + `. Projects created by a GitOps sync are skipped, since the
+sync manages them.
+
+Resource names are derived from the Arcane names. After pasting the blocks, run
+` + "`terraform plan -generate-config-out=generated.tf`" + ` to generate the resource configuration.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_import_blocks" "production" {
+  environment_id = "env-123"
+}
+
+resource "local_file" "imports" {
+  filename = "${path.module}/imports.tf"
+  content  = data.arcane_import_blocks.production.content
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment whose projects to import.",
+				Required:            true,
+			},
+			"exclude_ids": schema.ListAttribute{
+				MarkdownDescription: "Arcane IDs of projects, container registries and git repositories that are already managed, and get no import block.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"blocks": schema.ListNestedAttribute{
+				MarkdownDescription: "The import blocks: projects, then container registries, then git repositories, each ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"to": schema.StringAttribute{
+							MarkdownDescription: "The address the object is imported to, e.g. `arcane_project.webapp`.",
+							Computed:            true,
+						},
+						"import_id": schema.StringAttribute{
+							MarkdownDescription: "The import ID of the object.",
+							Computed:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The `import` block.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Every import block, separated by blank lines. Empty when there is nothing to import.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ImportBlocksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ImportBlocksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data ImportBlocksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentID := data.EnvironmentID.ValueString()
+	projects, err := d.client.ForEnvironment(environmentID).ListProjects(ctx)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list projects")
+		return
+	}
+	registries, err := d.client.ListContainerRegistries(ctx)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list container registries")
+		return
+	}
+	repositories, err := d.client.ListGitRepositories(ctx)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list git repositories")
+		return
+	}
+
+	excluded := make(map[string]bool, len(data.ExcludeIDs))
+	for _, id := range data.ExcludeIDs {
+		excluded[id.ValueString()] = true
+	}
+
+	blocks := newImportBlockWriter()
+	for _, project := range sortedByName(projects, func(p arcane.Project) string { return p.Name }) {
+		if excluded[project.ID] || project.GitOpsSyncID != "" {
+			continue
+		}
+		blocks.add("arcane_project", project.Name, formatCompositeID(environmentID, project.ID))
+	}
+	for _, registry := range sortedByName(registries, func(r arcane.ContainerRegistry) string { return r.Name }) {
+		if !excluded[registry.ID] {
+			blocks.add("arcane_container_registry", registry.Name, registry.ID)
+		}
+	}
+	for _, repository := range sortedByName(repositories, func(r arcane.GitRepository) string { return r.Name }) {
+		if !excluded[repository.ID] {
+			blocks.add("arcane_git_repository", repository.Name, repository.ID)
+		}
+	}
+
+	data.Blocks = blocks.elements
+	data.Content = types.StringValue(blocks.content())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sortedByName returns items ordered by name, so the blocks and the resource
+// names they are given are stable across reads.
+func sortedByName[T any](items []T, name func(T) string) []T {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b T) int {
+		return strings.Compare(name(a), name(b))
+	})
+	return sorted
+}
+
+// importBlockWriter collects import blocks, giving each object a resource
+// name unique within its type.
+type importBlockWriter struct {
+	elements []importBlockElement
+	used     map[string]bool
+}
+
+func newImportBlockWriter() *importBlockWriter {
+	return &importBlockWriter{elements: []importBlockElement{}, used: map[string]bool{}}
+}
+
+// add appends an import block of the object named name to a resource of
+// typeName.
+func (w *importBlockWriter) add(typeName, name, importID string) {
+	label := importBlockLabel(name)
+	to := typeName + "." + label
+	for i := 2; w.used[to]; i++ {
+		to = typeName + "." + label + "_" + strconv.Itoa(i)
+	}
+	w.used[to] = true
+
+	w.elements = append(w.elements, importBlockElement{
+		To:       types.StringValue(to),
+		ImportID: types.StringValue(importID),
+		Content:  types.StringValue(fmt.Sprintf("import {\n  to = %s\n  id = %q\n}\n", to, importID)),
+	})
+}
+
+// content returns every block, separated by blank lines.
+func (w *importBlockWriter) content() string {
+	blocks := make([]string, len(w.elements))
+	for i, element := range w.elements {
+		blocks[i] = element.Content.ValueString()
+	}
+	return strings.Join(blocks, "\n")
+}
+
+// importBlockLabel turns an Arcane name into a Terraform resource name:
+// lowercase, with runs of characters other than letters, digits and
+// underscores replaced by one underscore, and a leading underscore when the
+// name doesn't start with a letter.
+func importBlockLabel(name string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' {
+			b.WriteRune(r)
+			underscore = r == '_'
+			continue
+		}
+		if !underscore {
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+	label := strings.Trim(b.String(), "_")
+	if label == "" {
+		return "unnamed"
+	}
+	if label[0] >= '0' && label[0] <= '9' {
+		return "_" + label
+	}
+	return label
+}
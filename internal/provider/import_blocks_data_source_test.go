@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestImportBlocksDataSource_GivenRunningInstall_WhenRead_ThenBlocksForUnmanagedObjects
+// validates that blocks are generated for projects, registries and
+// repositories, skipping excluded IDs and projects created by GitOps syncs.
+func TestImportBlocksDataSource_GivenRunningInstall_WhenRead_ThenBlocksForUnmanagedObjects(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-prod"] = &arcane.Environment{ID: "env-prod", Name: "production"}
+	mockServer.AddProject("env-prod", &arcane.Project{ID: "proj-web", Name: "web-app", EnvironmentID: "env-prod"})
+	mockServer.AddProject("env-prod", &arcane.Project{ID: "proj-web2", Name: "Web App", EnvironmentID: "env-prod"})
+	mockServer.AddProject("env-prod", &arcane.Project{ID: "proj-db", Name: "db", EnvironmentID: "env-prod"})
+	mockServer.AddProject("env-prod", &arcane.Project{ID: "proj-media", Name: "media", EnvironmentID: "env-prod", GitOpsSyncID: "sync-media"})
+	mockServer.ContainerRegistries["reg-ghcr"] = &arcane.ContainerRegistry{ID: "reg-ghcr", Name: "ghcr.io", URL: "ghcr.io"}
+	mockServer.GitRepositories["repo-infra"] = &arcane.GitRepository{ID: "repo-infra", Name: "infra", URL: "https://git.example.com/infra.git"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testImportBlocksDataSourceConfig(mockServer.URL, `exclude_ids = ["proj-db"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_import_blocks.test", "blocks.#", "4"),
+					resource.TestCheckResourceAttr("data.arcane_import_blocks.test", "blocks.0.to", "arcane_project.web_app"),
+					resource.TestCheckResourceAttr("data.arcane_import_blocks.test", "blocks.0.import_id", "env-prod/proj-web2"),
+					resource.TestCheckResourceAttr("data.arcane_import_blocks.test", "blocks.1.to", "arcane_project.web_app_2"),
+					resource.TestCheckResourceAttr("data.arcane_import_blocks.test", "blocks.1.import_id", "env-prod/proj-web"),
+					resource.TestCheckResourceAttr("data.arcane_import_blocks.test", "blocks.2.to", "arcane_container_registry.ghcr_io"),
+					resource.TestCheckResourceAttr("data.arcane_import_blocks.test", "blocks.2.import_id", "reg-ghcr"),
+					resource.TestCheckResourceAttr("data.arcane_import_blocks.test", "blocks.3.content",
+						"import {\n  to = arcane_git_repository.infra\n  id = \"repo-infra\"\n}\n"),
+					resource.TestCheckResourceAttr("data.arcane_import_blocks.test", "content",
+						"import {\n  to = arcane_project.web_app\n  id = \"env-prod/proj-web2\"\n}\n\n"+
+							"import {\n  to = arcane_project.web_app_2\n  id = \"env-prod/proj-web\"\n}\n\n"+
+							"import {\n  to = arcane_container_registry.ghcr_io\n  id = \"reg-ghcr\"\n}\n\n"+
+							"import {\n  to = arcane_git_repository.infra\n  id = \"repo-infra\"\n}\n"),
+				),
+			},
+		},
+	})
+}
+
+// TestImportBlocksDataSource_GivenEverythingManaged_WhenRead_ThenEmpty
+// validates that an install with nothing left to import yields no blocks.
+func TestImportBlocksDataSource_GivenEverythingManaged_WhenRead_ThenEmpty(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-prod"] = &arcane.Environment{ID: "env-prod", Name: "production"}
+	mockServer.AddProject("env-prod", &arcane.Project{ID: "proj-db", Name: "db", EnvironmentID: "env-prod"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testImportBlocksDataSourceConfig(mockServer.URL, `exclude_ids = ["proj-db"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_import_blocks.test", "blocks.#", "0"),
+					resource.TestCheckResourceAttr("data.arcane_import_blocks.test", "content", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestImportBlockLabel(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"webapp":         "webapp",
+		"Web App":        "web_app",
+		"web-app":        "web_app",
+		"ghcr.io":        "ghcr_io",
+		"my__stack":      "my__stack",
+		"  -- padded --": "padded",
+		"2fa-service":    "_2fa_service",
+		"日本":             "unnamed",
+	}
+	for name, want := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := importBlockLabel(name); got != want {
+				t.Errorf("importBlockLabel(%q) = %q, want %q", name, got, want)
+			}
+		})
+	}
+}
+
+func testImportBlocksDataSourceConfig(url, extra string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_import_blocks" "test" {
+  environment_id = "env-prod"
+  %[2]s
+}
+`, url, extra)
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &JobDataSource{}
+
+// NewJobDataSource returns a new job data source.
+func NewJobDataSource() datasource.DataSource {
+	return &JobDataSource{}
+}
+
+// JobDataSource defines the job data source implementation.
+type JobDataSource struct {
+	client *Client
+}
+
+// JobDataSourceModel describes the job data source data model.
+type JobDataSourceModel struct {
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	ID            types.String `tfsdk:"id"`
+	Type          types.String `tfsdk:"type"`
+	ResourceID    types.String `tfsdk:"resource_id"`
+	Status        types.String `tfsdk:"status"`
+	Done          types.Bool   `tfsdk:"done"`
+	Error         types.String `tfsdk:"error"`
+	LogURL        types.String `tfsdk:"log_url"`
+	StartedAt     types.String `tfsdk:"started_at"`
+	FinishedAt    types.String `tfsdk:"finished_at"`
+}
+
+func (d *JobDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job"
+}
+
+func (d *JobDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to inspect a background job by ID, such as the deployment started by an
+` + "`arcane_project_deployment`" + ` (its ` + "`last_deployment_id`" + `).
+
+The job is read as it is at plan time; the data source does not wait for it to finish.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_job" "last_deploy" {
+  environment_id = arcane_environment.production.id
+  id             = arcane_project_deployment.webapp.last_deployment_id
+}
+
+output "last_deploy_log" {
+  value = data.arcane_job.last_deploy.log_url
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment the job runs in.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the job.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The kind of operation (e.g. deploy, sync, prune, pull).",
+				Computed:            true,
+			},
+			"resource_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project, sync or other object the job operates on, if any.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The job status: `queued`, `running`, `succeeded`, `failed` or `cancelled`.",
+				Computed:            true,
+			},
+			"done": schema.BoolAttribute{
+				MarkdownDescription: "Whether the job has finished, successfully or not.",
+				Computed:            true,
+			},
+			"error": schema.StringAttribute{
+				MarkdownDescription: "The error the job failed with, if any.",
+				Computed:            true,
+			},
+			"log_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the job's log, if Arcane reported one.",
+				Computed:            true,
+			},
+			"started_at": schema.StringAttribute{
+				MarkdownDescription: "When the job started.",
+				Computed:            true,
+			},
+			"finished_at": schema.StringAttribute{
+				MarkdownDescription: "When the job finished, if it has.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *JobDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *JobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data JobDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := d.client.ForEnvironment(data.EnvironmentID.ValueString()).GetJob(ctx, data.ID.ValueString())
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read job")
+		return
+	}
+
+	data.Type = optionalString(job.Type)
+	data.ResourceID = optionalString(job.ResourceID)
+	data.Status = types.StringValue(job.Status)
+	data.Done = types.BoolValue(job.Done())
+	data.Error = optionalString(job.Error)
+	data.LogURL = optionalString(job.LogURL)
+	data.StartedAt = optionalString(job.StartedAt)
+	data.FinishedAt = optionalString(job.FinishedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// jobFailureDetail describes a failed or cancelled job for an error
+// diagnostic, e.g. `Deployment "job-1" finished with status "failed": ...`.
+func jobFailureDetail(kind string, job *arcane.Job) string {
+	detail := fmt.Sprintf("%s %q finished with status %q", kind, job.ID, job.Status)
+	if job.Error != "" {
+		detail += ": " + job.Error
+	}
+	detail += "."
+	if job.LogURL != "" {
+		detail += " See the job log at " + job.LogURL + "."
+	}
+	return detail
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestJobDataSource_GivenFinishedJob_WhenRead_ThenJobAttributesSet validates
+// that a job is read with its status, error and log URL.
+func TestJobDataSource_GivenFinishedJob_WhenRead_ThenJobAttributesSet(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	envID := "env-job-1"
+	mockServer.Environments[envID] = &arcane.Environment{ID: envID, Name: "job-test-env"}
+	mockServer.Jobs["job-1"] = &arcane.Job{
+		ID:         "job-1",
+		Type:       "pull",
+		Status:     arcane.JobFailed,
+		Error:      "manifest unknown",
+		LogURL:     "https://arcane.example.com/jobs/job-1/log",
+		StartedAt:  "2026-01-01T00:00:00Z",
+		FinishedAt: "2026-01-01T00:00:05Z",
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testJobDataSourceConfig(mockServer.URL, envID, "job-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_job.test", "type", "pull"),
+					resource.TestCheckResourceAttr("data.arcane_job.test", "status", "failed"),
+					resource.TestCheckResourceAttr("data.arcane_job.test", "done", "true"),
+					resource.TestCheckResourceAttr("data.arcane_job.test", "error", "manifest unknown"),
+					resource.TestCheckResourceAttr("data.arcane_job.test", "log_url", "https://arcane.example.com/jobs/job-1/log"),
+					resource.TestCheckResourceAttr("data.arcane_job.test", "finished_at", "2026-01-01T00:00:05Z"),
+					resource.TestCheckNoResourceAttr("data.arcane_job.test", "resource_id"),
+				),
+			},
+		},
+	})
+}
+
+// TestJobDataSource_GivenUnknownJob_WhenRead_ThenError validates that reading
+// a job that does not exist fails.
+func TestJobDataSource_GivenUnknownJob_WhenRead_ThenError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	envID := "env-job-1"
+	mockServer.Environments[envID] = &arcane.Environment{ID: envID, Name: "job-test-env"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testJobDataSourceConfig(mockServer.URL, envID, "missing"),
+				ExpectError: regexp.MustCompile(`Failed to read job`),
+			},
+		},
+	})
+}
+
+func TestJobFailureDetail(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		job  arcane.Job
+		want string
+	}{
+		{
+			job:  arcane.Job{ID: "job-1", Status: arcane.JobCancelled},
+			want: `Deployment "job-1" finished with status "cancelled".`,
+		},
+		{
+			job:  arcane.Job{ID: "job-1", Status: arcane.JobFailed, Error: "boom", LogURL: "https://x/log"},
+			want: `Deployment "job-1" finished with status "failed": boom. See the job log at https://x/log.`,
+		},
+	}
+	for _, tc := range cases {
+		if got := jobFailureDetail("Deployment", &tc.job); got != tc.want {
+			t.Errorf("jobFailureDetail() = %q, want %q", got, tc.want)
+		}
+	}
+}
+
+func testJobDataSourceConfig(url, envID, jobID string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_job" "test" {
+  environment_id = %[2]q
+  id             = %[3]q
+}
+`, url, envID, jobID)
+}
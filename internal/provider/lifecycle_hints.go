@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// lifecycleHintsObjectType is the type of the lifecycle_hints attribute of
+// resources whose operation needs steps outside Terraform, so module outputs
+// can pass the guidance on to operators.
+var lifecycleHintsObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"post_create_steps": types.ListType{ElemType: types.StringType},
+		"caveats":           types.ListType{ElemType: types.StringType},
+	},
+}
+
+// lifecycleHintsSchema returns the schema of the lifecycle_hints attribute.
+func lifecycleHintsSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Operator guidance for this resource, derived from its configuration, e.g. to expose as a module output.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"post_create_steps": schema.ListAttribute{
+				MarkdownDescription: "Steps to take outside Terraform once the resource is created or replaced.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"caveats": schema.ListAttribute{
+				MarkdownDescription: "Behavior to keep in mind when changing or destroying the resource.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// lifecycleHintsValue returns a lifecycle_hints value.
+func lifecycleHintsValue(postCreateSteps, caveats []string) types.Object {
+	return types.ObjectValueMust(lifecycleHintsObjectType.AttrTypes, map[string]attr.Value{
+		"post_create_steps": stringListValue(postCreateSteps),
+		"caveats":           stringListValue(caveats),
+	})
+}
+
+// stringListValue converts strings to a list value.
+func stringListValue(values []string) types.List {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range stringValues(values) {
+		elems = append(elems, v)
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
@@ -0,0 +1,412 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// MockFault makes the MockServer answer matching requests with an error
+// instead of routing them to the normal handlers.
+type MockFault struct {
+	// Method restricts the fault to one HTTP method. Empty matches any method.
+	Method string
+	// Path is matched as a prefix of the request path, e.g.
+	// "/api/environments/env-1/projects/proj-1/up".
+	Path string
+	// Status is the HTTP status to return (401, 403, 409, 429, 503, ...).
+	Status int
+	// Message is returned in the APIError body. Defaults to the status text.
+	Message string
+	// RetryAfter, if set, is sent as the Retry-After header (useful with 429).
+	RetryAfter string
+	// Times is the number of requests to fail before the fault is cleared.
+	// Zero fails every matching request.
+	Times int
+}
+
+// RecordedRequest is a request received by the MockServer.
+type RecordedRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// InjectFault registers a fault. Faults are evaluated in registration order
+// and the first match wins.
+func (ms *MockServer) InjectFault(f MockFault) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if f.Message == "" {
+		f.Message = strings.ToLower(http.StatusText(f.Status))
+	}
+	ms.faults = append(ms.faults, &f)
+}
+
+// Requests returns a copy of every request received so far.
+func (ms *MockServer) Requests() []RecordedRequest {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	return append([]RecordedRequest(nil), ms.requests...)
+}
+
+// RequestCount returns how many requests matched method and path exactly.
+// An empty method matches any method.
+func (ms *MockServer) RequestCount(method, path string) int {
+	n := 0
+	for _, req := range ms.Requests() {
+		if (method == "" || req.Method == method) && req.Path == path {
+			n++
+		}
+	}
+	return n
+}
+
+// CheckRequestCount returns a TestCheckFunc asserting that the server received
+// exactly want requests for method and path.
+func (ms *MockServer) CheckRequestCount(method, path string, want int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if got := ms.RequestCount(method, path); got != want {
+			return fmt.Errorf("expected %d %s %s request(s), got %d", want, method, path, got)
+		}
+		return nil
+	}
+}
+
+// serveHTTP records the request, applies any matching fault and otherwise
+// dispatches to the handlers. Handlers run under ms.mu, so tests that apply
+// many resources in parallel never race on the mock's maps.
+func (ms *MockServer) serveHTTP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		ms.mu.Lock()
+		defer ms.mu.Unlock()
+
+		ms.requests = append(ms.requests, RecordedRequest{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.Query(),
+			Header: r.Header.Clone(),
+			Body:   body,
+		})
+
+		for k, v := range ms.ResponseHeaders {
+			w.Header()[k] = v
+		}
+
+		if f := ms.matchFault(r); f != nil {
+			if f.RetryAfter != "" {
+				w.Header().Set("Retry-After", f.RetryAfter)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(f.Status)
+			writeJSON(w, arcane.APIError{Message: f.Message})
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// matchFault returns the first fault matching r, consuming one of its Times.
+// Callers must hold ms.mu.
+func (ms *MockServer) matchFault(r *http.Request) *MockFault {
+	for i, f := range ms.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, f.Path) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				ms.faults = append(ms.faults[:i], ms.faults[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+// decodeBody decodes the request body into v. An empty body is accepted. In
+// strict mode unknown fields are rejected with a 400, mirroring a server that
+// validates its payloads. It returns false after writing an error response.
+func (ms *MockServer) decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	dec := json.NewDecoder(r.Body)
+	if ms.Strict {
+		dec.DisallowUnknownFields()
+	}
+	if err := dec.Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, arcane.APIError{Message: "invalid request body", Detail: err.Error()})
+		return false
+	}
+	return true
+}
+
+// mockEnvironmentUpdatePayload is the body accepted by PUT
+// /api/environments/{id}. Pointer fields distinguish "absent" from the zero
+// value, matching how the API treats partial updates: only fields present in
+// the payload are changed, so `"use_api_key": false` disables the API key.
+type mockEnvironmentUpdatePayload struct {
+	Name             *string `json:"name"`
+	Description      *string `json:"description"`
+	UseAPIKey        *bool   `json:"use_api_key"`
+	RegenerateAPIKey *bool   `json:"regenerateApiKey"`
+	ExpiresAt        *string `json:"expiresAt"`
+}
+
+// applyEnvironmentUpdate applies an update payload to env the way the API does.
+// A regenerateApiKey request only rotates the key and ignores other fields.
+func (ms *MockServer) applyEnvironmentUpdate(env *arcane.Environment, req *mockEnvironmentUpdatePayload) {
+	if req.RegenerateAPIKey != nil && *req.RegenerateAPIKey {
+		ms.keyRotations[env.ID]++
+		env.APIKey = fmt.Sprintf("arc_regenerated_%s_%d", env.Name, ms.keyRotations[env.ID])
+		return
+	}
+
+	if req.Name != nil && *req.Name != "" {
+		env.Name = *req.Name
+	}
+	if req.Description != nil {
+		env.Description = *req.Description
+	}
+	if req.ExpiresAt != nil {
+		env.ExpiresAt = *req.ExpiresAt
+	}
+	if req.UseAPIKey != nil {
+		env.UseAPIKey = *req.UseAPIKey
+		switch {
+		case !env.UseAPIKey:
+			env.AccessToken = ""
+		case env.AccessToken == "":
+			env.AccessToken = "mock-token-" + env.Name
+		}
+	}
+}
+
+// sortedValues returns the values of m ordered by key, so list responses (and
+// therefore pages) are stable across requests.
+func sortedValues[T any](m map[string]*T) []T {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	values := make([]T, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, *m[k])
+	}
+	return values
+}
+
+// writeListResponse writes a paginated list response. Items are filtered by
+// the `search` query parameter (case-insensitive substring of name(item)) and
+// the page is selected with the `start` and `limit` query parameters. Without
+// `limit`, ms.PageSize is used; a page size of zero returns everything in a
+// single page.
+func writeListResponse[T any](ms *MockServer, w http.ResponseWriter, r *http.Request, items []T, name func(T) string) {
+	q := r.URL.Query()
+
+	if search := strings.ToLower(q.Get("search")); search != "" {
+		filtered := items[:0:0]
+		for _, item := range items {
+			if strings.Contains(strings.ToLower(name(item)), search) {
+				filtered = append(filtered, item)
+			}
+		}
+		items = filtered
+	}
+
+	total := len(items)
+	limit := ms.PageSize
+	if l, err := strconv.Atoi(q.Get("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	if limit <= 0 {
+		writePaginatedResponse(w, items)
+		return
+	}
+
+	start, _ := strconv.Atoi(q.Get("start"))
+	if start < 0 || start > total {
+		start = total
+	}
+	end := min(start+limit, total)
+
+	writeJSON(w, arcane.PaginatedResponse[T]{
+		Success: true,
+		Data:    items[start:end],
+		Pagination: arcane.Pagination{
+			TotalPages:   (total + limit - 1) / limit,
+			TotalItems:   total,
+			CurrentPage:  start/limit + 1,
+			ItemsPerPage: limit,
+		},
+	})
+}
+
+// ─── MockServer self-tests ────────────────────────────────────────────────────
+
+func TestMockServer_GivenPageSize_WhenListing_ThenReturnsPagedResults(t *testing.T) {
+	t.Parallel()
+	ms := NewMockServer()
+	defer ms.Close()
+	ms.PageSize = 2
+	for _, id := range []string{"env-a", "env-b", "env-c"} {
+		ms.Environments[id] = &arcane.Environment{ID: id, Name: strings.TrimPrefix(id, "env-")}
+	}
+
+	var page arcane.PaginatedResponse[arcane.Environment]
+	c := newMockClient(t, ms)
+	err := c.Do(t.Context(), &arcane.Request{
+		Method: http.MethodGet,
+		Path:   "/api/environments",
+		Query:  url.Values{"start": {"2"}},
+		Result: &page,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Data) != 1 || page.Data[0].ID != "env-c" {
+		t.Errorf("expected second page to contain env-c, got %+v", page.Data)
+	}
+	if page.Pagination.TotalItems != 3 || page.Pagination.TotalPages != 2 || page.Pagination.CurrentPage != 2 {
+		t.Errorf("unexpected pagination: %+v", page.Pagination)
+	}
+}
+
+func TestMockServer_GivenSearch_WhenListing_ThenFiltersByName(t *testing.T) {
+	t.Parallel()
+	ms := NewMockServer()
+	defer ms.Close()
+	ms.ContainerRegistries["reg-1"] = &arcane.ContainerRegistry{ID: "reg-1", Name: "GHCR"}
+	ms.ContainerRegistries["reg-2"] = &arcane.ContainerRegistry{ID: "reg-2", Name: "docker-hub"}
+
+	var page arcane.PaginatedResponse[arcane.ContainerRegistry]
+	c := newMockClient(t, ms)
+	err := c.Do(t.Context(), &arcane.Request{
+		Method: http.MethodGet,
+		Path:   "/api/container-registries",
+		Query:  url.Values{"search": {"ghcr"}},
+		Result: &page,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Data) != 1 || page.Data[0].ID != "reg-1" {
+		t.Errorf("expected only reg-1, got %+v", page.Data)
+	}
+}
+
+func TestMockServer_GivenFault_WhenRequested_ThenReturnsStatusUntilConsumed(t *testing.T) {
+	t.Parallel()
+	ms := NewMockServer()
+	defer ms.Close()
+	ms.Environments["env-1"] = &arcane.Environment{ID: "env-1", Name: "one"}
+	ms.InjectFault(MockFault{Method: http.MethodGet, Path: "/api/environments/env-1", Status: http.StatusTooManyRequests, RetryAfter: "1", Times: 1})
+
+	c := newMockClient(t, ms)
+	_, err := c.GetEnvironment(t.Context(), "env-1")
+	var apiErr *arcane.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected 429 APIError, got %v", err)
+	}
+
+	if _, err := c.GetEnvironment(t.Context(), "env-1"); err != nil {
+		t.Fatalf("expected fault to be consumed, got %v", err)
+	}
+	if got := ms.RequestCount(http.MethodGet, "/api/environments/env-1"); got != 2 {
+		t.Errorf("expected 2 recorded requests, got %d", got)
+	}
+}
+
+func TestMockServer_GivenAPIKey_WhenRequested_ThenRecordsHeader(t *testing.T) {
+	t.Parallel()
+	ms := NewMockServer()
+	defer ms.Close()
+
+	c := newMockClient(t, ms)
+	if _, err := c.ListEnvironments(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	reqs := ms.Requests()
+	if len(reqs) != 1 || reqs[0].Header.Get("X-API-Key") != "test-key" {
+		t.Errorf("expected one request carrying the API key, got %+v", reqs)
+	}
+}
+
+func TestMockServer_GivenUseAPIKeyFalse_WhenEnvironmentUpdated_ThenDisablesKey(t *testing.T) {
+	t.Parallel()
+	ms := NewMockServer()
+	defer ms.Close()
+	ms.Environments["env-1"] = &arcane.Environment{ID: "env-1", Name: "one", UseAPIKey: true, AccessToken: "tok"}
+
+	c := newMockClient(t, ms)
+	disabled := false
+	env, err := c.UpdateEnvironment(t.Context(), "env-1", &arcane.EnvironmentUpdateRequest{UseAPIKey: &disabled})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if env.UseAPIKey || env.AccessToken != "" {
+		t.Errorf("expected API key to be disabled, got %+v", env)
+	}
+	if env.Name != "one" {
+		t.Errorf("expected absent name to be left unchanged, got %q", env.Name)
+	}
+}
+
+func TestMockServer_GivenStrict_WhenUnknownFieldSent_ThenBadRequest(t *testing.T) {
+	t.Parallel()
+	ms := NewMockServer()
+	defer ms.Close()
+	ms.Strict = true
+	ms.Environments["env-1"] = &arcane.Environment{ID: "env-1", Name: "one"}
+
+	c := newMockClient(t, ms)
+	err := c.Do(t.Context(), &arcane.Request{
+		Method: http.MethodPut,
+		Path:   "/api/environments/env-1",
+		Body:   map[string]interface{}{"useApiKey": true},
+	})
+	var apiErr *arcane.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 APIError, got %v", err)
+	}
+
+	// Every request the client itself builds must pass strict validation.
+	if _, err := c.RegenerateEnvironmentAPIKey(t.Context(), "env-1"); err != nil {
+		t.Errorf("expected regenerate request to be accepted, got %v", err)
+	}
+	if _, err := c.UpdateEnvironment(t.Context(), "env-1", &arcane.EnvironmentUpdateRequest{Name: "renamed"}); err != nil {
+		t.Errorf("expected update request to be accepted, got %v", err)
+	}
+	ms.AddProject("env-1", &arcane.Project{ID: "proj-1", Name: "web", Status: "stopped"})
+	if _, err := c.ForEnvironment("env-1").DeployProject(t.Context(), "proj-1", &arcane.ProjectDeployRequest{PullPolicy: "always"}); err != nil {
+		t.Errorf("expected deploy request to be accepted, got %v", err)
+	}
+}
+
+// newMockClient returns an API client pointed at ms.
+func newMockClient(t *testing.T, ms *MockServer) *Client {
+	t.Helper()
+	return newTestClient(t, ms.URL, ClientSettings{})
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectArchiveDataSource{}
+
+// NewProjectArchiveDataSource returns a new project archive data source.
+func NewProjectArchiveDataSource() datasource.DataSource {
+	return &ProjectArchiveDataSource{}
+}
+
+// ProjectArchiveDataSource defines the project archive data source implementation.
+type ProjectArchiveDataSource struct {
+	client *Client
+}
+
+// ProjectArchiveDataSourceModel describes the project archive data source data model.
+type ProjectArchiveDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	ProjectID     types.String `tfsdk:"project_id"`
+	Format        types.String `tfsdk:"format"`
+	OutputPath    types.String `tfsdk:"output_path"`
+	OutputSize    types.Int64  `tfsdk:"output_size"`
+	OutputSHA256  types.String `tfsdk:"output_sha256"`
+}
+
+func (d *ProjectArchiveDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_archive"
+}
+
+func (d *ProjectArchiveDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to download an archive of a project's directory (compose file,
+` + "`.env`" + ` and override files) to a local path, for backups or migrating a project
+to another environment.
+
+The archive is downloaded on every read, so it always reflects the current project.
+It may contain secrets from the ` + "`.env`" + ` file and is written with mode ` + "`0600`" + `.
+Requires an Arcane version that supports project archives.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_project_archive" "webapp" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+  output_path    = "${path.module}/backups/webapp.tar.gz"
+}
+
+output "webapp_backup_sha256" {
+  value = data.arcane_project_archive.webapp.output_sha256
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Composite identifier in the format `environment_id/project_id`.",
+				Computed:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment containing the project.",
+				Required:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project to archive.",
+				Required:            true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The archive format, `%s` or `%s`. Defaults to `%s`.",
+					arcane.ProjectArchiveTarGz, arcane.ProjectArchiveZip, arcane.ProjectArchiveTarGz),
+				Optional: true,
+			},
+			"output_path": schema.StringAttribute{
+				MarkdownDescription: "The local file to write the archive to. Missing parent directories are created; an existing file is replaced. Relative paths are resolved against the Terraform working directory. Fails when the provider sets `disable_local_artifacts`.",
+				Required:            true,
+			},
+			"output_size": schema.Int64Attribute{
+				MarkdownDescription: "The size of the written archive, in bytes.",
+				Computed:            true,
+			},
+			"output_sha256": schema.StringAttribute{
+				MarkdownDescription: "The hex-encoded SHA-256 checksum of the written archive.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ProjectArchiveDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ProjectArchiveDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data ProjectArchiveDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	format := arcane.ProjectArchiveTarGz
+	if !data.Format.IsNull() {
+		format = data.Format.ValueString()
+	}
+	if format != arcane.ProjectArchiveTarGz && format != arcane.ProjectArchiveZip {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("format"),
+			"Invalid archive format",
+			fmt.Sprintf("format must be %q or %q, got %q.", arcane.ProjectArchiveTarGz, arcane.ProjectArchiveZip, format),
+		)
+		return
+	}
+
+	if d.client.LocalArtifactsDisabled() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("output_path"),
+			"Local artifacts disabled",
+			"The provider is configured with disable_local_artifacts, so project archives can't be written to the local filesystem.",
+		)
+		return
+	}
+
+	envClient := d.client.ForEnvironment(data.EnvironmentID.ValueString())
+	outputPath := data.OutputPath.ValueString()
+
+	size, sum, err := writeFileAtomically(outputPath, func(w io.Writer) error {
+		return envClient.GetProjectArchive(ctx, data.ProjectID.ValueString(), format, w)
+	})
+	if err != nil {
+		if arcane.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Project archive not found",
+				fmt.Sprintf("No archive for project %q. Either the project does not exist or this Arcane version does not support project archives: %s",
+					data.ProjectID.ValueString(), err),
+			)
+			return
+		}
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to download project archive")
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.EnvironmentID.ValueString(), data.ProjectID.ValueString()))
+	data.OutputSize = types.Int64Value(size)
+	data.OutputSHA256 = types.StringValue(sum)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// writeFileAtomically writes the content produced by write to path via a
+// temporary file in the same directory, so a failed or interrupted download
+// never leaves a truncated file behind. The file is created with mode 0600.
+// It returns the number of bytes written and their hex-encoded SHA-256.
+func writeFileAtomically(path string, write func(io.Writer) error) (int64, string, error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, "", fmt.Errorf("creating directory %s: %w", dir, err)
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*")
+	if err != nil {
+		return 0, "", fmt.Errorf("creating temporary file in %s: %w", dir, err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	hash := sha256.New()
+	counter := &countingWriter{w: io.MultiWriter(tmp, hash)}
+	if err := write(counter); err != nil {
+		_ = tmp.Close()
+		return 0, "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return 0, "", fmt.Errorf("writing %s: %w", tmp.Name(), err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, "", fmt.Errorf("moving archive to %s: %w", path, err)
+	}
+	return counter.n, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestProjectArchiveDataSource_GivenExistingProject_WhenRead_ThenArchiveWritten
+// validates that the archive is written to output_path with its size and checksum.
+func TestProjectArchiveDataSource_GivenExistingProject_WhenRead_ThenArchiveWritten(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-archive"] = &arcane.Environment{ID: "env-archive", Name: "archive-env"}
+	mockServer.AddProject("env-archive", &arcane.Project{
+		ID:            "proj-archive",
+		Name:          "webapp",
+		Status:        "running",
+		EnvironmentID: "env-archive",
+	})
+
+	outputPath := filepath.Join(t.TempDir(), "backups", "webapp.zip")
+	content := "mock-archive:webapp:zip"
+	sum := sha256.Sum256([]byte(content))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectArchiveDataSourceConfig(mockServer.URL, "env-archive", "proj-archive", outputPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_project_archive.test", "id", "env-archive/proj-archive"),
+					resource.TestCheckResourceAttr("data.arcane_project_archive.test", "output_size", fmt.Sprint(len(content))),
+					resource.TestCheckResourceAttr("data.arcane_project_archive.test", "output_sha256", hex.EncodeToString(sum[:])),
+					func(*terraform.State) error {
+						got, err := os.ReadFile(outputPath)
+						if err != nil {
+							return err
+						}
+						if string(got) != content {
+							return fmt.Errorf("archive content = %q, want %q", got, content)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// TestProjectArchiveDataSource_GivenMissingProject_WhenRead_ThenError validates
+// that a missing project (or an Arcane version without archives) is reported.
+func TestProjectArchiveDataSource_GivenMissingProject_WhenRead_ThenError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-archive"] = &arcane.Environment{ID: "env-archive", Name: "archive-env"}
+
+	outputPath := filepath.Join(t.TempDir(), "missing.zip")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testProjectArchiveDataSourceConfig(mockServer.URL, "env-archive", "proj-missing", outputPath),
+				ExpectError: regexp.MustCompile(`Project archive not found`),
+			},
+		},
+	})
+}
+
+// TestProjectArchiveDataSource_GivenLocalArtifactsDisabled_WhenRead_ThenErrorAndNoFile
+// validates that disable_local_artifacts stops the archive from being written.
+func TestProjectArchiveDataSource_GivenLocalArtifactsDisabled_WhenRead_ThenErrorAndNoFile(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-archive"] = &arcane.Environment{ID: "env-archive", Name: "archive-env"}
+	mockServer.AddProject("env-archive", &arcane.Project{
+		ID:            "proj-archive",
+		Name:          "webapp",
+		Status:        "running",
+		EnvironmentID: "env-archive",
+	})
+
+	outputPath := filepath.Join(t.TempDir(), "webapp.zip")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testProjectArchiveDataSourceConfigNoLocalArtifacts(mockServer.URL, "env-archive", "proj-archive", outputPath),
+				ExpectError: regexp.MustCompile(`Local artifacts disabled`),
+			},
+		},
+	})
+
+	if _, err := os.Stat(outputPath); !os.IsNotExist(err) {
+		t.Errorf("expected no archive at %s, stat returned %v", outputPath, err)
+	}
+}
+
+// TestWriteFileAtomically validates that content is written with its size and
+// checksum, and that a failed write leaves neither the target nor a temporary
+// file behind.
+func TestWriteFileAtomically(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "nested", "out.tar.gz")
+
+		n, sum, err := writeFileAtomically(path, func(w io.Writer) error {
+			_, err := io.WriteString(w, "hello")
+			return err
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := sha256.Sum256([]byte("hello"))
+		if n != 5 || sum != hex.EncodeToString(want[:]) {
+			t.Errorf("got size %d sum %s", n, sum)
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("stat: %v", err)
+		}
+		// Windows has no Unix permission bits to check
+		if perm := info.Mode().Perm(); runtime.GOOS != "windows" && perm != 0o600 {
+			t.Errorf("mode = %o, want 600", perm)
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		path := filepath.Join(dir, "out.zip")
+
+		_, _, err := writeFileAtomically(path, func(w io.Writer) error {
+			_, _ = io.WriteString(w, "partial")
+			return errors.New("connection reset")
+		})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		entries, _ := os.ReadDir(dir)
+		if len(entries) != 0 {
+			t.Errorf("expected empty directory after failure, found %d entries", len(entries))
+		}
+	})
+}
+
+func testProjectArchiveDataSourceConfig(url, envID, projectID, outputPath string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_project_archive" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+  format         = "zip"
+  output_path    = %[4]q
+}
+`, url, envID, projectID, outputPath)
+}
+
+func testProjectArchiveDataSourceConfigNoLocalArtifacts(url, envID, projectID, outputPath string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url                     = %[1]q
+  disable_local_artifacts = true
+}
+
+data "arcane_project_archive" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+  output_path    = %[4]q
+}
+`, url, envID, projectID, outputPath)
+}
@@ -9,7 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -22,17 +23,18 @@ func NewProjectDataSource() datasource.DataSource {
 
 // ProjectDataSource defines the project data source implementation.
 type ProjectDataSource struct {
-	client *client.Client
+	client *Client
 }
 
 // ProjectDataSourceModel describes the project data source data model.
 type ProjectDataSourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	EnvironmentID types.String `tfsdk:"environment_id"`
-	Name          types.String `tfsdk:"name"`
-	Status        types.String `tfsdk:"status"`
-	Path          types.String `tfsdk:"path"`
-	Services      types.List   `tfsdk:"services"`
+	ID              types.String `tfsdk:"id"`
+	EnvironmentID   types.String `tfsdk:"environment_id"`
+	Name            types.String `tfsdk:"name"`
+	Status          types.String `tfsdk:"status"`
+	Path            types.String `tfsdk:"path"`
+	ManagedBySyncID types.String `tfsdk:"managed_by_sync_id"`
+	Services        types.List   `tfsdk:"services"`
 }
 
 // ProjectServiceModel describes a service within a project.
@@ -97,6 +99,10 @@ data "arcane_project" "webapp" {
 				MarkdownDescription: "The path to the docker-compose file on the Docker host.",
 				Computed:            true,
 			},
+			"managed_by_sync_id": schema.StringAttribute{
+				MarkdownDescription: managedBySyncIDDescription,
+				Computed:            true,
+			},
 			"services": schema.ListNestedAttribute{
 				MarkdownDescription: "The services defined in the project.",
 				Computed:            true,
@@ -126,11 +132,11 @@ func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	c, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
 		)
 		return
 	}
@@ -139,6 +145,9 @@ func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.Config
 }
 
 func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
 	var data ProjectDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -157,7 +166,7 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	envClient := d.client.ForEnvironment(data.EnvironmentID.ValueString())
 
-	var project *client.Project
+	var project *arcane.Project
 	var err error
 
 	if !data.ID.IsNull() {
@@ -169,7 +178,7 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read project", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read project")
 		return
 	}
 
@@ -184,6 +193,12 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		data.Path = types.StringNull()
 	}
 
+	data.ManagedBySyncID, err = projectManagedBySyncID(ctx, envClient, project)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list GitOps syncs")
+		return
+	}
+
 	// Convert services to list
 	serviceObjectType := types.ObjectType{
 		AttrTypes: map[string]attr.Type{
@@ -6,7 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // TestProjectDataSource_GivenExistingProject_WhenLookedUpByID_ThenReturnsProject
@@ -16,17 +16,17 @@ func TestProjectDataSource_GivenExistingProject_WhenLookedUpByID_ThenReturnsProj
 	defer mockServer.Close()
 
 	// Pre-populate mock server
-	mockServer.Environments["env-1"] = &client.Environment{
+	mockServer.Environments["env-1"] = &arcane.Environment{
 		ID:   "env-1",
 		Name: "test-env",
 	}
-	mockServer.AddProject("env-1", &client.Project{
+	mockServer.AddProject("env-1", &arcane.Project{
 		ID:            "proj-webapp",
 		Name:          "webapp",
 		Status:        "running",
 		Path:          "/opt/stacks/webapp",
 		EnvironmentID: "env-1",
-		Services: []client.ProjectService{
+		Services: []arcane.ProjectService{
 			{Name: "web", Status: "running", Image: "nginx:latest"},
 			{Name: "api", Status: "running", Image: "myapp:v1"},
 		},
@@ -56,11 +56,11 @@ func TestProjectDataSource_GivenExistingProject_WhenLookedUpByName_ThenReturnsPr
 	defer mockServer.Close()
 
 	// Pre-populate mock server
-	mockServer.Environments["env-2"] = &client.Environment{
+	mockServer.Environments["env-2"] = &arcane.Environment{
 		ID:   "env-2",
 		Name: "production",
 	}
-	mockServer.AddProject("env-2", &client.Project{
+	mockServer.AddProject("env-2", &arcane.Project{
 		ID:            "proj-api",
 		Name:          "api-service",
 		Status:        "stopped",
@@ -89,16 +89,16 @@ func TestProjectDataSource_GivenProjectWithServices_WhenRead_ThenServicesPopulat
 	defer mockServer.Close()
 
 	// Pre-populate mock server
-	mockServer.Environments["env-3"] = &client.Environment{
+	mockServer.Environments["env-3"] = &arcane.Environment{
 		ID:   "env-3",
 		Name: "staging",
 	}
-	mockServer.AddProject("env-3", &client.Project{
+	mockServer.AddProject("env-3", &arcane.Project{
 		ID:            "proj-stack",
 		Name:          "full-stack",
 		Status:        "running",
 		EnvironmentID: "env-3",
-		Services: []client.ProjectService{
+		Services: []arcane.ProjectService{
 			{Name: "frontend", Status: "running", Image: "frontend:latest"},
 			{Name: "backend", Status: "running", Image: "backend:v2"},
 			{Name: "database", Status: "running", Image: "postgres:15"},
@@ -130,7 +130,7 @@ func TestProjectDataSource_GivenEnvironmentCreatedByResource_WhenProjectLookedUp
 	// The mock server will create the environment when the resource is applied,
 	// so we need to pre-populate the project for the expected environment ID
 	// Note: mock server generates ID as "env-{name}"
-	mockServer.AddProject("env-dynamic-env", &client.Project{
+	mockServer.AddProject("env-dynamic-env", &arcane.Project{
 		ID:            "proj-dynamic",
 		Name:          "dynamic-project",
 		Status:        "running",
@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Values of the strategy attribute.
+const (
+	deployStrategyRecreate  = "recreate"
+	deployStrategyBlueGreen = "blue_green"
+)
+
+var deployStrategies = []string{deployStrategyRecreate, deployStrategyBlueGreen}
+
+// Name suffixes pairing the two projects of a blue/green deployment.
+const (
+	blueSuffix  = "-blue"
+	greenSuffix = "-green"
+)
+
+// blueGreenCounterpart returns the name of the project paired with the
+// project called name, by swapping its -blue or -green suffix.
+func blueGreenCounterpart(name string) (string, bool) {
+	if base, ok := strings.CutSuffix(name, blueSuffix); ok {
+		return base + greenSuffix, true
+	}
+	if base, ok := strings.CutSuffix(name, greenSuffix); ok {
+		return base + blueSuffix, true
+	}
+	return "", false
+}
+
+// activeProjectID returns the project serving the deployment: the last
+// active_project_id, or project_id if none is recorded yet.
+func (m *ProjectDeploymentResourceModel) activeProjectID() string {
+	if id := m.ActiveProjectID.ValueString(); id != "" {
+		return id
+	}
+	return m.ProjectID.ValueString()
+}
+
+// blueGreenShadow returns the project paired with active by name. It adds an
+// error and returns nil if active isn't named for blue/green or its
+// counterpart doesn't exist.
+func blueGreenShadow(ctx context.Context, envClient *EnvironmentClient, active *arcane.Project, diags *diag.Diagnostics) *arcane.Project {
+	name, ok := blueGreenCounterpart(active.Name)
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("strategy"),
+			"Invalid blue/green project",
+			fmt.Sprintf("The blue_green strategy deploys into a second project named like the first with -blue and -green swapped, "+
+				"but project %q has neither suffix. Rename the project, e.g. to %q, and create %q next to it.",
+				active.Name, active.Name+blueSuffix, active.Name+greenSuffix),
+		)
+		return nil
+	}
+	shadow, err := envClient.GetProjectByName(ctx, name)
+	if err != nil {
+		if arcane.IsNotFound(err) {
+			diags.AddAttributeError(
+				path.Root("strategy"),
+				"Blue/green project not found",
+				fmt.Sprintf("The blue_green strategy needs a project named %q next to %q, with the same compose content, to deploy into.", name, active.Name),
+			)
+			return nil
+		}
+		diagnostics.AddAPIError(ctx, diags, err, "Failed to look up blue/green project")
+		return nil
+	}
+	return shadow
+}
+
+// deployBlueGreen deploys the project paired with the active one, waits for
+// it to become healthy under health_policy and then stops the previously
+// active project, recording the swap and deploy outcome in data. If the new
+// project doesn't become healthy in time it is stopped again, leaving the
+// active project serving. Returns false when an error was added.
+func (r *ProjectDeploymentResource) deployBlueGreen(ctx context.Context, envClient *EnvironmentClient, data *ProjectDeploymentResourceModel, activeID string, deployReq *arcane.ProjectDeployRequest, timeout time.Duration, diags *diag.Diagnostics) bool {
+	active, err := envClient.GetProject(ctx, activeID)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, diags, err, "Failed to get active project")
+		return false
+	}
+	shadow := blueGreenShadow(ctx, envClient, active, diags)
+	if shadow == nil {
+		return false
+	}
+
+	// The caller holds the lock of project_id, which is one of the two.
+	other := shadow.ID
+	if other == data.ProjectID.ValueString() {
+		other = active.ID
+	}
+	unlock := lockProjectForDeploy(ctx, envClient, other, diags)
+	if unlock == nil {
+		return false
+	}
+	defer unlock()
+
+	if shadow.ID != data.ProjectID.ValueString() && !syncBlueGreenShadow(ctx, envClient, active, shadow, diags) {
+		return false
+	}
+
+	tflog.Info(ctx, "Deploying blue/green shadow project", map[string]interface{}{
+		"environment_id": data.EnvironmentID.ValueString(),
+		"active":         active.Name,
+		"shadow":         shadow.Name,
+	})
+
+	before := containersBeforeDeploy(ctx, envClient, data, shadow.ID)
+	deployStart := time.Now()
+	deploymentID, err := envClient.DeployProject(ctx, shadow.ID, deployReq)
+	if err != nil {
+		if !r.reconcileInterruptedDeploy(ctx, envClient, shadow.ID, deployStart, timeout, err, diags) {
+			addDeployError(ctx, diags, "Failed to deploy project", shadow.ID, err)
+			return false
+		}
+	}
+	envClient.MarkProjectDeployed(shadow.ID, deployReq)
+
+	job, err := r.waitForDeployment(ctx, envClient, deploymentID, timeout, diags)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, diags, err, "Failed to get deployment status")
+		return false
+	}
+	if diags.HasError() {
+		return false
+	}
+	project, status, notRunning, err := r.waitForDeployedStatus(ctx, envClient, shadow.ID, max(timeout-time.Since(deployStart), 0), diags)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, diags, err, "Failed to get project status")
+		return false
+	}
+
+	// The shadow must be healthy before the swap; wait_for_healthy extends
+	// the wait to health_check_timeout.
+	policy := data.HealthPolicy.ValueString()
+	healthTimeout := max(timeout-time.Since(deployStart), 0)
+	if data.WaitForHealthy.ValueBool() {
+		healthTimeout = data.healthCheckTimeout()
+	}
+	health, _, _ := pollContainerHealth(ctx, envClient, shadow.ID, policy, healthTimeout, nil)
+	if health != projectHealthHealthy {
+		stopUnhealthyShadow(ctx, envClient, shadow.ID)
+		diagnostics.AddError(ctx, diags, diagnostics.CodeDeployFailed,
+			"Blue/green deployment unhealthy",
+			fmt.Sprintf("Project %q reported health %q under health_policy %q within %s, so it was stopped and %q is still serving.",
+				shadow.Name, health, policy, healthTimeout, active.Name),
+		)
+		return false
+	}
+	// http_check targets the shadow by name, before it serves traffic.
+	if !checkDeployedProject(ctx, envClient, data, project, healthTimeout, diags) {
+		stopUnhealthyShadow(ctx, envClient, shadow.ID)
+		diags.AddWarning("Blue/green deployment not swapped",
+			fmt.Sprintf("Project %q failed its http_check, so it was stopped and %q is still serving.", shadow.Name, active.Name))
+		return false
+	}
+
+	if err := envClient.StopProject(ctx, active.ID); err != nil {
+		addDeployError(ctx, diags, "Failed to stop previously active project", active.ID, err)
+		return false
+	}
+	addPartialDeployWarning(diags, project, notRunning)
+	recordRemovedOrphans(ctx, envClient, data, project, before, diags)
+
+	data.ActiveProjectID = types.StringValue(shadow.ID)
+	data.Status = types.StringValue(status)
+	data.Health = types.StringValue(health)
+	data.LastDeployedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	data.DeployDuration = types.Int64Value(int64(time.Since(deployStart).Round(time.Second).Seconds()))
+	data.DeployResult = types.StringValue(deployResultForStatus(status))
+	data.DeploymentID = optionalString(deploymentID)
+	data.DeployLogURL = types.StringNull()
+	if job != nil {
+		data.DeployLogURL = optionalString(job.LogURL)
+	}
+	return true
+}
+
+// syncBlueGreenShadow copies the compose file and .env of active, the project
+// configuration manages, into shadow so that the shadow runs the same
+// configuration rather than whatever it was last deployed with. When the
+// shadow is project_id itself it already holds the configuration and isn't
+// synced. Arcane doesn't return .env content with the project, so it is read
+// from the project archive. It fails before changing anything if the compose
+// file publishes host ports, which both projects would bind while the shadow
+// starts. Returns false when an error was added.
+func syncBlueGreenShadow(ctx context.Context, envClient *EnvironmentClient, active, shadow *arcane.Project, diags *diag.Diagnostics) bool {
+	if active.ComposeContent == "" {
+		diags.AddAttributeError(
+			path.Root("strategy"),
+			"Blue/green compose content unavailable",
+			fmt.Sprintf("Arcane didn't return the compose content of project %q, so it can't be copied into %q before deploying.", active.Name, shadow.Name),
+		)
+		return false
+	}
+	ports, err := composePublishedPorts(active.ComposeContent)
+	if err != nil {
+		diags.AddAttributeError(path.Root("strategy"), "Invalid blue/green compose content",
+			fmt.Sprintf("The compose content of project %q could not be parsed: %s.", active.Name, err))
+		return false
+	}
+	if len(ports) > 0 {
+		diags.AddAttributeError(
+			path.Root("strategy"),
+			"Blue/green ports conflict",
+			fmt.Sprintf("Project %q publishes host ports (%s), which %q would also bind while both run during the swap. "+
+				"Publish them from a reverse proxy in front of both projects instead, e.g. one that reaches them over a shared network.",
+				active.Name, strings.Join(ports, ", "), shadow.Name),
+		)
+		return false
+	}
+
+	envContent, err := projectEnvContent(ctx, envClient, active.ID)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, diags, err, "Failed to read .env of active project")
+		return false
+	}
+	_, err = envClient.UpdateProject(ctx, shadow.ID, &arcane.ProjectUpdateRequest{
+		ComposeContent: active.ComposeContent,
+		EnvContent:     &envContent,
+	})
+	if err != nil {
+		diagnostics.AddAPIError(ctx, diags, err, "Failed to sync blue/green project")
+		return false
+	}
+	return true
+}
+
+// projectEnvContent returns the content of a project's .env file, read from
+// its tar.gz archive, or "" if it has none.
+func projectEnvContent(ctx context.Context, envClient *EnvironmentClient, projectID string) (string, error) {
+	var archive bytes.Buffer
+	if err := envClient.GetProjectArchive(ctx, projectID, arcane.ProjectArchiveTarGz, &archive); err != nil {
+		return "", err
+	}
+	gz, err := gzip.NewReader(&archive)
+	if err != nil {
+		return "", fmt.Errorf("failed to read project archive: %w", err)
+	}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return "", nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read project archive: %w", err)
+		}
+		if strings.TrimPrefix(hdr.Name, "./") != ".env" {
+			continue
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return "", fmt.Errorf("failed to read project archive: %w", err)
+		}
+		return string(content), nil
+	}
+}
+
+// composePublishedPorts returns the host ports published by the services of a
+// compose document, as "service:port".
+func composePublishedPorts(content string) ([]string, error) {
+	root, err := parseComposeDocument(content)
+	if err != nil {
+		return nil, err
+	}
+	i := composeMappingIndex(root.Content, "services")
+	if i < 0 || root.Content[i].Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	services := root.Content[i].Content
+	var published []string
+	for j := 0; j+1 < len(services); j += 2 {
+		k := composeMappingIndex(services[j+1].Content, "ports")
+		if services[j+1].Kind != yaml.MappingNode || k < 0 {
+			continue
+		}
+		for _, port := range services[j+1].Content[k].Content {
+			if host := composeHostPort(port); host != "" {
+				published = append(published, services[j].Value+":"+host)
+			}
+		}
+	}
+	return published, nil
+}
+
+// composeHostPort returns the host port of a ports entry in the short
+// ("8080:80", "127.0.0.1:8080:80/tcp") or long syntax, or "" if it only
+// exposes a container port.
+func composeHostPort(port *yaml.Node) string {
+	if port.Kind == yaml.MappingNode {
+		if i := composeMappingIndex(port.Content, "published"); i >= 0 {
+			return port.Content[i].Value
+		}
+		return ""
+	}
+	spec, _, _ := strings.Cut(port.Value, "/")
+	// The container port is last, after an optional host address, which is
+	// bracketed if it is IPv6.
+	i := strings.LastIndex(spec, ":")
+	if i < 0 {
+		return ""
+	}
+	host := spec[:i]
+	if strings.HasPrefix(host, "[") {
+		_, host, _ = strings.Cut(host, "]:")
+	} else if addr, p, ok := strings.Cut(host, ":"); ok && net.ParseIP(addr) != nil {
+		host = p
+	}
+	return host
+}
+
+// stopUnhealthyShadow stops a blue/green project that was deployed but must
+// not take over, logging rather than reporting a failure to stop it.
+func stopUnhealthyShadow(ctx context.Context, envClient *EnvironmentClient, projectID string) {
+	if err := envClient.StopProject(ctx, projectID); err != nil {
+		tflog.Warn(ctx, "Could not stop unhealthy blue/green project", map[string]interface{}{
+			"project_id": projectID,
+			"error":      err.Error(),
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// httpCheckModel describes the http_check attribute.
+type httpCheckModel struct {
+	URL          types.String `tfsdk:"url"`
+	ExpectStatus types.Int64  `tfsdk:"expect_status"`
+	Timeout      types.String `tfsdk:"timeout"`
+}
+
+// Defaults of the http_check attribute.
+const (
+	httpCheckDefaultStatus  = http.StatusOK
+	httpCheckDefaultTimeout = time.Minute
+)
+
+// httpCheckPollInterval is how often a failing http_check is retried.
+var httpCheckPollInterval = 2 * time.Second
+
+// httpCheckRequestTimeout bounds a single http_check request.
+const httpCheckRequestTimeout = 10 * time.Second
+
+// httpCheckProjectPlaceholder is replaced in the http_check URL with the name
+// of the project being checked.
+const httpCheckProjectPlaceholder = "{project}"
+
+func httpCheckAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "A smoke test run from the machine running Terraform after each deploy or redeploy, once the " +
+			"project is healthy under `health_policy` or `wait_timeout` has elapsed. `url` is requested until it responds " +
+			"with `expect_status` or `timeout` elapses, which fails the apply. With the `blue_green` strategy it runs " +
+			"against the new project before the previously active project is stopped, and a failure stops the new one instead. " +
+			"Changing it does not redeploy.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The `http` or `https` URL to request with `GET`, e.g. the app's health endpoint. `" +
+					httpCheckProjectPlaceholder + "` is replaced with the name of the deployed project. The `blue_green` strategy requires it, " +
+					"so the check reaches the new project rather than the one still serving, e.g. `http://" + httpCheckProjectPlaceholder +
+					".internal:8080/healthz` through a reverse proxy route per project.",
+				Required: true,
+			},
+			"expect_status": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The HTTP status the endpoint must respond with, after redirects. Defaults to `%d`.", httpCheckDefaultStatus),
+				Optional:            true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long to keep retrying the endpoint, as a Go duration (e.g. `30s`). Defaults to `%s`.", formatCanonicalDuration(httpCheckDefaultTimeout)),
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// httpCheck returns the configured http_check, or nil when it is unset.
+func (m *ProjectDeploymentResourceModel) httpCheck(ctx context.Context) (*httpCheckModel, diag.Diagnostics) {
+	if m.HTTPCheck.IsNull() || m.HTTPCheck.IsUnknown() {
+		return nil, nil
+	}
+	var check httpCheckModel
+	diags := m.HTTPCheck.As(ctx, &check, basetypes.ObjectAsOptions{})
+	return &check, diags
+}
+
+// expectedStatus returns expect_status, or its default when unset.
+func (c *httpCheckModel) expectedStatus() int {
+	if c.ExpectStatus.IsNull() {
+		return httpCheckDefaultStatus
+	}
+	return int(c.ExpectStatus.ValueInt64())
+}
+
+// url returns the URL to request for the named project.
+func (c *httpCheckModel) url(project string) string {
+	return strings.ReplaceAll(c.URL.ValueString(), httpCheckProjectPlaceholder, project)
+}
+
+// timeout returns the parsed timeout, or its default when unset.
+// ValidateConfig has already rejected values that don't parse.
+func (c *httpCheckModel) timeout() time.Duration {
+	if c.Timeout.IsNull() {
+		return httpCheckDefaultTimeout
+	}
+	d, _ := time.ParseDuration(c.Timeout.ValueString())
+	return d
+}
+
+// validateHTTPCheck rejects an http_check whose url, expect_status or timeout
+// is invalid, or whose url can't tell the projects of the blue_green strategy
+// apart.
+func validateHTTPCheck(check types.Object, strategy types.String, diags *diag.Diagnostics) {
+	if check.IsNull() || check.IsUnknown() {
+		return
+	}
+	attrs := check.Attributes()
+	attrPath := path.Root("http_check")
+
+	if rawURL, ok := attrs["url"].(types.String); ok && !rawURL.IsNull() && !rawURL.IsUnknown() {
+		u, err := url.Parse(strings.ReplaceAll(rawURL.ValueString(), httpCheckProjectPlaceholder, "project"))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			diags.AddAttributeError(attrPath.AtName("url"), "Invalid http_check URL",
+				fmt.Sprintf("Expected an absolute http or https URL, got %q.", rawURL.ValueString()))
+		} else if strategy.ValueString() == deployStrategyBlueGreen && !strings.Contains(rawURL.ValueString(), httpCheckProjectPlaceholder) {
+			diags.AddAttributeError(attrPath.AtName("url"), "Invalid http_check URL",
+				fmt.Sprintf("With the blue_green strategy the check runs before the new project serves traffic, so url must "+
+					"reach it directly: include %s, which is replaced with the new project's name, got %q.",
+					httpCheckProjectPlaceholder, rawURL.ValueString()))
+		}
+	}
+	if status, ok := attrs["expect_status"].(types.Int64); ok && !status.IsNull() && !status.IsUnknown() {
+		if s := status.ValueInt64(); s < 100 || s > 599 {
+			diags.AddAttributeError(attrPath.AtName("expect_status"), "Invalid http_check status",
+				fmt.Sprintf("Expected an HTTP status between 100 and 599, got %d.", s))
+		}
+	}
+	if timeout, ok := attrs["timeout"].(types.String); ok && !timeout.IsNull() && !timeout.IsUnknown() {
+		if d, err := time.ParseDuration(timeout.ValueString()); err != nil || d <= 0 {
+			diags.AddAttributeError(attrPath.AtName("timeout"), "Invalid http_check timeout",
+				fmt.Sprintf("Expected a positive Go duration such as 30s or 2m, got %q.", timeout.ValueString()))
+		}
+	}
+}
+
+// checkDeployedProject runs the http_check of data, if any, against project
+// once it is healthy under health_policy or healthTimeout elapses. It returns
+// false when an error was added.
+func checkDeployedProject(ctx context.Context, envClient *EnvironmentClient, data *ProjectDeploymentResourceModel, project *arcane.Project, healthTimeout time.Duration, diags *diag.Diagnostics) bool {
+	check, d := data.httpCheck(ctx)
+	diags.Append(d...)
+	if check == nil || diags.HasError() {
+		return !diags.HasError()
+	}
+	pollContainerHealth(ctx, envClient, project.ID, data.HealthPolicy.ValueString(), healthTimeout, nil)
+	return runHTTPCheck(ctx, check, project.Name, diags)
+}
+
+// runHTTPCheck requests the check's URL until it responds with the expected
+// status or the check's timeout elapses. It adds an error and returns false
+// when the endpoint never did; project names the project in the error.
+func runHTTPCheck(ctx context.Context, check *httpCheckModel, project string, diags *diag.Diagnostics) bool {
+	want := check.expectedStatus()
+	timeout := check.timeout()
+	deadline := time.Now().Add(timeout)
+	httpClient := &http.Client{Timeout: httpCheckRequestTimeout}
+	target := check.url(project)
+
+	var last string
+	for attempt := 1; ; attempt++ {
+		status, err := httpCheckStatus(ctx, httpClient, target)
+		switch {
+		case err != nil:
+			last = err.Error()
+		case status == want:
+			tflog.Info(ctx, "HTTP check passed", map[string]interface{}{
+				"url":      target,
+				"status":   status,
+				"attempts": attempt,
+			})
+			return true
+		default:
+			last = fmt.Sprintf("status %d", status)
+		}
+
+		if time.Until(deadline) <= 0 || ctx.Err() != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(min(httpCheckPollInterval, time.Until(deadline))):
+		}
+	}
+
+	diagnostics.AddError(ctx, diags, diagnostics.CodeDeployFailed, "HTTP check failed",
+		fmt.Sprintf("Project %q was deployed, but %s did not respond with status %d within %s; the last attempt got %s.",
+			project, target, want, timeout, last))
+	return false
+}
+
+// httpCheckStatus requests rawURL and returns the response status.
+func httpCheckStatus(ctx context.Context, httpClient *http.Client, rawURL string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// containersBeforeDeploy returns the containers of a project before it is
+// deployed with remove_orphans, to find the orphans the deploy removed, or
+// nil when remove_orphans is false or the containers can't be listed.
+func containersBeforeDeploy(ctx context.Context, envClient *EnvironmentClient, data *ProjectDeploymentResourceModel, projectID string) []arcane.ContainerDetail {
+	if !data.RemoveOrphans.ValueBool() {
+		return nil
+	}
+	containers, err := envClient.GetProjectContainers(ctx, projectID)
+	if err != nil {
+		tflog.Debug(ctx, "Could not list project containers, removed orphans won't be reported", map[string]interface{}{
+			"project_id": projectID,
+			"error":      err.Error(),
+		})
+		return nil
+	}
+	return containers
+}
+
+// recordRemovedOrphans sets removed_orphans to the containers in before that
+// are gone after the deploy of project and belong to none of its services,
+// and adds a warning listing them.
+func recordRemovedOrphans(ctx context.Context, envClient *EnvironmentClient, data *ProjectDeploymentResourceModel, project *arcane.Project, before []arcane.ContainerDetail, diags *diag.Diagnostics) {
+	var removed []string
+	if len(before) > 0 {
+		after, err := envClient.GetProjectContainers(ctx, project.ID)
+		if err != nil {
+			tflog.Debug(ctx, "Could not list project containers, removed orphans won't be reported", map[string]interface{}{
+				"project_id": project.ID,
+				"error":      err.Error(),
+			})
+		} else {
+			removed = removedOrphans(before, after, project)
+		}
+	}
+
+	values := make([]types.String, len(removed))
+	for i, name := range removed {
+		values[i] = types.StringValue(name)
+	}
+	list, d := types.ListValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	data.RemovedOrphans = list
+
+	if len(removed) > 0 {
+		diags.AddWarning(
+			"Orphan containers removed",
+			fmt.Sprintf("Deploying project %q with remove_orphans removed %d container(s) of services not in its compose file: %s. "+
+				"If they were still needed, add their services to the compose file and apply again.",
+				project.Name, len(removed), strings.Join(removed, ", ")),
+		)
+	}
+}
+
+// removedOrphans returns the names of the containers in before that are
+// missing from after and belong to none of the project's services, sorted.
+// Containers of a service that were recreated under another name are
+// therefore not reported.
+func removedOrphans(before, after []arcane.ContainerDetail, project *arcane.Project) []string {
+	remaining := make(map[string]bool, len(after))
+	for _, c := range after {
+		remaining[c.ID] = true
+		remaining[strings.TrimPrefix(c.Name, "/")] = true
+	}
+	delete(remaining, "")
+
+	var removed []string
+	for _, c := range before {
+		name := strings.TrimPrefix(c.Name, "/")
+		if remaining[c.ID] || remaining[name] {
+			continue
+		}
+		if slices.ContainsFunc(project.Services, func(svc arcane.ProjectService) bool {
+			return containerBelongsToService(c, project.Name, svc.Name)
+		}) {
+			continue
+		}
+		removed = append(removed, name)
+	}
+	slices.Sort(removed)
+	return removed
+}
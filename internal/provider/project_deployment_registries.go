@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+)
+
+// registriesSHA256 returns the hex-encoded SHA-256 hash of the registries
+// with the given IDs: their URL, auth type, username and when they were last
+// updated, which changes when their credentials are rotated. It is null when
+// ids is null, and unknown when ids is not known yet.
+func registriesSHA256(ctx context.Context, client *Client, ids types.List) (types.String, error) {
+	if ids.IsNull() {
+		return types.StringNull(), nil
+	}
+	if ids.IsUnknown() || client == nil {
+		return types.StringUnknown(), nil
+	}
+
+	var registryIDs []string
+	for _, v := range ids.Elements() {
+		id, ok := v.(types.String)
+		if !ok || id.IsUnknown() {
+			return types.StringUnknown(), nil
+		}
+		registryIDs = append(registryIDs, id.ValueString())
+	}
+	slices.Sort(registryIDs)
+	registryIDs = slices.Compact(registryIDs)
+
+	var b strings.Builder
+	for _, id := range registryIDs {
+		registry, err := client.GetContainerRegistry(ctx, id)
+		if err != nil {
+			return types.StringNull(), fmt.Errorf("container registry %q: %w", id, err)
+		}
+		fmt.Fprintf(&b, "%s\x00%s\x00%s\x00%s\x00%s\n", registry.ID, registry.URL, registry.AuthType, registry.Username, registry.UpdatedAt)
+	}
+	return types.StringValue(sha256Hex([]byte(b.String()))), nil
+}
+
+// resolveRegistriesSHA256 sets registries_sha256 of data when it was unknown
+// at plan time because depends_on_registries wasn't known yet. It returns
+// false when an error was added.
+func resolveRegistriesSHA256(ctx context.Context, client *Client, data *ProjectDeploymentResourceModel, diags *diag.Diagnostics) bool {
+	if !data.RegistriesSHA256.IsUnknown() {
+		return true
+	}
+	sum, err := registriesSHA256(ctx, client, data.DependsOnRegistries)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, diags, err, "Failed to read container registry")
+		return false
+	}
+	data.RegistriesSHA256 = sum
+	return true
+}
@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,66 +16,115 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ProjectDeploymentResource{}
-	_ resource.ResourceWithImportState = &ProjectDeploymentResource{}
+	_ resource.Resource                   = &ProjectDeploymentResource{}
+	_ resource.ResourceWithImportState    = &ProjectDeploymentResource{}
+	_ resource.ResourceWithValidateConfig = &ProjectDeploymentResource{}
+	_ resource.ResourceWithModifyPlan     = &ProjectDeploymentResource{}
 )
 
-// lastDeployedAtPlanModifier marks last_deployed_at as unknown when any mutable
-// attribute changes (triggers, pull, force_recreate, remove_orphans), since the
-// Update method will set it to time.Now(). When nothing changes, it preserves
-// the state value. This prevents "Provider produced inconsistent result" errors.
-type lastDeployedAtPlanModifier struct{}
-
-func (m lastDeployedAtPlanModifier) Description(ctx context.Context) string {
-	return "Marks last_deployed_at as unknown when deployment-triggering attributes change"
+// deployMetadataPlanModifier marks attributes describing the last deployment
+// (last_deployed_at, deploy_duration_seconds, deploy_result, last_deployment_id,
+// deploy_log_url, removed_orphans) as unknown when any
+// mutable attribute changes (triggers, override_files, pull, force_recreate,
+// remove_orphans, build, no_cache),
+// since the Update method will redeploy and set them again. When nothing
+// changes, it preserves the state value. This prevents "Provider produced
+// inconsistent result" errors.
+type deployMetadataPlanModifier struct{}
+
+func (m deployMetadataPlanModifier) Description(ctx context.Context) string {
+	return "Marks deployment metadata as unknown when deployment-triggering attributes change"
 }
 
-func (m lastDeployedAtPlanModifier) MarkdownDescription(ctx context.Context) string {
+func (m deployMetadataPlanModifier) MarkdownDescription(ctx context.Context) string {
 	return m.Description(ctx)
 }
 
-func (m lastDeployedAtPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+func (m deployMetadataPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 	// On create (no state yet), keep as unknown so provider can set it
-	if req.StateValue.IsNull() {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	if deployAttributesChanged(ctx, req.Plan, req.State) {
+		resp.PlanValue = types.StringUnknown()
+	} else {
+		// Nothing changed — preserve the current state value
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (m deployMetadataPlanModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.State.Raw.IsNull() {
 		return
 	}
 
-	// Check if any deployment-triggering attribute changed
-	changed := false
+	if deployAttributesChanged(ctx, req.Plan, req.State) {
+		resp.PlanValue = types.Int64Unknown()
+	} else {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (m deployMetadataPlanModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	if deployAttributesChanged(ctx, req.Plan, req.State) {
+		resp.PlanValue = types.ListUnknown(types.StringType)
+	} else {
+		resp.PlanValue = req.StateValue
+	}
+}
 
+// deployAttributesChanged reports whether any attribute that triggers a
+// redeploy differs between plan and state.
+func deployAttributesChanged(ctx context.Context, plan tfsdk.Plan, state tfsdk.State) bool {
 	// Check triggers
 	var planTriggers, stateTriggers types.Map
-	req.Plan.GetAttribute(ctx, path.Root("triggers"), &planTriggers)
-	req.State.GetAttribute(ctx, path.Root("triggers"), &stateTriggers)
+	plan.GetAttribute(ctx, path.Root("triggers"), &planTriggers)
+	state.GetAttribute(ctx, path.Root("triggers"), &stateTriggers)
 	if !planTriggers.Equal(stateTriggers) {
-		changed = true
+		return true
+	}
+
+	// Check override files
+	var planOverrides, stateOverrides types.List
+	plan.GetAttribute(ctx, path.Root("override_files"), &planOverrides)
+	state.GetAttribute(ctx, path.Root("override_files"), &stateOverrides)
+	if !planOverrides.Equal(stateOverrides) {
+		return true
+	}
+
+	// Check registries
+	var planRegistries, stateRegistries types.String
+	plan.GetAttribute(ctx, path.Root("registries_sha256"), &planRegistries)
+	state.GetAttribute(ctx, path.Root("registries_sha256"), &stateRegistries)
+	if !planRegistries.Equal(stateRegistries) {
+		return true
 	}
 
 	// Check bool options
-	for _, attr := range []string{"pull", "force_recreate", "remove_orphans"} {
+	for _, attr := range []string{"pull", "force_recreate", "remove_orphans", "build", "no_cache"} {
 		var planVal, stateVal types.Bool
-		req.Plan.GetAttribute(ctx, path.Root(attr), &planVal)
-		req.State.GetAttribute(ctx, path.Root(attr), &stateVal)
+		plan.GetAttribute(ctx, path.Root(attr), &planVal)
+		state.GetAttribute(ctx, path.Root(attr), &stateVal)
 		if !planVal.Equal(stateVal) {
-			changed = true
-			break
+			return true
 		}
 	}
-
-	if changed {
-		resp.PlanValue = types.StringUnknown()
-	} else {
-		// Nothing changed — preserve the current state value
-		resp.PlanValue = req.StateValue
-	}
+	return false
 }
 
 // NewProjectDeploymentResource returns a new project deployment resource.
@@ -82,33 +134,72 @@ func NewProjectDeploymentResource() resource.Resource {
 
 // ProjectDeploymentResource defines the project deployment resource implementation.
 type ProjectDeploymentResource struct {
-	client *client.Client
+	client *Client
 }
 
 // ProjectDeploymentResourceModel describes the project deployment resource data model.
 type ProjectDeploymentResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	EnvironmentID  types.String `tfsdk:"environment_id"`
-	ProjectID      types.String `tfsdk:"project_id"`
-	Pull           types.Bool   `tfsdk:"pull"`
-	ForceRecreate  types.Bool   `tfsdk:"force_recreate"`
-	RemoveOrphans  types.Bool   `tfsdk:"remove_orphans"`
-	StopOnDelete   types.Bool   `tfsdk:"stop_on_delete"`
-	Triggers       types.Map    `tfsdk:"triggers"`
-	WaitTimeout    types.String `tfsdk:"wait_timeout"`
-	Status         types.String `tfsdk:"status"`
-	LastDeployedAt types.String `tfsdk:"last_deployed_at"`
+	ID                  types.String `tfsdk:"id"`
+	EnvironmentID       types.String `tfsdk:"environment_id"`
+	ProjectID           types.String `tfsdk:"project_id"`
+	Pull                types.Bool   `tfsdk:"pull"`
+	ForceRecreate       types.Bool   `tfsdk:"force_recreate"`
+	RemoveOrphans       types.Bool   `tfsdk:"remove_orphans"`
+	Build               types.Bool   `tfsdk:"build"`
+	NoCache             types.Bool   `tfsdk:"no_cache"`
+	StopOnDelete        types.Bool   `tfsdk:"stop_on_delete"`
+	Triggers            types.Map    `tfsdk:"triggers"`
+	DependsOnRegistries types.List   `tfsdk:"depends_on_registries"`
+	RegistriesSHA256    types.String `tfsdk:"registries_sha256"`
+	OverrideFiles       types.List   `tfsdk:"override_files"`
+	WaitTimeout         types.String `tfsdk:"wait_timeout"`
+	Status              types.String `tfsdk:"status"`
+	HealthPolicy        types.String `tfsdk:"health_policy"`
+	WaitForHealthy      types.Bool   `tfsdk:"wait_for_healthy"`
+	HealthCheckTimeout  types.String `tfsdk:"health_check_timeout"`
+	Health              types.String `tfsdk:"health"`
+	LastDeployedAt      types.String `tfsdk:"last_deployed_at"`
+	DeployDuration      types.Int64  `tfsdk:"deploy_duration_seconds"`
+	DeployResult        types.String `tfsdk:"deploy_result"`
+	DeploymentID        types.String `tfsdk:"last_deployment_id"`
+	DeployLogURL        types.String `tfsdk:"deploy_log_url"`
+	APIKeyAlias         types.String `tfsdk:"api_key_alias"`
+	ConfirmDestroy      types.String `tfsdk:"confirm_destroy"`
+	Strategy            types.String `tfsdk:"strategy"`
+	Stagger             types.String `tfsdk:"stagger"`
+	ActiveProjectID     types.String `tfsdk:"active_project_id"`
+	HTTPCheck           types.Object `tfsdk:"http_check"`
+	RemovedOrphans      types.List   `tfsdk:"removed_orphans"`
+	Ownership           types.Object `tfsdk:"ownership"`
+	Updater             types.Object `tfsdk:"updater"`
+}
+
+// composeOverrideFileModel describes an element of override_files.
+type composeOverrideFileModel struct {
+	Path    types.String `tfsdk:"path"`
+	Content types.String `tfsdk:"content"`
 }
 
 // toDeployRequest converts the HCL attributes to the Arcane v1.16+ API request.
-func (m *ProjectDeploymentResourceModel) toDeployRequest() *client.ProjectDeployRequest {
-	req := &client.ProjectDeployRequest{
+func (m *ProjectDeploymentResourceModel) toDeployRequest(ctx context.Context) (*arcane.ProjectDeployRequest, diag.Diagnostics) {
+	req := &arcane.ProjectDeployRequest{
 		ForceRecreate: m.ForceRecreate.ValueBool(),
+		Build:         m.Build.ValueBool(),
+		NoCache:       m.Build.ValueBool() && m.NoCache.ValueBool(),
 	}
 	if m.Pull.ValueBool() {
 		req.PullPolicy = "always"
 	}
-	return req
+
+	var overrides []composeOverrideFileModel
+	diags := m.OverrideFiles.ElementsAs(ctx, &overrides, false)
+	for _, o := range overrides {
+		req.OverrideFiles = append(req.OverrideFiles, arcane.ComposeOverrideFile{
+			Path:    remoteComposePath(o.Path.ValueString()),
+			Content: normalizeLineEndings(o.Content.ValueString()),
+		})
+	}
+	return req, diags
 }
 
 func (r *ProjectDeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -132,6 +223,18 @@ in Arcane. It tracks the deployment state and can be used to ensure projects are
   - ` + "`true`" + `: Stops containers (docker compose down) before removing from state
 - **Read**: Fetches the current project status
 
+After a deploy or redeploy, the project status is polled until it settles (for
+example from ` + "`starting`" + ` to ` + "`running`" + `), bounded by ` + "`wait_timeout`" + `, so the
+recorded ` + "`status`" + ` reflects the outcome of the deployment.
+
+Deployment operations on the same project are serialized within an apply, so two
+resources targeting one project never interleave up/redeploy calls. When several
+resources (for example from different modules) deploy the same project in one apply,
+only the first deploys; the others are coalesced with it and report a warning about
+the duplicated management. If Arcane reports
+that an operation is already in progress (for example from another workspace), the
+apply fails with a "Deployment in progress" error.
+
 ## Example Usage
 
 ### Basic Deployment
@@ -163,6 +266,38 @@ resource "arcane_project_deployment" "webapp" {
 }
 ` + "```" + `
 
+### With Override Files
+
+` + "```hcl" + `
+resource "arcane_project_deployment" "webapp" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+
+  # Layered over the project's compose file like docker compose -f
+  override_files = [
+    { path = "docker-compose.prod.yml" },
+    { content = file("overrides/resources.yml") },
+  ]
+}
+` + "```" + `
+
+### With Local Builds
+
+` + "```hcl" + `
+resource "arcane_project_deployment" "app" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.app.id
+
+  # docker compose up --build, rebuilding from scratch
+  build    = true
+  no_cache = true
+
+  triggers = {
+    source = var.git_sha
+  }
+}
+` + "```" + `
+
 ### With Wait Timeout
 
 ` + "```hcl" + `
@@ -192,6 +327,8 @@ terraform import arcane_project_deployment.webapp env-id/project-id
 ` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
+			"api_key_alias":   apiKeyAliasAttribute(),
+			"confirm_destroy": confirmDestroyAttribute("project"),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier for this deployment (environment_id/project_id).",
 				Computed:            true,
@@ -214,23 +351,48 @@ terraform import arcane_project_deployment.webapp env-id/project-id
 				},
 			},
 			"pull": schema.BoolAttribute{
-				MarkdownDescription: "Pull images before deploying. Defaults to `false`.",
+				MarkdownDescription: "Pull images before deploying. Defaults to the provider's `default_deploy_options`, or `false`.",
 				Optional:            true,
 				Computed:            true,
-				Default:             booldefault.StaticBool(false),
 			},
 			"force_recreate": schema.BoolAttribute{
-				MarkdownDescription: "Force recreate containers even if configuration hasn't changed. Defaults to `false`.",
+				MarkdownDescription: "Force recreate containers even if configuration hasn't changed. Defaults to the provider's `default_deploy_options`, or `false`.",
 				Optional:            true,
 				Computed:            true,
-				Default:             booldefault.StaticBool(false),
 			},
 			"remove_orphans": schema.BoolAttribute{
-				MarkdownDescription: "Remove containers for services not defined in the compose file. Defaults to `false`.",
+				MarkdownDescription: "Remove containers for services not defined in the compose file. Defaults to the provider's `default_deploy_options`, or `false`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"build": schema.BoolAttribute{
+				MarkdownDescription: "Build images of services with a `build` section on the agent before starting them, like `docker compose up --build`. Defaults to `false`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"no_cache": schema.BoolAttribute{
+				MarkdownDescription: "Build images without the build cache, forcing a full rebuild. Requires `build`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"override_files": schema.ListNestedAttribute{
+				MarkdownDescription: "Additional compose files layered over the project's compose file, in order, following `docker compose -f` merge semantics. Use them for per-environment tweaks. Changing them triggers a redeploy.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path of an override file in the project directory on the agent, e.g. `docker-compose.prod.yml`. Backslashes are sent as `/`. Conflicts with `content`.",
+							Optional:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "Inline override file content. CRLF line endings are sent as LF. Conflicts with `path`.",
+							Optional:            true,
+						},
+					},
+				},
+			},
 			"stop_on_delete": schema.BoolAttribute{
 				MarkdownDescription: "Stop containers (docker compose down) when this resource is destroyed. Defaults to `false`. Set to `false` for projects containing the Arcane agent to prevent self-destruction.",
 				Optional:            true,
@@ -238,25 +400,122 @@ terraform import arcane_project_deployment.webapp env-id/project-id
 				Default:             booldefault.StaticBool(false),
 			},
 			"triggers": schema.MapAttribute{
-				MarkdownDescription: "A map of arbitrary strings that, when changed, will trigger a redeployment. Use this to redeploy only when specific files change, e.g. `{ compose = sha256(file(\"docker-compose.yml\")) }`.",
+				MarkdownDescription: "A map of arbitrary strings that, when changed, will trigger a redeployment. Use this to redeploy only when specific files change, e.g. `{ compose = sha256(file(\"docker-compose.yml\")) }`. When the configuration is applied from both Windows and Unix checkouts, hash `replace(file(...), \"\\r\\n\", \"\\n\")` so that line endings don't cause redeploys.",
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"depends_on_registries": schema.ListAttribute{
+				MarkdownDescription: "IDs of container registries the project pulls images from. Changing their URL, auth type or " +
+					"credentials, e.g. rotating a token with `arcane_container_registry`, triggers a redeployment on the next apply, " +
+					"so that containers run with images pulled using the current credentials. Registries are read on every plan, " +
+					"so a registry changed in the same apply as the deployment is picked up by the following apply.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"registries_sha256": schema.StringAttribute{
+				MarkdownDescription: "The hex-encoded SHA-256 hash of the registries in `depends_on_registries` as of the last deployment. Unset without `depends_on_registries`.",
+				Computed:            true,
+			},
 			"wait_timeout": schema.StringAttribute{
-				MarkdownDescription: "How long to wait for the agent to come online before deploying. Accepts Go duration strings (e.g. `30s`, `2m`, `5m`). Defaults to `2m`.",
+				MarkdownDescription: "How long to wait for the agent to come online before deploying, and for the project to reach a settled status (`running`, `degraded` or `exited`) afterwards. Accepts Go duration strings (e.g. `30s`, `2m`, `5m`). Defaults to `2m`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("2m"),
 			},
+			"strategy": schema.StringAttribute{
+				MarkdownDescription: "How changes are rolled out. `recreate` (the default) redeploys `project_id` in place. " +
+					"`blue_green` needs two projects named `<name>-blue` and `<name>-green`, one of them " +
+					"`project_id`: each change is deployed into the one not serving, which must become healthy under `health_policy` " +
+					"within `wait_timeout` before the serving one is stopped, for zero-downtime updates on a single host. " +
+					"The compose file and `.env` of `project_id` are copied into its counterpart before it is deployed. " +
+					"Both projects run during the swap, so services must not publish host ports; put a reverse proxy in front of them instead. " +
+					"Changing it does not redeploy.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(deployStrategyRecreate),
+			},
+			"stagger": schema.StringAttribute{
+				MarkdownDescription: "Redeploy the project's services one at a time instead of all at once, pausing this long " +
+					"(a Go duration, e.g. `30s`) between them. Each service's containers must become healthy under `health_policy` " +
+					"within `wait_timeout` before the pause starts; otherwise the redeploy stops there, leaving the remaining services " +
+					"untouched. Use it to limit the blast radius of a bad change on shared dependencies such as a database. " +
+					"Only applies to redeploys with the `recreate` strategy; the first deploy starts all services together.",
+				Optional: true,
+			},
+			"wait_for_healthy": schema.BoolAttribute{
+				MarkdownDescription: "Wait after each deploy or redeploy until the project's containers are healthy under `health_policy`, " +
+					"failing the apply with the containers that are not once `health_check_timeout` elapses. A container without a " +
+					"health check counts as healthy once it is running. Redeploys with the `blue_green` strategy always wait for " +
+					"the new project to be healthy, within what remains of `wait_timeout` unless this is set. Defaults to `false`. Changing it does not redeploy.",
+				Optional: true,
+			},
+			"health_check_timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long `wait_for_healthy` waits, as a Go duration (e.g. `90s`). Defaults to `%s`.", formatCanonicalDuration(defaultHealthCheckTimeout)),
+				Optional:            true,
+			},
+			"http_check": httpCheckAttribute(),
+			"ownership":  ownershipAttribute(),
+			"updater":    updaterAttribute(),
+			"active_project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project currently serving: `project_id`, or its blue/green counterpart after a `blue_green` swap. " +
+					"`status` and `health` describe this project.",
+				Computed: true,
+			},
 			"status": schema.StringAttribute{
-				MarkdownDescription: "The current status of the project.",
+				MarkdownDescription: "The current status of the project. Reported as `degraded` when some, but not all, of the project's services have a running container.",
+				Computed:            true,
+			},
+			"health_policy": schema.StringAttribute{
+				MarkdownDescription: healthPolicyDescription + " Changing it does not redeploy.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(healthPolicyAll),
+			},
+			"health": schema.StringAttribute{
+				MarkdownDescription: healthDescription,
 				Computed:            true,
 			},
 			"last_deployed_at": schema.StringAttribute{
 				MarkdownDescription: "The timestamp of the last deployment in RFC3339 format.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
-					lastDeployedAtPlanModifier{},
+					deployMetadataPlanModifier{},
+				},
+			},
+			"deploy_duration_seconds": schema.Int64Attribute{
+				MarkdownDescription: "How long the last deploy or redeploy took, in seconds, from issuing the request until the project status settled.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					deployMetadataPlanModifier{},
+				},
+			},
+			"deploy_result": schema.StringAttribute{
+				MarkdownDescription: "Outcome of the last deploy or redeploy: `success` when all services are running, `partial` when the project is `degraded`, and `failed` otherwise (including when the status did not settle within `wait_timeout`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					deployMetadataPlanModifier{},
+				},
+			},
+			"last_deployment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the server-side deployment started by the last deploy or redeploy. Null when Arcane deployed synchronously, without a deployment to track.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					deployMetadataPlanModifier{},
+				},
+			},
+			"deploy_log_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the log of the last server-side deployment, if Arcane reported one.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					deployMetadataPlanModifier{},
+				},
+			},
+			"removed_orphans": schema.ListAttribute{
+				MarkdownDescription: "The names of the containers the last deploy or redeploy removed because of `remove_orphans`, found by comparing the project's containers before and after it. Empty when none were removed or `remove_orphans` is `false`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					deployMetadataPlanModifier{},
 				},
 			},
 		},
@@ -268,11 +527,11 @@ func (r *ProjectDeploymentResource) Configure(ctx context.Context, req resource.
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	c, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
 		)
 		return
 	}
@@ -280,8 +539,182 @@ func (r *ProjectDeploymentResource) Configure(ctx context.Context, req resource.
 	r.client = c
 }
 
+// ModifyPlan rejects environments excluded by the provider's
+// allowed_environments or denied_environments, and fills pull, force_recreate
+// and remove_orphans from the provider's default_deploy_options when they
+// aren't set in the configuration. Attribute plan modifiers only see the plan
+// before this runs, so the deployment metadata is re-evaluated here against
+// the resolved options.
+func (r *ProjectDeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkPlannedEnvironmentAllowed(ctx, r.client, req, &resp.Diagnostics)
+
+	// Nothing to resolve on destroy
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var defaults DeployDefaults
+	if r.client != nil {
+		defaults = r.client.DeployDefaults()
+	}
+
+	for attr, def := range map[string]bool{
+		"pull":           defaults.Pull,
+		"force_recreate": defaults.ForceRecreate,
+		"remove_orphans": defaults.RemoveOrphans,
+	} {
+		var configVal types.Bool
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr), &configVal)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if configVal.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr), types.BoolValue(def))...)
+		}
+	}
+
+	var registryIDs types.List
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("depends_on_registries"), &registryIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	registriesSum, err := registriesSHA256(withAPIKeyAlias(ctx, req.Plan), r.client, registryIDs)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read container registry")
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("registries_sha256"), registriesSum)...)
+
+	// On create there is no deployment metadata to preserve
+	if req.State.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ProjectDeploymentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var strategy types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("strategy"), &strategy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if deployAttributesChanged(ctx, resp.Plan, req.State) {
+		// A blue/green redeploy swaps the active project
+		activeProjectID := state.ActiveProjectID
+		if strategy.ValueString() == deployStrategyBlueGreen {
+			activeProjectID = types.StringUnknown()
+		}
+		resp.Plan.SetAttribute(ctx, path.Root("active_project_id"), activeProjectID)
+		resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())
+		resp.Plan.SetAttribute(ctx, path.Root("health"), types.StringUnknown())
+		resp.Plan.SetAttribute(ctx, path.Root("last_deployed_at"), types.StringUnknown())
+		resp.Plan.SetAttribute(ctx, path.Root("deploy_duration_seconds"), types.Int64Unknown())
+		resp.Plan.SetAttribute(ctx, path.Root("deploy_result"), types.StringUnknown())
+		resp.Plan.SetAttribute(ctx, path.Root("last_deployment_id"), types.StringUnknown())
+		resp.Plan.SetAttribute(ctx, path.Root("deploy_log_url"), types.StringUnknown())
+		resp.Plan.SetAttribute(ctx, path.Root("removed_orphans"), types.ListUnknown(types.StringType))
+	} else {
+		resp.Plan.SetAttribute(ctx, path.Root("active_project_id"), state.ActiveProjectID)
+		resp.Plan.SetAttribute(ctx, path.Root("last_deployed_at"), state.LastDeployedAt)
+		resp.Plan.SetAttribute(ctx, path.Root("deploy_duration_seconds"), state.DeployDuration)
+		resp.Plan.SetAttribute(ctx, path.Root("deploy_result"), state.DeployResult)
+		resp.Plan.SetAttribute(ctx, path.Root("last_deployment_id"), state.DeploymentID)
+		resp.Plan.SetAttribute(ctx, path.Root("deploy_log_url"), state.DeployLogURL)
+		resp.Plan.SetAttribute(ctx, path.Root("removed_orphans"), state.RemovedOrphans)
+	}
+}
+
+func (r *ProjectDeploymentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var build, noCache types.Bool
+	var healthPolicy types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("build"), &build)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("no_cache"), &noCache)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("health_policy"), &healthPolicy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkHealthPolicy(path.Root("health_policy"), healthPolicy, &resp.Diagnostics)
+
+	var strategy types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("strategy"), &strategy)...)
+	if !strategy.IsNull() && !strategy.IsUnknown() && !slices.Contains(deployStrategies, strategy.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("strategy"),
+			"Invalid strategy",
+			fmt.Sprintf("Expected one of %s, got %q.", strings.Join(deployStrategies, ", "), strategy.ValueString()),
+		)
+	}
+
+	var stagger types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("stagger"), &stagger)...)
+	if !stagger.IsNull() && !stagger.IsUnknown() {
+		if _, err := time.ParseDuration(stagger.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("stagger"),
+				"Invalid stagger",
+				fmt.Sprintf("Expected a Go duration such as 30s or 2m: %s", err),
+			)
+		} else if strategy.ValueString() == deployStrategyBlueGreen {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("stagger"),
+				"Invalid stagger",
+				"stagger only applies to the recreate strategy; blue_green already waits for the new project to be healthy.",
+			)
+		}
+	}
+
+	var healthCheckTimeout types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("health_check_timeout"), &healthCheckTimeout)...)
+	validateHealthCheckTimeout(healthCheckTimeout, &resp.Diagnostics)
+
+	var httpCheck types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("http_check"), &httpCheck)...)
+	validateHTTPCheck(httpCheck, strategy, &resp.Diagnostics)
+
+	var updater types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("updater"), &updater)...)
+	validateUpdater(updater, strategy, &resp.Diagnostics)
+
+	if noCache.ValueBool() && !build.IsUnknown() && !build.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("no_cache"),
+			"Invalid no_cache",
+			"no_cache only applies to image builds; set build = true as well.",
+		)
+	}
+
+	var overrideList types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("override_files"), &overrideList)...)
+	if resp.Diagnostics.HasError() || overrideList.IsUnknown() {
+		return
+	}
+
+	var overrides []composeOverrideFileModel
+	resp.Diagnostics.Append(overrideList.ElementsAs(ctx, &overrides, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, o := range overrides {
+		if o.Path.IsUnknown() || o.Content.IsUnknown() {
+			continue
+		}
+		if o.Path.IsNull() == o.Content.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("override_files").AtListIndex(i),
+				"Invalid override file",
+				"Exactly one of path or content must be set for each override file.",
+			)
+		}
+	}
+}
+
 // waitForAgent waits for the agent to be reachable by polling the project endpoint.
-func (r *ProjectDeploymentResource) waitForAgent(ctx context.Context, envClient *client.EnvironmentClient, projectID string, timeout time.Duration) error {
+func (r *ProjectDeploymentResource) waitForAgent(ctx context.Context, envClient *EnvironmentClient, projectID string, timeout time.Duration) error {
 	deadline := time.Now().Add(timeout)
 	backoff := 5 * time.Second
 
@@ -316,6 +749,161 @@ func (r *ProjectDeploymentResource) waitForAgent(ctx context.Context, envClient
 	}
 }
 
+// lockProjectForDeploy acquires the client's per-project deployment lock so
+// resources in the same apply never interleave up/redeploy/down calls for one
+// project. It returns nil (with an error diagnostic) if the lock could not be
+// acquired before ctx was done.
+func lockProjectForDeploy(ctx context.Context, envClient *EnvironmentClient, projectID string, diags *diag.Diagnostics) func() {
+	unlock, waited, err := envClient.LockProject(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			diags.AddError(
+				"Deployment cancelled",
+				fmt.Sprintf("The operation was cancelled while waiting for another deployment of project %q in this apply to finish.", projectID),
+			)
+			return nil
+		}
+		diags.AddError(
+			"Deployment in progress",
+			fmt.Sprintf("Timed out waiting for another deployment of project %q in this apply to finish: %s", projectID, err),
+		)
+		return nil
+	}
+	if waited {
+		tflog.Info(ctx, "Waited for another deployment of the same project to finish", map[string]interface{}{
+			"project_id": projectID,
+		})
+	}
+	return unlock
+}
+
+// deployCoalesced reports whether the project was already deployed by another
+// arcane_project_deployment resource earlier in this apply, typically because
+// two modules manage the same project. The caller then skips its own deploy
+// call: it holds the project lock, so the earlier deploy has finished, and
+// redeploying would only restart the stack a second time. A warning flags the
+// duplicated management. When the earlier deploy used different options
+// (pull policy, force_recreate, services, ...) it isn't coalesced, since
+// skipping would silently drop this resource's options: the caller deploys
+// again after the earlier one, and the warning says so.
+func deployCoalesced(ctx context.Context, envClient *EnvironmentClient, environmentID, projectID string, req *arcane.ProjectDeployRequest, diags *diag.Diagnostics) bool {
+	if !envClient.ProjectDeployed(projectID) {
+		return false
+	}
+	if !envClient.ProjectDeployedWith(projectID, req) {
+		tflog.Info(ctx, "Project already deployed in this apply with different options, deploying again", map[string]interface{}{
+			"environment_id": environmentID,
+			"project_id":     projectID,
+		})
+		diags.AddWarning(
+			"Project managed by multiple deployments",
+			fmt.Sprintf("Project %q in environment %q is targeted by more than one arcane_project_deployment resource. "+
+				"It was already deployed earlier in this apply with different deploy options, so it was deployed again with this resource's options after the earlier deployment finished. "+
+				"Manage each project with a single arcane_project_deployment and combine the triggers there.", projectID, environmentID),
+		)
+		return false
+	}
+	tflog.Info(ctx, "Project already deployed in this apply, coalescing deployment", map[string]interface{}{
+		"environment_id": environmentID,
+		"project_id":     projectID,
+	})
+	diags.AddWarning(
+		"Project managed by multiple deployments",
+		fmt.Sprintf("Project %q in environment %q is targeted by more than one arcane_project_deployment resource. "+
+			"It was already deployed earlier in this apply with the same deploy options, so this deployment was coalesced with it instead of redeploying. "+
+			"Manage each project with a single arcane_project_deployment and combine the triggers there.", projectID, environmentID),
+	)
+	return true
+}
+
+// addDeployError reports a failed deploy/redeploy/stop call. A 409 Conflict
+// means Arcane is already running an operation on the project (typically from
+// another workspace), which gets a dedicated, actionable diagnostic.
+func addDeployError(ctx context.Context, diags *diag.Diagnostics, summary, projectID string, err error) {
+	if arcane.IsConflict(err) {
+		diagnostics.AddError(ctx, diags, diagnostics.CodeConflict,
+			"Deployment in progress",
+			fmt.Sprintf("Arcane reports that another operation on project %q is already in progress (%s). "+
+				"This usually means another workspace or a user is deploying the same project. "+
+				"Wait for it to finish and re-run the apply.", projectID, err),
+		)
+		return
+	}
+	diagnostics.AddAPIError(ctx, diags, err, summary)
+}
+
+// reconcileInterruptedDeploy decides whether a failed deploy/redeploy call can
+// be treated as successful. When the failure is transient (e.g. the agent
+// restarted and reset the connection mid-request) the deploy may still have
+// been applied, so the agent is awaited again and the project re-queried. The
+// deploy is only accepted, with a warning rather than tainting the resource,
+// when the project is running and its containers were (re)started since
+// requested, the time the call was sent: a running project alone may still
+// run the old containers of a request that never reached the agent. Start
+// times are set by Arcane's clock, so requested is shifted by the clock skew
+// measured from its responses. Returns false when the original error should
+// be reported.
+func (r *ProjectDeploymentResource) reconcileInterruptedDeploy(ctx context.Context, envClient *EnvironmentClient, projectID string, requested time.Time, timeout time.Duration, deployErr error, diags *diag.Diagnostics) bool {
+	if !arcane.IsTransient(deployErr) {
+		return false
+	}
+
+	tflog.Warn(ctx, "Deploy call failed with a transient error, re-checking project status", map[string]interface{}{
+		"project_id": projectID,
+		"error":      deployErr.Error(),
+	})
+
+	if err := r.waitForAgent(ctx, envClient, projectID, timeout); err != nil {
+		return false
+	}
+
+	project, err := envClient.GetProject(ctx, projectID)
+	if err != nil || !isRunningStatus(project.Status) {
+		return false
+	}
+	if !containersStartedSince(ctx, envClient, projectID, serverTime(r.client, requested)) {
+		tflog.Warn(ctx, "Project is running, but none of its containers started since the deploy call", map[string]interface{}{
+			"project_id": projectID,
+		})
+		return false
+	}
+
+	diags.AddWarning(
+		"Deployment call interrupted",
+		fmt.Sprintf("The deploy request for project %q failed with a transient error (%s), "+
+			"but the project is running with containers started after the request once the agent reconnected. "+
+			"The deployment is assumed to have succeeded.",
+			project.Name, deployErr),
+	)
+	return true
+}
+
+// serverTime returns local time t on Arcane's clock, using the clock skew
+// measured from the Date header of its last response. The header has a
+// resolution of one second, so the result is a second earlier to not miss
+// containers started right after t.
+func serverTime(client *Client, t time.Time) time.Time {
+	if skew, ok := client.LastClockSkew(); ok {
+		t = t.Add(skew)
+	}
+	return t.Add(-time.Second)
+}
+
+// containersStartedSince reports whether any container of the project
+// started at or after t, on Arcane's clock.
+func containersStartedSince(ctx context.Context, envClient *EnvironmentClient, projectID string, t time.Time) bool {
+	containers, err := envClient.GetProjectContainers(ctx, projectID)
+	if err != nil {
+		return false
+	}
+	for _, c := range containers {
+		if started, ok := c.StartedTime(); ok && !started.Before(t) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *ProjectDeploymentResource) parseWaitTimeout(data *ProjectDeploymentResourceModel) time.Duration {
 	timeoutStr := data.WaitTimeout.ValueString()
 	if timeoutStr == "" {
@@ -328,11 +916,222 @@ func (r *ProjectDeploymentResource) parseWaitTimeout(data *ProjectDeploymentReso
 	return d
 }
 
+// deployStatusPollInterval is how often the project status is re-checked while
+// a deployment settles.
+var deployStatusPollInterval = 2 * time.Second
+
+// isTerminalProjectStatus reports whether a project status is final, i.e. the
+// stack has either come up, partially come up or stopped. Transitional states
+// such as "starting", "restarting" or "deploying" are not terminal.
+func isTerminalProjectStatus(status string) bool {
+	switch strings.ToLower(status) {
+	case "running", "exited", "stopped", "dead", projectStatusDegraded:
+		return true
+	}
+	return isRunningStatus(status)
+}
+
+// waitForDeployedStatus polls the project after a deploy/redeploy until its
+// status (including the degraded check) is terminal, so the status written to
+// state reflects the outcome of the deployment rather than "starting". If the
+// timeout elapses first, the last observed status is returned with a warning.
+func (r *ProjectDeploymentResource) waitForDeployedStatus(ctx context.Context, envClient *EnvironmentClient, projectID string, timeout time.Duration, diags *diag.Diagnostics) (*arcane.Project, string, []string, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		project, err := envClient.GetProject(ctx, projectID)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		status, notRunning := projectServiceStatus(ctx, envClient, project)
+		if isTerminalProjectStatus(status) {
+			return project, status, notRunning, nil
+		}
+
+		if time.Now().After(deadline) {
+			diags.AddWarning(
+				"Deployment status not settled",
+				fmt.Sprintf("Project %q still reports status %q after %s. The status will be refreshed on the next plan.",
+					project.Name, status, timeout),
+			)
+			return project, status, notRunning, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for project status to settle", map[string]interface{}{
+			"project_id": projectID,
+			"status":     status,
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, "", nil, ctx.Err()
+		case <-time.After(deployStatusPollInterval):
+		}
+	}
+}
+
+// waitForDeployment waits for the job of a server-side deployment to finish.
+// It returns nil without polling when deploymentID is empty, i.e. Arcane
+// deployed synchronously. A failed or cancelled deployment is reported as an
+// error diagnostic; one still running after timeout as a warning.
+func (r *ProjectDeploymentResource) waitForDeployment(ctx context.Context, envClient *EnvironmentClient, deploymentID string, timeout time.Duration, diags *diag.Diagnostics) (*arcane.Job, error) {
+	if deploymentID == "" {
+		return nil, nil
+	}
+
+	tflog.Debug(ctx, "Waiting for deployment to finish", map[string]interface{}{
+		"deployment_id": deploymentID,
+	})
+	job, err := envClient.WaitForJob(ctx, deploymentID, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case job.Status == arcane.JobSucceeded:
+	case job.Done():
+		diagnostics.AddError(ctx, diags, diagnostics.CodeDeployFailed, "Deployment failed", jobFailureDetail("Deployment", job))
+	default:
+		diags.AddWarning(
+			"Deployment still running",
+			fmt.Sprintf("Deployment %q still reports status %q after %s. The project status is recorded as it is now and will be refreshed on the next plan.",
+				deploymentID, job.Status, timeout),
+		)
+	}
+	return job, nil
+}
+
+// Values of the deploy_result attribute.
+const (
+	deployResultSuccess = "success"
+	deployResultPartial = "partial"
+	deployResultFailed  = "failed"
+)
+
+// deployResultForStatus maps the settled status of a project after a deploy
+// to a deploy_result value.
+func deployResultForStatus(status string) string {
+	switch {
+	case status == projectStatusDegraded:
+		return deployResultPartial
+	case isRunningStatus(status):
+		return deployResultSuccess
+	default:
+		return deployResultFailed
+	}
+}
+
+// projectStatusDegraded is reported when a project is up but some of its
+// services have no running container (e.g. they exited right after start).
+const projectStatusDegraded = "degraded"
+
+// projectServiceStatus compares the project's services against its running
+// containers. When some, but not all, services have no running container the
+// status is downgraded to "degraded" and the names of those services are
+// returned. If container details are unavailable the server status is used.
+func projectServiceStatus(ctx context.Context, envClient *EnvironmentClient, project *arcane.Project) (string, []string) {
+	if len(project.Services) == 0 {
+		return project.Status, nil
+	}
+
+	containers, err := envClient.GetProjectContainers(ctx, project.ID)
+	if err != nil {
+		tflog.Debug(ctx, "Could not list project containers, using reported status", map[string]interface{}{
+			"project_id": project.ID,
+			"error":      err.Error(),
+		})
+		return project.Status, nil
+	}
+
+	var notRunning []string
+	for _, svc := range project.Services {
+		running := false
+		for _, c := range containers {
+			if containerBelongsToService(c, project.Name, svc.Name) && isRunningStatus(c.Status) {
+				running = true
+				break
+			}
+		}
+		if !running {
+			notRunning = append(notRunning, svc.Name)
+		}
+	}
+
+	if len(notRunning) > 0 && len(notRunning) < len(project.Services) {
+		return projectStatusDegraded, notRunning
+	}
+	return project.Status, nil
+}
+
+// composeServiceLabel is the label docker compose sets to the service name on
+// the containers it creates.
+const composeServiceLabel = "com.docker.compose.service"
+
+// containerBelongsToService reports whether a container belongs to a compose
+// service, by its com.docker.compose.service label or, for agents that don't
+// report labels, by the `<service>`, `<project>-<service>-N` or
+// `<project>_<service>_N` name docker compose gives it.
+func containerBelongsToService(c arcane.ContainerDetail, projectName, service string) bool {
+	if s, ok := c.Labels[composeServiceLabel]; ok {
+		return s == service
+	}
+	name := strings.TrimPrefix(c.Name, "/")
+	if name == service {
+		return true
+	}
+	for _, sep := range []string{"-", "_"} {
+		if n, ok := strings.CutPrefix(name, projectName+sep+service+sep); ok && isReplicaNumber(n) {
+			return true
+		}
+	}
+	return false
+}
+
+// isReplicaNumber reports whether s is the replica number docker compose
+// appends to container names.
+func isReplicaNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isRunningStatus reports whether a container status denotes a running container.
+// Docker reports either the state ("running") or a status line ("Up 3 minutes").
+func isRunningStatus(status string) bool {
+	s := strings.ToLower(status)
+	return s == "running" || s == "up" || strings.HasPrefix(s, "up ")
+}
+
+// addPartialDeployWarning adds a warning listing services that are not running
+// after a deployment.
+func addPartialDeployWarning(diags *diag.Diagnostics, project *arcane.Project, notRunning []string) {
+	if len(notRunning) == 0 {
+		return
+	}
+	diags.AddWarning(
+		"Partial deployment",
+		fmt.Sprintf("Project %q was deployed but %d of %d services are not running: %s. "+
+			"Check the container logs in Arcane; the deployment status has been set to %q.",
+			project.Name, len(notRunning), len(project.Services), strings.Join(notRunning, ", "), projectStatusDegraded),
+	)
+}
+
 func (r *ProjectDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+	warnSimulated(r.client, &resp.Diagnostics)
+
 	var data ProjectDeploymentResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
+	if resp.Diagnostics.HasError() || !resolveRegistriesSHA256(ctx, r.client, &data, &resp.Diagnostics) {
 		return
 	}
 
@@ -341,83 +1140,180 @@ func (r *ProjectDeploymentResource) Create(ctx context.Context, req resource.Cre
 	// Wait for agent to be reachable
 	timeout := r.parseWaitTimeout(&data)
 	if err := r.waitForAgent(ctx, envClient, data.ProjectID.ValueString(), timeout); err != nil {
-		resp.Diagnostics.AddError("Agent not reachable", err.Error())
+		diagnostics.AddError(ctx, &resp.Diagnostics, diagnostics.CodeAgentOffline, "Agent not reachable", err.Error())
 		return
 	}
 
+	// Fail before deploying if the blue/green counterpart is missing
+	if data.Strategy.ValueString() == deployStrategyBlueGreen {
+		project, err := envClient.GetProject(ctx, data.ProjectID.ValueString())
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to get project")
+			return
+		}
+		if blueGreenShadow(ctx, envClient, project, &resp.Diagnostics) == nil {
+			return
+		}
+	}
+
+	unlock := lockProjectForDeploy(ctx, envClient, data.ProjectID.ValueString(), &resp.Diagnostics)
+	if unlock == nil {
+		return
+	}
+	defer unlock()
+
 	// Deploy the project
-	deployReq := data.toDeployRequest()
+	deployReq, diags := data.toDeployRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deployStart := time.Now()
 
 	tflog.Debug(ctx, "Deploying project (v1.16+ API)", map[string]interface{}{
 		"environment_id": data.EnvironmentID.ValueString(),
 		"project_id":     data.ProjectID.ValueString(),
 		"pull_policy":    deployReq.PullPolicy,
 		"force_recreate": deployReq.ForceRecreate,
+		"build":          deployReq.Build,
 	})
 
-	err := envClient.DeployProject(ctx, data.ProjectID.ValueString(), deployReq)
+	before := containersBeforeDeploy(ctx, envClient, &data, data.ProjectID.ValueString())
+	var deploymentID string
+	if !deployCoalesced(ctx, envClient, data.EnvironmentID.ValueString(), data.ProjectID.ValueString(), deployReq, &resp.Diagnostics) {
+		requested := time.Now()
+		id, err := envClient.DeployProject(ctx, data.ProjectID.ValueString(), deployReq)
+		if err != nil {
+			if !r.reconcileInterruptedDeploy(ctx, envClient, data.ProjectID.ValueString(), requested, timeout, err, &resp.Diagnostics) {
+				addDeployError(ctx, &resp.Diagnostics, "Failed to deploy project", data.ProjectID.ValueString(), err)
+				return
+			}
+		}
+		deploymentID = id
+		envClient.MarkProjectDeployed(data.ProjectID.ValueString(), deployReq)
+	}
+
+	// Wait for a server-side deployment to finish, then for the project status
+	// to settle before recording it
+	job, err := r.waitForDeployment(ctx, envClient, deploymentID, timeout, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to deploy project", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to get deployment status")
 		return
 	}
-
-	// Get current project status
-	project, err := envClient.GetProject(ctx, data.ProjectID.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	project, status, notRunning, err := r.waitForDeployedStatus(ctx, envClient, data.ProjectID.ValueString(), max(timeout-time.Since(deployStart), 0), &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to get project status", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to get project status")
+		return
+	}
+	addPartialDeployWarning(&resp.Diagnostics, project, notRunning)
+	recordRemovedOrphans(ctx, envClient, &data, project, before, &resp.Diagnostics)
+	if !waitForHealthyContainers(ctx, envClient, &data, project, &resp.Diagnostics) {
+		return
+	}
+	if !checkDeployedProject(ctx, envClient, &data, project, max(timeout-time.Since(deployStart), 0), &resp.Diagnostics) {
 		return
 	}
 
 	// Update state
-	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.EnvironmentID.ValueString(), data.ProjectID.ValueString()))
-	data.Status = types.StringValue(project.Status)
+	data.ID = types.StringValue(formatCompositeID(data.EnvironmentID.ValueString(), data.ProjectID.ValueString()))
+	data.ActiveProjectID = data.ProjectID
+	data.Status = types.StringValue(status)
+	data.Health = types.StringValue(fetchProjectHealth(ctx, envClient, data.ProjectID.ValueString(), data.HealthPolicy.ValueString()))
 	data.LastDeployedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	data.DeployDuration = types.Int64Value(int64(time.Since(deployStart).Round(time.Second).Seconds()))
+	data.DeployResult = types.StringValue(deployResultForStatus(status))
+	data.DeploymentID = optionalString(deploymentID)
+	data.DeployLogURL = types.StringNull()
+	if job != nil {
+		data.DeployLogURL = optionalString(job.LogURL)
+	}
+	labelProjectOwner(ctx, envClient, &data, false, &resp.Diagnostics)
+	applyProjectUpdater(ctx, envClient, &data, types.ObjectNull(updaterAttrTypes), &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ProjectDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
 	var data ProjectDeploymentResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	prior := data
+
+	checkCompositeID("arcane_project_deployment", &data.ID, &data.EnvironmentID, &data.ProjectID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
 
-	// Get current project status
-	project, err := envClient.GetProject(ctx, data.ProjectID.ValueString())
+	// Get the current status of the serving project
+	data.ActiveProjectID = types.StringValue(data.activeProjectID())
+	project, err := envClient.GetProject(ctx, data.ActiveProjectID.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if arcane.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Failed to get project status", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to get project status")
 		return
 	}
 
 	// Update status only - triggers and last_deployed_at are preserved from state
-	data.Status = types.StringValue(project.Status)
+	status, _ := projectServiceStatus(ctx, envClient, project)
+	data.Status = types.StringValue(status)
+	// health_policy is not set in state after import
+	if data.HealthPolicy.IsNull() {
+		data.HealthPolicy = types.StringValue(healthPolicyAll)
+	}
+	data.Health = types.StringValue(fetchProjectHealth(ctx, envClient, data.ActiveProjectID.ValueString(), data.HealthPolicy.ValueString()))
+	refreshProjectUpdater(ctx, envClient, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	drift := newDriftReport("arcane_project_deployment", data.ID.ValueString())
+	drift.compare("status", prior.Status, data.Status)
+	drift.compare("health", prior.Health, data.Health)
+	drift.compare("updater", prior.Updater, data.Updater)
+	drift.addTo(&resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ProjectDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+	warnSimulated(r.client, &resp.Diagnostics)
+
 	var data ProjectDeploymentResourceModel
 	var state ProjectDeploymentResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
+	if resp.Diagnostics.HasError() || !resolveRegistriesSHA256(ctx, r.client, &data, &resp.Diagnostics) {
 		return
 	}
 
 	// Skip redeploy if no deployment-affecting attributes changed
 	needsRedeploy := !data.Triggers.Equal(state.Triggers) ||
+		!data.RegistriesSHA256.Equal(state.RegistriesSHA256) ||
+		!data.OverrideFiles.Equal(state.OverrideFiles) ||
 		!data.Pull.Equal(state.Pull) ||
 		!data.ForceRecreate.Equal(state.ForceRecreate) ||
-		!data.RemoveOrphans.Equal(state.RemoveOrphans)
+		!data.RemoveOrphans.Equal(state.RemoveOrphans) ||
+		!data.Build.Equal(state.Build) ||
+		!data.NoCache.Equal(state.NoCache)
 
 	if !needsRedeploy {
 		tflog.Debug(ctx, "No deployment-affecting attributes changed, skipping redeploy",
@@ -425,42 +1321,129 @@ func (r *ProjectDeploymentResource) Update(ctx context.Context, req resource.Upd
 				"project_id": data.ProjectID.ValueString(),
 			})
 		data.LastDeployedAt = state.LastDeployedAt
+		data.DeployDuration = state.DeployDuration
+		data.DeployResult = state.DeployResult
+		data.DeploymentID = state.DeploymentID
+		data.DeployLogURL = state.DeployLogURL
+		data.RemovedOrphans = state.RemovedOrphans
 		data.Status = state.Status
+		data.ActiveProjectID = types.StringValue(state.activeProjectID())
+		// health_policy may have changed
+		envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
+		data.Health = types.StringValue(fetchProjectHealth(ctx, envClient, data.ActiveProjectID.ValueString(), data.HealthPolicy.ValueString()))
+		labelProjectOwner(ctx, envClient, &data, !state.Ownership.IsNull(), &resp.Diagnostics)
+		applyProjectUpdater(ctx, envClient, &data, state.Updater, &resp.Diagnostics)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
 
 	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
 
+	unlock := lockProjectForDeploy(ctx, envClient, data.ProjectID.ValueString(), &resp.Diagnostics)
+	if unlock == nil {
+		return
+	}
+	defer unlock()
+
 	// Redeploy the project
-	deployReq := data.toDeployRequest()
+	deployReq, diags := data.toDeployRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deployStart := time.Now()
 
 	tflog.Debug(ctx, "Redeploying project", map[string]interface{}{
 		"environment_id": data.EnvironmentID.ValueString(),
 		"project_id":     data.ProjectID.ValueString(),
 	})
 
-	err := envClient.RedeployProject(ctx, data.ProjectID.ValueString(), deployReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to redeploy project", err.Error())
+	timeout := r.parseWaitTimeout(&data)
+
+	if data.Strategy.ValueString() == deployStrategyBlueGreen {
+		if r.deployBlueGreen(ctx, envClient, &data, state.activeProjectID(), deployReq, timeout, &resp.Diagnostics) {
+			labelProjectOwner(ctx, envClient, &data, !state.Ownership.IsNull(), &resp.Diagnostics)
+			applyProjectUpdater(ctx, envClient, &data, state.Updater, &resp.Diagnostics)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
 		return
 	}
 
-	// Get current project status
-	project, err := envClient.GetProject(ctx, data.ProjectID.ValueString())
+	before := containersBeforeDeploy(ctx, envClient, &data, data.ProjectID.ValueString())
+	var deploymentID string
+	var job *arcane.Job
+	coalesced := deployCoalesced(ctx, envClient, data.EnvironmentID.ValueString(), data.ProjectID.ValueString(), deployReq, &resp.Diagnostics)
+	if stagger := data.staggerDuration(); stagger > 0 && !coalesced {
+		var ok bool
+		deploymentID, job, ok = r.redeployStaggered(ctx, envClient, data.ProjectID.ValueString(), deployReq,
+			data.HealthPolicy.ValueString(), stagger, timeout, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+	} else if !coalesced {
+		requested := time.Now()
+		id, err := envClient.RedeployProject(ctx, data.ProjectID.ValueString(), deployReq)
+		if err != nil {
+			if !r.reconcileInterruptedDeploy(ctx, envClient, data.ProjectID.ValueString(), requested, timeout, err, &resp.Diagnostics) {
+				addDeployError(ctx, &resp.Diagnostics, "Failed to redeploy project", data.ProjectID.ValueString(), err)
+				return
+			}
+		}
+		deploymentID = id
+		envClient.MarkProjectDeployed(data.ProjectID.ValueString(), deployReq)
+	}
+
+	// Wait for a server-side deployment to finish, unless a staggered redeploy
+	// already did, then for the project status to settle before recording it
+	if job == nil {
+		var err error
+		job, err = r.waitForDeployment(ctx, envClient, deploymentID, timeout, &resp.Diagnostics)
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to get deployment status")
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	project, status, notRunning, err := r.waitForDeployedStatus(ctx, envClient, data.ProjectID.ValueString(), max(timeout-time.Since(deployStart), 0), &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to get project status", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to get project status")
+		return
+	}
+	addPartialDeployWarning(&resp.Diagnostics, project, notRunning)
+	recordRemovedOrphans(ctx, envClient, &data, project, before, &resp.Diagnostics)
+	if !waitForHealthyContainers(ctx, envClient, &data, project, &resp.Diagnostics) {
+		return
+	}
+	if !checkDeployedProject(ctx, envClient, &data, project, max(timeout-time.Since(deployStart), 0), &resp.Diagnostics) {
 		return
 	}
 
 	// Update state
-	data.Status = types.StringValue(project.Status)
+	data.ActiveProjectID = data.ProjectID
+	data.Status = types.StringValue(status)
+	data.Health = types.StringValue(fetchProjectHealth(ctx, envClient, data.ProjectID.ValueString(), data.HealthPolicy.ValueString()))
 	data.LastDeployedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	data.DeployDuration = types.Int64Value(int64(time.Since(deployStart).Round(time.Second).Seconds()))
+	data.DeployResult = types.StringValue(deployResultForStatus(status))
+	data.DeploymentID = optionalString(deploymentID)
+	data.DeployLogURL = types.StringNull()
+	if job != nil {
+		data.DeployLogURL = optionalString(job.LogURL)
+	}
+	labelProjectOwner(ctx, envClient, &data, !state.Ownership.IsNull(), &resp.Diagnostics)
+	applyProjectUpdater(ctx, envClient, &data, state.Updater, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ProjectDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+	warnSimulated(r.client, &resp.Diagnostics)
+
 	var data ProjectDeploymentResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -471,18 +1454,44 @@ func (r *ProjectDeploymentResource) Delete(ctx context.Context, req resource.Del
 	// Check if we should stop containers on delete
 	if data.StopOnDelete.ValueBool() {
 		envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
+		// After a blue/green swap the serving project is the one to stop
+		activeID := data.activeProjectID()
+
+		if r.client.RequiresDestroyConfirmation() {
+			// Accept the project ID as well, so a project that can no longer
+			// be read can still be confirmed.
+			name := activeID
+			names := []string{name}
+			if project, err := envClient.GetProject(ctx, activeID); err == nil {
+				name = project.Name
+				names = append(names, project.Name)
+			}
+			if !checkDestroyConfirmed(r.client, "project", name, data.ConfirmDestroy, names, &resp.Diagnostics) {
+				return
+			}
+		}
 
 		tflog.Info(ctx, "Stopping project (stop_on_delete=true)", map[string]interface{}{
 			"environment_id": data.EnvironmentID.ValueString(),
-			"project_id":     data.ProjectID.ValueString(),
+			"project_id":     activeID,
 		})
 
-		err := envClient.StopProject(ctx, data.ProjectID.ValueString())
+		unlock := lockProjectForDeploy(ctx, envClient, activeID, &resp.Diagnostics)
+		if unlock == nil {
+			return
+		}
+		defer unlock()
+
+		err := envClient.StopProject(ctx, activeID)
 		if err != nil {
-			if !client.IsNotFound(err) {
-				resp.Diagnostics.AddError("Failed to stop project", err.Error())
+			if !arcane.IsNotFound(err) && !r.environmentGone(ctx, data.EnvironmentID.ValueString()) {
+				addDeployError(ctx, &resp.Diagnostics, "Failed to stop project", activeID, err)
 				return
 			}
+			tflog.Info(ctx, "Project or environment already gone, nothing to stop", map[string]interface{}{
+				"environment_id": data.EnvironmentID.ValueString(),
+				"project_id":     activeID,
+			})
 		}
 	} else {
 		// Default: just remove from state, keep containers running
@@ -491,11 +1500,25 @@ func (r *ProjectDeploymentResource) Delete(ctx context.Context, req resource.Del
 			"project_id":     data.ProjectID.ValueString(),
 		})
 	}
+
+	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
+	unlabelProjectOwner(ctx, envClient, &data)
+	removeProjectUpdater(ctx, envClient, &data)
+}
+
+// environmentGone reports whether the environment no longer exists. When an
+// apply destroys an environment together with deployments that don't reference
+// it (e.g. a hard-coded environment_id), the environment may be deleted first,
+// and stopping the project then fails with whatever the agent proxy returns.
+// There is nothing left to stop in that case.
+func (r *ProjectDeploymentResource) environmentGone(ctx context.Context, environmentID string) bool {
+	_, err := r.client.GetEnvironment(ctx, environmentID)
+	return arcane.IsNotFound(err)
 }
 
 func (r *ProjectDeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.SplitN(req.ID, "/", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+	environmentID, projectID, ok := parseCompositeID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Invalid import ID",
 			fmt.Sprintf("Expected format: environment_id/project_id, got: %s", req.ID),
@@ -504,6 +1527,6 @@ func (r *ProjectDeploymentResource) ImportState(ctx context.Context, req resourc
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
 }
@@ -1,12 +1,27 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // TestProjectDeploymentResource_GivenExistingProject_WhenDeployed_ThenStatusRunning
@@ -15,12 +30,12 @@ func TestProjectDeploymentResource_GivenExistingProject_WhenDeployed_ThenStatusR
 	mockServer := NewMockServer()
 	defer mockServer.Close()
 
-	mockServer.Environments["env-basic"] = &client.Environment{
+	mockServer.Environments["env-basic"] = &arcane.Environment{
 		ID:   "env-basic",
 		Name: "basic-env",
 	}
 	mockServer.HealthyEnvs["env-basic"] = true
-	mockServer.AddProject("env-basic", &client.Project{
+	mockServer.AddProject("env-basic", &arcane.Project{
 		ID:            "proj-basic",
 		Name:          "basic-project",
 		Status:        "stopped",
@@ -37,6 +52,51 @@ func TestProjectDeploymentResource_GivenExistingProject_WhenDeployed_ThenStatusR
 					resource.TestCheckResourceAttr("arcane_project_deployment.test", "project_id", "proj-basic"),
 					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
 					resource.TestCheckResourceAttrSet("arcane_project_deployment.test", "last_deployed_at"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "deploy_result", "success"),
+					resource.TestCheckResourceAttrSet("arcane_project_deployment.test", "deploy_duration_seconds"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-basic/projects/proj-basic/up", 1),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenHealthPolicyChanged_WhenApplied_ThenHealthReaggregatedWithoutRedeploy
+// validates that health aggregates container health under health_policy and
+// that changing the policy does not redeploy the project.
+func TestProjectDeploymentResource_GivenHealthPolicyChanged_WhenApplied_ThenHealthReaggregatedWithoutRedeploy(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-health"] = &arcane.Environment{ID: "env-health", Name: "health-env"}
+	mockServer.HealthyEnvs["env-health"] = true
+	mockServer.AddProject("env-health", &arcane.Project{
+		ID:            "proj-health",
+		Name:          "health-project",
+		Status:        "stopped",
+		EnvironmentID: "env-health",
+	})
+	mockServer.AddContainers("env-health", "proj-health", []arcane.ContainerDetail{
+		{ID: "c1", Name: "health-project-web-1", Status: "running", Health: "healthy"},
+		{ID: "c2", Name: "health-project-web-2", Status: "running", Health: "unhealthy"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfig(mockServer.URL, "env-health", "proj-health"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "health_policy", "all"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "health", "degraded"),
+				),
+			},
+			{
+				Config: testDeploymentConfigHealthPolicy(mockServer.URL, "env-health", "proj-health", "any"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "health", "healthy"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-health/projects/proj-health/up", 1),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-health/projects/proj-health/redeploy", 0),
 				),
 			},
 		},
@@ -49,12 +109,12 @@ func TestProjectDeploymentResource_GivenAllOptions_WhenCreated_ThenAllOptionsSet
 	mockServer := NewMockServer()
 	defer mockServer.Close()
 
-	mockServer.Environments["env-allopts"] = &client.Environment{
+	mockServer.Environments["env-allopts"] = &arcane.Environment{
 		ID:   "env-allopts",
 		Name: "allopts-env",
 	}
 	mockServer.HealthyEnvs["env-allopts"] = true
-	mockServer.AddProject("env-allopts", &client.Project{
+	mockServer.AddProject("env-allopts", &arcane.Project{
 		ID:            "proj-allopts",
 		Name:          "allopts-project",
 		Status:        "stopped",
@@ -83,12 +143,12 @@ func TestProjectDeploymentResource_GivenTriggers_WhenCreated_ThenTriggersStored(
 	mockServer := NewMockServer()
 	defer mockServer.Close()
 
-	mockServer.Environments["env-triggers"] = &client.Environment{
+	mockServer.Environments["env-triggers"] = &arcane.Environment{
 		ID:   "env-triggers",
 		Name: "triggers-env",
 	}
 	mockServer.HealthyEnvs["env-triggers"] = true
-	mockServer.AddProject("env-triggers", &client.Project{
+	mockServer.AddProject("env-triggers", &arcane.Project{
 		ID:            "proj-triggers",
 		Name:          "triggers-project",
 		Status:        "stopped",
@@ -119,12 +179,12 @@ func TestProjectDeploymentResource_GivenTriggersChanged_WhenUpdated_ThenRedeploy
 	mockServer := NewMockServer()
 	defer mockServer.Close()
 
-	mockServer.Environments["env-trigupd"] = &client.Environment{
+	mockServer.Environments["env-trigupd"] = &arcane.Environment{
 		ID:   "env-trigupd",
 		Name: "trigupd-env",
 	}
 	mockServer.HealthyEnvs["env-trigupd"] = true
-	mockServer.AddProject("env-trigupd", &client.Project{
+	mockServer.AddProject("env-trigupd", &arcane.Project{
 		ID:            "proj-trigupd",
 		Name:          "trigupd-project",
 		Status:        "stopped",
@@ -165,12 +225,12 @@ func TestProjectDeploymentResource_GivenPullDisabled_WhenPullEnabled_ThenRedeplo
 	mockServer := NewMockServer()
 	defer mockServer.Close()
 
-	mockServer.Environments["env-pullupd"] = &client.Environment{
+	mockServer.Environments["env-pullupd"] = &arcane.Environment{
 		ID:   "env-pullupd",
 		Name: "pullupd-env",
 	}
 	mockServer.HealthyEnvs["env-pullupd"] = true
-	mockServer.AddProject("env-pullupd", &client.Project{
+	mockServer.AddProject("env-pullupd", &arcane.Project{
 		ID:            "proj-pullupd",
 		Name:          "pullupd-project",
 		Status:        "stopped",
@@ -207,12 +267,12 @@ func TestProjectDeploymentResource_GivenDefaultStopOnDelete_WhenDestroyed_ThenRe
 	mockServer := NewMockServer()
 	defer mockServer.Close()
 
-	mockServer.Environments["env-destroy"] = &client.Environment{
+	mockServer.Environments["env-destroy"] = &arcane.Environment{
 		ID:   "env-destroy",
 		Name: "destroy-env",
 	}
 	mockServer.HealthyEnvs["env-destroy"] = true
-	mockServer.AddProject("env-destroy", &client.Project{
+	mockServer.AddProject("env-destroy", &arcane.Project{
 		ID:            "proj-destroy",
 		Name:          "destroy-project",
 		Status:        "stopped",
@@ -243,12 +303,12 @@ func TestProjectDeploymentResource_GivenCompositeID_WhenImported_ThenStatePopula
 	mockServer := NewMockServer()
 	defer mockServer.Close()
 
-	mockServer.Environments["env-import"] = &client.Environment{
+	mockServer.Environments["env-import"] = &arcane.Environment{
 		ID:   "env-import",
 		Name: "import-env",
 	}
 	mockServer.HealthyEnvs["env-import"] = true
-	mockServer.AddProject("env-import", &client.Project{
+	mockServer.AddProject("env-import", &arcane.Project{
 		ID:            "proj-import",
 		Name:          "import-project",
 		Status:        "running",
@@ -273,12 +333,17 @@ func TestProjectDeploymentResource_GivenCompositeID_WhenImported_ThenStatePopula
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"last_deployed_at",
+					"deploy_duration_seconds",
+					"deploy_result",
 					"triggers",
 					"wait_timeout",
 					"pull",
 					"force_recreate",
 					"remove_orphans",
 					"stop_on_delete",
+					"build",
+					"no_cache",
+					"strategy",
 				},
 			},
 		},
@@ -291,12 +356,12 @@ func TestProjectDeploymentResource_GivenCustomWaitTimeout_WhenCreated_ThenTimeou
 	mockServer := NewMockServer()
 	defer mockServer.Close()
 
-	mockServer.Environments["env-timeout"] = &client.Environment{
+	mockServer.Environments["env-timeout"] = &arcane.Environment{
 		ID:   "env-timeout",
 		Name: "timeout-env",
 	}
 	mockServer.HealthyEnvs["env-timeout"] = true
-	mockServer.AddProject("env-timeout", &client.Project{
+	mockServer.AddProject("env-timeout", &arcane.Project{
 		ID:            "proj-timeout",
 		Name:          "timeout-project",
 		Status:        "stopped",
@@ -325,7 +390,7 @@ func TestProjectDeploymentResource_GivenEnvironmentResource_WhenDeploymentCreate
 
 	// Pre-populate project for the expected environment ID.
 	// Mock server generates ID as "env-{name}" when creating via resource.
-	mockServer.AddProject("env-dep-env", &client.Project{
+	mockServer.AddProject("env-dep-env", &arcane.Project{
 		ID:            "proj-dep",
 		Name:          "dep-project",
 		Status:        "stopped",
@@ -351,325 +416,2411 @@ func TestProjectDeploymentResource_GivenEnvironmentResource_WhenDeploymentCreate
 
 // --- Config helpers ---
 
-func testDeploymentConfig(url, envID, projectID string) string {
-	return fmt.Sprintf(`
-provider "arcane" {
-  url = %[1]q
-}
-
-resource "arcane_project_deployment" "test" {
-  environment_id = %[2]q
-  project_id     = %[3]q
-}
-`, url, envID, projectID)
-}
-
-func testDeploymentConfigAllOptions(url, envID, projectID string, pull, forceRecreate, removeOrphans bool) string {
-	return fmt.Sprintf(`
-provider "arcane" {
-  url = %[1]q
-}
-
-resource "arcane_project_deployment" "test" {
-  environment_id = %[2]q
-  project_id     = %[3]q
-  pull           = %[4]t
-  force_recreate = %[5]t
-  remove_orphans = %[6]t
-}
-`, url, envID, projectID, pull, forceRecreate, removeOrphans)
-}
-
-func testDeploymentConfigWithTriggers(url, envID, projectID string, triggers map[string]string) string {
-	triggerLines := ""
-	for k, v := range triggers {
-		triggerLines += fmt.Sprintf("    %s = %q\n", k, v)
-	}
-	return fmt.Sprintf(`
-provider "arcane" {
-  url = %[1]q
-}
-
-resource "arcane_project_deployment" "test" {
-  environment_id = %[2]q
-  project_id     = %[3]q
-  triggers = {
-%[4]s  }
-}
-`, url, envID, projectID, triggerLines)
-}
-
-func testDeploymentConfigWithTimeout(url, envID, projectID, timeout string) string {
-	return fmt.Sprintf(`
-provider "arcane" {
-  url = %[1]q
-}
-
-resource "arcane_project_deployment" "test" {
-  environment_id = %[2]q
-  project_id     = %[3]q
-  wait_timeout   = %[4]q
-}
-`, url, envID, projectID, timeout)
-}
-
-func testDeploymentConfigEmpty(url string) string {
-	return fmt.Sprintf(`
-provider "arcane" {
-  url = %[1]q
-}
-`, url)
-}
-
-func testDeploymentConfigWithEnvResource(url string) string {
-	return fmt.Sprintf(`
-provider "arcane" {
-  url = %[1]q
-}
-
-resource "arcane_environment" "test" {
-  name    = "dep-env"
-  api_url = "http://10.100.1.100:3553"
-}
-
-resource "arcane_project_deployment" "test" {
-  environment_id = arcane_environment.test.id
-  project_id     = "proj-dep"
-}
-`, url)
-}
-
-func testDeploymentConfigWithStopOnDelete(url, envID, projectID string, stopOnDelete bool) string {
-	return fmt.Sprintf(`
-provider "arcane" {
-  url = %[1]q
-}
-
-resource "arcane_project_deployment" "test" {
-  environment_id = %[2]q
-  project_id     = %[3]q
-  stop_on_delete = %[4]t
-}
-`, url, envID, projectID, stopOnDelete)
-}
-
-// --- Edge case lifecycle tests ---
-
-// TestProjectDeploymentResource_GivenStopOnDeleteTrue_WhenDestroyed_ThenProjectStopped
-// validates that destroying with stop_on_delete=true calls the down endpoint.
-func TestProjectDeploymentResource_GivenStopOnDeleteTrue_WhenDestroyed_ThenProjectStopped(t *testing.T) {
+// TestProjectDeploymentResource_GivenBlueGreen_WhenTriggersChanged_ThenShadowDeployedAndActiveStopped
+// validates that a blue_green redeploy copies the compose file and .env of
+// project_id into the counterpart project, deploys it, and stops the
+// previously active one once the counterpart is healthy.
+func TestProjectDeploymentResource_GivenBlueGreen_WhenTriggersChanged_ThenShadowDeployedAndActiveStopped(t *testing.T) {
 	mockServer := NewMockServer()
 	defer mockServer.Close()
 
-	mockServer.Environments["env-stopd"] = &client.Environment{
-		ID:   "env-stopd",
-		Name: "stopd-env",
+	mockServer.Environments["env-bg"] = &arcane.Environment{ID: "env-bg", Name: "bg-env"}
+	mockServer.HealthyEnvs["env-bg"] = true
+	for _, p := range []struct{ id, name, image string }{{"proj-blue", "web-blue", "nginx:2"}, {"proj-green", "web-green", "nginx:1"}} {
+		mockServer.AddProject("env-bg", &arcane.Project{
+			ID: p.id, Name: p.name, Status: "stopped", EnvironmentID: "env-bg",
+			ComposeContent: "services:\n  app:\n    image: " + p.image + "\n    expose: [\"80\"]\n",
+		})
+		mockServer.AddContainers("env-bg", p.id, []arcane.ContainerDetail{
+			{ID: p.id + "-c1", Name: p.name + "-app-1", Status: "running"},
+		})
+	}
+	mockServer.ProjectEnvContent["proj-blue"] = "TAG=2\n"
+
+	checkSynced := func(*terraform.State) error {
+		if got := mockServer.Projects["env-bg"]["proj-green"].ComposeContent; !strings.Contains(got, "nginx:2") {
+			return fmt.Errorf("expected the compose content of proj-blue in proj-green, got %q", got)
+		}
+		if got := mockServer.ProjectEnvContent["proj-green"]; got != "TAG=2\n" {
+			return fmt.Errorf("expected the .env of proj-blue in proj-green, got %q", got)
+		}
+		return nil
 	}
-	mockServer.HealthyEnvs["env-stopd"] = true
-	mockServer.AddProject("env-stopd", &client.Project{
-		ID:            "proj-stopd",
-		Name:          "stopd-project",
-		Status:        "stopped",
-		EnvironmentID: "env-stopd",
-	})
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Step 1: Create with stop_on_delete=true
 			{
-				Config: testDeploymentConfigWithStopOnDelete(mockServer.URL, "env-stopd", "proj-stopd", true),
+				Config: testDeploymentConfigBlueGreen(mockServer.URL, "env-bg", "proj-blue", "v1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("arcane_project_deployment.test", "stop_on_delete", "true"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "strategy", "blue_green"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "active_project_id", "proj-blue"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-bg/projects/proj-blue/up", 1),
+				),
+			},
+			{
+				Config: testDeploymentConfigBlueGreen(mockServer.URL, "env-bg", "proj-blue", "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "active_project_id", "proj-green"),
 					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "health", "healthy"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-bg/projects/proj-green/up", 1),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-bg/projects/proj-blue/down", 1),
+					mockServer.CheckRequestCount(http.MethodPut, "/api/environments/env-bg/projects/proj-green", 1),
+					checkSynced,
 				),
 			},
-			// Step 2: Destroy -- should call /down and project should be stopped
 			{
-				Config: testDeploymentConfigEmpty(mockServer.URL),
+				Config: testDeploymentConfigBlueGreen(mockServer.URL, "env-bg", "proj-blue", "v3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "active_project_id", "proj-blue"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-bg/projects/proj-blue/up", 2),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-bg/projects/proj-green/down", 1),
+					mockServer.CheckRequestCount(http.MethodPut, "/api/environments/env-bg/projects/proj-blue", 0),
+				),
 			},
 		},
 	})
-
-	// After destroy, verify the mock project was stopped
-	project := mockServer.Projects["env-stopd"]["proj-stopd"]
-	if project.Status != "stopped" {
-		t.Errorf("expected project status 'stopped' after stop_on_delete destroy, got %q", project.Status)
-	}
 }
 
-// TestProjectDeploymentResource_GivenTriggersUnchanged_WhenPlanned_ThenNoDiff
-// validates that re-applying the same triggers produces a clean plan (no diff).
-func TestProjectDeploymentResource_GivenTriggersUnchanged_WhenPlanned_ThenNoDiff(t *testing.T) {
+// TestProjectDeploymentResource_GivenBlueGreenHTTPCheck_WhenShadowFails_ThenNotSwapped
+// validates that with the blue_green strategy http_check requests the new
+// project's own URL before the swap, and that a failure stops it and keeps the
+// previously active project serving.
+func TestProjectDeploymentResource_GivenBlueGreenHTTPCheck_WhenShadowFails_ThenNotSwapped(t *testing.T) {
 	mockServer := NewMockServer()
 	defer mockServer.Close()
 
-	mockServer.Environments["env-nodiff"] = &client.Environment{
-		ID:   "env-nodiff",
-		Name: "nodiff-env",
+	mockServer.Environments["env-bg"] = &arcane.Environment{ID: "env-bg", Name: "bg-env"}
+	mockServer.HealthyEnvs["env-bg"] = true
+	for _, p := range []struct{ id, name string }{{"proj-blue", "web-blue"}, {"proj-green", "web-green"}} {
+		mockServer.AddProject("env-bg", &arcane.Project{
+			ID: p.id, Name: p.name, Status: "stopped", EnvironmentID: "env-bg",
+			ComposeContent: "services:\n  app:\n    image: nginx\n",
+		})
+		mockServer.AddContainers("env-bg", p.id, []arcane.ContainerDetail{
+			{ID: p.id + "-c1", Name: p.name + "-app-1", Status: "running"},
+		})
 	}
-	mockServer.HealthyEnvs["env-nodiff"] = true
-	mockServer.AddProject("env-nodiff", &client.Project{
-		ID:            "proj-nodiff",
-		Name:          "nodiff-project",
-		Status:        "stopped",
-		EnvironmentID: "env-nodiff",
-	})
 
-	config := testDeploymentConfigWithTriggers(mockServer.URL, "env-nodiff", "proj-nodiff", map[string]string{
-		"compose": "same-hash",
-	})
+	// Only the serving project answers; the shared route would pass.
+	var mu sync.Mutex
+	var requested []string
+	app := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requested = append(requested, r.URL.Path)
+		mu.Unlock()
+		if r.URL.Path != "/web-blue/healthz" {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer app.Close()
+
+	checkRequested := func(want ...string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if got := slices.Compact(slices.Clone(requested)); !reflect.DeepEqual(got, want) {
+				return fmt.Errorf("http_check requested %v, want %v", got, want)
+			}
+			return nil
+		}
+	}
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Step 1: Create
 			{
-				Config: config,
+				Config: testDeploymentConfigBlueGreenHTTPCheck(mockServer.URL, app.URL+"/{project}/healthz", "v1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("arcane_project_deployment.test", "triggers.compose", "same-hash"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "active_project_id", "proj-blue"),
+					checkRequested("/web-blue/healthz"),
 				),
 			},
-			// Step 2: Re-apply identical config -- should produce empty plan
 			{
-				Config:   config,
-				PlanOnly: true,
+				Config:      testDeploymentConfigBlueGreenHTTPCheck(mockServer.URL, app.URL+"/{project}/healthz", "v2"),
+				ExpectError: regexp.MustCompile(`HTTP check failed`),
+			},
+			{
+				Config: testDeploymentConfigBlueGreenHTTPCheck(mockServer.URL, app.URL+"/{project}/healthz", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "active_project_id", "proj-blue"),
+					checkRequested("/web-blue/healthz", "/web-green/healthz"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-bg/projects/proj-green/down", 1),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-bg/projects/proj-blue/down", 0),
+				),
 			},
 		},
 	})
 }
 
-// TestProjectDeploymentResource_GivenProjectStoppedExternally_WhenRead_ThenStatusReflected
-// validates that if a project is stopped outside Terraform, Read picks up
-// the new status in state. Since status is computed-only, Terraform won't
-// re-deploy automatically — the user would use triggers or -replace.
-func TestProjectDeploymentResource_GivenProjectStoppedExternally_WhenRead_ThenStatusReflected(t *testing.T) {
+// TestProjectDeploymentResource_GivenBlueGreenWithoutCounterpart_WhenCreated_ThenError
+// validates that blue_green fails before deploying when the counterpart
+// project doesn't exist.
+func TestProjectDeploymentResource_GivenBlueGreenWithoutCounterpart_WhenCreated_ThenError(t *testing.T) {
 	mockServer := NewMockServer()
 	defer mockServer.Close()
 
-	mockServer.Environments["env-drift"] = &client.Environment{
-		ID:   "env-drift",
-		Name: "drift-env",
-	}
-	mockServer.HealthyEnvs["env-drift"] = true
-	mockServer.AddProject("env-drift", &client.Project{
-		ID:            "proj-drift",
-		Name:          "drift-project",
-		Status:        "stopped",
-		EnvironmentID: "env-drift",
-	})
+	mockServer.Environments["env-bgmiss"] = &arcane.Environment{ID: "env-bgmiss", Name: "bgmiss-env"}
+	mockServer.HealthyEnvs["env-bgmiss"] = true
+	mockServer.AddProject("env-bgmiss", &arcane.Project{ID: "proj-blue", Name: "web-blue", Status: "stopped", EnvironmentID: "env-bgmiss"})
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Step 1: Create the deployment
-			{
-				Config: testDeploymentConfig(mockServer.URL, "env-drift", "proj-drift"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
-				),
-			},
-			// Step 2: Simulate external drift — project was stopped outside TF.
-			// Read reflects the new status in state.
 			{
-				PreConfig: func() {
-					mockServer.Projects["env-drift"]["proj-drift"].Status = "stopped"
-				},
-				Config: testDeploymentConfig(mockServer.URL, "env-drift", "proj-drift"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "stopped"),
-				),
+				Config:      testDeploymentConfigBlueGreen(mockServer.URL, "env-bgmiss", "proj-blue", "v1"),
+				ExpectError: regexp.MustCompile(`Blue/green project not found`),
 			},
 		},
 	})
+
+	if got := mockServer.Projects["env-bgmiss"]["proj-blue"].Status; got != "stopped" {
+		t.Errorf("expected project to stay stopped, got %q", got)
+	}
 }
 
-// TestProjectDeploymentResource_GivenMultipleTriggersChanged_WhenUpdated_ThenAllUpdated
-// validates that changing multiple triggers at once triggers update with all new values.
-func TestProjectDeploymentResource_GivenMultipleTriggersChanged_WhenUpdated_ThenAllUpdated(t *testing.T) {
+// TestProjectDeploymentResource_GivenBlueGreenWithPublishedPorts_WhenTriggersChanged_ThenError
+// validates that blue_green fails before touching the counterpart when the
+// compose file publishes host ports both projects would bind.
+func TestProjectDeploymentResource_GivenBlueGreenWithPublishedPorts_WhenTriggersChanged_ThenError(t *testing.T) {
 	mockServer := NewMockServer()
 	defer mockServer.Close()
 
-	mockServer.Environments["env-multi"] = &client.Environment{
-		ID:   "env-multi",
-		Name: "multi-env",
+	mockServer.Environments["env-bgports"] = &arcane.Environment{ID: "env-bgports", Name: "bgports-env"}
+	mockServer.HealthyEnvs["env-bgports"] = true
+	for _, p := range []struct{ id, name string }{{"proj-blue", "web-blue"}, {"proj-green", "web-green"}} {
+		mockServer.AddProject("env-bgports", &arcane.Project{
+			ID: p.id, Name: p.name, Status: "stopped", EnvironmentID: "env-bgports",
+			ComposeContent: "services:\n  app:\n    image: nginx\n    ports: [\"8080:80\"]\n",
+		})
+		mockServer.AddContainers("env-bgports", p.id, []arcane.ContainerDetail{
+			{ID: p.id + "-c1", Name: p.name + "-app-1", Status: "running"},
+		})
 	}
-	mockServer.HealthyEnvs["env-multi"] = true
-	mockServer.AddProject("env-multi", &client.Project{
-		ID:            "proj-multi",
-		Name:          "multi-project",
-		Status:        "stopped",
-		EnvironmentID: "env-multi",
-	})
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Step 1: Create with two triggers
 			{
-				Config: testDeploymentConfigWithTriggers(mockServer.URL, "env-multi", "proj-multi", map[string]string{
-					"compose": "hash-v1",
-					"env":     "env-v1",
-				}),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("arcane_project_deployment.test", "triggers.compose", "hash-v1"),
-					resource.TestCheckResourceAttr("arcane_project_deployment.test", "triggers.env", "env-v1"),
-				),
+				Config: testDeploymentConfigBlueGreen(mockServer.URL, "env-bgports", "proj-blue", "v1"),
 			},
-			// Step 2: Change both triggers
 			{
-				Config: testDeploymentConfigWithTriggers(mockServer.URL, "env-multi", "proj-multi", map[string]string{
-					"compose": "hash-v2",
-					"env":     "env-v2",
-				}),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("arcane_project_deployment.test", "triggers.compose", "hash-v2"),
-					resource.TestCheckResourceAttr("arcane_project_deployment.test", "triggers.env", "env-v2"),
-					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
-					resource.TestCheckResourceAttrSet("arcane_project_deployment.test", "last_deployed_at"),
-				),
+				Config:      testDeploymentConfigBlueGreen(mockServer.URL, "env-bgports", "proj-blue", "v2"),
+				ExpectError: regexp.MustCompile(`Blue/green ports conflict`),
 			},
 		},
 	})
+
+	if got := mockServer.RequestCount(http.MethodPut, "/api/environments/env-bgports/projects/proj-green"); got != 0 {
+		t.Errorf("expected proj-green not to be updated, got %d updates", got)
+	}
+	if got := mockServer.RequestCount(http.MethodPost, "/api/environments/env-bgports/projects/proj-green/up"); got != 0 {
+		t.Errorf("expected proj-green not to be deployed, got %d deploys", got)
+	}
 }
 
-// TestProjectDeploymentResource_GivenOptionsUnchanged_WhenPlanned_ThenNoDiff
-// validates that re-applying the same options produces a clean plan.
-func TestProjectDeploymentResource_GivenOptionsUnchanged_WhenPlanned_ThenNoDiff(t *testing.T) {
+func TestSyncBlueGreenShadow(t *testing.T) {
+	t.Parallel()
+
 	mockServer := NewMockServer()
 	defer mockServer.Close()
 
-	mockServer.Environments["env-optnodiff"] = &client.Environment{
-		ID:   "env-optnodiff",
-		Name: "optnodiff-env",
+	compose := "services:\n  app:\n    image: nginx:2\n"
+	mockServer.AddProject("env-sync", &arcane.Project{ID: "proj-blue", Name: "web-blue", ComposeContent: compose})
+	mockServer.AddProject("env-sync", &arcane.Project{ID: "proj-green", Name: "web-green", ComposeContent: "services: {}\n"})
+	mockServer.AddProject("env-sync", &arcane.Project{ID: "proj-noenv", Name: "api-blue", ComposeContent: compose})
+	mockServer.ProjectEnvContent["proj-blue"] = "TAG=2\n"
+	mockServer.ProjectEnvContent["proj-green"] = "TAG=1\n"
+	envClient := newMockClient(t, mockServer).ForEnvironment("env-sync")
+	projects := mockServer.Projects["env-sync"]
+
+	var diags diag.Diagnostics
+	if !syncBlueGreenShadow(context.Background(), envClient, projects["proj-blue"], projects["proj-green"], &diags) {
+		t.Fatalf("unexpected diagnostics: %v", diags)
 	}
-	mockServer.HealthyEnvs["env-optnodiff"] = true
-	mockServer.AddProject("env-optnodiff", &client.Project{
-		ID:            "proj-optnodiff",
-		Name:          "optnodiff-project",
-		Status:        "stopped",
+	if got := projects["proj-green"].ComposeContent; got != compose {
+		t.Errorf("expected the compose content of proj-blue, got %q", got)
+	}
+	if got := mockServer.ProjectEnvContent["proj-green"]; got != "TAG=2\n" {
+		t.Errorf("expected the .env of proj-blue, got %q", got)
+	}
+
+	// A project without a .env empties the shadow's.
+	if !syncBlueGreenShadow(context.Background(), envClient, projects["proj-noenv"], projects["proj-green"], &diags) {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got, ok := mockServer.ProjectEnvContent["proj-green"]; !ok || got != "" {
+		t.Errorf("expected an empty .env, got %q", got)
+	}
+
+	projects["proj-blue"].ComposeContent = ""
+	if syncBlueGreenShadow(context.Background(), envClient, projects["proj-blue"], projects["proj-green"], &diags) {
+		t.Error("expected an error without compose content")
+	}
+}
+
+func TestComposePublishedPorts(t *testing.T) {
+	t.Parallel()
+
+	content := `
+services:
+  web:
+    image: nginx
+    ports:
+      - "80"
+      - "8080:80"
+      - "127.0.0.1:8443:443/tcp"
+      - "[::1]:9000:9000"
+      - "127.0.0.1::53/udp"
+      - "${HTTP_PORT:-8081}:80"
+      - target: 81
+      - target: 82
+        published: "8082"
+  worker:
+    image: busybox
+    expose: ["9090"]
+`
+	got, err := composePublishedPorts(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"web:8080", "web:8443", "web:9000", "web:${HTTP_PORT:-8081}", "web:8082"}
+	if !slices.Equal(got, want) {
+		t.Errorf("composePublishedPorts() = %q, want %q", got, want)
+	}
+
+	if _, err := composePublishedPorts("services: ["); err == nil {
+		t.Error("expected an error for invalid YAML")
+	}
+}
+
+func TestBlueGreenCounterpart(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		want string
+		ok   bool
+	}{
+		"web-blue":  {"web-green", true},
+		"web-green": {"web-blue", true},
+		"blue":      {"", false},
+		"web":       {"", false},
+		"web-blue-": {"", false},
+	}
+	for name, tc := range cases {
+		got, ok := blueGreenCounterpart(name)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("blueGreenCounterpart(%q) = %q, %t, want %q, %t", name, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+// TestProjectDeploymentResource_GivenStagger_WhenTriggersChanged_ThenServicesRedeployedOneAtATime
+// validates that a redeploy with stagger sends one redeploy per service, in
+// the order the project lists them.
+func TestProjectDeploymentResource_GivenStagger_WhenTriggersChanged_ThenServicesRedeployedOneAtATime(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-stg"] = &arcane.Environment{ID: "env-stg", Name: "stg-env"}
+	mockServer.HealthyEnvs["env-stg"] = true
+	mockServer.AddProject("env-stg", &arcane.Project{
+		ID:            "proj-stg",
+		Name:          "stg-project",
+		Status:        "stopped",
+		EnvironmentID: "env-stg",
+		Services:      []arcane.ProjectService{{Name: "api"}, {Name: "worker"}},
+	})
+	mockServer.AddContainers("env-stg", "proj-stg", []arcane.ContainerDetail{
+		{ID: "c1", Name: "stg-project-api-1", Status: "running", Health: "healthy"},
+		{ID: "c2", Name: "stg-project-worker-1", Status: "running"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfigStagger(mockServer.URL, "env-stg", "proj-stg", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "stagger", "10ms"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-stg/projects/proj-stg/up", 1),
+				),
+			},
+			{
+				Config: testDeploymentConfigStagger(mockServer.URL, "env-stg", "proj-stg", "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "deploy_result", "success"),
+					checkRedeployedServices(mockServer, "/api/environments/env-stg/projects/proj-stg/redeploy", [][]string{{"api"}, {"worker"}}),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenInvalidStagger_WhenValidated_ThenError
+// validates that stagger must be a duration.
+func TestProjectDeploymentResource_GivenInvalidStagger_WhenValidated_ThenError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "arcane" {
+  url = "http://localhost:1"
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = "env-1"
+  project_id     = "proj-1"
+  stagger        = "soon"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid stagger`),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenHTTPCheck_WhenEndpointFails_ThenApplyFails
+// validates that http_check runs after a deploy and fails the apply while the
+// endpoint doesn't respond with the expected status.
+func TestProjectDeploymentResource_GivenHTTPCheck_WhenEndpointFails_ThenApplyFails(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-http"] = &arcane.Environment{ID: "env-http", Name: "http-env"}
+	mockServer.HealthyEnvs["env-http"] = true
+	mockServer.AddProject("env-http", &arcane.Project{ID: "proj-http", Name: "http-project", Status: "stopped", EnvironmentID: "env-http"})
+	mockServer.AddContainers("env-http", "proj-http", []arcane.ContainerDetail{
+		{ID: "c1", Name: "http-project-app-1", Status: "running", Health: "healthy"},
+	})
+
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	app := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer app.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfigHTTPCheck(mockServer.URL, app.URL+"/healthz", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "http_check.url", app.URL+"/healthz"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "deploy_result", "success"),
+				),
+			},
+			{
+				PreConfig:   func() { status.Store(http.StatusBadGateway) },
+				Config:      testDeploymentConfigHTTPCheck(mockServer.URL, app.URL+"/healthz", "v2"),
+				ExpectError: regexp.MustCompile(`HTTP check failed`),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenInvalidHTTPCheck_WhenValidated_ThenError
+// validates the url, expect_status and timeout of http_check.
+func TestProjectDeploymentResource_GivenInvalidHTTPCheck_WhenValidated_ThenError(t *testing.T) {
+	for name, check := range map[string]string{
+		"relative url": `{ url = "/healthz" }`,
+		"status":       `{ url = "http://app:8080", expect_status = 42 }`,
+		"timeout":      `{ url = "http://app:8080", timeout = "soon" }`,
+		"blue_green":   "{ url = \"http://app:8080\" }\n  strategy       = \"blue_green\"",
+	} {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+provider "arcane" {
+  url = "http://localhost:1"
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = "env-1"
+  project_id     = "proj-1"
+  http_check     = %s
+}
+`, check),
+						ExpectError: regexp.MustCompile(`Invalid http_check`),
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestRunHTTPCheck validates retrying until the expected status and the
+// error once the timeout elapses.
+func TestRunHTTPCheck(t *testing.T) {
+	prev := httpCheckPollInterval
+	httpCheckPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { httpCheckPollInterval = prev })
+
+	var calls atomic.Int32
+	app := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/created":
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/flaky" && calls.Add(1) < 3:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case r.URL.Path == "/down":
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer app.Close()
+
+	cases := []struct {
+		name       string
+		check      httpCheckModel
+		wantOK     bool
+		wantDetail string
+	}{
+		{name: "ok", check: httpCheckModel{URL: types.StringValue(app.URL + "/"), ExpectStatus: types.Int64Null(), Timeout: types.StringValue("1s")}, wantOK: true},
+		{name: "expected status", check: httpCheckModel{URL: types.StringValue(app.URL + "/created"), ExpectStatus: types.Int64Value(201), Timeout: types.StringValue("1s")}, wantOK: true},
+		{name: "recovers", check: httpCheckModel{URL: types.StringValue(app.URL + "/flaky"), ExpectStatus: types.Int64Null(), Timeout: types.StringValue("5s")}, wantOK: true},
+		{name: "times out", check: httpCheckModel{URL: types.StringValue(app.URL + "/down"), ExpectStatus: types.Int64Null(), Timeout: types.StringValue("50ms")}, wantDetail: "the last attempt got status 500"},
+		{name: "unreachable", check: httpCheckModel{URL: types.StringValue("http://127.0.0.1:1/"), ExpectStatus: types.Int64Null(), Timeout: types.StringValue("50ms")}, wantDetail: "connection refused"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			if ok := runHTTPCheck(context.Background(), &tc.check, "web", &diags); ok != tc.wantOK {
+				t.Fatalf("runHTTPCheck() = %t, want %t: %v", ok, tc.wantOK, diags)
+			}
+			if tc.wantOK {
+				return
+			}
+			if diags.ErrorsCount() != 1 || !strings.Contains(diags.Errors()[0].Detail(), tc.wantDetail) {
+				t.Errorf("diagnostics = %v, want one error containing %q", diags, tc.wantDetail)
+			}
+		})
+	}
+}
+
+// TestProjectDeploymentResource_GivenDependsOnRegistries_WhenRegistryUpdated_ThenRedeployed
+// validates that a change to a registry in depends_on_registries, such as a
+// rotated token, redeploys the project, and that an unchanged one doesn't.
+func TestProjectDeploymentResource_GivenDependsOnRegistries_WhenRegistryUpdated_ThenRedeployed(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-reg"] = &arcane.Environment{ID: "env-reg", Name: "reg-env"}
+	mockServer.HealthyEnvs["env-reg"] = true
+	mockServer.AddProject("env-reg", &arcane.Project{ID: "proj-reg", Name: "reg-project", Status: "stopped", EnvironmentID: "env-reg"})
+	mockServer.ContainerRegistries["reg-ghcr"] = &arcane.ContainerRegistry{
+		ID: "reg-ghcr", Name: "ghcr", URL: "ghcr.io", Username: "deploy", UpdatedAt: "2026-01-01T00:00:00Z",
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfigDependsOnRegistries(mockServer.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("arcane_project_deployment.test", "registries_sha256"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-reg/projects/proj-reg/up", 1),
+				),
+			},
+			{
+				Config:   testDeploymentConfigDependsOnRegistries(mockServer.URL),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					mockServer.mu.Lock()
+					defer mockServer.mu.Unlock()
+					mockServer.ContainerRegistries["reg-ghcr"].UpdatedAt = "2026-02-01T00:00:00Z"
+				},
+				Config: testDeploymentConfigDependsOnRegistries(mockServer.URL),
+				Check:  mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-reg/projects/proj-reg/redeploy", 1),
+			},
+		},
+	})
+}
+
+// TestRegistriesSHA256 validates that the hash ignores the order of the IDs
+// and changes with the registries' credentials.
+func TestRegistriesSHA256(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+	mockServer.ContainerRegistries["reg-a"] = &arcane.ContainerRegistry{ID: "reg-a", URL: "ghcr.io", UpdatedAt: "2026-01-01T00:00:00Z"}
+	mockServer.ContainerRegistries["reg-b"] = &arcane.ContainerRegistry{ID: "reg-b", URL: "registry.example.com"}
+	client := newMockClient(t, mockServer)
+	ctx := context.Background()
+
+	hash := func(ids ...string) types.String {
+		t.Helper()
+		list, diags := types.ListValueFrom(ctx, types.StringType, ids)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		sum, err := registriesSHA256(ctx, client, list)
+		if err != nil {
+			t.Fatalf("registriesSHA256(%q) error: %v", ids, err)
+		}
+		return sum
+	}
+
+	first := hash("reg-a", "reg-b")
+	if first.IsNull() || first.IsUnknown() {
+		t.Fatalf("registriesSHA256() = %s, want a hash", first)
+	}
+	if got := hash("reg-b", "reg-a", "reg-b"); !got.Equal(first) {
+		t.Errorf("registriesSHA256() depends on the order of the IDs: %s != %s", got, first)
+	}
+	mockServer.mu.Lock()
+	mockServer.ContainerRegistries["reg-a"].UpdatedAt = "2026-02-01T00:00:00Z"
+	mockServer.mu.Unlock()
+	if got := hash("reg-a", "reg-b"); got.Equal(first) {
+		t.Error("registriesSHA256() did not change when a registry was updated")
+	}
+
+	if got, _ := registriesSHA256(ctx, client, types.ListNull(types.StringType)); !got.IsNull() {
+		t.Errorf("registriesSHA256(null) = %s, want null", got)
+	}
+	unknown := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("reg-a"), types.StringUnknown()})
+	if got, _ := registriesSHA256(ctx, client, unknown); !got.IsUnknown() {
+		t.Errorf("registriesSHA256() with an unknown ID = %s, want unknown", got)
+	}
+	missing := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("reg-missing")})
+	if _, err := registriesSHA256(ctx, client, missing); !arcane.IsNotFound(err) {
+		t.Errorf("registriesSHA256() of a missing registry error = %v, want not found", err)
+	}
+}
+
+// TestProjectDeploymentResource_GivenWaitForHealthy_WhenContainersUnhealthy_ThenApplyFails
+// validates that wait_for_healthy fails the apply, naming the containers that
+// are not healthy, and that health_check_timeout must be a positive duration.
+func TestProjectDeploymentResource_GivenWaitForHealthy_WhenContainersUnhealthy_ThenApplyFails(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-wait"] = &arcane.Environment{ID: "env-wait", Name: "wait-env"}
+	mockServer.HealthyEnvs["env-wait"] = true
+	mockServer.AddProject("env-wait", &arcane.Project{ID: "proj-wait", Name: "wait-project", Status: "stopped", EnvironmentID: "env-wait"})
+	mockServer.AddContainers("env-wait", "proj-wait", []arcane.ContainerDetail{
+		{ID: "c1", Name: "wait-project-web-1", Status: "running", Health: "healthy"},
+		{ID: "c2", Name: "wait-project-db-1", Status: "running", Health: "starting"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testDeploymentConfigWaitForHealthy(mockServer.URL, "0s"),
+				ExpectError: regexp.MustCompile(`Invalid health_check_timeout`),
+			},
+			{
+				Config:      testDeploymentConfigWaitForHealthy(mockServer.URL, "1s"),
+				ExpectError: regexp.MustCompile(`(?s)Project did not become healthy.*wait-project-db-1: running, starting`),
+			},
+		},
+	})
+}
+
+// TestWaitForHealthyContainers validates polling until the containers are
+// healthy and the error once health_check_timeout elapses.
+func TestWaitForHealthyContainers(t *testing.T) {
+	prev := deployStatusPollInterval
+	deployStatusPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { deployStatusPollInterval = prev })
+
+	cases := []struct {
+		name       string
+		wait       bool
+		containers []arcane.ContainerDetail
+		faults     int
+		wantOK     bool
+		wantDetail string
+	}{
+		{name: "disabled", containers: []arcane.ContainerDetail{{Name: "web-1", Status: "exited"}}, wantOK: true},
+		{name: "healthy", wait: true, containers: []arcane.ContainerDetail{{Name: "web-1", Status: "running", Health: "healthy"}, {Name: "worker-1", Status: "running"}}, wantOK: true},
+		{name: "recovers", wait: true, containers: []arcane.ContainerDetail{{Name: "web-1", Status: "running", Health: "healthy"}}, faults: 2, wantOK: true},
+		{name: "unhealthy", wait: true, containers: []arcane.ContainerDetail{{Name: "web-1", Status: "running", Health: "unhealthy"}, {Name: "worker-1", Status: "exited"}}, wantDetail: "- web-1: running, unhealthy\n- worker-1: exited, no health check"},
+		{name: "no containers", wait: true, wantDetail: "It has no containers."},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockServer := NewMockServer()
+			defer mockServer.Close()
+			mockServer.Environments["env-1"] = &arcane.Environment{ID: "env-1", Name: "env"}
+			mockServer.AddProject("env-1", &arcane.Project{ID: "proj-1", Name: "web", EnvironmentID: "env-1"})
+			mockServer.AddContainers("env-1", "proj-1", tc.containers)
+			if tc.faults > 0 {
+				mockServer.InjectFault(MockFault{Method: http.MethodGet, Path: "/api/environments/env-1/projects/proj-1/containers", Status: http.StatusBadGateway, Times: tc.faults})
+			}
+
+			data := &ProjectDeploymentResourceModel{
+				WaitForHealthy:     types.BoolValue(tc.wait),
+				HealthCheckTimeout: types.StringValue("200ms"),
+				HealthPolicy:       types.StringValue(healthPolicyAll),
+			}
+			envClient := newMockClient(t, mockServer).ForEnvironment("env-1")
+			var diags diag.Diagnostics
+			if ok := waitForHealthyContainers(context.Background(), envClient, data, &arcane.Project{ID: "proj-1", Name: "web"}, &diags); ok != tc.wantOK {
+				t.Fatalf("waitForHealthyContainers() = %t, want %t: %v", ok, tc.wantOK, diags)
+			}
+			if tc.wantOK {
+				return
+			}
+			if diags.ErrorsCount() != 1 || !strings.Contains(diags.Errors()[0].Detail(), tc.wantDetail) {
+				t.Errorf("diagnostics = %v, want one error containing %q", diags, tc.wantDetail)
+			}
+		})
+	}
+}
+
+// TestProjectDeploymentResource_GivenRemoveOrphans_WhenOrphansRemoved_ThenListed
+// validates that containers of services no longer in the compose file that
+// disappear during a redeploy with remove_orphans are listed in
+// removed_orphans and reported in a warning.
+func TestProjectDeploymentResource_GivenRemoveOrphans_WhenOrphansRemoved_ThenListed(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-orphans"] = &arcane.Environment{ID: "env-orphans", Name: "orphans-env"}
+	mockServer.HealthyEnvs["env-orphans"] = true
+	mockServer.AddProject("env-orphans", &arcane.Project{
+		ID: "proj-orphans", Name: "shop", Status: "stopped", EnvironmentID: "env-orphans",
+		Services: []arcane.ProjectService{{Name: "web"}},
+	})
+	web := arcane.ContainerDetail{ID: "c-web", Name: "shop-web-1", Status: "running"}
+	mockServer.AddContainers("env-orphans", "proj-orphans", []arcane.ContainerDetail{web})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfigRemoveOrphans(mockServer.URL, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "removed_orphans.#", "0"),
+				),
+			},
+			{
+				PreConfig: func() {
+					mockServer.AddContainers("env-orphans", "proj-orphans", []arcane.ContainerDetail{
+						web,
+						{ID: "c-worker", Name: "shop-worker-1", Status: "running"},
+						{ID: "c-cron", Name: "/shop-cron-1", Status: "exited"},
+					})
+					mockServer.ContainersAfterDeploy["proj-orphans"] = []arcane.ContainerDetail{
+						{ID: "c-web-2", Name: "shop-web-1", Status: "running"},
+					}
+				},
+				Config: testDeploymentConfigRemoveOrphans(mockServer.URL, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "removed_orphans.#", "2"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "removed_orphans.0", "shop-cron-1"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "removed_orphans.1", "shop-worker-1"),
+				),
+			},
+		},
+	})
+}
+
+// TestRemovedOrphans validates which containers missing after a deploy are
+// reported as removed orphans.
+func TestRemovedOrphans(t *testing.T) {
+	t.Parallel()
+
+	project := &arcane.Project{Name: "shop", Services: []arcane.ProjectService{{Name: "web"}, {Name: "db"}}}
+	cases := []struct {
+		name   string
+		before []arcane.ContainerDetail
+		after  []arcane.ContainerDetail
+		want   []string
+	}{
+		{
+			name:   "nothing removed",
+			before: []arcane.ContainerDetail{{ID: "1", Name: "shop-web-1"}, {ID: "2", Name: "shop-worker-1"}},
+			after:  []arcane.ContainerDetail{{ID: "1", Name: "shop-web-1"}, {ID: "2", Name: "shop-worker-1"}},
+		},
+		{
+			name:   "orphans removed",
+			before: []arcane.ContainerDetail{{ID: "1", Name: "shop-web-1"}, {ID: "3", Name: "shop_worker_1"}, {ID: "2", Name: "/shop-cron-1"}},
+			after:  []arcane.ContainerDetail{{ID: "1", Name: "shop-web-1"}},
+			want:   []string{"shop-cron-1", "shop_worker_1"},
+		},
+		{
+			name:   "recreated container kept its name",
+			before: []arcane.ContainerDetail{{ID: "1", Name: "shop-web-1"}},
+			after:  []arcane.ContainerDetail{{ID: "9", Name: "shop-web-1"}},
+		},
+		{
+			name:   "service containers scaled down",
+			before: []arcane.ContainerDetail{{ID: "1", Name: "shop-db-1"}, {ID: "2", Name: "shop-db-2"}},
+			after:  []arcane.ContainerDetail{{ID: "1", Name: "shop-db-1"}},
+		},
+		{
+			name:   "renamed container",
+			before: []arcane.ContainerDetail{{ID: "1", Name: "shop-worker-1"}},
+			after:  []arcane.ContainerDetail{{ID: "1", Name: "shop-worker-renamed"}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := removedOrphans(tc.before, tc.after, project); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("removedOrphans() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestProjectDeploymentResource_GivenOwnership_WhenApplied_ThenProjectLabeled
+// validates that ownership labels the deployed project, follows changes
+// without redeploying and is removed again when unset.
+func TestProjectDeploymentResource_GivenOwnership_WhenApplied_ThenProjectLabeled(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-owner"] = &arcane.Environment{ID: "env-owner", Name: "owner-env"}
+	mockServer.HealthyEnvs["env-owner"] = true
+	project := &arcane.Project{ID: "proj-owner", Name: "owned", Status: "stopped", EnvironmentID: "env-owner", Labels: map[string]string{"team": "web"}}
+	mockServer.AddProject("env-owner", project)
+
+	checkLabels := func(want map[string]string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			if !reflect.DeepEqual(project.Labels, want) {
+				return fmt.Errorf("project labels = %v, want %v", project.Labels, want)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfigOwnership(mockServer.URL, `ownership = {
+    workspace        = "prod"
+    resource_address = "arcane_project_deployment.test"
+  }`),
+				Check: checkLabels(map[string]string{
+					"team": "web", ownerWorkspaceLabel: "prod", ownerAddressLabel: "arcane_project_deployment.test",
+				}),
+			},
+			{
+				Config: testDeploymentConfigOwnership(mockServer.URL, `ownership = { workspace = "staging" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkLabels(map[string]string{"team": "web", ownerWorkspaceLabel: "staging"}),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-owner/projects/proj-owner/redeploy", 0),
+				),
+			},
+			{
+				Config: testDeploymentConfigOwnership(mockServer.URL, ""),
+				Check:  checkLabels(map[string]string{"team": "web"}),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenUpdater_WhenApplied_ThenScheduleSet
+// validates that updater sets the project's update schedule, keeps the
+// configured interval notation, follows changes without redeploying and is
+// removed again when unset.
+func TestProjectDeploymentResource_GivenUpdater_WhenApplied_ThenScheduleSet(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-updater"] = &arcane.Environment{ID: "env-updater", Name: "updater-env"}
+	mockServer.HealthyEnvs["env-updater"] = true
+	mockServer.AddProject("env-updater", &arcane.Project{ID: "proj-updater", Name: "updated", Status: "stopped", EnvironmentID: "env-updater"})
+
+	checkSchedule := func(want *arcane.ProjectUpdater) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			if got := mockServer.ProjectUpdaters["proj-updater"]; !reflect.DeepEqual(got, want) {
+				return fmt.Errorf("project updater = %+v, want %+v", got, want)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfigUpdater(mockServer.URL, `updater = { check_interval = "6h" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkSchedule(&arcane.ProjectUpdater{Enabled: true, CheckInterval: "21600"}),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "updater.check_interval", "6h"),
+					resource.TestCheckNoResourceAttr("arcane_project_deployment.test", "updater.auto_apply"),
+				),
+			},
+			{
+				Config: testDeploymentConfigUpdater(mockServer.URL, `updater = {
+    check_interval = "30m"
+    auto_apply     = true
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkSchedule(&arcane.ProjectUpdater{Enabled: true, CheckInterval: "1800", AutoApply: true}),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "updater.auto_apply", "true"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-updater/projects/proj-updater/redeploy", 0),
+				),
+			},
+			{
+				// A schedule removed in Arcane is set again.
+				PreConfig: func() { delete(mockServer.ProjectUpdaters, "proj-updater") },
+				Config: testDeploymentConfigUpdater(mockServer.URL, `updater = {
+    check_interval = "30m"
+    auto_apply     = true
+  }`),
+				Check: checkSchedule(&arcane.ProjectUpdater{Enabled: true, CheckInterval: "1800", AutoApply: true}),
+			},
+			{
+				Config: testDeploymentConfigUpdater(mockServer.URL, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkSchedule(nil),
+					resource.TestCheckNoResourceAttr("arcane_project_deployment.test", "updater.%"),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenInvalidUpdater_WhenValidated_ThenError
+// validates check_interval and rejects updater with the blue_green strategy.
+func TestProjectDeploymentResource_GivenInvalidUpdater_WhenValidated_ThenError(t *testing.T) {
+	for name, updater := range map[string]string{
+		"interval":   `updater = { check_interval = "soon" }`,
+		"zero":       `updater = { check_interval = "0s" }`,
+		"blue_green": "strategy = \"blue_green\"\n  updater = { check_interval = \"6h\" }",
+	} {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      testDeploymentConfigUpdater("http://localhost:1", updater),
+						ExpectError: regexp.MustCompile(`Invalid updater`),
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestWithOwnerLabels validates setting and removing ownership labels.
+func TestWithOwnerLabels(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		labels      map[string]string
+		set         map[string]string
+		want        map[string]string
+		wantChanged bool
+	}{
+		"added to nil": {
+			set:         map[string]string{ownerWorkspaceLabel: "prod", ownerAddressLabel: ""},
+			want:        map[string]string{ownerWorkspaceLabel: "prod"},
+			wantChanged: true,
+		},
+		"unchanged": {
+			labels: map[string]string{"team": "web", ownerWorkspaceLabel: "prod"},
+			set:    map[string]string{ownerWorkspaceLabel: "prod", ownerAddressLabel: ""},
+			want:   map[string]string{"team": "web", ownerWorkspaceLabel: "prod"},
+		},
+		"removed": {
+			labels:      map[string]string{"team": "web", ownerWorkspaceLabel: "prod", ownerAddressLabel: "a.b"},
+			set:         ownerLabels(nil),
+			want:        map[string]string{"team": "web"},
+			wantChanged: true,
+		},
+		"nothing to remove": {
+			set:  ownerLabels(nil),
+			want: map[string]string{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, changed := withOwnerLabels(tc.labels, tc.set)
+			if !reflect.DeepEqual(got, tc.want) || changed != tc.wantChanged {
+				t.Errorf("withOwnerLabels() = %v, %t, want %v, %t", got, changed, tc.want, tc.wantChanged)
+			}
+		})
+	}
+}
+
+// checkRedeployedServices asserts the services sent in each request to path.
+func checkRedeployedServices(ms *MockServer, path string, want [][]string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		var got [][]string
+		for _, req := range ms.Requests() {
+			if req.Path != path {
+				continue
+			}
+			var body arcane.ProjectDeployRequest
+			if err := json.Unmarshal(req.Body, &body); err != nil {
+				return fmt.Errorf("decoding %s body %q: %w", path, req.Body, err)
+			}
+			got = append(got, body.Services)
+		}
+		if !reflect.DeepEqual(got, want) {
+			return fmt.Errorf("services redeployed via %s = %v, want %v", path, got, want)
+		}
+		return nil
+	}
+}
+
+func testDeploymentConfig(url, envID, projectID string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+}
+`, url, envID, projectID)
+}
+
+func testDeploymentConfigAllOptions(url, envID, projectID string, pull, forceRecreate, removeOrphans bool) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+  pull           = %[4]t
+  force_recreate = %[5]t
+  remove_orphans = %[6]t
+}
+`, url, envID, projectID, pull, forceRecreate, removeOrphans)
+}
+
+func testDeploymentConfigWithTriggers(url, envID, projectID string, triggers map[string]string) string {
+	triggerLines := ""
+	for k, v := range triggers {
+		triggerLines += fmt.Sprintf("    %s = %q\n", k, v)
+	}
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+  triggers = {
+%[4]s  }
+}
+`, url, envID, projectID, triggerLines)
+}
+
+func testDeploymentConfigWithTimeout(url, envID, projectID, timeout string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+  wait_timeout   = %[4]q
+}
+`, url, envID, projectID, timeout)
+}
+
+func testDeploymentConfigEmpty(url string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+`, url)
+}
+
+func testDeploymentConfigWithEnvResource(url string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_environment" "test" {
+  name    = "dep-env"
+  api_url = "http://10.100.1.100:3553"
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = arcane_environment.test.id
+  project_id     = "proj-dep"
+}
+`, url)
+}
+
+func testDeploymentConfigWithStopOnDelete(url, envID, projectID string, stopOnDelete bool) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+  stop_on_delete = %[4]t
+}
+`, url, envID, projectID, stopOnDelete)
+}
+
+// --- Edge case lifecycle tests ---
+
+// TestProjectDeploymentResource_GivenStopOnDeleteTrue_WhenDestroyed_ThenProjectStopped
+// validates that destroying with stop_on_delete=true calls the down endpoint.
+func TestProjectDeploymentResource_GivenStopOnDeleteTrue_WhenDestroyed_ThenProjectStopped(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-stopd"] = &arcane.Environment{
+		ID:   "env-stopd",
+		Name: "stopd-env",
+	}
+	mockServer.HealthyEnvs["env-stopd"] = true
+	mockServer.AddProject("env-stopd", &arcane.Project{
+		ID:            "proj-stopd",
+		Name:          "stopd-project",
+		Status:        "stopped",
+		EnvironmentID: "env-stopd",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create with stop_on_delete=true
+			{
+				Config: testDeploymentConfigWithStopOnDelete(mockServer.URL, "env-stopd", "proj-stopd", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "stop_on_delete", "true"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
+				),
+			},
+			// Step 2: Destroy -- should call /down and project should be stopped
+			{
+				Config: testDeploymentConfigEmpty(mockServer.URL),
+			},
+		},
+	})
+
+	// After destroy, verify the mock project was stopped
+	project := mockServer.Projects["env-stopd"]["proj-stopd"]
+	if project.Status != "stopped" {
+		t.Errorf("expected project status 'stopped' after stop_on_delete destroy, got %q", project.Status)
+	}
+}
+
+// TestProjectDeploymentResource_GivenTriggersUnchanged_WhenPlanned_ThenNoDiff
+// validates that re-applying the same triggers produces a clean plan (no diff).
+func TestProjectDeploymentResource_GivenTriggersUnchanged_WhenPlanned_ThenNoDiff(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-nodiff"] = &arcane.Environment{
+		ID:   "env-nodiff",
+		Name: "nodiff-env",
+	}
+	mockServer.HealthyEnvs["env-nodiff"] = true
+	mockServer.AddProject("env-nodiff", &arcane.Project{
+		ID:            "proj-nodiff",
+		Name:          "nodiff-project",
+		Status:        "stopped",
+		EnvironmentID: "env-nodiff",
+	})
+
+	config := testDeploymentConfigWithTriggers(mockServer.URL, "env-nodiff", "proj-nodiff", map[string]string{
+		"compose": "same-hash",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "triggers.compose", "same-hash"),
+				),
+			},
+			// Step 2: Re-apply identical config -- should produce empty plan
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenProjectStoppedExternally_WhenRead_ThenStatusReflected
+// validates that if a project is stopped outside Terraform, Read picks up
+// the new status in state. Since status is computed-only, Terraform won't
+// re-deploy automatically — the user would use triggers or -replace.
+func TestProjectDeploymentResource_GivenProjectStoppedExternally_WhenRead_ThenStatusReflected(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-drift"] = &arcane.Environment{
+		ID:   "env-drift",
+		Name: "drift-env",
+	}
+	mockServer.HealthyEnvs["env-drift"] = true
+	mockServer.AddProject("env-drift", &arcane.Project{
+		ID:            "proj-drift",
+		Name:          "drift-project",
+		Status:        "stopped",
+		EnvironmentID: "env-drift",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create the deployment
+			{
+				Config: testDeploymentConfig(mockServer.URL, "env-drift", "proj-drift"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
+				),
+			},
+			// Step 2: Simulate external drift — project was stopped outside TF.
+			// Read reflects the new status in state.
+			{
+				PreConfig: func() {
+					mockServer.Projects["env-drift"]["proj-drift"].Status = "stopped"
+				},
+				Config: testDeploymentConfig(mockServer.URL, "env-drift", "proj-drift"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "stopped"),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenMultipleTriggersChanged_WhenUpdated_ThenAllUpdated
+// validates that changing multiple triggers at once triggers update with all new values.
+func TestProjectDeploymentResource_GivenMultipleTriggersChanged_WhenUpdated_ThenAllUpdated(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-multi"] = &arcane.Environment{
+		ID:   "env-multi",
+		Name: "multi-env",
+	}
+	mockServer.HealthyEnvs["env-multi"] = true
+	mockServer.AddProject("env-multi", &arcane.Project{
+		ID:            "proj-multi",
+		Name:          "multi-project",
+		Status:        "stopped",
+		EnvironmentID: "env-multi",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create with two triggers
+			{
+				Config: testDeploymentConfigWithTriggers(mockServer.URL, "env-multi", "proj-multi", map[string]string{
+					"compose": "hash-v1",
+					"env":     "env-v1",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "triggers.compose", "hash-v1"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "triggers.env", "env-v1"),
+				),
+			},
+			// Step 2: Change both triggers
+			{
+				Config: testDeploymentConfigWithTriggers(mockServer.URL, "env-multi", "proj-multi", map[string]string{
+					"compose": "hash-v2",
+					"env":     "env-v2",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "triggers.compose", "hash-v2"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "triggers.env", "env-v2"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
+					resource.TestCheckResourceAttrSet("arcane_project_deployment.test", "last_deployed_at"),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenOptionsUnchanged_WhenPlanned_ThenNoDiff
+// validates that re-applying the same options produces a clean plan.
+func TestProjectDeploymentResource_GivenOptionsUnchanged_WhenPlanned_ThenNoDiff(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-optnodiff"] = &arcane.Environment{
+		ID:   "env-optnodiff",
+		Name: "optnodiff-env",
+	}
+	mockServer.HealthyEnvs["env-optnodiff"] = true
+	mockServer.AddProject("env-optnodiff", &arcane.Project{
+		ID:            "proj-optnodiff",
+		Name:          "optnodiff-project",
+		Status:        "stopped",
 		EnvironmentID: "env-optnodiff",
 	})
 
-	config := testDeploymentConfigAllOptions(mockServer.URL, "env-optnodiff", "proj-optnodiff", true, false, true)
+	config := testDeploymentConfigAllOptions(mockServer.URL, "env-optnodiff", "proj-optnodiff", true, false, true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "pull", "true"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "force_recreate", "false"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "remove_orphans", "true"),
+				),
+			},
+			// Step 2: Re-apply identical config -- should produce empty plan
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenServiceExitedAfterUp_WhenDeployed_ThenStatusDegraded
+// validates that a deploy where only some services end up running is reported as degraded.
+func TestProjectDeploymentResource_GivenServiceExitedAfterUp_WhenDeployed_ThenStatusDegraded(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-partial"] = &arcane.Environment{
+		ID:   "env-partial",
+		Name: "partial-env",
+	}
+	mockServer.HealthyEnvs["env-partial"] = true
+	mockServer.AddProject("env-partial", &arcane.Project{
+		ID:            "proj-partial",
+		Name:          "partial",
+		Status:        "stopped",
+		EnvironmentID: "env-partial",
+		Services: []arcane.ProjectService{
+			{Name: "web", Status: "running"},
+			{Name: "worker", Status: "exited"},
+		},
+	})
+	mockServer.AddContainers("env-partial", "proj-partial", []arcane.ContainerDetail{
+		{ID: "c-web", Name: "partial-web-1", Status: "running"},
+		{ID: "c-worker", Name: "partial-worker-1", Status: "exited"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfig(mockServer.URL, "env-partial", "proj-partial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "degraded"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "deploy_result", "partial"),
+				),
+			},
+		},
+	})
+}
+
+func TestContainerBelongsToService(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		container string
+		labels    map[string]string
+		want      bool
+	}{
+		{container: "web", want: true},
+		{container: "/web", want: true},
+		{container: "shop-web-1", want: true},
+		{container: "shop_web_1", want: true},
+		{container: "shop-web-12", want: true},
+		{container: "shop-webhook-1", want: false},
+		{container: "shop-web-api-1", want: false},
+		{container: "other-web-1", want: false},
+		{container: "frontend", labels: map[string]string{composeServiceLabel: "web"}, want: true},
+		{container: "shop-web-1", labels: map[string]string{composeServiceLabel: "web-api"}, want: false},
+	}
+	for _, tc := range cases {
+		c := arcane.ContainerDetail{Name: tc.container, Labels: tc.labels}
+		if got := containerBelongsToService(c, "shop", "web"); got != tc.want {
+			t.Errorf("containerBelongsToService(%q, %v) = %t, want %t", tc.container, tc.labels, got, tc.want)
+		}
+	}
+}
+
+func TestIsRunningStatus(t *testing.T) {
+	t.Parallel()
+	cases := map[string]bool{
+		"running":              true,
+		"Running":              true,
+		"Up":                   true,
+		"Up 3 minutes":         true,
+		"Up 2 hours (healthy)": true,
+		"updating":             false,
+		"upgrading":            false,
+		"exited":               false,
+		"":                     false,
+	}
+	for status, want := range cases {
+		if got := isRunningStatus(status); got != want {
+			t.Errorf("isRunningStatus(%q) = %t, want %t", status, got, want)
+		}
+	}
+}
+
+// TestProjectDeploymentResource_GivenAgentRestartsDuringUp_WhenDeployed_ThenReconciledAsRunning
+// validates that a deploy whose connection is reset after the stack started is
+// reconciled from the project status instead of failing the apply.
+func TestProjectDeploymentResource_GivenAgentRestartsDuringUp_WhenDeployed_ThenReconciledAsRunning(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-reset"] = &arcane.Environment{
+		ID:   "env-reset",
+		Name: "reset-env",
+	}
+	mockServer.HealthyEnvs["env-reset"] = true
+	mockServer.AddProject("env-reset", &arcane.Project{
+		ID:            "proj-reset",
+		Name:          "reset-project",
+		Status:        "stopped",
+		EnvironmentID: "env-reset",
+	})
+	mockServer.AddContainers("env-reset", "proj-reset", []arcane.ContainerDetail{
+		{ID: "c-web", Name: "reset-project-web-1", Status: "exited", StartedAt: "2026-01-01T00:00:00Z"},
+	})
+	mockServer.ResetAfterDeploy["proj-reset"] = true
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfig(mockServer.URL, "env-reset", "proj-reset"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenDeployNeverApplied_WhenConnectionReset_ThenError
+// validates that a deploy whose connection is reset before the agent applied
+// it is reported as failed, even though the project is still running its old
+// containers.
+func TestProjectDeploymentResource_GivenDeployNeverApplied_WhenConnectionReset_ThenError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-reset"] = &arcane.Environment{
+		ID:   "env-reset",
+		Name: "reset-env",
+	}
+	mockServer.HealthyEnvs["env-reset"] = true
+	mockServer.AddProject("env-reset", &arcane.Project{
+		ID:            "proj-reset",
+		Name:          "reset-project",
+		Status:        "running",
+		EnvironmentID: "env-reset",
+	})
+	mockServer.AddContainers("env-reset", "proj-reset", []arcane.ContainerDetail{
+		{ID: "c-web", Name: "reset-project-web-1", Status: "running", StartedAt: "2026-01-01T00:00:00Z"},
+	})
+	mockServer.ResetBeforeDeploy["proj-reset"] = true
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testDeploymentConfig(mockServer.URL, "env-reset", "proj-reset"),
+				ExpectError: regexp.MustCompile(`Failed to deploy project`),
+			},
+		},
+	})
+}
+
+func TestDeployCoalesced(t *testing.T) {
+	t.Parallel()
+
+	envClient := newTestClient(t, "http://localhost", ClientSettings{}).ForEnvironment("env-coalesce")
+	ctx := context.Background()
+	req := &arcane.ProjectDeployRequest{PullPolicy: "always"}
+
+	var diags diag.Diagnostics
+	if deployCoalesced(ctx, envClient, "env-coalesce", "proj-web", req, &diags) || len(diags) != 0 {
+		t.Errorf("first deploy: expected no coalescing and no diagnostics, got %v", diags)
+	}
+
+	envClient.MarkProjectDeployed("proj-web", req)
+	diags = nil
+	if !deployCoalesced(ctx, envClient, "env-coalesce", "proj-web", &arcane.ProjectDeployRequest{PullPolicy: "always"}, &diags) || diags.WarningsCount() != 1 {
+		t.Errorf("same options: expected coalescing with a warning, got %v", diags)
+	}
+
+	diags = nil
+	if deployCoalesced(ctx, envClient, "env-coalesce", "proj-web", &arcane.ProjectDeployRequest{PullPolicy: "always", ForceRecreate: true}, &diags) || diags.WarningsCount() != 1 {
+		t.Errorf("different options: expected another deploy with a warning, got %v", diags)
+	}
+}
+
+func TestLockProjectForDeploy_GivenHeldLock_WhenContextDone_ThenReportsCancellationOrTimeout(t *testing.T) {
+	t.Parallel()
+
+	envClient := newTestClient(t, "http://localhost", ClientSettings{}).ForEnvironment("env-lock")
+	unlock, _, err := envClient.LockProject(context.Background(), "proj-lock")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer unlock()
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	var diags diag.Diagnostics
+	if lockProjectForDeploy(cancelled, envClient, "proj-lock", &diags) != nil {
+		t.Fatal("expected the lock not to be acquired")
+	}
+	if got := diags.Errors()[0].Summary(); got != "Deployment cancelled" {
+		t.Errorf("cancelled: summary = %q, want %q", got, "Deployment cancelled")
+	}
+
+	expired, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	diags = nil
+	if lockProjectForDeploy(expired, envClient, "proj-lock", &diags) != nil {
+		t.Fatal("expected the lock not to be acquired")
+	}
+	if got := diags.Errors()[0].Detail(); !strings.Contains(got, "Timed out") {
+		t.Errorf("expired: detail = %q, want a timeout", got)
+	}
+}
+
+func TestContainersStartedSince(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	requested := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockServer.AddProject("env-started", &arcane.Project{ID: "proj-old", Name: "old", EnvironmentID: "env-started"})
+	mockServer.AddContainers("env-started", "proj-old", []arcane.ContainerDetail{
+		{ID: "c-1", Name: "old-web-1", StartedAt: "2026-03-01T11:59:59Z"},
+		{ID: "c-2", Name: "old-db-1"},
+	})
+	mockServer.AddProject("env-started", &arcane.Project{ID: "proj-new", Name: "new", EnvironmentID: "env-started"})
+	mockServer.AddContainers("env-started", "proj-new", []arcane.ContainerDetail{
+		{ID: "c-3", Name: "new-web-1", StartedAt: "2026-03-01T11:00:00Z"},
+		{ID: "c-4", Name: "new-db-1", StartedAt: "2026-03-01T12:00:01.5Z"},
+	})
+	envClient := newMockClient(t, mockServer).ForEnvironment("env-started")
+
+	if containersStartedSince(context.Background(), envClient, "proj-old", requested) {
+		t.Error("expected no container of proj-old to have started since the request")
+	}
+	if !containersStartedSince(context.Background(), envClient, "proj-new", requested) {
+		t.Error("expected a container of proj-new to have started since the request")
+	}
+}
+
+// TestReconcileInterruptedDeploy_GivenSkewedClock validates that container
+// start times are compared with the deploy request on Arcane's clock, measured
+// from the Date header, rather than on the local one.
+func TestReconcileInterruptedDeploy_GivenSkewedClock(t *testing.T) {
+	cases := map[string]struct {
+		skew     time.Duration // Arcane's clock minus the local one
+		started  time.Duration // container start minus the request, on Arcane's clock
+		accepted bool
+	}{
+		"behind, restarted after the request": {skew: -time.Hour, started: 5 * time.Second, accepted: true},
+		"behind, started before the request":  {skew: -time.Hour, started: -10 * time.Minute},
+		"ahead, started before the request":   {skew: time.Hour, started: -10 * time.Minute},
+		"ahead, restarted after the request":  {skew: time.Hour, started: 5 * time.Second, accepted: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			requested := time.Now()
+			arcaneNow := requested.Add(tc.skew)
+
+			mockServer := NewMockServer()
+			defer mockServer.Close()
+			mockServer.ResponseHeaders = http.Header{"Date": {arcaneNow.UTC().Format(http.TimeFormat)}}
+			mockServer.Environments["env-skew"] = &arcane.Environment{ID: "env-skew", Name: "skew-env"}
+			mockServer.AddProject("env-skew", &arcane.Project{ID: "proj-skew", Name: "skew", Status: "running", EnvironmentID: "env-skew"})
+			mockServer.AddContainers("env-skew", "proj-skew", []arcane.ContainerDetail{
+				{ID: "c-1", Name: "skew-web-1", Status: "running", StartedAt: arcaneNow.Add(tc.started).UTC().Format(time.RFC3339)},
+			})
+
+			client := newMockClient(t, mockServer)
+			r := &ProjectDeploymentResource{client: client}
+			var diags diag.Diagnostics
+			deployErr := &arcane.APIError{StatusCode: http.StatusBadGateway, Message: "agent restarted"}
+			got := r.reconcileInterruptedDeploy(context.Background(), client.ForEnvironment("env-skew"), "proj-skew", requested, time.Second, deployErr, &diags)
+			if got != tc.accepted {
+				t.Errorf("reconcileInterruptedDeploy() = %t, want %t", got, tc.accepted)
+			}
+		})
+	}
+}
+
+// TestProjectDeploymentResource_GivenSlowStart_WhenDeployed_ThenStatusPolledUntilRunning
+// validates that a project reporting "starting" right after deploy is polled
+// until it is running, instead of recording the transitional status.
+func TestProjectDeploymentResource_GivenSlowStart_WhenDeployed_ThenStatusPolledUntilRunning(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-slow"] = &arcane.Environment{
+		ID:   "env-slow",
+		Name: "slow-env",
+	}
+	mockServer.HealthyEnvs["env-slow"] = true
+	mockServer.AddProject("env-slow", &arcane.Project{
+		ID:            "proj-slow",
+		Name:          "slow-project",
+		Status:        "stopped",
+		EnvironmentID: "env-slow",
+	})
+	mockServer.StartingAfterDeploy["proj-slow"] = 2
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfig(mockServer.URL, "env-slow", "proj-slow"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenAsyncDeploy_WhenDeployed_ThenDeploymentPolledAndRecorded
+// validates that a deployment ID returned by the deploy call is polled until
+// the deployment finishes and recorded in state with its log URL.
+func TestProjectDeploymentResource_GivenAsyncDeploy_WhenDeployed_ThenDeploymentPolledAndRecorded(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-async"] = &arcane.Environment{
+		ID:   "env-async",
+		Name: "async-env",
+	}
+	mockServer.HealthyEnvs["env-async"] = true
+	mockServer.AddProject("env-async", &arcane.Project{
+		ID:            "proj-async",
+		Name:          "async-project",
+		Status:        "stopped",
+		EnvironmentID: "env-async",
+	})
+	mockServer.AsyncDeploys["proj-async"] = arcane.Job{
+		Status: arcane.JobSucceeded,
+		LogURL: "https://arcane.example.com/deployments/1/log",
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfig(mockServer.URL, "env-async", "proj-async"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "last_deployment_id", "deploy-proj-async-1"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "deploy_log_url", "https://arcane.example.com/deployments/1/log"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "deploy_result", "success"),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenAsyncDeployFails_WhenDeployed_ThenDeploymentFailedError
+// validates that a server-side deployment finishing as failed fails the apply
+// with the deployment's error, instead of being reported from the project status.
+func TestProjectDeploymentResource_GivenAsyncDeployFails_WhenDeployed_ThenDeploymentFailedError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-async"] = &arcane.Environment{
+		ID:   "env-async",
+		Name: "async-env",
+	}
+	mockServer.HealthyEnvs["env-async"] = true
+	mockServer.AddProject("env-async", &arcane.Project{
+		ID:            "proj-async",
+		Name:          "async-project",
+		Status:        "stopped",
+		EnvironmentID: "env-async",
+	})
+	mockServer.AsyncDeploys["proj-async"] = arcane.Job{
+		Status: arcane.JobFailed,
+		Error:  "pull access denied for private/web",
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testDeploymentConfig(mockServer.URL, "env-async", "proj-async"),
+				ExpectError: regexp.MustCompile(`(?s)Deployment failed.*pull access\s+denied`),
+			},
+		},
+	})
+}
+
+// TestIsTerminalProjectStatus validates which project statuses end status polling.
+func TestIsTerminalProjectStatus(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		status string
+		want   bool
+	}{
+		{"running", true},
+		{"Up 2 minutes", true},
+		{"exited", true},
+		{"stopped", true},
+		{"degraded", true},
+		{"starting", false},
+		{"restarting", false},
+		{"deploying", false},
+		{"", false},
+	}
+	for _, tc := range cases {
+		if got := isTerminalProjectStatus(tc.status); got != tc.want {
+			t.Errorf("isTerminalProjectStatus(%q) = %v, want %v", tc.status, got, tc.want)
+		}
+	}
+}
+
+// TestDeployResultForStatus validates the mapping from settled status to deploy_result.
+func TestDeployResultForStatus(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		status string
+		want   string
+	}{
+		{"running", "success"},
+		{"Up 5 seconds", "success"},
+		{"degraded", "partial"},
+		{"exited", "failed"},
+		{"starting", "failed"},
+	}
+	for _, tc := range cases {
+		if got := deployResultForStatus(tc.status); got != tc.want {
+			t.Errorf("deployResultForStatus(%q) = %q, want %q", tc.status, got, tc.want)
+		}
+	}
+}
+
+// TestProjectDeploymentResource_GivenConflict_WhenDeployed_ThenDeploymentInProgressError
+// validates that a 409 from Arcane is reported as a "Deployment in progress" error.
+func TestProjectDeploymentResource_GivenConflict_WhenDeployed_ThenDeploymentInProgressError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-busy"] = &arcane.Environment{
+		ID:   "env-busy",
+		Name: "busy-env",
+	}
+	mockServer.HealthyEnvs["env-busy"] = true
+	mockServer.AddProject("env-busy", &arcane.Project{
+		ID:            "proj-busy",
+		Name:          "busy-project",
+		Status:        "running",
+		EnvironmentID: "env-busy",
+	})
+	mockServer.InjectFault(MockFault{
+		Method:  http.MethodPost,
+		Path:    "/api/environments/env-busy/projects/proj-busy/up",
+		Status:  http.StatusConflict,
+		Message: "project operation already in progress",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testDeploymentConfig(mockServer.URL, "env-busy", "proj-busy"),
+				ExpectError: regexp.MustCompile(`Deployment in progress`),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenDuplicateTargets_WhenApplied_ThenDeployCoalesced
+// validates that two deployments of the same project in one apply result in a
+// single up call, and a single redeploy when both change together.
+func TestProjectDeploymentResource_GivenDuplicateTargets_WhenApplied_ThenDeployCoalesced(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-dup"] = &arcane.Environment{
+		ID:   "env-dup",
+		Name: "dup-env",
+	}
+	mockServer.HealthyEnvs["env-dup"] = true
+	mockServer.AddProject("env-dup", &arcane.Project{
+		ID:            "proj-dup",
+		Name:          "dup-project",
+		Status:        "stopped",
+		EnvironmentID: "env-dup",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfigDuplicate(mockServer.URL, "env-dup", "proj-dup", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.first", "status", "running"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.second", "status", "running"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-dup/projects/proj-dup/up", 1),
+				),
+			},
+			{
+				Config: testDeploymentConfigDuplicate(mockServer.URL, "env-dup", "proj-dup", "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.first", "triggers.version", "v2"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.second", "triggers.version", "v2"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-dup/projects/proj-dup/redeploy", 1),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenOverrideFiles_WhenDeployedAndChanged_ThenPassedAndRedeployed
+// validates that override files are sent with the deploy request in order and
+// that changing them triggers a redeploy.
+func TestProjectDeploymentResource_GivenOverrideFiles_WhenDeployedAndChanged_ThenPassedAndRedeployed(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Strict = true
+	mockServer.Environments["env-override"] = &arcane.Environment{
+		ID:   "env-override",
+		Name: "override-env",
+	}
+	mockServer.HealthyEnvs["env-override"] = true
+	mockServer.AddProject("env-override", &arcane.Project{
+		ID:            "proj-override",
+		Name:          "override-project",
+		Status:        "stopped",
+		EnvironmentID: "env-override",
+	})
+
+	upPath := "/api/environments/env-override/projects/proj-override/up"
+	redeployPath := "/api/environments/env-override/projects/proj-override/redeploy"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfigOverrideFiles(mockServer.URL, "env-override", "proj-override", "services: {}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "override_files.#", "2"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "override_files.0.path", "docker-compose.prod.yml"),
+					mockServer.CheckRequestCount(http.MethodPost, upPath, 1),
+					checkDeployOverrideFiles(mockServer, upPath, []arcane.ComposeOverrideFile{
+						{Path: "docker-compose.prod.yml"},
+						{Content: "services: {}"},
+					}),
+				),
+			},
+			{
+				Config: testDeploymentConfigOverrideFiles(mockServer.URL, "env-override", "proj-override", "services:\n  web: {}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					mockServer.CheckRequestCount(http.MethodPost, redeployPath, 1),
+					checkDeployOverrideFiles(mockServer, redeployPath, []arcane.ComposeOverrideFile{
+						{Path: "docker-compose.prod.yml"},
+						{Content: "services:\n  web: {}"},
+					}),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenOverrideFileWithPathAndContent_WhenValidated_ThenError
+// validates that each override file must set exactly one of path or content.
+func TestProjectDeploymentResource_GivenOverrideFileWithPathAndContent_WhenValidated_ThenError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "arcane" {
+  url = "http://localhost:1"
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = "env-1"
+  project_id     = "proj-1"
+
+  override_files = [
+    { path = "docker-compose.prod.yml", content = "services: {}" },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid override file`),
+			},
+		},
+	})
+}
+
+// checkDeployOverrideFiles asserts the override files sent in the last request
+// to path.
+func checkDeployOverrideFiles(ms *MockServer, path string, want []arcane.ComposeOverrideFile) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		var body []byte
+		for _, req := range ms.Requests() {
+			if req.Path == path {
+				body = req.Body
+			}
+		}
+		var got arcane.ProjectDeployRequest
+		if err := json.Unmarshal(body, &got); err != nil {
+			return fmt.Errorf("decoding %s body %q: %w", path, body, err)
+		}
+		if !reflect.DeepEqual(got.OverrideFiles, want) {
+			return fmt.Errorf("override files sent to %s = %+v, want %+v", path, got.OverrideFiles, want)
+		}
+		return nil
+	}
+}
+
+// TestProjectDeploymentResource_GivenBuild_WhenDeployedAndNoCacheEnabled_ThenBuildOptionsSentAndRedeployed
+// validates that build and no_cache are sent to Arcane and that changing them
+// triggers a redeploy.
+func TestProjectDeploymentResource_GivenBuild_WhenDeployedAndNoCacheEnabled_ThenBuildOptionsSentAndRedeployed(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-build"] = &arcane.Environment{
+		ID:   "env-build",
+		Name: "build-env",
+	}
+	mockServer.HealthyEnvs["env-build"] = true
+	mockServer.AddProject("env-build", &arcane.Project{
+		ID:            "proj-build",
+		Name:          "build-project",
+		Status:        "stopped",
+		EnvironmentID: "env-build",
+	})
+
+	upPath := "/api/environments/env-build/projects/proj-build/up"
+	redeployPath := "/api/environments/env-build/projects/proj-build/redeploy"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfigBuild(mockServer.URL, "env-build", "proj-build", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "build", "true"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "no_cache", "false"),
+					checkDeployBuildOptions(mockServer, upPath, true, false),
+				),
+			},
+			{
+				Config: testDeploymentConfigBuild(mockServer.URL, "env-build", "proj-build", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "no_cache", "true"),
+					mockServer.CheckRequestCount(http.MethodPost, redeployPath, 1),
+					checkDeployBuildOptions(mockServer, redeployPath, true, true),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenNoCacheWithoutBuild_WhenValidated_ThenError
+// validates that no_cache requires build.
+func TestProjectDeploymentResource_GivenNoCacheWithoutBuild_WhenValidated_ThenError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "arcane" {
+  url = "http://localhost:1"
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = "env-1"
+  project_id     = "proj-1"
+  no_cache       = true
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid no_cache`),
+			},
+		},
+	})
+}
+
+// checkDeployBuildOptions asserts the build options sent in the last request
+// to path.
+func checkDeployBuildOptions(ms *MockServer, path string, build, noCache bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		var body []byte
+		for _, req := range ms.Requests() {
+			if req.Path == path {
+				body = req.Body
+			}
+		}
+		var got arcane.ProjectDeployRequest
+		if err := json.Unmarshal(body, &got); err != nil {
+			return fmt.Errorf("decoding %s body %q: %w", path, body, err)
+		}
+		if got.Build != build || got.NoCache != noCache {
+			return fmt.Errorf("build options sent to %s = build:%t no_cache:%t, want build:%t no_cache:%t",
+				path, got.Build, got.NoCache, build, noCache)
+		}
+		return nil
+	}
+}
+
+// TestProjectDeploymentResource_GivenForbidden_WhenDeployed_ThenError validates
+// that an authorization failure on deploy surfaces as a deploy error.
+func TestProjectDeploymentResource_GivenForbidden_WhenDeployed_ThenError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-denied"] = &arcane.Environment{
+		ID:   "env-denied",
+		Name: "denied-env",
+	}
+	mockServer.HealthyEnvs["env-denied"] = true
+	mockServer.AddProject("env-denied", &arcane.Project{
+		ID:            "proj-denied",
+		Name:          "denied-project",
+		Status:        "stopped",
+		EnvironmentID: "env-denied",
+	})
+	mockServer.InjectFault(MockFault{
+		Method: http.MethodPost,
+		Path:   "/api/environments/env-denied/projects/proj-denied/up",
+		Status: http.StatusForbidden,
+	})
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Step 1: Create
 			{
-				Config: config,
+				Config:      testDeploymentConfig(mockServer.URL, "env-denied", "proj-denied"),
+				ExpectError: regexp.MustCompile(`Failed to deploy project`),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenProviderDefaultDeployOptions_WhenUnset_ThenInherited
+// validates that deployments inherit default_deploy_options unless they set
+// the option explicitly, and that changing a default redeploys them.
+func TestProjectDeploymentResource_GivenProviderDefaultDeployOptions_WhenUnset_ThenInherited(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-defaults"] = &arcane.Environment{
+		ID:   "env-defaults",
+		Name: "defaults-env",
+	}
+	mockServer.HealthyEnvs["env-defaults"] = true
+	for _, id := range []string{"proj-inherit", "proj-explicit"} {
+		mockServer.AddProject("env-defaults", &arcane.Project{
+			ID:            id,
+			Name:          id,
+			Status:        "stopped",
+			EnvironmentID: "env-defaults",
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create with provider defaults
+			{
+				Config: testDeploymentConfigProviderDefaults(mockServer.URL, "env-defaults", true),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("arcane_project_deployment.test", "pull", "true"),
-					resource.TestCheckResourceAttr("arcane_project_deployment.test", "force_recreate", "false"),
-					resource.TestCheckResourceAttr("arcane_project_deployment.test", "remove_orphans", "true"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.inherit", "pull", "true"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.inherit", "remove_orphans", "true"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.inherit", "force_recreate", "false"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.explicit", "pull", "false"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.explicit", "remove_orphans", "true"),
 				),
 			},
 			// Step 2: Re-apply identical config -- should produce empty plan
 			{
-				Config:   config,
+				Config:   testDeploymentConfigProviderDefaults(mockServer.URL, "env-defaults", true),
 				PlanOnly: true,
 			},
+			// Step 3: Change the provider default -- the inheriting deployment is redeployed
+			{
+				Config: testDeploymentConfigProviderDefaults(mockServer.URL, "env-defaults", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_deployment.inherit", "pull", "false"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.inherit", "status", "running"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.explicit", "pull", "false"),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectDeploymentResource_GivenFullStack_WhenDestroyed_ThenProjectStoppedBeforeEnvironmentDeleted
+// validates that tearing down an environment together with its deployment
+// stops the project and deletes both.
+func TestProjectDeploymentResource_GivenFullStack_WhenDestroyed_ThenProjectStoppedBeforeEnvironmentDeleted(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddProject("env-teardown", &arcane.Project{
+		ID:            "proj-teardown",
+		Name:          "teardown-project",
+		Status:        "stopped",
+		EnvironmentID: "env-teardown",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfigFullStack(mockServer.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_environment.test", "id", "env-teardown"),
+					resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
+				),
+			},
+		},
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-teardown/projects/proj-teardown/down", 1),
+			mockServer.CheckRequestCount(http.MethodDelete, "/api/environments/env-teardown", 1),
+		),
+	})
+}
+
+// TestProjectDeploymentResource_GivenEnvironmentDeleted_WhenDestroyed_ThenSucceeds
+// validates that a deployment whose environment was deleted first (e.g. a
+// hard-coded environment_id in the same apply) is destroyed without error,
+// even when stopping the project fails.
+func TestProjectDeploymentResource_GivenEnvironmentDeleted_WhenDestroyed_ThenSucceeds(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-gone"] = &arcane.Environment{
+		ID:   "env-gone",
+		Name: "gone-env",
+	}
+	mockServer.HealthyEnvs["env-gone"] = true
+	mockServer.AddProject("env-gone", &arcane.Project{
+		ID:            "proj-gone",
+		Name:          "gone-project",
+		Status:        "stopped",
+		EnvironmentID: "env-gone",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentConfigWithStopOnDelete(mockServer.URL, "env-gone", "proj-gone", true),
+				Check:  resource.TestCheckResourceAttr("arcane_project_deployment.test", "status", "running"),
+			},
+			{
+				PreConfig: func() {
+					delete(mockServer.Environments, "env-gone")
+					delete(mockServer.HealthyEnvs, "env-gone")
+					mockServer.InjectFault(MockFault{
+						Method: http.MethodPost,
+						Path:   "/api/environments/env-gone/projects/proj-gone/down",
+						Status: http.StatusBadGateway,
+					})
+				},
+				Config: testDeploymentConfigEmpty(mockServer.URL),
+				Check:  mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-gone/projects/proj-gone/down", 1),
+			},
 		},
 	})
 }
+
+func testDeploymentConfigDuplicate(url, envID, projectID, version string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "first" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+
+  triggers = {
+    version = %[4]q
+  }
+}
+
+resource "arcane_project_deployment" "second" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+
+  triggers = {
+    version = %[4]q
+  }
+}
+`, url, envID, projectID, version)
+}
+
+func testDeploymentConfigOverrideFiles(url, envID, projectID, overrideContent string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+
+  override_files = [
+    { path = "docker-compose.prod.yml" },
+    { content = %[4]q },
+  ]
+}
+`, url, envID, projectID, overrideContent)
+}
+
+func testDeploymentConfigProviderDefaults(url, envID string, defaultPull bool) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+
+  default_deploy_options {
+    pull           = %[3]t
+    remove_orphans = true
+  }
+}
+
+resource "arcane_project_deployment" "inherit" {
+  environment_id = %[2]q
+  project_id     = "proj-inherit"
+}
+
+resource "arcane_project_deployment" "explicit" {
+  environment_id = %[2]q
+  project_id     = "proj-explicit"
+  pull           = false
+}
+`, url, envID, defaultPull)
+}
+
+func testDeploymentConfigFullStack(url string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_environment" "test" {
+  name    = "teardown"
+  api_url = "http://10.100.1.100:3553"
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = arcane_environment.test.id
+  project_id     = "proj-teardown"
+  stop_on_delete = true
+}
+`, url)
+}
+
+func testDeploymentConfigBuild(url, envID, projectID string, noCache bool) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+  build          = true
+  no_cache       = %[4]t
+}
+`, url, envID, projectID, noCache)
+}
+
+func testDeploymentConfigHealthPolicy(url, envID, projectID, policy string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+  health_policy  = %[4]q
+}
+`, url, envID, projectID, policy)
+}
+
+func testDeploymentConfigBlueGreen(url, envID, projectID, version string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+  strategy       = "blue_green"
+  wait_timeout   = "5s"
+
+  triggers = {
+    version = %[4]q
+  }
+}
+`, url, envID, projectID, version)
+}
+
+func testDeploymentConfigBlueGreenHTTPCheck(url, checkURL, version string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = "env-bg"
+  project_id     = "proj-blue"
+  strategy       = "blue_green"
+  wait_timeout   = "5s"
+
+  http_check = {
+    url     = %[2]q
+    timeout = "1s"
+  }
+
+  triggers = {
+    version = %[3]q
+  }
+}
+`, url, checkURL, version)
+}
+
+func testDeploymentConfigStagger(url, envID, projectID, version string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+  stagger        = "10ms"
+
+  triggers = {
+    version = %[4]q
+  }
+}
+`, url, envID, projectID, version)
+}
+
+func testDeploymentConfigHTTPCheck(url, checkURL, version string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = "env-http"
+  project_id     = "proj-http"
+
+  http_check = {
+    url     = %[2]q
+    timeout = "1s"
+  }
+
+  triggers = {
+    version = %[3]q
+  }
+}
+`, url, checkURL, version)
+}
+
+func testDeploymentConfigDependsOnRegistries(url string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id        = "env-reg"
+  project_id            = "proj-reg"
+  depends_on_registries = ["reg-ghcr"]
+}
+`, url)
+}
+
+func testDeploymentConfigWaitForHealthy(url, timeout string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id       = "env-wait"
+  project_id           = "proj-wait"
+  wait_for_healthy     = true
+  health_check_timeout = %[2]q
+}
+`, url, timeout)
+}
+
+func testDeploymentConfigRemoveOrphans(url, version string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = "env-orphans"
+  project_id     = "proj-orphans"
+  remove_orphans = true
+
+  triggers = {
+    version = %[2]q
+  }
+}
+`, url, version)
+}
+
+func testDeploymentConfigOwnership(url, ownership string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = "env-owner"
+  project_id     = "proj-owner"
+  %[2]s
+}
+`, url, ownership)
+}
+
+func testDeploymentConfigUpdater(url, updater string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_deployment" "test" {
+  environment_id = "env-updater"
+  project_id     = "proj-updater"
+  %[2]s
+}
+`, url, updater)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// staggerDuration returns the parsed stagger, or zero when it is unset.
+// ValidateConfig has already rejected values that don't parse.
+func (m *ProjectDeploymentResourceModel) staggerDuration() time.Duration {
+	if m.Stagger.IsNull() || m.Stagger.IsUnknown() {
+		return 0
+	}
+	d, _ := time.ParseDuration(m.Stagger.ValueString())
+	return d
+}
+
+// redeployStaggered redeploys a project one service at a time, in the order
+// the project lists them. After each service it waits, within timeout, for
+// the service's containers to be healthy under policy, and then for stagger
+// before moving on, so a bad change stops at the first service it breaks.
+// Projects with fewer than two services are redeployed in one go. It returns
+// the deployment ID and job of the last service; false when an error was
+// added.
+func (r *ProjectDeploymentResource) redeployStaggered(ctx context.Context, envClient *EnvironmentClient, projectID string, deployReq *arcane.ProjectDeployRequest, policy string, stagger, timeout time.Duration, diags *diag.Diagnostics) (string, *arcane.Job, bool) {
+	project, err := envClient.GetProject(ctx, projectID)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, diags, err, "Failed to get project")
+		return "", nil, false
+	}
+
+	services := []string{""}
+	if len(project.Services) > 1 {
+		services = services[:0]
+		for _, svc := range project.Services {
+			services = append(services, svc.Name)
+		}
+	}
+
+	var deploymentID string
+	var job *arcane.Job
+	for i, service := range services {
+		serviceReq := *deployReq
+		if service != "" {
+			serviceReq.Services = []string{service}
+			tflog.Info(ctx, "Redeploying service", map[string]interface{}{
+				"project_id": projectID,
+				"service":    service,
+			})
+		}
+
+		serviceStart := time.Now()
+		id, err := envClient.RedeployProject(ctx, projectID, &serviceReq)
+		if err != nil {
+			if !r.reconcileInterruptedDeploy(ctx, envClient, projectID, serviceStart, timeout, err, diags) {
+				addDeployError(ctx, diags, "Failed to redeploy project", projectID, err)
+				return "", nil, false
+			}
+		}
+		deploymentID = id
+		envClient.MarkProjectDeployed(projectID, deployReq)
+
+		job, err = r.waitForDeployment(ctx, envClient, deploymentID, timeout, diags)
+		if err != nil {
+			diagnostics.AddAPIError(ctx, diags, err, "Failed to get deployment status")
+			return "", nil, false
+		}
+		if diags.HasError() || service == "" {
+			return deploymentID, job, !diags.HasError()
+		}
+
+		inService := func(c arcane.ContainerDetail) bool { return containerBelongsToService(c, project.Name, service) }
+		health, _, _ := pollContainerHealth(ctx, envClient, project.ID, policy, max(timeout-time.Since(serviceStart), 0), inService)
+		if health != projectHealthHealthy {
+			detail := fmt.Sprintf("Service %q of project %q reported health %q under health_policy %q within %s.",
+				service, project.Name, health, policy, timeout)
+			if rest := services[i+1:]; len(rest) > 0 {
+				detail += fmt.Sprintf(" These services were not redeployed: %s.", strings.Join(rest, ", "))
+			}
+			diagnostics.AddError(ctx, diags, diagnostics.CodeDeployFailed, "Staggered redeploy halted", detail)
+			return "", nil, false
+		}
+
+		if i < len(services)-1 {
+			select {
+			case <-ctx.Done():
+				diagnostics.AddAPIError(ctx, diags, ctx.Err(), "Staggered redeploy interrupted")
+				return "", nil, false
+			case <-time.After(stagger):
+			}
+		}
+	}
+	return deploymentID, job, true
+}
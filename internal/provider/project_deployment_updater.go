@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// updaterModel describes the updater attribute.
+type updaterModel struct {
+	CheckInterval durationStringValue `tfsdk:"check_interval"`
+	AutoApply     types.Bool          `tfsdk:"auto_apply"`
+	Enabled       types.Bool          `tfsdk:"enabled"`
+}
+
+// updaterAttrTypes are the attribute types of the updater attribute.
+var updaterAttrTypes = map[string]attr.Type{
+	"check_interval": durationStringType{},
+	"auto_apply":     types.BoolType,
+	"enabled":        types.BoolType,
+}
+
+func updaterAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "A per-project image update schedule, overriding Arcane's global update settings for this project. " +
+			"Arcane checks the project's images every `check_interval` and, with `auto_apply`, redeploys the project when a " +
+			"newer image is found. Removing it, or destroying the resource, reverts the project to the global settings. " +
+			"Changing it does not redeploy. Not supported with the `blue_green` strategy, which deploys to a different project each time.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"check_interval": schema.StringAttribute{
+				MarkdownDescription: "How often to check for newer images (e.g. `30m`, `6h`). Arcane may report the interval " +
+					"in another notation, such as `21600` for `6h`; the same duration is not a change.",
+				Required:   true,
+				CustomType: durationStringType{},
+			},
+			"auto_apply": schema.BoolAttribute{
+				MarkdownDescription: "Whether to redeploy the project when a newer image is found, rather than only reporting it. Defaults to `false`.",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Set to `false` to pause the schedule without reverting to the global settings. Defaults to `true`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// updater returns the configured updater, or nil when it is unset.
+func (m *ProjectDeploymentResourceModel) updater(ctx context.Context) (*updaterModel, diag.Diagnostics) {
+	if m.Updater.IsNull() || m.Updater.IsUnknown() {
+		return nil, nil
+	}
+	var u updaterModel
+	diags := m.Updater.As(ctx, &u, basetypes.ObjectAsOptions{})
+	return &u, diags
+}
+
+// request returns the schedule to send for u, applying the defaults of unset
+// attributes.
+func (u *updaterModel) request() *arcane.ProjectUpdater {
+	return &arcane.ProjectUpdater{
+		Enabled:       u.Enabled.IsNull() || u.Enabled.ValueBool(),
+		CheckInterval: u.CheckInterval.ValueString(),
+		AutoApply:     u.AutoApply.ValueBool(),
+	}
+}
+
+// validateUpdater rejects an updater whose check_interval is invalid, or one
+// combined with the blue_green strategy.
+func validateUpdater(updater types.Object, strategy types.String, diags *diag.Diagnostics) {
+	if updater.IsNull() || updater.IsUnknown() {
+		return
+	}
+	attrPath := path.Root("updater")
+
+	if strategy.ValueString() == deployStrategyBlueGreen {
+		diags.AddAttributeError(attrPath, "Invalid updater",
+			"updater doesn't apply to the blue_green strategy, which deploys to a different project each time; "+
+				"configure image updates in Arcane's global settings instead.")
+	}
+	interval, ok := updater.Attributes()["check_interval"].(durationStringValue)
+	if !ok || interval.IsNull() || interval.IsUnknown() {
+		return
+	}
+	if d, err := parseFlexibleDuration(interval.ValueString()); err != nil || d <= 0 {
+		diags.AddAttributeError(attrPath.AtName("check_interval"), "Invalid updater check_interval",
+			fmt.Sprintf("Expected a positive duration such as 30m or 6h, got %q.", interval.ValueString()))
+	}
+}
+
+// applyProjectUpdater sets the update schedule of the project serving data
+// after an apply, or removes it when updater is unset and wasSet, i.e. the
+// resource set a schedule before. Failures are reported as warnings, since
+// the deployment itself succeeded, and prior is kept in data so the next plan
+// retries the change.
+func applyProjectUpdater(ctx context.Context, envClient *EnvironmentClient, data *ProjectDeploymentResourceModel, prior types.Object, diags *diag.Diagnostics) {
+	updater, d := data.updater(ctx)
+	diags.Append(d...)
+	if diags.HasError() || (updater == nil && prior.IsNull()) {
+		return
+	}
+
+	projectID := data.activeProjectID()
+	var err error
+	if updater != nil {
+		_, err = envClient.PutProjectUpdater(ctx, projectID, updater.request())
+	} else if err = envClient.DeleteProjectUpdater(ctx, projectID); arcane.IsNotFound(err) {
+		err = nil
+	}
+	if err != nil {
+		diags.AddWarning(
+			"Failed to update project update schedule",
+			fmt.Sprintf("The project %s was deployed, but its update schedule could not be changed: %s. "+
+				"The next plan retries the change.", projectID, err),
+		)
+		data.Updater = prior
+	}
+}
+
+// removeProjectUpdater removes the update schedule of the project serving
+// data on destroy, so the project reverts to Arcane's global update settings.
+func removeProjectUpdater(ctx context.Context, envClient *EnvironmentClient, data *ProjectDeploymentResourceModel) {
+	if data.Updater.IsNull() {
+		return
+	}
+	projectID := data.activeProjectID()
+	if err := envClient.DeleteProjectUpdater(ctx, projectID); err != nil && !arcane.IsNotFound(err) {
+		tflog.Warn(ctx, "Could not remove project update schedule", map[string]interface{}{
+			"project_id": projectID,
+			"error":      err.Error(),
+		})
+	}
+}
+
+// refreshProjectUpdater reads the update schedule of the project serving data
+// into data when the resource manages one. A schedule removed outside
+// Terraform leaves updater null, so the next plan sets it again.
+func refreshProjectUpdater(ctx context.Context, envClient *EnvironmentClient, data *ProjectDeploymentResourceModel, diags *diag.Diagnostics) {
+	prior, d := data.updater(ctx)
+	diags.Append(d...)
+	if diags.HasError() || prior == nil {
+		return
+	}
+
+	current, err := envClient.GetProjectUpdater(ctx, data.activeProjectID())
+	if err != nil {
+		if arcane.IsNotFound(err) {
+			data.Updater = types.ObjectNull(updaterAttrTypes)
+			return
+		}
+		diagnostics.AddAPIError(ctx, diags, err, "Failed to read project update schedule")
+		return
+	}
+
+	// Unset attributes stay unset while Arcane reports their defaults.
+	refreshed := updaterModel{
+		CheckInterval: reconcileDurationString(prior.CheckInterval, current.CheckInterval),
+		AutoApply:     prior.AutoApply,
+		Enabled:       prior.Enabled,
+	}
+	if !prior.AutoApply.IsNull() || current.AutoApply {
+		refreshed.AutoApply = types.BoolValue(current.AutoApply)
+	}
+	if !prior.Enabled.IsNull() || !current.Enabled {
+		refreshed.Enabled = types.BoolValue(current.Enabled)
+	}
+	value, d := types.ObjectValueFrom(ctx, updaterAttrTypes, refreshed)
+	diags.Append(d...)
+	data.Updater = value
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// defaultHealthCheckTimeout is how long wait_for_healthy waits when
+// health_check_timeout is unset.
+const defaultHealthCheckTimeout = 5 * time.Minute
+
+// healthCheckTimeout returns the parsed health_check_timeout, or its default
+// when unset. ValidateConfig has already rejected values that don't parse.
+func (m *ProjectDeploymentResourceModel) healthCheckTimeout() time.Duration {
+	if m.HealthCheckTimeout.IsNull() || m.HealthCheckTimeout.IsUnknown() {
+		return defaultHealthCheckTimeout
+	}
+	d, _ := time.ParseDuration(m.HealthCheckTimeout.ValueString())
+	return d
+}
+
+// validateHealthCheckTimeout rejects a health_check_timeout that isn't a
+// positive Go duration.
+func validateHealthCheckTimeout(timeout types.String, diags *diag.Diagnostics) {
+	if timeout.IsNull() || timeout.IsUnknown() {
+		return
+	}
+	if d, err := time.ParseDuration(timeout.ValueString()); err != nil || d <= 0 {
+		diags.AddAttributeError(
+			path.Root("health_check_timeout"),
+			"Invalid health_check_timeout",
+			fmt.Sprintf("Expected a positive Go duration such as 30s or 5m, got %q.", timeout.ValueString()),
+		)
+	}
+}
+
+// waitForHealthyContainers waits, when wait_for_healthy is set, for the
+// containers of project to be healthy under health_policy, polling them until
+// health_check_timeout elapses. It adds an error listing the containers that
+// are not healthy and returns false when they never were.
+func waitForHealthyContainers(ctx context.Context, envClient *EnvironmentClient, data *ProjectDeploymentResourceModel, project *arcane.Project, diags *diag.Diagnostics) bool {
+	if !data.WaitForHealthy.ValueBool() {
+		return true
+	}
+	timeout := data.healthCheckTimeout()
+	policy := data.HealthPolicy.ValueString()
+
+	health, containers, err := pollContainerHealth(ctx, envClient, project.ID, policy, timeout, nil)
+	if health == projectHealthHealthy {
+		tflog.Info(ctx, "Project containers are healthy", map[string]interface{}{
+			"project_id": project.ID,
+			"containers": len(containers),
+		})
+		return true
+	}
+
+	var detail string
+	switch {
+	case err != nil:
+		detail = fmt.Sprintf("Its containers could not be listed: %s", err)
+	case len(containers) == 0:
+		detail = "It has no containers."
+	default:
+		var lines []string
+		for _, c := range containers {
+			if isHealthyContainer(c) {
+				continue
+			}
+			health := c.Health
+			if health == "" {
+				health = "no health check"
+			}
+			lines = append(lines, fmt.Sprintf("- %s: %s, %s", c.Name, c.Status, health))
+		}
+		detail = "Containers that are not healthy:\n" + strings.Join(lines, "\n")
+	}
+	diags.AddError(
+		"Project did not become healthy",
+		fmt.Sprintf("Project %q was deployed, but its containers were not healthy under health_policy %q within %s. %s",
+			project.Name, policy, timeout, detail),
+	)
+	return false
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectEndpointsDataSource{}
+
+// NewProjectEndpointsDataSource returns a new project endpoints data source.
+func NewProjectEndpointsDataSource() datasource.DataSource {
+	return &ProjectEndpointsDataSource{}
+}
+
+// ProjectEndpointsDataSource defines the project endpoints data source implementation.
+type ProjectEndpointsDataSource struct {
+	client *Client
+}
+
+// ProjectEndpointsDataSourceModel describes the project endpoints data source data model.
+type ProjectEndpointsDataSourceModel struct {
+	EnvironmentID types.String           `tfsdk:"environment_id"`
+	ProjectID     types.String           `tfsdk:"project_id"`
+	Host          types.String           `tfsdk:"host"`
+	Endpoints     []serviceEndpointModel `tfsdk:"endpoints"`
+}
+
+// serviceEndpointModel describes an element of endpoints.
+type serviceEndpointModel struct {
+	Container     types.String `tfsdk:"container"`
+	HostPort      types.Int64  `tfsdk:"host_port"`
+	ContainerPort types.Int64  `tfsdk:"container_port"`
+	Protocol      types.String `tfsdk:"protocol"`
+	URL           types.String `tfsdk:"url"`
+}
+
+func (d *ProjectEndpointsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_endpoints"
+}
+
+func (d *ProjectEndpointsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to list the reachable endpoints of a project's published ports.
+
+Each port a container publishes on its host is combined with the host of the environment's
+` + "`api_url`" + ` (or ` + "`host`" + `) into a URL such as ` + "`http://10.0.0.5:8080`" + `, so DNS records or
+reverse-proxy configuration can be generated from what is actually deployed.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_project_endpoints" "webapp" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+}
+
+output "webapp_urls" {
+  value = [for e in data.arcane_project_endpoints.webapp.endpoints : e.url]
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment containing the project.",
+				Required:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project to query.",
+				Required:            true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The host name or address the endpoints are reached at. Defaults to the host of the environment's `api_url`; set it when the agent's API is reached through another address than its services (e.g. a VPN or DNS name).",
+				Optional:            true,
+				Computed:            true,
+			},
+			"endpoints": schema.ListNestedAttribute{
+				MarkdownDescription: "The published ports of the project's containers. Ports that are not published on the host are omitted.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"container": schema.StringAttribute{
+							MarkdownDescription: "The name of the container publishing the port.",
+							Computed:            true,
+						},
+						"host_port": schema.Int64Attribute{
+							MarkdownDescription: "The port on the host.",
+							Computed:            true,
+						},
+						"container_port": schema.Int64Attribute{
+							MarkdownDescription: "The port inside the container.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "The protocol (tcp, udp).",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The endpoint URL: `https://` for host port 443, `udp://` for UDP ports and `http://` otherwise.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectEndpointsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ProjectEndpointsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data ProjectEndpointsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.RedactRuntimeDetails() {
+		resp.Diagnostics.AddError(
+			"Runtime details redacted",
+			"The provider is configured with redact_runtime_details, so published ports can't be read into state.",
+		)
+		return
+	}
+
+	if data.Host.IsNull() || data.Host.IsUnknown() {
+		env, err := d.client.GetEnvironment(ctx, data.EnvironmentID.ValueString())
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read environment")
+			return
+		}
+		host := apiURLHost(env.APIURL)
+		if host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("host"),
+				"Missing endpoint host",
+				fmt.Sprintf("Environment %q has no api_url host to build endpoints from; set host explicitly.", data.EnvironmentID.ValueString()),
+			)
+			return
+		}
+		data.Host = types.StringValue(host)
+	}
+
+	envClient := d.client.ForEnvironment(data.EnvironmentID.ValueString())
+	containers, err := envClient.GetProjectContainers(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read project containers")
+		return
+	}
+
+	data.Endpoints = serviceEndpoints(data.Host.ValueString(), containers)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apiURLHost returns the host name of an environment's api_url, without port.
+func apiURLHost(apiURL string) string {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+// serviceEndpoints returns an endpoint for every port the containers publish
+// on host, in container and port order as reported by Arcane.
+func serviceEndpoints(host string, containers []arcane.ContainerDetail) []serviceEndpointModel {
+	endpoints := []serviceEndpointModel{}
+	for _, c := range containers {
+		for _, p := range c.Ports {
+			if p.HostPort == 0 {
+				continue
+			}
+
+			scheme := "http"
+			switch {
+			case p.Protocol == "udp":
+				scheme = "udp"
+			case p.HostPort == 443:
+				scheme = "https"
+			}
+
+			endpoints = append(endpoints, serviceEndpointModel{
+				Container:     types.StringValue(c.Name),
+				HostPort:      types.Int64Value(int64(p.HostPort)),
+				ContainerPort: types.Int64Value(int64(p.ContainerPort)),
+				Protocol:      types.StringValue(p.Protocol),
+				URL:           types.StringValue(scheme + "://" + net.JoinHostPort(host, strconv.Itoa(p.HostPort))),
+			})
+		}
+	}
+	return endpoints
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestProjectEndpointsDataSource_GivenPublishedPorts_WhenRead_ThenURLsUseAPIURLHost
+// validates that published ports are combined with the host of the environment's api_url.
+func TestProjectEndpointsDataSource_GivenPublishedPorts_WhenRead_ThenURLsUseAPIURLHost(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	envID := "env-endpoints-1"
+	projectID := "proj-endpoints-1"
+
+	mockServer.Environments[envID] = &arcane.Environment{
+		ID:     envID,
+		Name:   "endpoints-test-env",
+		APIURL: "http://10.0.0.5:3552",
+	}
+	mockServer.HealthyEnvs[envID] = true
+	mockServer.AddProject(envID, &arcane.Project{ID: projectID, Name: "webapp", EnvironmentID: envID})
+	mockServer.AddContainers(envID, projectID, []arcane.ContainerDetail{
+		{
+			ID:   "c1",
+			Name: "web",
+			Ports: []arcane.ContainerPort{
+				{HostPort: 8080, ContainerPort: 80, Protocol: "tcp"},
+				{HostPort: 443, ContainerPort: 8443, Protocol: "tcp"},
+				{ContainerPort: 9000, Protocol: "tcp"},
+			},
+		},
+		{
+			ID:   "c2",
+			Name: "dns",
+			Ports: []arcane.ContainerPort{
+				{HostPort: 5353, ContainerPort: 53, Protocol: "udp"},
+			},
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectEndpointsDataSourceConfig(mockServer.URL, envID, projectID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_project_endpoints.test", "host", "10.0.0.5"),
+					resource.TestCheckResourceAttr("data.arcane_project_endpoints.test", "endpoints.#", "3"),
+					resource.TestCheckResourceAttr("data.arcane_project_endpoints.test", "endpoints.0.container", "web"),
+					resource.TestCheckResourceAttr("data.arcane_project_endpoints.test", "endpoints.0.host_port", "8080"),
+					resource.TestCheckResourceAttr("data.arcane_project_endpoints.test", "endpoints.0.container_port", "80"),
+					resource.TestCheckResourceAttr("data.arcane_project_endpoints.test", "endpoints.0.url", "http://10.0.0.5:8080"),
+					resource.TestCheckResourceAttr("data.arcane_project_endpoints.test", "endpoints.1.url", "https://10.0.0.5:443"),
+					resource.TestCheckResourceAttr("data.arcane_project_endpoints.test", "endpoints.2.container", "dns"),
+					resource.TestCheckResourceAttr("data.arcane_project_endpoints.test", "endpoints.2.url", "udp://10.0.0.5:5353"),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectEndpointsDataSource_GivenHost_WhenRead_ThenURLsUseHost
+// validates that an explicit host takes precedence over the environment's api_url.
+func TestProjectEndpointsDataSource_GivenHost_WhenRead_ThenURLsUseHost(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	envID := "env-endpoints-2"
+	projectID := "proj-endpoints-2"
+
+	mockServer.Environments[envID] = &arcane.Environment{ID: envID, Name: "endpoints-host-env"}
+	mockServer.HealthyEnvs[envID] = true
+	mockServer.AddProject(envID, &arcane.Project{ID: projectID, Name: "webapp", EnvironmentID: envID})
+	mockServer.AddContainers(envID, projectID, []arcane.ContainerDetail{
+		{ID: "c1", Name: "web", Ports: []arcane.ContainerPort{{HostPort: 8080, ContainerPort: 80, Protocol: "tcp"}}},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectEndpointsDataSourceConfigHost(mockServer.URL, envID, projectID, "apps.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_project_endpoints.test", "endpoints.#", "1"),
+					resource.TestCheckResourceAttr("data.arcane_project_endpoints.test", "endpoints.0.url", "http://apps.example.com:8080"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceEndpoints(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		host  string
+		ports []arcane.ContainerPort
+		want  []string
+	}{
+		{name: "http", host: "10.0.0.5", ports: []arcane.ContainerPort{{HostPort: 8080, ContainerPort: 80, Protocol: "tcp"}}, want: []string{"http://10.0.0.5:8080"}},
+		{name: "https", host: "10.0.0.5", ports: []arcane.ContainerPort{{HostPort: 443, ContainerPort: 443, Protocol: "tcp"}}, want: []string{"https://10.0.0.5:443"}},
+		{name: "udp", host: "10.0.0.5", ports: []arcane.ContainerPort{{HostPort: 443, ContainerPort: 443, Protocol: "udp"}}, want: []string{"udp://10.0.0.5:443"}},
+		{name: "unpublished", host: "10.0.0.5", ports: []arcane.ContainerPort{{ContainerPort: 80, Protocol: "tcp"}}, want: nil},
+		{name: "ipv6", host: "fd00::5", ports: []arcane.ContainerPort{{HostPort: 8080, ContainerPort: 80, Protocol: "tcp"}}, want: []string{"http://[fd00::5]:8080"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			endpoints := serviceEndpoints(tc.host, []arcane.ContainerDetail{{Name: "web", Ports: tc.ports}})
+
+			var got []string
+			for _, e := range endpoints {
+				got = append(got, e.URL.ValueString())
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Errorf("urls = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAPIURLHost(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"http://10.0.0.5:3552":      "10.0.0.5",
+		"https://agent.example.com": "agent.example.com",
+		"http://[fd00::5]:3552":     "fd00::5",
+		"":                          "",
+	}
+
+	for apiURL, want := range cases {
+		if got := apiURLHost(apiURL); got != want {
+			t.Errorf("apiURLHost(%q) = %q, want %q", apiURL, got, want)
+		}
+	}
+}
+
+func testProjectEndpointsDataSourceConfig(url, envID, projectID string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_project_endpoints" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+}
+`, url, envID, projectID)
+}
+
+func testProjectEndpointsDataSourceConfigHost(url, envID, projectID, host string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_project_endpoints" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+  host           = %[4]q
+}
+`, url, envID, projectID, host)
+}
@@ -0,0 +1,466 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ProjectFileResource{}
+	_ resource.ResourceWithImportState    = &ProjectFileResource{}
+	_ resource.ResourceWithModifyPlan     = &ProjectFileResource{}
+	_ resource.ResourceWithValidateConfig = &ProjectFileResource{}
+)
+
+// fileModeRe matches an octal file mode such as 644, 0640 or 1777.
+var fileModeRe = regexp.MustCompile(`^[0-7]{3,4}$`)
+
+// NewProjectFileResource returns a new project file resource.
+func NewProjectFileResource() resource.Resource {
+	return &ProjectFileResource{}
+}
+
+// ProjectFileResource defines the project file resource implementation.
+type ProjectFileResource struct {
+	client *Client
+}
+
+// ProjectFileResourceModel describes the project file resource data model.
+type ProjectFileResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	ProjectID     types.String `tfsdk:"project_id"`
+	Path          types.String `tfsdk:"path"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Mode          types.String `tfsdk:"mode"`
+	Owner         types.String `tfsdk:"owner"`
+	Group         types.String `tfsdk:"group"`
+	SHA256        types.String `tfsdk:"sha256"`
+	APIKeyAlias   types.String `tfsdk:"api_key_alias"`
+}
+
+func (r *ProjectFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_file"
+}
+
+func (r *ProjectFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages an auxiliary file, such as a configuration file or a certificate, in a project's
+directory on the agent, so that the files a stack mounts are provisioned along with its
+compose file.
+
+The file is compared by SHA-256 on every refresh: a file changed or removed on the agent is
+written again on the next apply. Content is written byte for byte; wrap secrets in
+` + "`sensitive()`" + ` to keep them out of plan output.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "arcane_project_file" "nginx_conf" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+  path           = "nginx/nginx.conf"
+  content        = file("${path.module}/nginx.conf")
+}
+
+resource "arcane_project_file" "tls_key" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+  path           = "certs/tls.key"
+  content        = sensitive(tls_private_key.webapp.private_key_pem)
+  mode           = "0600"
+  owner          = "101"
+}
+` + "```" + `
+
+## Import
+
+Project files can be imported using ` + "`environment_id/project_id/path`" + `:
+
+` + "```shell" + `
+terraform import arcane_project_file.nginx_conf <environment-id>/<project-id>/nginx/nginx.conf
+` + "```" + `
+
+**Note:** The content of a file is not retrieved from the API, so the first apply after
+import writes it again.
+`,
+		Attributes: map[string]schema.Attribute{
+			"api_key_alias": apiKeyAliasAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the file, `environment_id/project_id/path`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment the project is in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project whose directory holds the file.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path of the file relative to the project directory, e.g. `certs/tls.crt`. Missing parent directories are created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The content of the file as UTF-8 text. Exactly one of `content` or `content_base64` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "The content of the file, base64-encoded, for binary files such as keystores.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "The permissions of the file in octal notation. Defaults to `0644`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("0644"),
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The user owning the file, by name or UID. The configured form is kept while the agent reports the same user by the other one. If not specified, the agent's default is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "The group owning the file, by name or GID. The configured form is kept while the agent reports the same group by the other one. If not specified, the agent's default is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "The hex-encoded SHA-256 hash of the file content.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *ProjectFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ProjectFileResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Path.IsUnknown() && !data.Path.IsNull() {
+		if err := checkProjectFilePath(data.Path.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("path"), "Invalid path", err.Error())
+		}
+	}
+
+	if !data.Content.IsUnknown() && !data.ContentBase64.IsUnknown() && data.Content.IsNull() == data.ContentBase64.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Invalid file content",
+			"Exactly one of content or content_base64 must be set.",
+		)
+	}
+	if !data.ContentBase64.IsUnknown() && !data.ContentBase64.IsNull() {
+		if _, err := base64.StdEncoding.DecodeString(data.ContentBase64.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content_base64"),
+				"Invalid content_base64",
+				fmt.Sprintf("Expected standard base64: %s", err),
+			)
+		}
+	}
+
+	if !data.Mode.IsUnknown() && !data.Mode.IsNull() && !fileModeRe.MatchString(data.Mode.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mode"),
+			"Invalid mode",
+			fmt.Sprintf("Expected an octal file mode such as 0644, got %q.", data.Mode.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan rejects environments excluded by the provider's
+// allowed_environments or denied_environments, and plans sha256 from the
+// configured content so that a file changed on the agent is written again.
+func (r *ProjectFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkPlannedEnvironmentAllowed(ctx, r.client, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sum := types.StringUnknown()
+	if content, ok := projectFileContent(&plan); ok {
+		sum = types.StringValue(sha256Hex(content))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sha256"), sum)...)
+}
+
+func (r *ProjectFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
+	var data ProjectFileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.write(ctx, &data, "Failed to create project file", &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
+	var data ProjectFileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	prior := data
+
+	file, err := r.client.ForEnvironment(data.EnvironmentID.ValueString()).
+		GetProjectFile(ctx, data.ProjectID.ValueString(), remoteComposePath(data.Path.ValueString()))
+	if err != nil {
+		if arcane.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read project file")
+		return
+	}
+
+	data.ID = types.StringValue(projectFileID(&data))
+	setProjectFileAttributes(&data, file)
+	// Preserve content from state (API does not return file content)
+
+	drift := newDriftReport("arcane_project_file", data.ID.ValueString())
+	drift.compare("sha256", prior.SHA256, data.SHA256)
+	drift.compare("mode", prior.Mode, data.Mode)
+	drift.compare("owner", prior.Owner, data.Owner)
+	drift.compare("group", prior.Group, data.Group)
+	drift.addTo(&resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
+	var data ProjectFileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.write(ctx, &data, "Failed to update project file", &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
+	var data ProjectFileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ForEnvironment(data.EnvironmentID.ValueString()).
+		DeleteProjectFile(ctx, data.ProjectID.ValueString(), remoteComposePath(data.Path.ValueString()))
+	if err != nil {
+		if !arcane.IsNotFound(err) {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to delete project file")
+			return
+		}
+	}
+}
+
+func (r *ProjectFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	environmentID, rest, ok := parseCompositeID(req.ID)
+	projectID, filePath, pathOK := strings.Cut(rest, "/")
+	if !ok || !pathOK || projectID == "" || filePath == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected format: environment_id/project_id/path, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), filePath)...)
+}
+
+// write uploads the planned file and updates data from the response. It
+// returns false after adding an error to diags.
+func (r *ProjectFileResource) write(ctx context.Context, data *ProjectFileResourceModel, summary string, diags *diag.Diagnostics) bool {
+	content, _ := projectFileContent(data)
+	writeReq := &arcane.ProjectFileWriteRequest{
+		Path:    remoteComposePath(data.Path.ValueString()),
+		Content: base64.StdEncoding.EncodeToString(content),
+		Mode:    data.Mode.ValueString(),
+		Owner:   data.Owner.ValueString(),
+		Group:   data.Group.ValueString(),
+	}
+
+	file, err := r.client.ForEnvironment(data.EnvironmentID.ValueString()).
+		PutProjectFile(ctx, data.ProjectID.ValueString(), writeReq)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, diags, err, summary)
+		return false
+	}
+
+	data.ID = types.StringValue(projectFileID(data))
+	setProjectFileAttributes(data, file)
+	return true
+}
+
+// setProjectFileAttributes updates the attributes of data reported by the
+// API. The agent's default owner and group are kept when none is configured.
+func setProjectFileAttributes(data *ProjectFileResourceModel, file *arcane.ProjectFile) {
+	data.SHA256 = types.StringValue(file.SHA256)
+	if file.Mode != "" {
+		data.Mode = types.StringValue(file.Mode)
+	}
+	data.Owner = fileOwnership(data.Owner, file.Owner, file.UID)
+	data.Group = fileOwnership(data.Group, file.Group, file.GID)
+}
+
+// fileOwnership returns the owner or group to record for a file the agent
+// reports as owned by name and id (either may be missing). The planned value
+// is kept when it names the same user or group by name or by ID, so that
+// configuring "1000" for a user the agent reports as "app", or the reverse,
+// doesn't show a diff. Otherwise the agent's name, or else its ID, is used.
+func fileOwnership(planned types.String, name string, id *int64) types.String {
+	idString := ""
+	if id != nil {
+		idString = strconv.FormatInt(*id, 10)
+	}
+	if p := planned.ValueString(); p != "" && (p == name || p == idString) {
+		return planned
+	}
+	if name != "" {
+		return types.StringValue(name)
+	}
+	return optionalString(idString)
+}
+
+// projectFileID returns the composite ID of a project file.
+func projectFileID(data *ProjectFileResourceModel) string {
+	return formatCompositeID(data.EnvironmentID.ValueString(), data.ProjectID.ValueString()+"/"+data.Path.ValueString())
+}
+
+// projectFileContent returns the configured file content, decoding
+// content_base64. It returns false while the content is unknown.
+func projectFileContent(data *ProjectFileResourceModel) ([]byte, bool) {
+	if data.Content.IsUnknown() || data.ContentBase64.IsUnknown() {
+		return nil, false
+	}
+	if !data.ContentBase64.IsNull() {
+		content, err := base64.StdEncoding.DecodeString(data.ContentBase64.ValueString())
+		return content, err == nil
+	}
+	return []byte(data.Content.ValueString()), true
+}
+
+// checkProjectFilePath rejects file paths that would resolve outside the
+// project directory.
+func checkProjectFilePath(p string) error {
+	p = remoteComposePath(p)
+	switch {
+	case p == "":
+		return fmt.Errorf("the path must not be empty")
+	case strings.HasPrefix(p, "/") || (len(p) > 1 && p[1] == ':'):
+		return fmt.Errorf("expected a path relative to the project directory, got %q", p)
+	case slices.Contains(strings.Split(p, "/"), ".."):
+		return fmt.Errorf("the path %q must not leave the project directory", p)
+	case strings.HasSuffix(p, "/"):
+		return fmt.Errorf("the path %q must name a file, not a directory", p)
+	}
+	return nil
+}
+
+// sha256Hex returns the hex-encoded SHA-256 hash of b.
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
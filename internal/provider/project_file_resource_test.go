@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// newProjectFileMockServer returns a mock server with project proj-files in
+// environment env-files.
+func newProjectFileMockServer() *MockServer {
+	mockServer := NewMockServer()
+	mockServer.Environments["env-files"] = &arcane.Environment{ID: "env-files", Name: "files-env"}
+	mockServer.HealthyEnvs["env-files"] = true
+	mockServer.AddProject("env-files", &arcane.Project{
+		ID:            "proj-files",
+		Name:          "files-project",
+		Status:        "running",
+		EnvironmentID: "env-files",
+	})
+	return mockServer
+}
+
+// TestProjectFileResource_GivenContent_WhenCreated_ThenFileWritten validates
+// that a file is written to the project directory with the default mode and
+// the agent's default owner, and that sha256 is the hash of its content.
+func TestProjectFileResource_GivenContent_WhenCreated_ThenFileWritten(t *testing.T) {
+	mockServer := newProjectFileMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectFileConfig(mockServer.URL, "nginx/nginx.conf", "worker_processes 1;\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_file.test", "id", "env-files/proj-files/nginx/nginx.conf"),
+					resource.TestCheckResourceAttr("arcane_project_file.test", "mode", "0644"),
+					resource.TestCheckResourceAttr("arcane_project_file.test", "owner", "root"),
+					resource.TestCheckResourceAttr("arcane_project_file.test", "group", "root"),
+					resource.TestCheckResourceAttr("arcane_project_file.test", "sha256", sha256Hex([]byte("worker_processes 1;\n"))),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectFileResource_GivenContentChanged_WhenApplied_ThenFileRewritten
+// validates that changing content updates the file in place.
+func TestProjectFileResource_GivenContentChanged_WhenApplied_ThenFileRewritten(t *testing.T) {
+	mockServer := newProjectFileMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectFileConfig(mockServer.URL, "app.conf", "v1"),
+			},
+			{
+				Config: testProjectFileConfig(mockServer.URL, "app.conf", "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_file.test", "sha256", sha256Hex([]byte("v2"))),
+					mockServer.CheckRequestCount(http.MethodPut, "/api/environments/env-files/projects/proj-files/files", 2),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectFileResource_GivenFileChangedOnAgent_WhenApplied_ThenFileRewritten
+// validates that a file modified outside Terraform is detected by its hash
+// and written again.
+func TestProjectFileResource_GivenFileChangedOnAgent_WhenApplied_ThenFileRewritten(t *testing.T) {
+	mockServer := newProjectFileMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectFileConfig(mockServer.URL, "app.conf", "v1"),
+			},
+			{
+				PreConfig: func() {
+					mockServer.ProjectFiles["proj-files"]["app.conf"].SHA256 = sha256Hex([]byte("edited"))
+				},
+				Config: testProjectFileConfig(mockServer.URL, "app.conf", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_file.test", "sha256", sha256Hex([]byte("v1"))),
+					mockServer.CheckRequestCount(http.MethodPut, "/api/environments/env-files/projects/proj-files/files", 2),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectFileResource_GivenBinaryContent_WhenCreated_ThenModeAndOwnerSet
+// validates content_base64 together with explicit mode, owner and group.
+func TestProjectFileResource_GivenBinaryContent_WhenCreated_ThenModeAndOwnerSet(t *testing.T) {
+	mockServer := newProjectFileMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectFileConfigBase64(mockServer.URL, "certs/keystore.p12", "AAEC/w=="),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_file.test", "mode", "0600"),
+					resource.TestCheckResourceAttr("arcane_project_file.test", "owner", "101"),
+					resource.TestCheckResourceAttr("arcane_project_file.test", "group", "101"),
+					resource.TestCheckResourceAttr("arcane_project_file.test", "sha256", sha256Hex([]byte{0, 1, 2, 255})),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectFileResource_GivenOwnerByID_WhenAgentReportsName_ThenNoDiff
+// validates that an owner and group configured by ID are kept when the agent
+// reports them by name, and the reverse.
+func TestProjectFileResource_GivenOwnerByID_WhenAgentReportsName_ThenNoDiff(t *testing.T) {
+	mockServer := newProjectFileMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectFileConfigOwner(mockServer.URL, "1000", "app"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project_file.test", "owner", "1000"),
+					resource.TestCheckResourceAttr("arcane_project_file.test", "group", "app"),
+					func(*terraform.State) error {
+						file := mockServer.ProjectFiles["proj-files"]["config/app.env"]
+						if file == nil || file.Owner != "app" || file.Group != "app" {
+							return fmt.Errorf("expected the agent to report owner and group app, got %+v", file)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// TestProjectFileResource_GivenExistingFile_WhenImported_ThenStateMatches
+// validates import by environment_id/project_id/path.
+func TestProjectFileResource_GivenExistingFile_WhenImported_ThenStateMatches(t *testing.T) {
+	mockServer := newProjectFileMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectFileConfig(mockServer.URL, "nginx/nginx.conf", "worker_processes 1;\n"),
+			},
+			{
+				ResourceName:            "arcane_project_file.test",
+				ImportState:             true,
+				ImportStateId:           "env-files/proj-files/nginx/nginx.conf",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
+
+// TestProjectFileResource_GivenPathOutsideProject_WhenPlanned_ThenError
+// validates that paths escaping the project directory are rejected.
+func TestProjectFileResource_GivenPathOutsideProject_WhenPlanned_ThenError(t *testing.T) {
+	mockServer := newProjectFileMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testProjectFileConfig(mockServer.URL, "../other/app.conf", "v1"),
+				ExpectError: regexp.MustCompile(`must not leave the project directory`),
+			},
+		},
+	})
+}
+
+func TestCheckProjectFilePath(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		path    string
+		wantErr bool
+	}{
+		{path: "app.conf"},
+		{path: "certs/tls.crt"},
+		{path: `certs\tls.crt`},
+		{path: "./app.conf"},
+		{path: "config..d/app.conf"},
+		{path: "", wantErr: true},
+		{path: "/etc/passwd", wantErr: true},
+		{path: `C:\certs\tls.crt`, wantErr: true},
+		{path: "../app.conf", wantErr: true},
+		{path: `certs\..\..\app.conf`, wantErr: true},
+		{path: "certs/", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		err := checkProjectFilePath(tc.path)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("checkProjectFilePath(%q) error = %v, wantErr %v", tc.path, err, tc.wantErr)
+		}
+	}
+}
+
+func TestFileOwnership(t *testing.T) {
+	t.Parallel()
+
+	id := func(v int64) *int64 { return &v }
+	cases := []struct {
+		name    string
+		planned types.String
+		owner   string
+		id      *int64
+		want    types.String
+	}{
+		{"unset uses name", types.StringNull(), "root", id(0), types.StringValue("root")},
+		{"unset without name uses ID", types.StringNull(), "", id(101), types.StringValue("101")},
+		{"unset without either", types.StringNull(), "", nil, types.StringNull()},
+		{"ID kept for name", types.StringValue("1000"), "app", id(1000), types.StringValue("1000")},
+		{"name kept", types.StringValue("app"), "app", id(1000), types.StringValue("app")},
+		{"name matches without ID", types.StringValue("app"), "app", nil, types.StringValue("app")},
+		{"name not resolved by agent", types.StringValue("app"), "", id(1000), types.StringValue("1000")},
+		{"other user by name", types.StringValue("app"), "root", id(0), types.StringValue("root")},
+		{"other user by ID", types.StringValue("1000"), "", id(0), types.StringValue("0")},
+	}
+	for _, tc := range cases {
+		if got := fileOwnership(tc.planned, tc.owner, tc.id); !got.Equal(tc.want) {
+			t.Errorf("%s: fileOwnership = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestProjectFileContent(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		data   ProjectFileResourceModel
+		want   string
+		wantOK bool
+	}{
+		"content": {
+			data:   ProjectFileResourceModel{Content: types.StringValue("a\r\nb"), ContentBase64: types.StringNull()},
+			want:   "a\r\nb",
+			wantOK: true,
+		},
+		"content_base64": {
+			data:   ProjectFileResourceModel{Content: types.StringNull(), ContentBase64: types.StringValue("aGk=")},
+			want:   "hi",
+			wantOK: true,
+		},
+		"unknown content": {
+			data: ProjectFileResourceModel{Content: types.StringUnknown(), ContentBase64: types.StringNull()},
+		},
+	}
+
+	for name, tc := range cases {
+		got, ok := projectFileContent(&tc.data)
+		if ok != tc.wantOK || string(got) != tc.want {
+			t.Errorf("%s: projectFileContent() = %q, %v, want %q, %v", name, got, ok, tc.want, tc.wantOK)
+		}
+	}
+}
+
+// --- Config helpers ---
+
+func testProjectFileConfig(url, filePath, content string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_file" "test" {
+  environment_id = "env-files"
+  project_id     = "proj-files"
+  path           = %[2]q
+  content        = %[3]q
+}
+`, url, filePath, content)
+}
+
+func testProjectFileConfigOwner(url, owner, group string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_file" "test" {
+  environment_id = "env-files"
+  project_id     = "proj-files"
+  path           = "config/app.env"
+  content        = "LOG_LEVEL=info\n"
+  owner          = %[2]q
+  group          = %[3]q
+}
+`, url, owner, group)
+}
+
+func testProjectFileConfigBase64(url, filePath, contentBase64 string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project_file" "test" {
+  environment_id = "env-files"
+  project_id     = "proj-files"
+  path           = %[2]q
+  content_base64 = %[3]q
+  mode           = "0600"
+  owner          = "101"
+  group          = "101"
+}
+`, url, filePath, contentBase64)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+const managedBySyncIDDescription = "The ID of the GitOps sync that manages the project, or unset for projects no sync manages. " +
+	"Arcane versions that don't report it are matched to the sync whose repository path the project's path ends with."
+
+// projectManagedBySyncID returns the ID of the GitOps sync that manages
+// project, as reported by Arcane or else the only sync of the environment
+// whose path the project's path ends with, or null when none does.
+func projectManagedBySyncID(ctx context.Context, envClient *EnvironmentClient, project *arcane.Project) (types.String, error) {
+	if project.GitOpsSyncID != "" {
+		return types.StringValue(project.GitOpsSyncID), nil
+	}
+	if project.Path == "" {
+		return types.StringNull(), nil
+	}
+
+	syncs, err := envClient.ListGitOpsSyncs(ctx)
+	if err != nil {
+		// Arcane versions without GitOps have no syncs to manage projects
+		if arcane.IsNotFound(err) {
+			return types.StringNull(), nil
+		}
+		return types.StringNull(), err
+	}
+
+	projectPath := path.Clean(project.Path)
+	var matches []string
+	for _, sync := range syncs {
+		syncPath := strings.Trim(path.Clean("/"+sync.Path), "/")
+		if syncPath != "" && strings.HasSuffix(projectPath, "/"+syncPath) {
+			matches = append(matches, sync.ID)
+		}
+	}
+	if len(matches) != 1 {
+		if len(matches) > 1 {
+			tflog.Debug(ctx, "Project path matches several GitOps syncs, leaving managed_by_sync_id unset", map[string]interface{}{
+				"project_id": project.ID,
+				"sync_ids":   matches,
+			})
+		}
+		return types.StringNull(), nil
+	}
+	return types.StringValue(matches[0]), nil
+}
+
+// projectManagedBySyncIDOrWarn is projectManagedBySyncID for a project that
+// was just written, whose state must be saved even when listing the syncs
+// fails: the failure is a warning and the ID is left unset until the next
+// refresh.
+func projectManagedBySyncIDOrWarn(ctx context.Context, envClient *EnvironmentClient, project *arcane.Project, diags *diag.Diagnostics) types.String {
+	id, err := projectManagedBySyncID(ctx, envClient, project)
+	if err != nil {
+		diags.AddWarning(
+			"Failed to list GitOps syncs",
+			fmt.Sprintf("managed_by_sync_id of project %q is unset until the next refresh: %s", project.ID, err),
+		)
+	}
+	return id
+}
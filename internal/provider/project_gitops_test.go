@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+func TestProjectManagedBySyncID(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		envID   string
+		project arcane.Project
+		want    types.String
+	}{
+		"reported by Arcane": {
+			envID:   "env-gitops",
+			project: arcane.Project{ID: "proj-1", Path: "/data/projects/other", GitOpsSyncID: "sync-reported"},
+			want:    types.StringValue("sync-reported"),
+		},
+		"matched by path": {
+			envID:   "env-gitops",
+			project: arcane.Project{ID: "proj-1", Path: "/data/gitops/infra/apps/webapp/"},
+			want:    types.StringValue("sync-webapp"),
+		},
+		"path of no sync": {
+			envID:   "env-gitops",
+			project: arcane.Project{ID: "proj-1", Path: "/data/projects/webapp-2"},
+			want:    types.StringNull(),
+		},
+		"path of several syncs": {
+			envID:   "env-gitops",
+			project: arcane.Project{ID: "proj-1", Path: "/data/gitops/infra/apps/api"},
+			want:    types.StringNull(),
+		},
+		"no path": {
+			envID:   "env-gitops",
+			project: arcane.Project{ID: "proj-1"},
+			want:    types.StringNull(),
+		},
+		"GitOps not supported": {
+			envID:   "env-missing",
+			project: arcane.Project{ID: "proj-1", Path: "/data/gitops/infra/apps/webapp"},
+			want:    types.StringNull(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := NewMockServer()
+			defer mockServer.Close()
+			mockServer.AddGitOpsSync("env-gitops", &arcane.GitOpsSync{ID: "sync-webapp", Path: "apps/webapp"})
+			mockServer.AddGitOpsSync("env-gitops", &arcane.GitOpsSync{ID: "sync-api", Path: "apps/api"})
+			mockServer.AddGitOpsSync("env-gitops", &arcane.GitOpsSync{ID: "sync-api-canary", Path: "/apps/api/"})
+			mockServer.AddGitOpsSync("env-gitops", &arcane.GitOpsSync{ID: "sync-root"})
+
+			envClient := newMockClient(t, mockServer).ForEnvironment(tc.envID)
+			got, err := projectManagedBySyncID(context.Background(), envClient, &tc.project)
+			if err != nil {
+				t.Fatalf("projectManagedBySyncID() error: %v", err)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("projectManagedBySyncID() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("list error", func(t *testing.T) {
+		t.Parallel()
+		mockServer := NewMockServer()
+		defer mockServer.Close()
+		mockServer.AddGitOpsSync("env-gitops", &arcane.GitOpsSync{ID: "sync-webapp", Path: "apps/webapp"})
+		mockServer.InjectFault(MockFault{Method: http.MethodGet, Path: "/api/environments/env-gitops/gitops-syncs", Status: http.StatusInternalServerError, Message: "boom"})
+
+		envClient := newMockClient(t, mockServer).ForEnvironment("env-gitops")
+		if _, err := projectManagedBySyncID(context.Background(), envClient, &arcane.Project{ID: "proj-1", Path: "/data/apps/webapp"}); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
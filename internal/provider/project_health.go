@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Aggregated health of a project's containers.
+const (
+	projectHealthHealthy   = "healthy"
+	projectHealthDegraded  = "degraded"
+	projectHealthUnhealthy = "unhealthy"
+	projectHealthUnknown   = "unknown"
+)
+
+// Policies deciding how many healthy containers make a project healthy.
+const (
+	healthPolicyAll    = "all"
+	healthPolicyAny    = "any"
+	healthPolicyQuorum = "quorum"
+)
+
+var healthPolicies = []string{healthPolicyAll, healthPolicyAny, healthPolicyQuorum}
+
+// healthPolicyDescription documents the health_policy attribute.
+const healthPolicyDescription = "How many healthy containers make the project `healthy`: `all` (the default), " +
+	"`any` (at least one) or `quorum` (more than half)."
+
+// healthDescription documents the health attribute.
+const healthDescription = "The aggregated health of the project's containers under `health_policy`: `healthy` when the " +
+	"policy is met, `degraded` when it isn't but some containers are healthy, `unhealthy` when none are, or `unknown` " +
+	"when the project has no containers or they couldn't be listed. A container is healthy when its health check " +
+	"passes or, without a health check, when it is running."
+
+// isHealthyContainer reports whether a container counts as healthy: its health
+// check passes, or it has none and is running.
+func isHealthyContainer(c arcane.ContainerDetail) bool {
+	switch strings.ToLower(c.Health) {
+	case "healthy":
+		return true
+	case "", "none":
+		return isRunningStatus(c.Status)
+	}
+	return false
+}
+
+// aggregateHealth returns the health of a project with the given containers
+// under policy. An empty policy is treated as "all".
+func aggregateHealth(containers []arcane.ContainerDetail, policy string) string {
+	if len(containers) == 0 {
+		return projectHealthUnknown
+	}
+
+	healthy := 0
+	for _, c := range containers {
+		if isHealthyContainer(c) {
+			healthy++
+		}
+	}
+
+	var met bool
+	switch policy {
+	case healthPolicyAny:
+		met = healthy > 0
+	case healthPolicyQuorum:
+		met = healthy > len(containers)/2
+	default:
+		met = healthy == len(containers)
+	}
+
+	switch {
+	case met:
+		return projectHealthHealthy
+	case healthy > 0:
+		return projectHealthDegraded
+	default:
+		return projectHealthUnhealthy
+	}
+}
+
+// fetchProjectHealth lists a project's containers and returns their health
+// under policy, or "unknown" if they can't be listed.
+func fetchProjectHealth(ctx context.Context, envClient *EnvironmentClient, projectID, policy string) string {
+	containers, err := envClient.GetProjectContainers(ctx, projectID)
+	if err != nil {
+		tflog.Debug(ctx, "Could not list project containers, health is unknown", map[string]interface{}{
+			"project_id": projectID,
+			"error":      err.Error(),
+		})
+		return projectHealthUnknown
+	}
+	return aggregateHealth(containers, policy)
+}
+
+// pollContainerHealth polls the containers of a project that filter accepts,
+// or all of them when filter is nil, until they are healthy under policy,
+// timeout elapses or ctx is done. It returns the last health seen along with
+// the containers it was computed from, or the error listing them.
+func pollContainerHealth(ctx context.Context, envClient *EnvironmentClient, projectID, policy string, timeout time.Duration, filter func(arcane.ContainerDetail) bool) (string, []arcane.ContainerDetail, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		health := projectHealthUnknown
+		containers, err := envClient.GetProjectContainers(ctx, projectID)
+		if err == nil {
+			if filter != nil {
+				containers = slices.DeleteFunc(containers, func(c arcane.ContainerDetail) bool { return !filter(c) })
+			}
+			health = aggregateHealth(containers, policy)
+		}
+		if health == projectHealthHealthy || time.Now().After(deadline) || ctx.Err() != nil {
+			return health, containers, err
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(deployStatusPollInterval):
+		}
+	}
+}
+
+// checkHealthPolicy adds an error for a health_policy that isn't one of
+// healthPolicies.
+func checkHealthPolicy(p path.Path, policy types.String, diags *diag.Diagnostics) {
+	if policy.IsNull() || policy.IsUnknown() || slices.Contains(healthPolicies, policy.ValueString()) {
+		return
+	}
+	diags.AddAttributeError(
+		p,
+		"Invalid health_policy",
+		fmt.Sprintf("Expected one of %s, got %q.", strings.Join(healthPolicies, ", "), policy.ValueString()),
+	)
+}
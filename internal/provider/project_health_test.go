@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+func TestAggregateHealth(t *testing.T) {
+	t.Parallel()
+
+	healthy := arcane.ContainerDetail{Status: "running", Health: "healthy"}
+	noCheck := arcane.ContainerDetail{Status: "Up 3 minutes"}
+	unhealthy := arcane.ContainerDetail{Status: "running", Health: "unhealthy"}
+	starting := arcane.ContainerDetail{Status: "running", Health: "starting"}
+	exited := arcane.ContainerDetail{Status: "exited"}
+
+	cases := []struct {
+		name       string
+		containers []arcane.ContainerDetail
+		policy     string
+		want       string
+	}{
+		{name: "no containers", policy: healthPolicyAll, want: projectHealthUnknown},
+		{name: "all healthy", containers: []arcane.ContainerDetail{healthy, noCheck}, policy: healthPolicyAll, want: projectHealthHealthy},
+		{name: "all with one unhealthy", containers: []arcane.ContainerDetail{healthy, unhealthy}, policy: healthPolicyAll, want: projectHealthDegraded},
+		{name: "default policy is all", containers: []arcane.ContainerDetail{healthy, starting}, want: projectHealthDegraded},
+		{name: "all none healthy", containers: []arcane.ContainerDetail{unhealthy, exited}, policy: healthPolicyAll, want: projectHealthUnhealthy},
+		{name: "any with one healthy", containers: []arcane.ContainerDetail{healthy, exited, unhealthy}, policy: healthPolicyAny, want: projectHealthHealthy},
+		{name: "any none healthy", containers: []arcane.ContainerDetail{exited}, policy: healthPolicyAny, want: projectHealthUnhealthy},
+		{name: "quorum met", containers: []arcane.ContainerDetail{healthy, noCheck, exited}, policy: healthPolicyQuorum, want: projectHealthHealthy},
+		{name: "quorum tied", containers: []arcane.ContainerDetail{healthy, exited}, policy: healthPolicyQuorum, want: projectHealthDegraded},
+	}
+
+	for _, tc := range cases {
+		if got := aggregateHealth(tc.containers, tc.policy); got != tc.want {
+			t.Errorf("%s: aggregateHealth() = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+// TestPollContainerHealth validates that the containers filter accepts decide
+// the health, and that polling stops at the timeout with the last health.
+func TestPollContainerHealth(t *testing.T) {
+	prev := deployStatusPollInterval
+	deployStatusPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { deployStatusPollInterval = prev })
+
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+	mockServer.AddProject("env-1", &arcane.Project{ID: "proj-1", Name: "web"})
+	mockServer.AddContainers("env-1", "proj-1", []arcane.ContainerDetail{
+		{Name: "web-app-1", Status: "running", Health: "healthy"},
+		{Name: "web-db-1", Status: "running", Health: "starting"},
+	})
+	envClient := newMockClient(t, mockServer).ForEnvironment("env-1")
+	inService := func(service string) func(arcane.ContainerDetail) bool {
+		return func(c arcane.ContainerDetail) bool { return strings.HasPrefix(c.Name, "web-"+service+"-") }
+	}
+
+	health, containers, err := pollContainerHealth(context.Background(), envClient, "proj-1", healthPolicyAll, time.Second, inService("app"))
+	if health != projectHealthHealthy || len(containers) != 1 || err != nil {
+		t.Errorf("app: got %q with %d containers and error %v, want healthy with 1 container", health, len(containers), err)
+	}
+
+	before := mockServer.RequestCount(http.MethodGet, "/api/environments/env-1/projects/proj-1/containers")
+	health, containers, _ = pollContainerHealth(context.Background(), envClient, "proj-1", healthPolicyAll, 50*time.Millisecond, nil)
+	if health != projectHealthDegraded || len(containers) != 2 {
+		t.Errorf("all: got %q with %d containers, want degraded with 2", health, len(containers))
+	}
+	if polls := mockServer.RequestCount(http.MethodGet, "/api/environments/env-1/projects/proj-1/containers") - before; polls < 2 {
+		t.Errorf("expected polling until the timeout, got %d polls", polls)
+	}
+
+	health, containers, _ = pollContainerHealth(context.Background(), envClient, "proj-1", healthPolicyAll, 0, inService("cache"))
+	if health != projectHealthUnknown || len(containers) != 0 {
+		t.Errorf("cache: got %q with %d containers, want unknown with none", health, len(containers))
+	}
+}
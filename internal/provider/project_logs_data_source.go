@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &ProjectLogsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ProjectLogsDataSource{}
+)
+
+// defaultLogsTail is the number of log lines read when tail is unset.
+const defaultLogsTail = 100
+
+// NewProjectLogsDataSource returns a new project logs data source.
+func NewProjectLogsDataSource() datasource.DataSource {
+	return &ProjectLogsDataSource{}
+}
+
+// ProjectLogsDataSource defines the project logs data source implementation.
+type ProjectLogsDataSource struct {
+	client *Client
+}
+
+// ProjectLogsDataSourceModel describes the project logs data source data model.
+type ProjectLogsDataSourceModel struct {
+	EnvironmentID types.String   `tfsdk:"environment_id"`
+	ProjectID     types.String   `tfsdk:"project_id"`
+	ContainerID   types.String   `tfsdk:"container_id"`
+	Tail          types.Int64    `tfsdk:"tail"`
+	Since         types.String   `tfsdk:"since"`
+	Timestamps    types.Bool     `tfsdk:"timestamps"`
+	Content       types.String   `tfsdk:"content"`
+	Lines         []types.String `tfsdk:"lines"`
+}
+
+func (d *ProjectLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_logs"
+}
+
+func (d *ProjectLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to read the recent logs of a project, or of a single container, e.g. to
+record a snapshot of the logs after a deployment in an output or send it to external monitoring.
+
+The logs are read whenever the data source is, so they reflect the time of the plan or apply.
+Reference a deployment attribute such as ` + "`last_deployed_at`" + ` to read them after it.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_project_logs" "webapp" {
+  environment_id = arcane_project_deployment.webapp.environment_id
+  project_id     = arcane_project_deployment.webapp.project_id
+  tail           = 50
+  since          = arcane_project_deployment.webapp.last_deployed_at
+}
+
+output "webapp_startup_logs" {
+  value = data.arcane_project_logs.webapp.content
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment containing the project or container.",
+				Required:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project whose containers' logs to read. Exactly one of `project_id` or `container_id` must be specified.",
+				Optional:            true,
+			},
+			"container_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the container whose logs to read. Exactly one of `project_id` or `container_id` must be specified.",
+				Optional:            true,
+			},
+			"tail": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of lines to read from the end of the logs. Defaults to `%d`.", defaultLogsTail),
+				Optional:            true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only read lines newer than this, as an RFC 3339 timestamp (e.g. `last_deployed_at` of a deployment) or a duration (e.g. `10m`).",
+				Optional:            true,
+			},
+			"timestamps": schema.BoolAttribute{
+				MarkdownDescription: "Prefix every line with its timestamp. Defaults to `false`.",
+				Optional:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The logs, one line per entry.",
+				Computed:            true,
+			},
+			"lines": schema.ListAttribute{
+				MarkdownDescription: "The lines of `content`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ProjectLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ProjectLogsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data ProjectLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ProjectID.IsUnknown() && !data.ContainerID.IsUnknown() && data.ProjectID.IsNull() == data.ContainerID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project_id"),
+			"Invalid logs source",
+			"Exactly one of project_id or container_id must be specified to read logs.",
+		)
+	}
+	if !data.Tail.IsNull() && !data.Tail.IsUnknown() && data.Tail.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tail"),
+			"Invalid tail",
+			fmt.Sprintf("tail must be at least 1, got %d.", data.Tail.ValueInt64()),
+		)
+	}
+}
+
+func (d *ProjectLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data ProjectLogsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &arcane.LogsOptions{
+		Tail:       defaultLogsTail,
+		Since:      data.Since.ValueString(),
+		Timestamps: data.Timestamps.ValueBool(),
+	}
+	if !data.Tail.IsNull() {
+		opts.Tail = int(data.Tail.ValueInt64())
+	}
+
+	envClient := d.client.ForEnvironment(data.EnvironmentID.ValueString())
+	var logs string
+	var err error
+	if !data.ProjectID.IsNull() {
+		logs, err = envClient.GetProjectLogs(ctx, data.ProjectID.ValueString(), opts)
+	} else {
+		logs, err = envClient.GetContainerLogs(ctx, data.ContainerID.ValueString(), opts)
+	}
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read logs")
+		return
+	}
+
+	data.Content = types.StringValue(logs)
+	data.Lines = []types.String{}
+	for _, line := range logLines(logs) {
+		data.Lines = append(data.Lines, types.StringValue(line))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// logLines splits logs into lines, treating CRLF like LF and dropping the
+// empty line after a trailing newline.
+func logLines(logs string) []string {
+	logs = strings.TrimSuffix(normalizeLineEndings(logs), "\n")
+	if logs == "" {
+		return nil
+	}
+	return strings.Split(logs, "\n")
+}
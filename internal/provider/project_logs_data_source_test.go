@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestProjectLogsDataSource_GivenProject_WhenRead_ThenTailReturned validates
+// that the last tail lines of a project's logs are returned as content and
+// lines.
+func TestProjectLogsDataSource_GivenProject_WhenRead_ThenTailReturned(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddProject("env-projects", &arcane.Project{ID: "proj-webapp", Name: "webapp", Status: "running", EnvironmentID: "env-projects"})
+	mockServer.Logs["proj-webapp"] = "web-1  | starting\nweb-1  | listening on :80\nweb-1  | GET / 200\n"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectLogsDataSourceConfig(mockServer.URL, `
+  project_id = "proj-webapp"
+  tail       = 2`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_project_logs.test", "content", "web-1  | listening on :80\nweb-1  | GET / 200\n"),
+					resource.TestCheckResourceAttr("data.arcane_project_logs.test", "lines.#", "2"),
+					resource.TestCheckResourceAttr("data.arcane_project_logs.test", "lines.0", "web-1  | listening on :80"),
+					resource.TestCheckResourceAttr("data.arcane_project_logs.test", "lines.1", "web-1  | GET / 200"),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectLogsDataSource_GivenContainer_WhenRead_ThenLogsReturned
+// validates that a single container's logs can be read.
+func TestProjectLogsDataSource_GivenContainer_WhenRead_ThenLogsReturned(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	mockServer.Logs["abc123"] = "ready\n"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectLogsDataSourceConfig(mockServer.URL, `container_id = "abc123"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_project_logs.test", "content", "ready\n"),
+					resource.TestCheckResourceAttr("data.arcane_project_logs.test", "lines.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectLogsDataSource_GivenInvalidConfig_WhenValidated_ThenError
+// validates that exactly one logs source and a positive tail are required.
+func TestProjectLogsDataSource_GivenInvalidConfig_WhenValidated_ThenError(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testProjectLogsDataSourceConfig(mockServer.URL, ""),
+				ExpectError: regexp.MustCompile(`Invalid logs source`),
+			},
+			{
+				Config: testProjectLogsDataSourceConfig(mockServer.URL, `
+  project_id   = "proj-webapp"
+  container_id = "abc123"`),
+				ExpectError: regexp.MustCompile(`Invalid logs source`),
+			},
+			{
+				Config: testProjectLogsDataSourceConfig(mockServer.URL, `
+  project_id = "proj-webapp"
+  tail       = 0`),
+				ExpectError: regexp.MustCompile(`Invalid tail`),
+			},
+		},
+	})
+}
+
+func TestLogLines(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string][]string{
+		"":            nil,
+		"\n":          nil,
+		"a":           {"a"},
+		"a\nb\n":      {"a", "b"},
+		"a\r\nb\r\n":  {"a", "b"},
+		"a\n\nb\n":    {"a", "", "b"},
+		"a\nb\n\n":    {"a", "b", ""},
+		"no newline ": {"no newline "},
+	}
+	for in, want := range cases {
+		if got := logLines(in); !reflect.DeepEqual(got, want) {
+			t.Errorf("logLines(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func testProjectLogsDataSourceConfig(url, extra string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_project_logs" "test" {
+  environment_id = "env-projects"
+  %[2]s
+}
+`, url, extra)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// checkProjectNameAvailable fails the plan of a new project when a project
+// with the same name already exists in the environment, instead of letting
+// create fail late with a server error. allowExisting disables the check for
+// configurations that intend to adopt the project. When the projects can't be
+// listed (e.g. the agent is unreachable at plan time) the check is skipped and
+// the server remains the final authority.
+func checkProjectNameAvailable(ctx context.Context, envClient *EnvironmentClient, environmentID, name string, allowExisting bool, diags *diag.Diagnostics) {
+	if allowExisting || name == "" {
+		return
+	}
+
+	existing, err := envClient.GetProjectByName(ctx, name)
+	if err != nil {
+		if !arcane.IsNotFound(err) {
+			tflog.Debug(ctx, "Could not list projects, skipping project name check", map[string]interface{}{
+				"environment_id": environmentID,
+				"name":           name,
+				"error":          err.Error(),
+			})
+		}
+		return
+	}
+
+	diags.AddAttributeError(
+		path.Root("name"),
+		"Project already exists",
+		fmt.Sprintf("Environment %q already has a project named %q (ID %q). "+
+			"Import it to manage it with Terraform:\n\n"+
+			"  terraform import <resource address> %s/%s\n\n"+
+			"or set allow_existing = true to adopt it on create.",
+			environmentID, name, existing.ID, environmentID, existing.ID),
+	)
+}
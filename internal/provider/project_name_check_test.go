@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+func TestCheckProjectNameAvailable(t *testing.T) {
+	t.Parallel()
+
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-names"] = &arcane.Environment{ID: "env-names", Name: "names"}
+	mockServer.Environments["env-down"] = &arcane.Environment{ID: "env-down", Name: "down"}
+	mockServer.AddProject("env-names", &arcane.Project{ID: "proj-web", Name: "webapp", EnvironmentID: "env-names"})
+	mockServer.InjectFault(MockFault{
+		Method: http.MethodGet,
+		Path:   "/api/environments/env-down/projects",
+		Status: http.StatusServiceUnavailable,
+	})
+
+	c := newMockClient(t, mockServer)
+
+	cases := []struct {
+		name          string
+		envID         string
+		project       string
+		allowExisting bool
+		wantError     bool
+	}{
+		{name: "free name", envID: "env-names", project: "api"},
+		{name: "taken name", envID: "env-names", project: "webapp", wantError: true},
+		{name: "taken name allowed", envID: "env-names", project: "webapp", allowExisting: true},
+		{name: "agent unreachable", envID: "env-down", project: "webapp"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkProjectNameAvailable(context.Background(), c.ForEnvironment(tc.envID), tc.envID, tc.project, tc.allowExisting, &diags)
+
+			if got := diags.HasError(); got != tc.wantError {
+				t.Fatalf("HasError = %t, want %t: %v", got, tc.wantError, diags)
+			}
+			if tc.wantError && !strings.Contains(diags.Errors()[0].Detail(), "env-names/proj-web") {
+				t.Errorf("expected import ID in detail, got %q", diags.Errors()[0].Detail())
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectOwnersDataSource{}
+
+// NewProjectOwnersDataSource returns a new project owners data source.
+func NewProjectOwnersDataSource() datasource.DataSource {
+	return &ProjectOwnersDataSource{}
+}
+
+// ProjectOwnersDataSource defines the project owners data source implementation.
+type ProjectOwnersDataSource struct {
+	client *Client
+}
+
+// ProjectOwnersDataSourceModel describes the project owners data source data model.
+type ProjectOwnersDataSourceModel struct {
+	EnvironmentID types.String        `tfsdk:"environment_id"`
+	Workspace     types.String        `tfsdk:"workspace"`
+	Projects      []projectOwnerModel `tfsdk:"projects"`
+	Conflicts     []projectOwnerModel `tfsdk:"conflicts"`
+}
+
+// projectOwnerModel describes an element of projects and conflicts.
+type projectOwnerModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Workspace       types.String `tfsdk:"workspace"`
+	ResourceAddress types.String `tfsdk:"resource_address"`
+}
+
+func (d *ProjectOwnersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_owners"
+}
+
+func (d *ProjectOwnersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ownerAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the project.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the project.",
+			Computed:            true,
+		},
+		"workspace": schema.StringAttribute{
+			MarkdownDescription: "The workspace the project was last deployed from, from its `" + ownerWorkspaceLabel + "` label.",
+			Computed:            true,
+		},
+		"resource_address": schema.StringAttribute{
+			MarkdownDescription: "The address of the resource that last deployed the project, from its `" + ownerAddressLabel + "` label. Unset when the label is missing.",
+			Computed:            true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to find which Terraform workspaces deploy the projects of an environment, from the
+ownership labels set by ` + "`arcane_project_deployment`" + `'s ` + "`ownership`" + `. With ` + "`workspace`" + `, projects
+last deployed from another workspace are listed in ` + "`conflicts`" + `, e.g. to fail a plan that would take over
+projects owned by another stack.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_project_owners" "production" {
+  environment_id = data.arcane_environment.production.id
+  workspace      = terraform.workspace
+}
+
+check "project_ownership" {
+  assert {
+    condition     = length(data.arcane_project_owners.production.conflicts) == 0
+    error_message = "Projects owned by other workspaces: ${join(", ", data.arcane_project_owners.production.conflicts[*].name)}"
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment containing the projects.",
+				Required:            true,
+			},
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "The workspace to check for conflicts, as set in `ownership.workspace`.",
+				Optional:            true,
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "The projects with an `" + ownerWorkspaceLabel + "` label, ordered by name.",
+				Computed:            true,
+				NestedObject:        schema.NestedAttributeObject{Attributes: ownerAttributes},
+			},
+			"conflicts": schema.ListNestedAttribute{
+				MarkdownDescription: "The projects in `projects` owned by a workspace other than `workspace`. Empty without `workspace`.",
+				Computed:            true,
+				NestedObject:        schema.NestedAttributeObject{Attributes: ownerAttributes},
+			},
+		},
+	}
+}
+
+func (d *ProjectOwnersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ProjectOwnersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data ProjectOwnersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := d.client.ForEnvironment(data.EnvironmentID.ValueString()).ListProjects(ctx)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list projects")
+		return
+	}
+	slices.SortFunc(projects, func(a, b arcane.Project) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	data.Projects = []projectOwnerModel{}
+	data.Conflicts = []projectOwnerModel{}
+	for _, p := range projects {
+		workspace := p.Labels[ownerWorkspaceLabel]
+		if workspace == "" {
+			continue
+		}
+		owner := projectOwnerModel{
+			ID:              types.StringValue(p.ID),
+			Name:            types.StringValue(p.Name),
+			Workspace:       types.StringValue(workspace),
+			ResourceAddress: optionalString(p.Labels[ownerAddressLabel]),
+		}
+		data.Projects = append(data.Projects, owner)
+		if !data.Workspace.IsNull() && workspace != data.Workspace.ValueString() {
+			data.Conflicts = append(data.Conflicts, owner)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
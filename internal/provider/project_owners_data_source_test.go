@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestProjectOwnersDataSource_GivenLabeledProjects_WhenRead_ThenConflictsListed
+// validates that projects with ownership labels are listed, and those owned
+// by another workspace are reported as conflicts.
+func TestProjectOwnersDataSource_GivenLabeledProjects_WhenRead_ThenConflictsListed(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-owners"] = &arcane.Environment{ID: "env-owners", Name: "owners-env"}
+	mockServer.AddProject("env-owners", &arcane.Project{ID: "proj-web", Name: "web", Labels: map[string]string{
+		ownerWorkspaceLabel: "prod", ownerAddressLabel: "arcane_project_deployment.web",
+	}})
+	mockServer.AddProject("env-owners", &arcane.Project{ID: "proj-api", Name: "api", Labels: map[string]string{
+		ownerWorkspaceLabel: "staging",
+	}})
+	mockServer.AddProject("env-owners", &arcane.Project{ID: "proj-db", Name: "db"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectOwnersDataSourceConfig(mockServer.URL, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_project_owners.test", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.arcane_project_owners.test", "projects.0.name", "api"),
+					resource.TestCheckResourceAttr("data.arcane_project_owners.test", "projects.0.workspace", "staging"),
+					resource.TestCheckNoResourceAttr("data.arcane_project_owners.test", "projects.0.resource_address"),
+					resource.TestCheckResourceAttr("data.arcane_project_owners.test", "projects.1.resource_address", "arcane_project_deployment.web"),
+					resource.TestCheckResourceAttr("data.arcane_project_owners.test", "conflicts.#", "0"),
+				),
+			},
+			{
+				Config: testProjectOwnersDataSourceConfig(mockServer.URL, `workspace = "prod"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_project_owners.test", "conflicts.#", "1"),
+					resource.TestCheckResourceAttr("data.arcane_project_owners.test", "conflicts.0.id", "proj-api"),
+				),
+			},
+		},
+	})
+}
+
+func testProjectOwnersDataSourceConfig(url, extra string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_project_owners" "test" {
+  environment_id = "env-owners"
+  %[2]s
+}
+`, url, extra)
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Project labels recording the Terraform configuration that deploys a
+// project.
+const (
+	ownerWorkspaceLabel = "tf_workspace"
+	ownerAddressLabel   = "tf_resource_address"
+)
+
+// ownershipModel describes the ownership attribute.
+type ownershipModel struct {
+	Workspace       types.String `tfsdk:"workspace"`
+	ResourceAddress types.String `tfsdk:"resource_address"`
+}
+
+func ownershipAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Label the deployed project with the Terraform configuration that owns it, so the Arcane UI " +
+			"shows which stack deploys each project and `arcane_project_owners` can find projects deployed from more than " +
+			"one workspace. Sets the `" + ownerWorkspaceLabel + "` and `" + ownerAddressLabel + "` project labels after each " +
+			"apply, warning when the project was owned by another workspace, and removes them when unset or destroyed. " +
+			"Terraform doesn't tell providers the workspace or resource address, so both are passed in. Changing it does not redeploy.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "The workspace deploying the project, usually `terraform.workspace`, prefixed with the " +
+					"configuration's name when several configurations share workspace names, e.g. `\"infra/${terraform.workspace}\"`.",
+				Required: true,
+			},
+			"resource_address": schema.StringAttribute{
+				MarkdownDescription: "The address of this resource, e.g. `module.app.arcane_project_deployment.web`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// ownership returns the configured ownership, or nil when it is unset.
+func (m *ProjectDeploymentResourceModel) ownership(ctx context.Context) (*ownershipModel, diag.Diagnostics) {
+	if m.Ownership.IsNull() || m.Ownership.IsUnknown() {
+		return nil, nil
+	}
+	var owner ownershipModel
+	diags := m.Ownership.As(ctx, &owner, basetypes.ObjectAsOptions{})
+	return &owner, diags
+}
+
+// ownerLabels returns the labels to set on a project owned by owner. An empty
+// value removes the label.
+func ownerLabels(owner *ownershipModel) map[string]string {
+	if owner == nil {
+		return map[string]string{ownerWorkspaceLabel: "", ownerAddressLabel: ""}
+	}
+	return map[string]string{
+		ownerWorkspaceLabel: owner.Workspace.ValueString(),
+		ownerAddressLabel:   owner.ResourceAddress.ValueString(),
+	}
+}
+
+// labelProjectOwner updates the ownership labels of the project serving data
+// after an apply. Without ownership, labels are only removed when wasOwned,
+// i.e. the resource labeled the project before. Failures are reported as
+// warnings, since the deployment itself succeeded.
+func labelProjectOwner(ctx context.Context, envClient *EnvironmentClient, data *ProjectDeploymentResourceModel, wasOwned bool, diags *diag.Diagnostics) {
+	owner, d := data.ownership(ctx)
+	diags.Append(d...)
+	if diags.HasError() || (owner == nil && !wasOwned) {
+		return
+	}
+
+	projectID := data.activeProjectID()
+	project, err := envClient.GetProject(ctx, projectID)
+	if err != nil {
+		addOwnerLabelsWarning(diags, projectID, err)
+		return
+	}
+	if owner != nil {
+		if current := project.Labels[ownerWorkspaceLabel]; current != "" && current != owner.Workspace.ValueString() {
+			previous := fmt.Sprintf("workspace %q", current)
+			if address := project.Labels[ownerAddressLabel]; address != "" {
+				previous += " by " + address
+			}
+			diags.AddWarning(
+				"Project owned by another workspace",
+				fmt.Sprintf("Project %q was labeled as deployed from %s and is now labeled as deployed from workspace %q. "+
+					"Configurations deploying the same project undo each other's changes; check which one should own it.",
+					project.Name, previous, owner.Workspace.ValueString()),
+			)
+		}
+	}
+
+	labels, changed := withOwnerLabels(project.Labels, ownerLabels(owner))
+	if !changed {
+		return
+	}
+	if _, err := envClient.UpdateProject(ctx, projectID, &arcane.ProjectUpdateRequest{Labels: &labels}); err != nil {
+		addOwnerLabelsWarning(diags, project.Name, err)
+	}
+}
+
+// unlabelProjectOwner removes the ownership labels from the project serving
+// data when the resource is destroyed, logging rather than reporting
+// failures, e.g. because the project is gone.
+func unlabelProjectOwner(ctx context.Context, envClient *EnvironmentClient, data *ProjectDeploymentResourceModel) {
+	if data.Ownership.IsNull() {
+		return
+	}
+	projectID := data.activeProjectID()
+	project, err := envClient.GetProject(ctx, projectID)
+	if err == nil {
+		labels, changed := withOwnerLabels(project.Labels, ownerLabels(nil))
+		if !changed {
+			return
+		}
+		_, err = envClient.UpdateProject(ctx, projectID, &arcane.ProjectUpdateRequest{Labels: &labels})
+	}
+	if err != nil {
+		tflog.Warn(ctx, "Could not remove ownership labels", map[string]interface{}{
+			"project_id": projectID,
+			"error":      err.Error(),
+		})
+	}
+}
+
+// withOwnerLabels returns labels with set applied, where an empty value
+// removes a label, and whether that changed anything.
+func withOwnerLabels(labels, set map[string]string) (map[string]string, bool) {
+	out := maps.Clone(labels)
+	if out == nil {
+		out = make(map[string]string)
+	}
+	for key, value := range set {
+		if value == "" {
+			delete(out, key)
+		} else {
+			out[key] = value
+		}
+	}
+	return out, !maps.Equal(labels, out)
+}
+
+// addOwnerLabelsWarning reports that the ownership labels of a project could
+// not be updated.
+func addOwnerLabelsWarning(diags *diag.Diagnostics, project string, err error) {
+	diags.AddWarning(
+		"Ownership labels not updated",
+		fmt.Sprintf("The project %q was deployed, but its ownership labels could not be updated: %s.", project, err),
+	)
+}
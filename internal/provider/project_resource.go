@@ -0,0 +1,549 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ProjectResource{}
+	_ resource.ResourceWithImportState    = &ProjectResource{}
+	_ resource.ResourceWithModifyPlan     = &ProjectResource{}
+	_ resource.ResourceWithValidateConfig = &ProjectResource{}
+)
+
+// NewProjectResource returns a new project resource.
+func NewProjectResource() resource.Resource {
+	return &ProjectResource{}
+}
+
+// ProjectResource defines the project resource implementation.
+type ProjectResource struct {
+	client *Client
+}
+
+// ProjectResourceModel describes the project resource data model.
+type ProjectResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	EnvironmentID     types.String `tfsdk:"environment_id"`
+	ProjectID         types.String `tfsdk:"project_id"`
+	Name              types.String `tfsdk:"name"`
+	ComposeContent    types.String `tfsdk:"compose_content"`
+	ComposeFile       types.String `tfsdk:"compose_file"`
+	EnvContent        types.String `tfsdk:"env_content"`
+	ExternalVariables types.Set    `tfsdk:"external_variables"`
+	StrictVariables   types.Bool   `tfsdk:"strict_variables"`
+	ComposeSHA256     types.String `tfsdk:"compose_sha256"`
+	ContentSHA256     types.String `tfsdk:"content_sha256"`
+	Status            types.String `tfsdk:"status"`
+	ManagedBySyncID   types.String `tfsdk:"managed_by_sync_id"`
+	AllowExisting     types.Bool   `tfsdk:"allow_existing"`
+	ConfirmDestroy    types.String `tfsdk:"confirm_destroy"`
+	APIKeyAlias       types.String `tfsdk:"api_key_alias"`
+}
+
+func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages a compose project in an Arcane environment, created from inline compose content or a
+local compose file.
+
+Creating or updating a project uploads its compose file and ` + "`.env`" + ` file but does not
+start it: deploy it with ` + "`arcane_project_deployment`" + `, using ` + "`compose_sha256`" + ` as a
+trigger to redeploy whenever the compose content changes. Destroying it stops the project and
+deletes it along with its directory on the agent.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "arcane_project" "webapp" {
+  environment_id  = arcane_environment.production.id
+  name            = "webapp"
+  compose_content = file("${path.module}/docker-compose.yml")
+  env_content     = provider::arcane::env_file_encode({ TAG = var.image_tag })
+}
+
+resource "arcane_project_deployment" "webapp" {
+  environment_id = arcane_environment.production.id
+  project_id     = arcane_project.webapp.project_id
+
+  triggers = {
+    compose = arcane_project.webapp.compose_sha256
+    env     = sha256(arcane_project.webapp.env_content)
+  }
+}
+` + "```" + `
+
+## Import
+
+Projects can be imported using ` + "`environment_id/project_id`" + `:
+
+` + "```shell" + `
+terraform import arcane_project.webapp <environment-id>/<project-id>
+` + "```" + `
+
+**Note:** The ` + "`.env`" + ` content of a project is not retrieved from the API, nor is the compose
+content by Arcane versions that don't return it, so the first apply after import uploads them again.
+`,
+		Attributes: map[string]schema.Attribute{
+			"api_key_alias": apiKeyAliasAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the project, `environment_id/project_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment to create the project in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project, e.g. for `arcane_project_deployment`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the project, unique within the environment. Docker Compose uses it as the project name, so renaming a deployed project recreates its containers on the next deploy.",
+				Required:            true,
+			},
+			"compose_content": schema.StringAttribute{
+				MarkdownDescription: "The content of the project's compose file. Exactly one of `compose_content` or `compose_file` must be set.",
+				Optional:            true,
+			},
+			"compose_file": schema.StringAttribute{
+				MarkdownDescription: "The path of a local compose file to upload, read by the provider on every plan so that changes to it are detected.",
+				Optional:            true,
+			},
+			"env_content": schema.StringAttribute{
+				MarkdownDescription: "The content of the project's `.env` file, e.g. from `provider::arcane::env_file_encode`. Left empty when unset.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"external_variables": schema.SetAttribute{
+				MarkdownDescription: "Names of variables the compose content may reference without `env_content` setting them, e.g. because the agent sets them. " +
+					"Other references without a default, such as `${TAG}` or `$TAG`, are reported during plan when `env_content` does not set them, " +
+					"since Compose would deploy them as empty values.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"strict_variables": schema.BoolAttribute{
+				MarkdownDescription: "Fail the plan, rather than warn, when the compose content references variables that neither `env_content` " +
+					"nor `external_variables` set. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"compose_sha256": schema.StringAttribute{
+				MarkdownDescription: "The hex-encoded SHA-256 hash of the uploaded compose content, with line endings normalized to LF.",
+				Computed:            true,
+			},
+			"content_sha256": schema.StringAttribute{
+				MarkdownDescription: "The hex-encoded SHA-256 hash of the compose content as stored by Arcane, without normalizing line endings. " +
+					"It differs from `compose_sha256` when a proxy or the agent changes the content on upload, e.g. its line endings or encoding, " +
+					"which a `check` block can assert on. Unset for Arcane versions that don't return the compose content.",
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the project, e.g. `stopped` until it is deployed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"managed_by_sync_id": schema.StringAttribute{
+				MarkdownDescription: managedBySyncIDDescription + " A `check` block can assert it is unset so that Terraform and GitOps never manage the same project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt a project with the same name in the environment on create, replacing its compose and `.env` content, instead of failing the plan. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"confirm_destroy": confirmDestroyAttribute("project"),
+		},
+	}
+}
+
+func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *ProjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ComposeContent.IsUnknown() && !data.ComposeFile.IsUnknown() && data.ComposeContent.IsNull() == data.ComposeFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("compose_content"),
+			"Invalid compose content",
+			"Exactly one of compose_content or compose_file must be set.",
+		)
+	}
+	if !data.ComposeContent.IsUnknown() && !data.ComposeContent.IsNull() && strings.TrimSpace(data.ComposeContent.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("compose_content"),
+			"Invalid compose content",
+			"The compose content must not be empty.",
+		)
+	}
+}
+
+// ModifyPlan rejects environments excluded by the provider's
+// allowed_environments or denied_environments, fails the plan of a project
+// whose name is taken, and plans compose_sha256 from the configured content
+// so that a compose file changed locally or on the agent is uploaded again.
+// content_sha256 is only unknown when the content is uploaded again.
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkPlannedEnvironmentAllowed(ctx, r.client, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sum := types.StringUnknown()
+	if content, ok := projectComposeContent(&plan, &resp.Diagnostics); ok {
+		sum = types.StringValue(sha256Hex([]byte(content)))
+		var external []types.String
+		resp.Diagnostics.Append(plan.ExternalVariables.ElementsAs(ctx, &external, false)...)
+		if !plan.EnvContent.IsUnknown() && !plan.ExternalVariables.IsUnknown() && !slices.ContainsFunc(external, types.String.IsUnknown) {
+			attr := path.Root("compose_content")
+			if !plan.ComposeFile.IsNull() {
+				attr = path.Root("compose_file")
+			}
+			checkComposeVariablesResolved(attr, content, plan.EnvContent.ValueString(), external, plan.StrictVariables.ValueBool(), &resp.Diagnostics)
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("compose_sha256"), sum)...)
+	if !req.State.Raw.IsNull() {
+		// The content may change without the config changing, e.g. in
+		// compose_file or on the agent, so plan content_sha256 explicitly
+		contentSum := types.StringUnknown()
+		if sum.Equal(state.ComposeSHA256) {
+			contentSum = state.ContentSHA256
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), contentSum)...)
+	}
+	if resp.Diagnostics.HasError() || r.client == nil {
+		return
+	}
+
+	// Check the name of new and renamed projects
+	if plan.EnvironmentID.IsUnknown() || plan.Name.IsUnknown() || plan.Name.Equal(state.Name) {
+		return
+	}
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+	envClient := r.client.ForEnvironment(plan.EnvironmentID.ValueString())
+	creating := req.State.Raw.IsNull()
+	checkProjectNameAvailable(ctx, envClient, plan.EnvironmentID.ValueString(), plan.Name.ValueString(), creating && plan.AllowExisting.ValueBool(), &resp.Diagnostics)
+}
+
+func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, ok := projectComposeContent(&data, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
+
+	var project *arcane.Project
+	if data.AllowExisting.ValueBool() {
+		existing, err := envClient.GetProjectByName(ctx, data.Name.ValueString())
+		switch {
+		case err == nil:
+			tflog.Info(ctx, "Adopting existing project (allow_existing=true)", map[string]interface{}{
+				"environment_id": data.EnvironmentID.ValueString(),
+				"project_id":     existing.ID,
+			})
+			envContent := normalizeLineEndings(data.EnvContent.ValueString())
+			project, err = envClient.UpdateProject(ctx, existing.ID, &arcane.ProjectUpdateRequest{
+				ComposeContent: content,
+				EnvContent:     &envContent,
+			})
+			if err != nil {
+				diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to update existing project")
+				return
+			}
+		case !arcane.IsNotFound(err):
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to look up existing project")
+			return
+		}
+	}
+	if project == nil {
+		// ModifyPlan can't check names whose environment_id or name is only
+		// known at apply time, nor projects created earlier in this apply
+		checkProjectNameAvailable(ctx, envClient, data.EnvironmentID.ValueString(), data.Name.ValueString(), data.AllowExisting.ValueBool(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		var err error
+		project, err = envClient.CreateProject(ctx, &arcane.ProjectCreateRequest{
+			Name:           data.Name.ValueString(),
+			ComposeContent: content,
+			EnvContent:     normalizeLineEndings(data.EnvContent.ValueString()),
+		})
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to create project")
+			return
+		}
+	}
+
+	data.ProjectID = types.StringValue(project.ID)
+	data.ID = types.StringValue(formatCompositeID(data.EnvironmentID.ValueString(), project.ID))
+	data.Status = types.StringValue(project.Status)
+	data.ComposeSHA256 = types.StringValue(sha256Hex([]byte(content)))
+	data.ContentSHA256 = storedContentSHA256(project)
+	data.ManagedBySyncID = projectManagedBySyncIDOrWarn(ctx, envClient, project, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	prior := data
+
+	checkCompositeID("arcane_project", &data.ID, &data.EnvironmentID, &data.ProjectID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
+	project, err := envClient.GetProject(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		if arcane.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read project")
+		return
+	}
+	data.ManagedBySyncID, err = projectManagedBySyncID(ctx, envClient, project)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list GitOps syncs")
+		return
+	}
+
+	data.Name = types.StringValue(project.Name)
+	data.Status = types.StringValue(project.Status)
+	// Arcane versions that don't return the compose content keep the hash
+	// of the last upload
+	if project.ComposeContent != "" {
+		data.ComposeSHA256 = types.StringValue(sha256Hex([]byte(normalizeLineEndings(project.ComposeContent))))
+	}
+	data.ContentSHA256 = storedContentSHA256(project)
+	if data.AllowExisting.IsNull() {
+		data.AllowExisting = types.BoolValue(false)
+	}
+	if data.StrictVariables.IsNull() {
+		data.StrictVariables = types.BoolValue(false)
+	}
+
+	drift := newDriftReport("arcane_project", data.ID.ValueString())
+	drift.compare("name", prior.Name, data.Name)
+	drift.compare("compose_sha256", prior.ComposeSHA256, data.ComposeSHA256)
+	drift.addTo(&resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+
+	var data, state ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, ok := projectComposeContent(&data, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	updateReq := &arcane.ProjectUpdateRequest{ComposeContent: content}
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueString()
+	}
+	if !data.EnvContent.Equal(state.EnvContent) {
+		envContent := normalizeLineEndings(data.EnvContent.ValueString())
+		updateReq.EnvContent = &envContent
+	}
+
+	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
+	project, err := envClient.UpdateProject(ctx, data.ProjectID.ValueString(), updateReq)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to update project")
+		return
+	}
+
+	data.ID = types.StringValue(formatCompositeID(data.EnvironmentID.ValueString(), data.ProjectID.ValueString()))
+	data.Status = types.StringValue(project.Status)
+	data.ComposeSHA256 = types.StringValue(sha256Hex([]byte(content)))
+	data.ContentSHA256 = storedContentSHA256(project)
+	data.ManagedBySyncID = projectManagedBySyncIDOrWarn(ctx, envClient, project, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	if !checkDestroyConfirmed(r.client, "project", name, data.ConfirmDestroy, []string{name}, &resp.Diagnostics) {
+		return
+	}
+
+	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
+	projectID := data.ProjectID.ValueString()
+
+	unlock := lockProjectForDeploy(ctx, envClient, projectID, &resp.Diagnostics)
+	if unlock == nil {
+		return
+	}
+	defer unlock()
+
+	if err := envClient.StopProject(ctx, projectID); err != nil {
+		if arcane.IsNotFound(err) {
+			return
+		}
+		addDeployError(ctx, &resp.Diagnostics, "Failed to stop project", projectID, err)
+		return
+	}
+	if err := envClient.DeleteProject(ctx, projectID); err != nil && !arcane.IsNotFound(err) {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to delete project")
+	}
+}
+
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	environmentID, projectID, ok := parseCompositeID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected format: environment_id/project_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+}
+
+// projectComposeContent returns the configured compose content, reading
+// compose_file, with line endings normalized to LF. It returns false while
+// the content is unknown, or after adding an error when the file can't be
+// read.
+func projectComposeContent(data *ProjectResourceModel, diags *diag.Diagnostics) (string, bool) {
+	if data.ComposeContent.IsUnknown() || data.ComposeFile.IsUnknown() {
+		return "", false
+	}
+	if data.ComposeFile.IsNull() {
+		return normalizeLineEndings(data.ComposeContent.ValueString()), true
+	}
+	content, err := os.ReadFile(data.ComposeFile.ValueString()) //nolint:gosec // reading the configured file is the point
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("compose_file"),
+			"Failed to read compose file",
+			fmt.Sprintf("Could not read %q: %s", data.ComposeFile.ValueString(), err),
+		)
+		return "", false
+	}
+	return normalizeLineEndings(string(content)), true
+}
+
+// storedContentSHA256 returns the hash of the compose content returned by
+// Arcane, byte for byte, or null when it isn't returned.
+func storedContentSHA256(project *arcane.Project) types.String {
+	if project.ComposeContent == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(sha256Hex([]byte(project.ComposeContent)))
+}
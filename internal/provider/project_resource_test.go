@@ -0,0 +1,429 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// newProjectMockServer returns a mock server with the empty environment
+// env-projects.
+func newProjectMockServer() *MockServer {
+	mockServer := NewMockServer()
+	mockServer.Environments["env-projects"] = &arcane.Environment{ID: "env-projects", Name: "projects-env"}
+	mockServer.HealthyEnvs["env-projects"] = true
+	return mockServer
+}
+
+// TestProjectResource_GivenComposeContent_WhenCreated_ThenProjectUploaded
+// validates that a project is created with its compose and .env content, and
+// that compose_sha256 is the hash of the compose content.
+func TestProjectResource_GivenComposeContent_WhenCreated_ThenProjectUploaded(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectConfig(mockServer.URL, "webapp", "services:\n  web:\n    image: nginx\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project.test", "id", "env-projects/proj-webapp"),
+					resource.TestCheckResourceAttr("arcane_project.test", "project_id", "proj-webapp"),
+					resource.TestCheckResourceAttr("arcane_project.test", "status", "stopped"),
+					resource.TestCheckResourceAttr("arcane_project.test", "compose_sha256", sha256Hex([]byte("services:\n  web:\n    image: nginx\n"))),
+					resource.TestCheckResourceAttrPair("arcane_project.test", "content_sha256", "arcane_project.test", "compose_sha256"),
+					resource.TestCheckNoResourceAttr("arcane_project.test", "managed_by_sync_id"),
+					func(*terraform.State) error {
+						if got := mockServer.ProjectEnvContent["proj-webapp"]; got != "TAG=1\n" {
+							return fmt.Errorf("env content = %q, want %q", got, "TAG=1\n")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// TestProjectResource_GivenComposeChanged_WhenApplied_ThenProjectUpdated
+// validates that changing the compose content or the name updates the
+// project in place.
+func TestProjectResource_GivenComposeChanged_WhenApplied_ThenProjectUpdated(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectConfig(mockServer.URL, "webapp", "services: {}\n"),
+			},
+			{
+				Config: testProjectConfig(mockServer.URL, "website", "services:\n  web:\n    image: nginx\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project.test", "id", "env-projects/proj-webapp"),
+					resource.TestCheckResourceAttr("arcane_project.test", "name", "website"),
+					resource.TestCheckResourceAttr("arcane_project.test", "compose_sha256", sha256Hex([]byte("services:\n  web:\n    image: nginx\n"))),
+					mockServer.CheckRequestCount(http.MethodPut, "/api/environments/env-projects/projects/proj-webapp", 1),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectResource_GivenComposeFile_WhenFileChanged_ThenProjectUpdated
+// validates that compose_file is read on plan, so that editing the file
+// uploads it again.
+func TestProjectResource_GivenComposeFile_WhenFileChanged_ThenProjectUpdated(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	composeFile := filepath.Join(t.TempDir(), "docker-compose.yml")
+	writeCompose := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(composeFile, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	writeCompose("services: {}\r\n")()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectConfigFile(mockServer.URL, composeFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project.test", "compose_sha256", sha256Hex([]byte("services: {}\n"))),
+				),
+			},
+			{
+				PreConfig: writeCompose("services:\n  web:\n    image: nginx\n"),
+				Config:    testProjectConfigFile(mockServer.URL, composeFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project.test", "compose_sha256", sha256Hex([]byte("services:\n  web:\n    image: nginx\n"))),
+					mockServer.CheckRequestCount(http.MethodPut, "/api/environments/env-projects/projects/proj-webapp", 1),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectResource_GivenComposeChangedOnAgent_WhenApplied_ThenComposeRestored
+// validates that compose content modified outside Terraform is detected by
+// its hash and uploaded again.
+func TestProjectResource_GivenComposeChangedOnAgent_WhenApplied_ThenComposeRestored(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectConfig(mockServer.URL, "webapp", "services: {}\n"),
+			},
+			{
+				PreConfig: func() {
+					mockServer.Projects["env-projects"]["proj-webapp"].ComposeContent = "services:\n  edited: {}\n"
+				},
+				Config: testProjectConfig(mockServer.URL, "webapp", "services: {}\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project.test", "compose_sha256", sha256Hex([]byte("services: {}\n"))),
+					mockServer.CheckRequestCount(http.MethodPut, "/api/environments/env-projects/projects/proj-webapp", 1),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectResource_GivenLineEndingsChangedOnAgent_WhenRefreshed_ThenContentSHA256Differs
+// validates that content_sha256 hashes the compose content as stored, so
+// that line endings converted on upload show up there without making
+// compose_sha256 drift.
+func TestProjectResource_GivenLineEndingsChangedOnAgent_WhenRefreshed_ThenContentSHA256Differs(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectConfig(mockServer.URL, "webapp", "services: {}\n"),
+			},
+			{
+				PreConfig: func() {
+					mockServer.Projects["env-projects"]["proj-webapp"].ComposeContent = "services: {}\r\n"
+				},
+				Config: testProjectConfig(mockServer.URL, "webapp", "services: {}\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project.test", "compose_sha256", sha256Hex([]byte("services: {}\n"))),
+					resource.TestCheckResourceAttr("arcane_project.test", "content_sha256", sha256Hex([]byte("services: {}\r\n"))),
+					mockServer.CheckRequestCount(http.MethodPut, "/api/environments/env-projects/projects/proj-webapp", 0),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectResource_GivenExistingName_WhenPlanned_ThenError validates that
+// a project whose name is taken fails the plan unless allow_existing adopts
+// it.
+func TestProjectResource_GivenExistingName_WhenPlanned_ThenError(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+	mockServer.AddProject("env-projects", &arcane.Project{ID: "proj-existing", Name: "webapp", Status: "running"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testProjectConfig(mockServer.URL, "webapp", "services: {}\n"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Project already exists`),
+			},
+			{
+				Config: testProjectConfigAllowExisting(mockServer.URL, "webapp", "services: {}\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project.test", "project_id", "proj-existing"),
+					resource.TestCheckResourceAttr("arcane_project.test", "status", "running"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-projects/projects", 0),
+				),
+			},
+		},
+	})
+}
+
+// TestProjectResource_GivenNameTakenDuringApply_WhenCreated_ThenError
+// validates that create checks the name again, for projects that didn't exist
+// yet when the plan was checked.
+func TestProjectResource_GivenNameTakenDuringApply_WhenCreated_ThenError(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testProjectConfigDuplicateName(mockServer.URL, "webapp"),
+				ExpectError: regexp.MustCompile(`Project already exists`),
+			},
+		},
+	})
+}
+
+// TestProjectResource_GivenExistingProject_WhenImported_ThenStateMatches
+// validates import by environment_id/project_id.
+func TestProjectResource_GivenExistingProject_WhenImported_ThenStateMatches(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectConfig(mockServer.URL, "webapp", "services: {}\n"),
+			},
+			{
+				ResourceName:            "arcane_project.test",
+				ImportState:             true,
+				ImportStateId:           "env-projects/proj-webapp",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"compose_content", "env_content"},
+			},
+		},
+	})
+}
+
+// TestProjectResource_GivenProject_WhenDestroyed_ThenProjectDeleted validates
+// that destroying the resource deletes the project.
+func TestProjectResource_GivenProject_WhenDestroyed_ThenProjectDeleted(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if _, ok := mockServer.Projects["env-projects"]["proj-webapp"]; ok {
+				return fmt.Errorf("project proj-webapp was not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectConfig(mockServer.URL, "webapp", "services: {}\n"),
+			},
+		},
+	})
+}
+
+// TestProjectResource_GivenInvalidComposeSource_WhenValidated_ThenError
+// validates that exactly one of compose_content and compose_file is required.
+func TestProjectResource_GivenInvalidComposeSource_WhenValidated_ThenError(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project" "test" {
+  environment_id = "env-projects"
+  name           = "webapp"
+}
+`, mockServer.URL),
+				ExpectError: regexp.MustCompile(`Exactly one of compose_content or compose_file`),
+			},
+			{
+				Config:      testProjectConfig(mockServer.URL, "webapp", "  \n"),
+				ExpectError: regexp.MustCompile(`must not be empty`),
+			},
+		},
+	})
+}
+
+// TestProjectResource_GivenUnresolvedVariables_WhenPlanned_ThenError
+// validates that with strict_variables, compose variables without a default
+// must be set in env_content or listed in external_variables.
+func TestProjectResource_GivenUnresolvedVariables_WhenPlanned_ThenError(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testProjectConfigVariables(mockServer.URL, "[]", true),
+				ExpectError: regexp.MustCompile(`(?s)Unresolved compose variables.*DB_PASSWORD, REPLICAS`),
+			},
+			{
+				Config: testProjectConfigVariables(mockServer.URL, `["DB_PASSWORD", "REPLICAS"]`, true),
+				Check:  resource.TestCheckResourceAttr("arcane_project.test", "project_id", "proj-webapp"),
+			},
+		},
+	})
+}
+
+// TestProjectResource_GivenUnresolvedVariablesWithoutStrict_WhenApplied_ThenCreated
+// validates that unresolved compose variables only warn by default.
+func TestProjectResource_GivenUnresolvedVariablesWithoutStrict_WhenApplied_ThenCreated(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectConfigVariables(mockServer.URL, "[]", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_project.test", "project_id", "proj-webapp"),
+					resource.TestCheckResourceAttr("arcane_project.test", "strict_variables", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testProjectConfig(url, name, compose string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project" "test" {
+  environment_id  = "env-projects"
+  name            = %[2]q
+  compose_content = %[3]q
+  env_content     = "TAG=1\n"
+}
+`, url, name, compose)
+}
+
+func testProjectConfigDuplicateName(url, name string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project" "first" {
+  environment_id  = "env-projects"
+  name            = %[2]q
+  compose_content = "services: {}\n"
+}
+
+resource "arcane_project" "second" {
+  environment_id  = "env-projects"
+  name            = %[2]q
+  compose_content = "services: {}\n"
+
+  depends_on = [arcane_project.first]
+}
+`, url, name)
+}
+
+func testProjectConfigAllowExisting(url, name, compose string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project" "test" {
+  environment_id  = "env-projects"
+  name            = %[2]q
+  compose_content = %[3]q
+  allow_existing  = true
+}
+`, url, name, compose)
+}
+
+func testProjectConfigFile(url, composeFile string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project" "test" {
+  environment_id = "env-projects"
+  name           = "webapp"
+  compose_file   = %[2]q
+}
+`, url, composeFile)
+}
+
+func testProjectConfigVariables(url, externalVariables string, strict bool) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_project" "test" {
+  environment_id     = "env-projects"
+  name               = "webapp"
+  compose_content    = <<-EOT
+    services:
+      web:
+        image: nginx:$${TAG}
+        scale: $REPLICAS
+        environment:
+          DB_PASSWORD: $${DB_PASSWORD}
+          LOG_LEVEL: $${LOG_LEVEL:-info}
+  EOT
+  env_content        = "TAG=1\n"
+  external_variables = %[2]s
+  strict_variables   = %[3]t
+}
+`, url, externalVariables, strict)
+}
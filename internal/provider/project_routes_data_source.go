@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectRoutesDataSource{}
+
+// traefikRouterLabel matches Traefik router labels, capturing the protocol,
+// router name and option (e.g. "rule", "entrypoints", "tls.certresolver").
+var traefikRouterLabel = regexp.MustCompile(`^traefik\.(http|tcp)\.routers\.([^.]+)\.(.+)$`)
+
+// traefikHostMatcher matches the Host and HostSNI matchers of a rule,
+// capturing their arguments.
+var traefikHostMatcher = regexp.MustCompile(`\bHost(?:SNI)?\(([^)]*)\)`)
+
+// traefikRuleArg matches a quoted matcher argument.
+var traefikRuleArg = regexp.MustCompile("[`\"]([^`\"]*)[`\"]")
+
+// NewProjectRoutesDataSource returns a new project routes data source.
+func NewProjectRoutesDataSource() datasource.DataSource {
+	return &ProjectRoutesDataSource{}
+}
+
+// ProjectRoutesDataSource defines the project routes data source implementation.
+type ProjectRoutesDataSource struct {
+	client *Client
+}
+
+// ProjectRoutesDataSourceModel describes the project routes data source data model.
+type ProjectRoutesDataSourceModel struct {
+	EnvironmentID types.String        `tfsdk:"environment_id"`
+	ProjectID     types.String        `tfsdk:"project_id"`
+	Hosts         []types.String      `tfsdk:"hosts"`
+	Routes        []traefikRouteModel `tfsdk:"routes"`
+}
+
+// traefikRouteModel describes an element of routes.
+type traefikRouteModel struct {
+	Container    types.String   `tfsdk:"container"`
+	Router       types.String   `tfsdk:"router"`
+	Protocol     types.String   `tfsdk:"protocol"`
+	Rule         types.String   `tfsdk:"rule"`
+	Hosts        []types.String `tfsdk:"hosts"`
+	EntryPoints  []types.String `tfsdk:"entrypoints"`
+	TLS          types.Bool     `tfsdk:"tls"`
+	CertResolver types.String   `tfsdk:"cert_resolver"`
+}
+
+func (d *ProjectRoutesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_routes"
+}
+
+func (d *ProjectRoutesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to read the Traefik routers declared by the labels of a project's containers.
+
+Routers are read from ` + "`traefik.http.routers.<name>.*`" + ` and ` + "`traefik.tcp.routers.<name>.*`" + ` labels of the
+running containers, so DNS records and certificates can be generated from what is actually deployed.
+Containers labelled ` + "`traefik.enable=false`" + ` are skipped.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_project_routes" "webapp" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+}
+
+resource "cloudflare_record" "webapp" {
+  for_each = toset(data.arcane_project_routes.webapp.hosts)
+
+  zone_id = var.zone_id
+  name    = each.value
+  type    = "CNAME"
+  content = "proxy.example.com"
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment containing the project.",
+				Required:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project to query.",
+				Required:            true,
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: "The distinct host names matched by all routes, sorted.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"routes": schema.ListNestedAttribute{
+				MarkdownDescription: "The routers declared by the containers, by container and router name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"container": schema.StringAttribute{
+							MarkdownDescription: "The name of the container declaring the router.",
+							Computed:            true,
+						},
+						"router": schema.StringAttribute{
+							MarkdownDescription: "The router name.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "The router protocol (http, tcp).",
+							Computed:            true,
+						},
+						"rule": schema.StringAttribute{
+							MarkdownDescription: "The router rule, e.g. ``Host(`app.example.com`)``.",
+							Computed:            true,
+						},
+						"hosts": schema.ListAttribute{
+							MarkdownDescription: "The host names of the rule's `Host` (or `HostSNI`) matchers.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"entrypoints": schema.ListAttribute{
+							MarkdownDescription: "The entrypoints the router listens on. Empty when the router uses Traefik's default entrypoints.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"tls": schema.BoolAttribute{
+							MarkdownDescription: "Whether the router terminates TLS.",
+							Computed:            true,
+						},
+						"cert_resolver": schema.StringAttribute{
+							MarkdownDescription: "The certificate resolver of the router, if any.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectRoutesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ProjectRoutesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data ProjectRoutesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.RedactRuntimeDetails() {
+		resp.Diagnostics.AddError(
+			"Runtime details redacted",
+			"The provider is configured with redact_runtime_details, so container labels can't be read into state.",
+		)
+		return
+	}
+
+	envClient := d.client.ForEnvironment(data.EnvironmentID.ValueString())
+	containers, err := envClient.GetProjectContainers(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read project containers")
+		return
+	}
+
+	data.Routes = []traefikRouteModel{}
+	var hosts []string
+	for _, c := range containers {
+		for _, route := range traefikRoutes(c.Labels) {
+			data.Routes = append(data.Routes, traefikRouteModel{
+				Container:    types.StringValue(c.Name),
+				Router:       types.StringValue(route.Router),
+				Protocol:     types.StringValue(route.Protocol),
+				Rule:         types.StringValue(route.Rule),
+				Hosts:        stringValues(route.Hosts),
+				EntryPoints:  stringValues(route.EntryPoints),
+				TLS:          types.BoolValue(route.TLS),
+				CertResolver: optionalString(route.CertResolver),
+			})
+			hosts = append(hosts, route.Hosts...)
+		}
+	}
+	slices.Sort(hosts)
+	data.Hosts = stringValues(slices.Compact(hosts))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// traefikRoute is a router declared by Traefik labels.
+type traefikRoute struct {
+	Router       string
+	Protocol     string
+	Rule         string
+	Hosts        []string
+	EntryPoints  []string
+	TLS          bool
+	CertResolver string
+}
+
+// traefikRoutes returns the routers declared by a container's labels, sorted
+// by protocol and name. A container labelled traefik.enable=false has none.
+func traefikRoutes(labels map[string]string) []traefikRoute {
+	if strings.EqualFold(labels["traefik.enable"], "false") {
+		return nil
+	}
+
+	routers := map[string]*traefikRoute{}
+	for label, value := range labels {
+		m := traefikRouterLabel.FindStringSubmatch(label)
+		if m == nil {
+			continue
+		}
+		key := m[1] + "/" + m[2]
+		route, ok := routers[key]
+		if !ok {
+			route = &traefikRoute{Router: m[2], Protocol: m[1]}
+			routers[key] = route
+		}
+
+		switch option := m[3]; {
+		case option == "rule":
+			route.Rule = value
+			route.Hosts = traefikRuleHosts(value)
+		case option == "entrypoints":
+			route.EntryPoints = splitList(value)
+		case option == "tls":
+			route.TLS = route.TLS || strings.EqualFold(value, "true")
+		case option == "tls.certresolver":
+			route.TLS = true
+			route.CertResolver = value
+		case strings.HasPrefix(option, "tls."):
+			route.TLS = true
+		}
+	}
+
+	keys := make([]string, 0, len(routers))
+	for key := range routers {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	routes := make([]traefikRoute, 0, len(keys))
+	for _, key := range keys {
+		routes = append(routes, *routers[key])
+	}
+	return routes
+}
+
+// traefikRuleHosts returns the host names of a rule's Host and HostSNI
+// matchers in rule order, without duplicates.
+func traefikRuleHosts(rule string) []string {
+	var hosts []string
+	for _, matcher := range traefikHostMatcher.FindAllStringSubmatch(rule, -1) {
+		for _, arg := range traefikRuleArg.FindAllStringSubmatch(matcher[1], -1) {
+			if host := strings.TrimSpace(arg[1]); host != "" && host != "*" && !slices.Contains(hosts, host) {
+				hosts = append(hosts, host)
+			}
+		}
+	}
+	return hosts
+}
+
+// splitList splits a comma-separated label value, dropping empty items.
+func splitList(value string) []string {
+	var items []string
+	for item := range strings.SplitSeq(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// stringValues converts strings to a non-nil slice of framework values.
+func stringValues(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, v := range values {
+		result = append(result, types.StringValue(v))
+	}
+	return result
+}
+
+// optionalString returns a null string for an empty value.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
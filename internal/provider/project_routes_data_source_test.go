@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestProjectRoutesDataSource_GivenTraefikLabels_WhenRead_ThenRoutesExtracted
+// validates that routers are extracted from container labels and their hosts collected.
+func TestProjectRoutesDataSource_GivenTraefikLabels_WhenRead_ThenRoutesExtracted(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	envID := "env-routes-1"
+	projectID := "proj-routes-1"
+
+	mockServer.Environments[envID] = &arcane.Environment{ID: envID, Name: "routes-test-env"}
+	mockServer.HealthyEnvs[envID] = true
+	mockServer.AddProject(envID, &arcane.Project{ID: projectID, Name: "webapp", EnvironmentID: envID})
+	mockServer.AddContainers(envID, projectID, []arcane.ContainerDetail{
+		{
+			ID:   "c1",
+			Name: "web",
+			Labels: map[string]string{
+				"traefik.enable":                                     "true",
+				"traefik.http.routers.web.rule":                      "Host(`app.example.com`) || Host(`www.example.com`)",
+				"traefik.http.routers.web.entrypoints":               "websecure",
+				"traefik.http.routers.web.tls.certresolver":          "letsencrypt",
+				"traefik.http.services.web.loadbalancer.server.port": "8080",
+			},
+		},
+		{
+			ID:   "c2",
+			Name: "db",
+			Labels: map[string]string{
+				"traefik.enable":               "false",
+				"traefik.http.routers.db.rule": "Host(`db.example.com`)",
+			},
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProjectRoutesDataSourceConfig(mockServer.URL, envID, projectID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_project_routes.test", "routes.#", "1"),
+					resource.TestCheckResourceAttr("data.arcane_project_routes.test", "routes.0.container", "web"),
+					resource.TestCheckResourceAttr("data.arcane_project_routes.test", "routes.0.router", "web"),
+					resource.TestCheckResourceAttr("data.arcane_project_routes.test", "routes.0.protocol", "http"),
+					resource.TestCheckResourceAttr("data.arcane_project_routes.test", "routes.0.entrypoints.#", "1"),
+					resource.TestCheckResourceAttr("data.arcane_project_routes.test", "routes.0.entrypoints.0", "websecure"),
+					resource.TestCheckResourceAttr("data.arcane_project_routes.test", "routes.0.tls", "true"),
+					resource.TestCheckResourceAttr("data.arcane_project_routes.test", "routes.0.cert_resolver", "letsencrypt"),
+					resource.TestCheckResourceAttr("data.arcane_project_routes.test", "hosts.#", "2"),
+					resource.TestCheckResourceAttr("data.arcane_project_routes.test", "hosts.0", "app.example.com"),
+					resource.TestCheckResourceAttr("data.arcane_project_routes.test", "hosts.1", "www.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestTraefikRoutes(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		labels map[string]string
+		want   string
+	}{
+		{
+			name:   "no labels",
+			labels: nil,
+			want:   "[]",
+		},
+		{
+			name:   "disabled",
+			labels: map[string]string{"traefik.enable": "False", "traefik.http.routers.a.rule": "Host(`a.example.com`)"},
+			want:   "[]",
+		},
+		{
+			name: "plain http router",
+			labels: map[string]string{
+				"traefik.http.routers.a.rule":        "Host(`a.example.com`) && PathPrefix(`/api`)",
+				"traefik.http.routers.a.entrypoints": "web, websecure",
+			},
+			want: "[{a http Host(`a.example.com`) && PathPrefix(`/api`) [a.example.com] [web websecure] false }]",
+		},
+		{
+			name: "tls without resolver",
+			labels: map[string]string{
+				"traefik.http.routers.a.rule": "Host(`a.example.com`, `b.example.com`)",
+				"traefik.http.routers.a.tls":  "true",
+			},
+			want: "[{a http Host(`a.example.com`, `b.example.com`) [a.example.com b.example.com] [] true }]",
+		},
+		{
+			name: "tcp router",
+			labels: map[string]string{
+				"traefik.tcp.routers.pg.rule":        "HostSNI(`db.example.com`)",
+				"traefik.tcp.routers.pg.tls.options": "modern@file",
+			},
+			want: "[{pg tcp HostSNI(`db.example.com`) [db.example.com] [] true }]",
+		},
+		{
+			name: "sorted routers",
+			labels: map[string]string{
+				"traefik.http.routers.b.rule": "Host(`b.example.com`)",
+				"traefik.http.routers.a.rule": "Host(`a.example.com`)",
+			},
+			want: "[{a http Host(`a.example.com`) [a.example.com] [] false } {b http Host(`b.example.com`) [b.example.com] [] false }]",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := fmt.Sprint(traefikRoutes(tc.labels)); got != tc.want {
+				t.Errorf("traefikRoutes() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTraefikRuleHosts(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"Host(`a.example.com`)":                          "[a.example.com]",
+		"Host(`a.example.com`) || Host(`a.example.com`)": "[a.example.com]",
+		`Host("a.example.com")`:                          "[a.example.com]",
+		"HostSNI(`*`)":                                   "[]",
+		"HostRegexp(`{sub:[a-z]+}.example.com`)":         "[]",
+		"PathPrefix(`/`)":                                "[]",
+	}
+
+	for rule, want := range cases {
+		if got := fmt.Sprint(traefikRuleHosts(rule)); got != want {
+			t.Errorf("traefikRuleHosts(%q) = %s, want %s", rule, got, want)
+		}
+	}
+}
+
+func testProjectRoutesDataSourceConfig(url, envID, projectID string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_project_routes" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+}
+`, url, envID, projectID)
+}
@@ -7,14 +7,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &ProjectStatusDataSource{}
+var (
+	_ datasource.DataSource                   = &ProjectStatusDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ProjectStatusDataSource{}
+)
 
 // NewProjectStatusDataSource returns a new project status data source.
 func NewProjectStatusDataSource() datasource.DataSource {
@@ -23,7 +26,7 @@ func NewProjectStatusDataSource() datasource.DataSource {
 
 // ProjectStatusDataSource defines the project status data source implementation.
 type ProjectStatusDataSource struct {
-	client *client.Client
+	client *Client
 }
 
 // ProjectStatusDataSourceModel describes the project status data source data model.
@@ -34,6 +37,9 @@ type ProjectStatusDataSourceModel struct {
 	Status        types.String `tfsdk:"status"`
 	Path          types.String `tfsdk:"path"`
 	Containers    types.List   `tfsdk:"containers"`
+	HealthPolicy  types.String `tfsdk:"health_policy"`
+	Health        types.String `tfsdk:"health"`
+	IncludeEnv    types.Bool   `tfsdk:"include_env"`
 }
 
 func (d *ProjectStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -59,6 +65,17 @@ data "arcane_project_status" "webapp" {
 output "container_health" {
   value = data.arcane_project_status.webapp.containers
 }
+
+# Healthy as long as most replicas are
+data "arcane_project_status" "workers" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.workers.id
+  health_policy  = "quorum"
+}
+
+output "workers_health" {
+  value = data.arcane_project_status.workers.health
+}
 ` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
@@ -82,6 +99,18 @@ output "container_health" {
 				MarkdownDescription: "The path to the docker-compose file on the host.",
 				Computed:            true,
 			},
+			"health_policy": schema.StringAttribute{
+				MarkdownDescription: healthPolicyDescription,
+				Optional:            true,
+			},
+			"health": schema.StringAttribute{
+				MarkdownDescription: healthDescription,
+				Computed:            true,
+			},
+			"include_env": schema.BoolAttribute{
+				MarkdownDescription: includeEnvDescription,
+				Optional:            true,
+			},
 			"containers": schema.ListNestedAttribute{
 				MarkdownDescription: "The containers in this project with detailed runtime information.",
 				Computed:            true,
@@ -108,7 +137,7 @@ output "container_health" {
 							Computed:            true,
 						},
 						"ports": schema.ListNestedAttribute{
-							MarkdownDescription: "Port mappings for the container.",
+							MarkdownDescription: "Port mappings for the container. Null when the provider's `redact_runtime_details` is set.",
 							Computed:            true,
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
@@ -127,6 +156,25 @@ output "container_health" {
 								},
 							},
 						},
+						"labels": schema.MapAttribute{
+							MarkdownDescription: containerLabelsDescription,
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"env": schema.MapAttribute{
+							MarkdownDescription: containerEnvDescription,
+							Computed:            true,
+							Sensitive:           true,
+							ElementType:         types.StringType,
+						},
+						"restart_count": schema.Int64Attribute{
+							MarkdownDescription: restartCountDescription,
+							Computed:            true,
+						},
+						"started_at": schema.StringAttribute{
+							MarkdownDescription: startedAtDescription,
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -139,11 +187,11 @@ func (d *ProjectStatusDataSource) Configure(ctx context.Context, req datasource.
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	c, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
 		)
 		return
 	}
@@ -151,6 +199,15 @@ func (d *ProjectStatusDataSource) Configure(ctx context.Context, req datasource.
 	d.client = c
 }
 
+func (d *ProjectStatusDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var policy types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("health_policy"), &policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkHealthPolicy(path.Root("health_policy"), policy, &resp.Diagnostics)
+}
+
 var containerPortObjectType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
 		"host_port":      types.Int64Type,
@@ -161,16 +218,23 @@ var containerPortObjectType = types.ObjectType{
 
 var containerObjectType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
-		"id":     types.StringType,
-		"name":   types.StringType,
-		"image":  types.StringType,
-		"status": types.StringType,
-		"health": types.StringType,
-		"ports":  types.ListType{ElemType: containerPortObjectType},
+		"id":            types.StringType,
+		"name":          types.StringType,
+		"image":         types.StringType,
+		"status":        types.StringType,
+		"health":        types.StringType,
+		"ports":         types.ListType{ElemType: containerPortObjectType},
+		"labels":        types.MapType{ElemType: types.StringType},
+		"env":           types.MapType{ElemType: types.StringType},
+		"restart_count": types.Int64Type,
+		"started_at":    types.StringType,
 	},
 }
 
 func (d *ProjectStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
 	var data ProjectStatusDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -183,7 +247,7 @@ func (d *ProjectStatusDataSource) Read(ctx context.Context, req datasource.ReadR
 	// Get project with container details
 	project, err := envClient.GetProject(ctx, data.ProjectID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read project status", err.Error())
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read project status")
 		return
 	}
 
@@ -199,23 +263,29 @@ func (d *ProjectStatusDataSource) Read(ctx context.Context, req datasource.ReadR
 	// Get container details
 	containers, err := envClient.GetProjectContainers(ctx, data.ProjectID.ValueString())
 	if err != nil {
+		data.Health = types.StringValue(projectHealthUnknown)
+
 		// Fallback: build container list from project services
 		if len(project.Services) > 0 {
 			containerValues := make([]attr.Value, len(project.Services))
 			for i, svc := range project.Services {
-				portsListVal, diags := types.ListValue(containerPortObjectType, []attr.Value{})
+				portsListVal, diags := containerPortsValue(nil, d.client.RedactRuntimeDetails())
 				resp.Diagnostics.Append(diags...)
 				if resp.Diagnostics.HasError() {
 					return
 				}
 
 				objVal, diags := types.ObjectValue(containerObjectType.AttrTypes, map[string]attr.Value{
-					"id":     types.StringValue(""),
-					"name":   types.StringValue(svc.Name),
-					"image":  types.StringValue(svc.Image),
-					"status": types.StringValue(svc.Status),
-					"health": types.StringValue(""),
-					"ports":  portsListVal,
+					"id":            types.StringValue(""),
+					"name":          types.StringValue(svc.Name),
+					"image":         types.StringValue(svc.Image),
+					"status":        types.StringValue(svc.Status),
+					"health":        types.StringValue(""),
+					"ports":         portsListVal,
+					"labels":        types.MapNull(types.StringType),
+					"env":           types.MapNull(types.StringType),
+					"restart_count": types.Int64Null(),
+					"started_at":    types.StringNull(),
 				})
 				resp.Diagnostics.Append(diags...)
 				if resp.Diagnostics.HasError() {
@@ -236,35 +306,15 @@ func (d *ProjectStatusDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	data.Health = types.StringValue(aggregateHealth(containers, data.HealthPolicy.ValueString()))
+
 	// Build container list from detailed response
+	withEnv := includeEnv(data.IncludeEnv, d.client.RedactRuntimeDetails(), &resp.Diagnostics)
 	if len(containers) > 0 {
 		containerValues := make([]attr.Value, len(containers))
 		for i, c := range containers {
-			// Build ports list
-			var portsListVal types.List
-			if len(c.Ports) > 0 {
-				portValues := make([]attr.Value, len(c.Ports))
-				for j, p := range c.Ports {
-					portObj, diags := types.ObjectValue(containerPortObjectType.AttrTypes, map[string]attr.Value{
-						"host_port":      types.Int64Value(int64(p.HostPort)),
-						"container_port": types.Int64Value(int64(p.ContainerPort)),
-						"protocol":       types.StringValue(p.Protocol),
-					})
-					resp.Diagnostics.Append(diags...)
-					if resp.Diagnostics.HasError() {
-						return
-					}
-					portValues[j] = portObj
-				}
-				var portsDiags diag.Diagnostics
-				portsListVal, portsDiags = types.ListValue(containerPortObjectType, portValues)
-				resp.Diagnostics.Append(portsDiags...)
-			} else {
-				emptyList, diags := types.ListValue(containerPortObjectType, []attr.Value{})
-				resp.Diagnostics.Append(diags...)
-				portsListVal = emptyList
-			}
-
+			portsListVal, diags := containerPortsValue(c.Ports, d.client.RedactRuntimeDetails())
+			resp.Diagnostics.Append(diags...)
 			if resp.Diagnostics.HasError() {
 				return
 			}
@@ -280,12 +330,16 @@ func (d *ProjectStatusDataSource) Read(ctx context.Context, req datasource.ReadR
 			}
 
 			objVal, diags := types.ObjectValue(containerObjectType.AttrTypes, map[string]attr.Value{
-				"id":     types.StringValue(c.ID),
-				"name":   types.StringValue(c.Name),
-				"image":  imageVal,
-				"status": types.StringValue(c.Status),
-				"health": healthVal,
-				"ports":  portsListVal,
+				"id":            types.StringValue(c.ID),
+				"name":          types.StringValue(c.Name),
+				"image":         imageVal,
+				"status":        types.StringValue(c.Status),
+				"health":        healthVal,
+				"ports":         portsListVal,
+				"labels":        containerLabelsValue(c.Labels, d.client.RedactRuntimeDetails()),
+				"env":           containerEnvValue(c, withEnv),
+				"restart_count": types.Int64Value(int64(c.RestartCount)),
+				"started_at":    containerStartedAtValue(c),
 			})
 			resp.Diagnostics.Append(diags...)
 			if resp.Diagnostics.HasError() {
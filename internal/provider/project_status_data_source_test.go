@@ -2,11 +2,12 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // TestProjectStatusDataSource_GivenProjectWithContainers_WhenRead_ThenContainerDetailsPopulated
@@ -19,12 +20,12 @@ func TestProjectStatusDataSource_GivenProjectWithContainers_WhenRead_ThenContain
 	projectID := "proj-status-1"
 
 	// Pre-populate mock server with environment and project
-	mockServer.Environments[envID] = &client.Environment{
+	mockServer.Environments[envID] = &arcane.Environment{
 		ID:   envID,
 		Name: "status-test-env",
 	}
 	mockServer.HealthyEnvs[envID] = true
-	mockServer.AddProject(envID, &client.Project{
+	mockServer.AddProject(envID, &arcane.Project{
 		ID:            projectID,
 		Name:          "webapp",
 		Status:        "running",
@@ -33,14 +34,14 @@ func TestProjectStatusDataSource_GivenProjectWithContainers_WhenRead_ThenContain
 	})
 
 	// Add container details
-	mockServer.AddContainers(envID, projectID, []client.ContainerDetail{
+	mockServer.AddContainers(envID, projectID, []arcane.ContainerDetail{
 		{
 			ID:     "c1",
 			Name:   "web",
 			Image:  "nginx:latest",
 			Status: "running",
 			Health: "healthy",
-			Ports: []client.ContainerPort{
+			Ports: []arcane.ContainerPort{
 				{HostPort: 8080, ContainerPort: 80, Protocol: "tcp"},
 			},
 		},
@@ -65,6 +66,7 @@ func TestProjectStatusDataSource_GivenProjectWithContainers_WhenRead_ThenContain
 					resource.TestCheckResourceAttr("data.arcane_project_status.test", "containers.0.ports.0.host_port", "8080"),
 					resource.TestCheckResourceAttr("data.arcane_project_status.test", "containers.0.ports.0.container_port", "80"),
 					resource.TestCheckResourceAttr("data.arcane_project_status.test", "containers.0.ports.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr("data.arcane_project_status.test", "health", "healthy"),
 				),
 			},
 		},
@@ -81,18 +83,18 @@ func TestProjectStatusDataSource_GivenProjectWithoutContainers_WhenRead_ThenFall
 	projectID := "proj-status-2"
 
 	// Pre-populate mock server with environment and project (with services but no containers)
-	mockServer.Environments[envID] = &client.Environment{
+	mockServer.Environments[envID] = &arcane.Environment{
 		ID:   envID,
 		Name: "fallback-test-env",
 	}
 	mockServer.HealthyEnvs[envID] = true
-	mockServer.AddProject(envID, &client.Project{
+	mockServer.AddProject(envID, &arcane.Project{
 		ID:            projectID,
 		Name:          "api-service",
 		Status:        "running",
 		Path:          "/opt/stacks/api",
 		EnvironmentID: envID,
-		Services: []client.ProjectService{
+		Services: []arcane.ProjectService{
 			{Name: "api", Status: "running", Image: "myapp:v1"},
 			{Name: "db", Status: "running", Image: "postgres:15"},
 		},
@@ -125,6 +127,47 @@ func TestProjectStatusDataSource_GivenProjectWithoutContainers_WhenRead_ThenFall
 	})
 }
 
+// TestProjectStatusDataSource_GivenHealthPolicy_WhenRead_ThenHealthAggregated
+// validates that health reflects the configured policy when one of three
+// containers is unhealthy.
+func TestProjectStatusDataSource_GivenHealthPolicy_WhenRead_ThenHealthAggregated(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-health"] = &arcane.Environment{ID: "env-health", Name: "health-env"}
+	mockServer.AddProject("env-health", &arcane.Project{
+		ID:            "proj-health",
+		Name:          "workers",
+		Status:        "running",
+		EnvironmentID: "env-health",
+	})
+	mockServer.AddContainers("env-health", "proj-health", []arcane.ContainerDetail{
+		{ID: "c1", Name: "workers-worker-1", Status: "running", Health: "healthy"},
+		{ID: "c2", Name: "workers-worker-2", Status: "running"},
+		{ID: "c3", Name: "workers-worker-3", Status: "running", Health: "unhealthy"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The invalid policy goes first: the post-test destroy runs
+			// the last step's config
+			{
+				Config:      testProjectStatusDataSourceConfigHealthPolicy(mockServer.URL, "env-health", "proj-health", "most"),
+				ExpectError: regexp.MustCompile(`Invalid health_policy`),
+			},
+			{
+				Config: testProjectStatusDataSourceConfig(mockServer.URL, "env-health", "proj-health"),
+				Check:  resource.TestCheckResourceAttr("data.arcane_project_status.test", "health", "degraded"),
+			},
+			{
+				Config: testProjectStatusDataSourceConfigHealthPolicy(mockServer.URL, "env-health", "proj-health", "quorum"),
+				Check:  resource.TestCheckResourceAttr("data.arcane_project_status.test", "health", "healthy"),
+			},
+		},
+	})
+}
+
 func testProjectStatusDataSourceConfig(url, envID, projectID string) string {
 	return fmt.Sprintf(`
 provider "arcane" {
@@ -137,3 +180,17 @@ data "arcane_project_status" "test" {
 }
 `, url, envID, projectID)
 }
+
+func testProjectStatusDataSourceConfigHealthPolicy(url, envID, projectID, policy string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_project_status" "test" {
+  environment_id = %[2]q
+  project_id     = %[3]q
+  health_policy  = %[4]q
+}
+`, url, envID, projectID, policy)
+}
@@ -2,19 +2,38 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"net/url"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/darshan-rambhia/terraform-provider-arcane/internal/client"
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
 )
 
 // Ensure ArcaneProvider satisfies provider interfaces.
-var _ provider.Provider = &ArcaneProvider{}
+var (
+	_ provider.Provider              = &ArcaneProvider{}
+	_ provider.ProviderWithFunctions = &ArcaneProvider{}
+)
+
+// defaultRetryMax is how many times requests failing with a transient error
+// are retried unless the provider's retry_max is set.
+const defaultRetryMax = 3
 
 // ArcaneProvider defines the provider implementation.
 type ArcaneProvider struct {
@@ -23,8 +42,46 @@ type ArcaneProvider struct {
 
 // ArcaneProviderModel describes the provider data model.
 type ArcaneProviderModel struct {
-	URL    types.String `tfsdk:"url"`
-	APIKey types.String `tfsdk:"api_key"`
+	URL                                   types.String               `tfsdk:"url"`
+	APIKey                                types.String               `tfsdk:"api_key"`
+	APIKeys                               types.Map                  `tfsdk:"api_keys"`
+	Username                              types.String               `tfsdk:"username"`
+	Password                              types.String               `tfsdk:"password"`
+	MaxConcurrentOperationsPerEnvironment types.Int64                `tfsdk:"max_concurrent_operations_per_environment"`
+	Simulate                              types.String               `tfsdk:"simulate"`
+	RedactRuntimeDetails                  types.Bool                 `tfsdk:"redact_runtime_details"`
+	DisableLocalArtifacts                 types.Bool                 `tfsdk:"disable_local_artifacts"`
+	RequireDestroyConfirmation            types.Bool                 `tfsdk:"require_destroy_confirmation"`
+	NamePrefix                            types.String               `tfsdk:"name_prefix"`
+	KeepaliveInterval                     types.String               `tfsdk:"keepalive_interval"`
+	MaxClockSkew                          types.String               `tfsdk:"max_clock_skew"`
+	RetryMax                              types.Int64                `tfsdk:"retry_max"`
+	RetryWaitMax                          types.String               `tfsdk:"retry_wait_max"`
+	RequestsPerSecond                     types.Float64              `tfsdk:"requests_per_second"`
+	Burst                                 types.Int64                `tfsdk:"burst"`
+	CACertPEM                             types.String               `tfsdk:"ca_cert_pem"`
+	ClientCertPEM                         types.String               `tfsdk:"client_cert_pem"`
+	ClientKeyPEM                          types.String               `tfsdk:"client_key_pem"`
+	InsecureSkipVerify                    types.Bool                 `tfsdk:"insecure_skip_verify"`
+	FollowRedirects                       types.Bool                 `tfsdk:"follow_redirects"`
+	AllowedEnvironments                   types.List                 `tfsdk:"allowed_environments"`
+	DeniedEnvironments                    types.List                 `tfsdk:"denied_environments"`
+	DefaultDeployOptions                  *defaultDeployOptionsModel `tfsdk:"default_deploy_options"`
+	RequestSigning                        *requestSigningModel       `tfsdk:"request_signing"`
+}
+
+// defaultDeployOptionsModel describes the default_deploy_options block.
+type defaultDeployOptionsModel struct {
+	Pull          types.Bool `tfsdk:"pull"`
+	ForceRecreate types.Bool `tfsdk:"force_recreate"`
+	RemoveOrphans types.Bool `tfsdk:"remove_orphans"`
+}
+
+// requestSigningModel describes the request_signing block.
+type requestSigningModel struct {
+	Key       types.String `tfsdk:"key"`
+	Algorithm types.String `tfsdk:"algorithm"`
+	Headers   types.List   `tfsdk:"headers"`
 }
 
 // New returns a new provider instance.
@@ -49,14 +106,17 @@ a container management platform that provides a unified API for Docker environme
 
 ## Authentication
 
-The provider requires an API URL and optionally an API key for authentication:
+The provider requires an API URL and optionally an API key or a username and password for authentication:
 
 - **url**: The Arcane API URL (e.g., ` + "`http://arcane.local:8000`" + `)
 - **api_key**: Optional API key for authentication
+- **username** and **password**: Optional credentials of an Arcane user. The provider logs in with them
+  and logs in again once if the session expires during an apply.
 
 These can also be set via environment variables:
 - ` + "`ARCANE_URL`" + `
 - ` + "`ARCANE_API_KEY`" + `
+- ` + "`ARCANE_USERNAME`" + ` and ` + "`ARCANE_PASSWORD`" + `
 
 ## Example Usage
 
@@ -89,7 +149,7 @@ resource "arcane_project_deployment" "webapp" {
 `,
 		Attributes: map[string]schema.Attribute{
 			"url": schema.StringAttribute{
-				MarkdownDescription: "The Arcane API URL (e.g., `http://arcane.local:8000`). Can also be set via the `ARCANE_URL` environment variable.",
+				MarkdownDescription: "The Arcane API URL (e.g., `http://arcane.local:8000`), without the `/api` path the provider adds to requests. Can also be set via the `ARCANE_URL` environment variable.",
 				Optional:            true,
 			},
 			"api_key": schema.StringAttribute{
@@ -97,6 +157,183 @@ resource "arcane_project_deployment" "webapp" {
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username of an Arcane user to log in as, with `password`. The session token is sent alongside " +
+					"any API key, and the provider logs in again once if it expires. " +
+					"Can also be set via the `ARCANE_USERNAME` environment variable.",
+				Optional: true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of `username`. Can also be set via the `ARCANE_PASSWORD` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA certificates trusted in addition to the system roots when verifying Arcane's certificate, " +
+					"e.g. `file(\"homelab-ca.pem\")` for a manager behind a self-signed certificate. " +
+					"Can also be set via the `ARCANE_CA_CERT` environment variable.",
+				Optional: true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate presented to Arcane, for reverse proxies requiring mutual TLS. " +
+					"Requires `client_key_pem`. Can also be set via the `ARCANE_CLIENT_CERT` environment variable.",
+				Optional: true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded private key of `client_cert_pem`. Can also be set via the `ARCANE_CLIENT_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of Arcane's certificate. Anyone on the network path can then intercept the API key, " +
+					"so prefer `ca_cert_pem`. Can also be set via the `ARCANE_INSECURE_SKIP_VERIFY` environment variable. Defaults to `false`.",
+				Optional: true,
+			},
+			"follow_redirects": schema.BoolAttribute{
+				MarkdownDescription: "Follow redirects of API requests. The Arcane API never redirects, so by default a redirect fails the request " +
+					"with an error naming its location, which usually points at a proxy's login page. Set this when a proxy redirects to " +
+					"the right place, e.g. from an old address. A redirect to an HTML page fails either way. Defaults to `false`.",
+				Optional: true,
+			},
+			"api_keys": schema.MapAttribute{
+				MarkdownDescription: "Additional API keys by alias. Resources select one with `api_key_alias`, so a single provider " +
+					"block can run with different privileges, e.g. a read-only `api_key` for data sources and a key allowed to deploy " +
+					"for `arcane_project_deployment`.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"max_concurrent_operations_per_environment": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of deploy, redeploy and stop operations the provider runs at the same time against a single environment. " +
+					"Terraform applies resources in parallel, which can overwhelm small agents (e.g. a Raspberry Pi); set this to `1` to run them one at a time. " +
+					"Unlimited when unset.",
+				Optional: true,
+			},
+			"simulate": schema.StringAttribute{
+				MarkdownDescription: "Failure-injection mode for testing module error handling in CI. " +
+					"`fail_deploys` makes every deploy and redeploy fail; `conflict_deploys` makes them fail as if another deployment were in progress. " +
+					"Affected calls never reach Arcane, and `arcane_project_deployment` and `arcane_stack` operations report a warning while it is set. Can also be set via the `ARCANE_SIMULATE` environment variable. **Never set this in production.**",
+				Optional: true,
+			},
+			"redact_runtime_details": schema.BoolAttribute{
+				MarkdownDescription: "Leave container port mappings out of the `arcane_container` and `arcane_project_status` data sources (`ports` is null), " +
+					"and fail the `arcane_project_endpoints` and `arcane_project_routes` data sources, " +
+					"for when state is shared with people who shouldn't see the exposed attack surface. Defaults to `false`.",
+				Optional: true,
+			},
+			"disable_local_artifacts": schema.BoolAttribute{
+				MarkdownDescription: "Make the `arcane_project_archive` data source fail instead of writing its `output_path`, " +
+					"for restricted filesystems such as Terraform Cloud agents. It is the only feature of the provider that writes files on the machine running Terraform; " +
+					"the flag doesn't change where other files are read from or add timeouts. " +
+					"Can also be set via the `ARCANE_DISABLE_LOCAL_ARTIFACTS` environment variable. Defaults to `false`.",
+				Optional: true,
+			},
+			"require_destroy_confirmation": schema.BoolAttribute{
+				MarkdownDescription: "Make `arcane_environment` deletes, and `arcane_project_deployment` deletes that stop the project, fail unless " +
+					"the resource's `confirm_destroy` matches the environment or project name. Set `confirm_destroy` and apply before destroying, " +
+					"as a safety latch for long-lived data. Defaults to `false`.",
+				Optional: true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Prepended to the names of environments, container registries and git repositories when " +
+					"they are created or renamed, e.g. `pr-123-` for the preview environments of a CI pipeline, so that they are " +
+					"namespaced and easy to sweep. `name` in configuration and state stays unprefixed. Changing it renames the " +
+					"existing resources on the next apply. Can also be set via the `ARCANE_NAME_PREFIX` environment variable.",
+				Optional: true,
+			},
+			"keepalive_interval": schema.StringAttribute{
+				MarkdownDescription: "Interval (e.g. `30s`) at which the provider pings Arcane while API calls are in flight. " +
+					"When a ping fails, pending and new calls fail right away with a \"manager unreachable since\" error " +
+					"instead of each waiting for the 120 second request timeout, which shortens long applies against a manager that went away. " +
+					"Disabled when unset.",
+				Optional: true,
+			},
+			"max_clock_skew": schema.StringAttribute{
+				MarkdownDescription: "Largest difference (e.g. `30s`) between the local clock and the `Date` header of Arcane's responses " +
+					"tolerated before a warning. Timestamps such as `last_deployed_at` are recorded locally while expiries and sync " +
+					"times are set by Arcane, so clocks that disagree break `ttl` and time-based rotation. " +
+					fmt.Sprintf("Defaults to `%s`; `0s` disables the check.", formatCanonicalDuration(defaultMaxClockSkew)),
+				Optional: true,
+			},
+			"retry_max": schema.Int64Attribute{
+				MarkdownDescription: "How many times a request failing with a transient error is sent again, waiting with exponential " +
+					"backoff and jitter in between, or as long as a `Retry-After` header asks: `429` responses are retried for every request, " +
+					"`502`, `503` and `504` responses and network errors only for reads and other idempotent requests, so that a deploy is " +
+					fmt.Sprintf("never sent twice. Defaults to `%d`; `0` disables retries.", defaultRetryMax),
+				Optional: true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				MarkdownDescription: "Longest wait (e.g. `10s`) between two retries, including waits asked for by a `Retry-After` header. " +
+					fmt.Sprintf("Defaults to `%s`.", formatCanonicalDuration(arcane.DefaultRetryWaitMax)),
+				Optional: true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum rate of requests the provider sends to Arcane, e.g. `5`, shared by all resources and data sources. " +
+					"Terraform applies resources in parallel, so a workspace with dozens of deployments can hammer the manager; requests " +
+					"over the limit wait their turn. Retries count against the limit. Unlimited when unset.",
+				Optional: true,
+			},
+			"burst": schema.Int64Attribute{
+				MarkdownDescription: "Number of requests that may be sent at once before `requests_per_second` applies. " +
+					"Defaults to `requests_per_second` rounded up. Requires `requests_per_second`.",
+				Optional: true,
+			},
+			"allowed_environments": schema.ListAttribute{
+				MarkdownDescription: "IDs or names of the only environments resources may manage. A plan that creates, changes or destroys " +
+					"a resource in any other environment fails, e.g. to keep a staging workspace on a shared manager away from production. " +
+					"Names are matched as they are in Arcane, i.e. including `name_prefix`. All environments are allowed when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"denied_environments": schema.ListAttribute{
+				MarkdownDescription: "IDs or names (including `name_prefix`) of environments resources must not manage, even when listed in `allowed_environments`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_deploy_options": schema.SingleNestedBlock{
+				MarkdownDescription: "Deploy options inherited by every `arcane_project_deployment` that doesn't set them explicitly, " +
+					"to avoid repeating them across many deployments. Changing a default redeploys the deployments that inherit it.",
+				Attributes: map[string]schema.Attribute{
+					"pull": schema.BoolAttribute{
+						MarkdownDescription: "Default for `pull`. Defaults to `false`.",
+						Optional:            true,
+					},
+					"force_recreate": schema.BoolAttribute{
+						MarkdownDescription: "Default for `force_recreate`. Defaults to `false`.",
+						Optional:            true,
+					},
+					"remove_orphans": schema.BoolAttribute{
+						MarkdownDescription: "Default for `remove_orphans`. Defaults to `false`.",
+						Optional:            true,
+					},
+				},
+			},
+			"request_signing": schema.SingleNestedBlock{
+				MarkdownDescription: "**Experimental.** Signs every request with an HMAC, for installs behind a WAF or proxy that only accepts signed traffic. " +
+					"Arcane itself doesn't verify signatures, so this only helps when something in front of it is configured to check them " +
+					"with the same key and scheme, and the scheme may change while it is experimental. " +
+					"The signature covers the method, the path and query, the `X-Arcane-Timestamp` header (Unix seconds), the hex SHA-256 " +
+					"of the body and then `name:value` for each of `headers`, joined by newlines. It is sent as " +
+					"`X-Arcane-Signature: <algorithm>=<hex HMAC>`, with the signed header names in `X-Arcane-Signed-Headers` separated by `;`.",
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						MarkdownDescription: "The shared HMAC key. Can also be set via the `ARCANE_REQUEST_SIGNING_KEY` environment variable.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"algorithm": schema.StringAttribute{
+						MarkdownDescription: "`hmac-sha256` or `hmac-sha512`. Defaults to `hmac-sha256`.",
+						Optional:            true,
+					},
+					"headers": schema.ListAttribute{
+						MarkdownDescription: "Names of request headers to include in the signature, e.g. `[\"Content-Type\", \"X-API-Key\"]`.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
 		},
 	}
 }
@@ -109,18 +346,59 @@ func (p *ArcaneProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	if config.URL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Unknown Arcane URL",
+			"The provider cannot connect to Arcane because url depends on a value that is only known after apply. "+
+				"Set it statically, via the ARCANE_URL environment variable, or apply its source first with -target.",
+		)
+	}
+	if config.APIKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Unknown Arcane API key",
+			"The provider cannot authenticate to Arcane because api_key depends on a value that is only known after apply. "+
+				"Set it statically, via the ARCANE_API_KEY environment variable, or apply its source first with -target.",
+		)
+	}
+	if config.Username.IsUnknown() || config.Password.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown Arcane credentials",
+			"The provider cannot log in to Arcane because username or password depends on a value that is only known after apply. "+
+				"Set them statically, via the ARCANE_USERNAME and ARCANE_PASSWORD environment variables, or apply their source first with -target.",
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get URL from config or environment
 	url := config.URL.ValueString()
 	if url == "" {
 		url = os.Getenv("ARCANE_URL")
 	}
 	if url == "" {
-		resp.Diagnostics.AddError(
+		diagnostics.AddError(ctx, &resp.Diagnostics, diagnostics.CodeInvalidURL,
 			"Missing Arcane URL",
 			"The provider requires an Arcane URL. Set it in the provider configuration or via the ARCANE_URL environment variable.",
 		)
 		return
 	}
+	if !isHTTPURL(url) {
+		diagnostics.AddError(ctx, &resp.Diagnostics, diagnostics.CodeInvalidURL,
+			"Invalid Arcane URL",
+			fmt.Sprintf("Expected an http:// or https:// URL such as https://arcane.example.com, got %q.", url),
+		)
+		return
+	}
+	if problem := baseURLProblem(url); problem != "" {
+		diagnostics.AddError(ctx, &resp.Diagnostics, diagnostics.CodeInvalidURL,
+			"Invalid Arcane URL",
+			fmt.Sprintf("Expected the base URL of Arcane such as https://arcane.example.com, got %q, which %s.", url, problem),
+		)
+		return
+	}
 
 	// Get API key from config or environment
 	apiKey := config.APIKey.ValueString()
@@ -128,10 +406,266 @@ func (p *ArcaneProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		apiKey = os.Getenv("ARCANE_API_KEY")
 	}
 
+	// Get session credentials from config or environment
+	username := config.Username.ValueString()
+	if username == "" {
+		username = os.Getenv("ARCANE_USERNAME")
+	}
+	password := config.Password.ValueString()
+	if password == "" {
+		password = os.Getenv("ARCANE_PASSWORD")
+	}
+	if (username == "") != (password == "") {
+		resp.Diagnostics.AddError(
+			"Incomplete Arcane credentials",
+			"Set both username and password (or ARCANE_USERNAME and ARCANE_PASSWORD) to log in to Arcane, or neither.",
+		)
+		return
+	}
+
+	tlsConfig, ok := providerTLSConfig(ctx, &config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	var apiKeys map[string]string
+	resp.Diagnostics.Append(config.APIKeys.ElementsAs(ctx, &apiKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for alias, key := range apiKeys {
+		if alias == "" || key == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_keys"),
+				"Invalid api_keys",
+				"Aliases and keys must not be empty.",
+			)
+			return
+		}
+	}
+
+	maxOps := config.MaxConcurrentOperationsPerEnvironment.ValueInt64()
+	if maxOps < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_operations_per_environment"),
+			"Invalid max_concurrent_operations_per_environment",
+			fmt.Sprintf("Must be at least 1, got %d.", maxOps),
+		)
+		return
+	}
+	if !config.MaxConcurrentOperationsPerEnvironment.IsNull() && maxOps == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_operations_per_environment"),
+			"Invalid max_concurrent_operations_per_environment",
+			"Must be at least 1. Omit the attribute to allow unlimited concurrent operations.",
+		)
+		return
+	}
+
+	simulate := config.Simulate.ValueString()
+	if simulate == "" {
+		simulate = os.Getenv("ARCANE_SIMULATE")
+	}
+	if simulate != "" && !slices.Contains(simulateModes, simulate) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("simulate"),
+			"Invalid simulate mode",
+			fmt.Sprintf("Unknown simulate mode %q. Expected one of: %s.", simulate, strings.Join(simulateModes, ", ")),
+		)
+		return
+	}
+	if simulate != "" {
+		tflog.Warn(ctx, "Failure simulation enabled, deployments will fail without contacting Arcane", map[string]interface{}{
+			"simulate": simulate,
+		})
+	}
+
+	disableLocalArtifacts := config.DisableLocalArtifacts.ValueBool()
+	if config.DisableLocalArtifacts.IsNull() {
+		if v := os.Getenv("ARCANE_DISABLE_LOCAL_ARTIFACTS"); v != "" {
+			parsed, err := strconv.ParseBool(v)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("disable_local_artifacts"),
+					"Invalid ARCANE_DISABLE_LOCAL_ARTIFACTS",
+					fmt.Sprintf("Expected a boolean, got %q.", v),
+				)
+				return
+			}
+			disableLocalArtifacts = parsed
+		}
+	}
+
+	namePrefix := config.NamePrefix.ValueString()
+	if config.NamePrefix.IsNull() {
+		namePrefix = os.Getenv("ARCANE_NAME_PREFIX")
+	}
+
+	var keepaliveInterval time.Duration
+	if !config.KeepaliveInterval.IsNull() {
+		parsed, err := time.ParseDuration(config.KeepaliveInterval.ValueString())
+		if err != nil || parsed <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("keepalive_interval"),
+				"Invalid keepalive_interval",
+				fmt.Sprintf("Expected a positive duration such as \"30s\", got %q.", config.KeepaliveInterval.ValueString()),
+			)
+			return
+		}
+		keepaliveInterval = parsed
+	}
+
+	maxClockSkew := defaultMaxClockSkew
+	if !config.MaxClockSkew.IsNull() {
+		parsed, err := time.ParseDuration(config.MaxClockSkew.ValueString())
+		if err != nil || parsed < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_clock_skew"),
+				"Invalid max_clock_skew",
+				fmt.Sprintf("Expected a duration such as \"30s\", or \"0s\" to disable the check, got %q.", config.MaxClockSkew.ValueString()),
+			)
+			return
+		}
+		maxClockSkew = parsed
+	}
+
+	retryMax := int64(defaultRetryMax)
+	if !config.RetryMax.IsNull() {
+		retryMax = config.RetryMax.ValueInt64()
+	}
+	if retryMax < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max"),
+			"Invalid retry_max",
+			fmt.Sprintf("Must not be negative, got %d. Set it to 0 to disable retries.", retryMax),
+		)
+		return
+	}
+
+	retryWaitMax := arcane.DefaultRetryWaitMax
+	if !config.RetryWaitMax.IsNull() {
+		parsed, err := time.ParseDuration(config.RetryWaitMax.ValueString())
+		if err != nil || parsed < arcane.DefaultRetryWaitMin {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_wait_max"),
+				"Invalid retry_wait_max",
+				fmt.Sprintf("Expected a duration of at least %s such as \"10s\", got %q.",
+					formatCanonicalDuration(arcane.DefaultRetryWaitMin), config.RetryWaitMax.ValueString()),
+			)
+			return
+		}
+		retryWaitMax = parsed
+	}
+
+	requestsPerSecond := config.RequestsPerSecond.ValueFloat64()
+	if !config.RequestsPerSecond.IsNull() && (requestsPerSecond <= 0 || math.IsInf(requestsPerSecond, 0)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid requests_per_second",
+			fmt.Sprintf("Must be a positive number, got %g. Omit the attribute to send requests without a rate limit.", requestsPerSecond),
+		)
+		return
+	}
+	burst := config.Burst.ValueInt64()
+	if !config.Burst.IsNull() && burst < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("burst"),
+			"Invalid burst",
+			fmt.Sprintf("Must be at least 1, got %d.", burst),
+		)
+		return
+	}
+	if !config.Burst.IsNull() && config.RequestsPerSecond.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("burst"),
+			"Invalid burst",
+			"burst only applies together with requests_per_second.",
+		)
+		return
+	}
+
+	var allowedEnvs, deniedEnvs []string
+	resp.Diagnostics.Append(config.AllowedEnvironments.ElementsAs(ctx, &allowedEnvs, false)...)
+	resp.Diagnostics.Append(config.DeniedEnvironments.ElementsAs(ctx, &deniedEnvs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var deployDefaults DeployDefaults
+	if d := config.DefaultDeployOptions; d != nil {
+		deployDefaults = DeployDefaults{
+			Pull:          d.Pull.ValueBool(),
+			ForceRecreate: d.ForceRecreate.ValueBool(),
+			RemoveOrphans: d.RemoveOrphans.ValueBool(),
+		}
+	}
+
+	var requestSigning *arcane.RequestSigning
+	if rs := config.RequestSigning; rs != nil {
+		key := rs.Key.ValueString()
+		if key == "" {
+			key = os.Getenv("ARCANE_REQUEST_SIGNING_KEY")
+		}
+		if key == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_signing").AtName("key"),
+				"Missing request signing key",
+				"Set key in the request_signing block or via the ARCANE_REQUEST_SIGNING_KEY environment variable.",
+			)
+			return
+		}
+		algorithm := rs.Algorithm.ValueString()
+		if algorithm != "" && !slices.Contains(arcane.SigningAlgorithms, algorithm) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_signing").AtName("algorithm"),
+				"Invalid request signing algorithm",
+				fmt.Sprintf("Unknown algorithm %q. Expected one of: %s.", algorithm, strings.Join(arcane.SigningAlgorithms, ", ")),
+			)
+			return
+		}
+		var headers []string
+		resp.Diagnostics.Append(rs.Headers.ElementsAs(ctx, &headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestSigning = &arcane.RequestSigning{Key: []byte(key), Algorithm: algorithm, Headers: headers}
+	}
+
 	// Create client
-	c, err := client.New(client.Config{
-		URL:    url,
-		APIKey: apiKey,
+	api, err := arcane.New(arcane.Config{
+		URL:                                   url,
+		APIKey:                                apiKey,
+		APIKeys:                               apiKeys,
+		Username:                              username,
+		Password:                              password,
+		MaxConcurrentOperationsPerEnvironment: int(maxOps),
+		Logger:                                tflogLogger{},
+		KeepaliveInterval:                     keepaliveInterval,
+		MaxClockSkew:                          maxClockSkew,
+		RetryMax:                              int(retryMax),
+		RetryWaitMax:                          retryWaitMax,
+		RequestsPerSecond:                     requestsPerSecond,
+		Burst:                                 int(burst),
+		RequestSigning:                        requestSigning,
+		TLS:                                   tlsConfig,
+		FollowRedirects:                       config.FollowRedirects.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create Arcane client",
+			err.Error(),
+		)
+		return
+	}
+	c, err := NewClient(api, ClientSettings{
+		Simulate:                   simulate,
+		DeployDefaults:             deployDefaults,
+		RedactRuntimeDetails:       config.RedactRuntimeDetails.ValueBool(),
+		DisableLocalArtifacts:      disableLocalArtifacts,
+		RequireDestroyConfirmation: config.RequireDestroyConfirmation.ValueBool(),
+		NamePrefix:                 namePrefix,
+		AllowedEnvironments:        allowedEnvs,
+		DeniedEnvironments:         deniedEnvs,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -146,13 +680,99 @@ func (p *ArcaneProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	resp.ResourceData = c
 }
 
+// providerTLSConfig returns the TLS settings of config, falling back to the
+// ARCANE_CA_CERT, ARCANE_CLIENT_CERT, ARCANE_CLIENT_KEY and
+// ARCANE_INSECURE_SKIP_VERIFY environment variables, or nil when none is set.
+// It returns false after adding an error diagnostic.
+func providerTLSConfig(ctx context.Context, config *ArcaneProviderModel, diags *diag.Diagnostics) (*arcane.TLSConfig, bool) {
+	stringSetting := func(v types.String, env string) string {
+		if v.IsNull() {
+			return os.Getenv(env)
+		}
+		return v.ValueString()
+	}
+	caCert := stringSetting(config.CACertPEM, "ARCANE_CA_CERT")
+	clientCert := stringSetting(config.ClientCertPEM, "ARCANE_CLIENT_CERT")
+	clientKey := stringSetting(config.ClientKeyPEM, "ARCANE_CLIENT_KEY")
+
+	insecure := config.InsecureSkipVerify.ValueBool()
+	if config.InsecureSkipVerify.IsNull() {
+		if v := os.Getenv("ARCANE_INSECURE_SKIP_VERIFY"); v != "" {
+			parsed, err := strconv.ParseBool(v)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("insecure_skip_verify"),
+					"Invalid ARCANE_INSECURE_SKIP_VERIFY",
+					fmt.Sprintf("Expected a boolean, got %q.", v),
+				)
+				return nil, false
+			}
+			insecure = parsed
+		}
+	}
+
+	if (clientCert == "") != (clientKey == "") {
+		diags.AddAttributeError(
+			path.Root("client_cert_pem"),
+			"Incomplete client certificate",
+			"client_cert_pem and client_key_pem (or ARCANE_CLIENT_CERT and ARCANE_CLIENT_KEY) must be set together.",
+		)
+		return nil, false
+	}
+	if caCert == "" && clientCert == "" && !insecure {
+		return nil, true
+	}
+	if insecure {
+		tflog.Warn(ctx, "TLS certificate verification disabled, connections to Arcane can be intercepted")
+	}
+	return &arcane.TLSConfig{
+		CACertPEM:          []byte(caCert),
+		ClientCertPEM:      []byte(clientCert),
+		ClientKeyPEM:       []byte(clientKey),
+		InsecureSkipVerify: insecure,
+	}, true
+}
+
+// isHTTPURL reports whether raw is an absolute http or https URL with a host.
+func isHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// baseURLProblem describes why raw, an http(s) URL, is not a base URL the
+// provider can add API paths to, or returns "" if it is. A path is allowed for
+// Arcane served under a prefix, but not the /api path the provider adds itself.
+func baseURLProblem(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "is not a valid URL"
+	}
+	switch {
+	case u.RawQuery != "" || u.Fragment != "" || strings.HasSuffix(raw, "?") || strings.HasSuffix(raw, "#"):
+		return "has a query or fragment"
+	case u.User != nil:
+		return "has credentials, which belong in api_key"
+	}
+	for _, segment := range strings.Split(strings.Trim(u.Path, "/"), "/") {
+		if segment == "api" {
+			return "includes the /api path that the provider adds to every request"
+		}
+	}
+	return ""
+}
+
 func (p *ArcaneProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewEnvironmentResource,
+		NewEnvironmentTokenResource,
+		NewContainerActionResource,
 		NewProjectDeploymentResource,
 		NewContainerRegistryResource,
 		NewGitRepositoryResource,
 		NewGitOpsSyncResource,
+		NewProjectFileResource,
+		NewProjectResource,
+		NewStackResource,
 	}
 }
 
@@ -163,5 +783,34 @@ func (p *ArcaneProvider) DataSources(ctx context.Context) []func() datasource.Da
 		NewProjectStatusDataSource,
 		NewEnvironmentHealthDataSource,
 		NewContainerDataSource,
+		NewGitOpsSyncRunsDataSource,
+		NewComposeValidationDataSource,
+		NewComposePreviewDataSource,
+		NewProjectArchiveDataSource,
+		NewProjectEndpointsDataSource,
+		NewProjectRoutesDataSource,
+		NewJobDataSource,
+		NewServerInfoDataSource,
+		NewDoctorDataSource,
+		NewRegistryImageDataSource,
+		NewRestartPolicyCheckDataSource,
+		NewStaleEnvironmentsDataSource,
+		NewProjectOwnersDataSource,
+		NewEnvironmentsDataSource,
+		NewEnvironmentTokenValidationDataSource,
+		NewGitOpsSyncDataSource,
+		NewGitOpsSyncsDataSource,
+		NewImportBlocksDataSource,
+		NewProviderStatsDataSource,
+		NewConfigExportDataSource,
+		NewProjectLogsDataSource,
+	}
+}
+
+func (p *ArcaneProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewDurationNormalizeFunction,
+		NewComposeMergeFunction,
+		NewEnvFileEncodeFunction,
 	}
 }
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProviderStatsDataSource{}
+
+// NewProviderStatsDataSource returns a new provider stats data source.
+func NewProviderStatsDataSource() datasource.DataSource {
+	return &ProviderStatsDataSource{}
+}
+
+// ProviderStatsDataSource defines the provider stats data source implementation.
+type ProviderStatsDataSource struct {
+	client *Client
+}
+
+// ProviderStatsDataSourceModel describes the provider stats data source data model.
+type ProviderStatsDataSourceModel struct {
+	Requests         types.Int64 `tfsdk:"requests"`
+	RequestsByMethod types.Map   `tfsdk:"requests_by_method"`
+	Retries          types.Int64 `tfsdk:"retries"`
+	CachedResponses  types.Int64 `tfsdk:"cached_responses"`
+	RequestTimeMs    types.Int64 `tfsdk:"request_time_ms"`
+	WaitTimeMs       types.Int64 `tfsdk:"wait_time_ms"`
+}
+
+func (d *ProviderStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provider_stats"
+}
+
+func (d *ProviderStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to read how much the current plan or apply has used the Arcane API: the
+number of requests the provider sent and the time spent waiting for responses and between
+requests. Output the figures to track the cost of applies over time.
+
+The figures cover everything the provider did before the data source is read. Use
+` + "`depends_on`" + ` to read it after the resources of a module, which defers the read to the
+end of the apply when they change. Reading the data source itself sends no request.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_provider_stats" "apply" {
+  depends_on = [arcane_project_deployment.webapp]
+}
+
+output "arcane_api_requests" {
+  value = data.arcane_provider_stats.apply.requests
+}
+
+output "arcane_wait_time_ms" {
+  value = data.arcane_provider_stats.apply.wait_time_ms
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"requests": schema.Int64Attribute{
+				MarkdownDescription: "The number of API requests sent, including retries.",
+				Computed:            true,
+			},
+			"requests_by_method": schema.MapAttribute{
+				MarkdownDescription: "The number of API requests sent by HTTP method, e.g. `GET`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"retries": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests sent again after a transient error.",
+				Computed:            true,
+			},
+			"cached_responses": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests answered from the provider's cache because Arcane reported the response unchanged.",
+				Computed:            true,
+			},
+			"request_time_ms": schema.Int64Attribute{
+				MarkdownDescription: "The total time spent waiting for responses, in milliseconds. Concurrent requests each count in full.",
+				Computed:            true,
+			},
+			"wait_time_ms": schema.Int64Attribute{
+				MarkdownDescription: "The total time spent waiting between requests, in milliseconds: for the `requests_per_second` limit, backing off before retries, " +
+					"for free operation slots (`max_concurrent_operations_per_environment`) and project locks, and between polls of a deployment job.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *ProviderStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ProviderStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProviderStatsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stats := d.client.Stats()
+	byMethod, diags := types.MapValueFrom(ctx, types.Int64Type, stats.RequestsByMethod)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Requests = types.Int64Value(stats.Requests)
+	data.RequestsByMethod = byMethod
+	data.Retries = types.Int64Value(stats.Retries)
+	data.CachedResponses = types.Int64Value(stats.CachedResponses)
+	data.RequestTimeMs = types.Int64Value(stats.RequestTime.Milliseconds())
+	data.WaitTimeMs = types.Int64Value(stats.WaitTime.Milliseconds())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestProviderStatsDataSource_GivenEarlierReads_WhenRead_ThenRequestsCounted
+// validates that the stats cover the requests sent before the data source is
+// read.
+func TestProviderStatsDataSource_GivenEarlierReads_WhenRead_ThenRequestsCounted(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.Environments["env-prod"] = &arcane.Environment{ID: "env-prod", Name: "production"}
+
+	atLeastOne := func(value string) error {
+		if n, err := strconv.Atoi(value); err != nil || n < 1 {
+			return fmt.Errorf("expected at least 1, got %q", value)
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderStatsDataSourceConfig(mockServer.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.arcane_provider_stats.test", "requests", atLeastOne),
+					resource.TestCheckResourceAttrWith("data.arcane_provider_stats.test", "requests_by_method.GET", atLeastOne),
+					resource.TestCheckResourceAttr("data.arcane_provider_stats.test", "retries", "0"),
+					resource.TestCheckResourceAttrSet("data.arcane_provider_stats.test", "request_time_ms"),
+					resource.TestCheckResourceAttr("data.arcane_provider_stats.test", "wait_time_ms", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testProviderStatsDataSourceConfig(url string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_environment" "prod" {
+  id = "env-prod"
+}
+
+data "arcane_provider_stats" "test" {
+  depends_on = [data.arcane_environment.prod]
+}
+`, url)
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RegistryImageDataSource{}
+
+// defaultImageTag is the tag looked up when none is given.
+const defaultImageTag = "latest"
+
+// NewRegistryImageDataSource returns a new registry image data source.
+func NewRegistryImageDataSource() datasource.DataSource {
+	return &RegistryImageDataSource{}
+}
+
+// RegistryImageDataSource defines the registry image data source implementation.
+type RegistryImageDataSource struct {
+	client *Client
+}
+
+// RegistryImageDataSourceModel describes the registry image data source data model.
+type RegistryImageDataSourceModel struct {
+	RegistryID types.String `tfsdk:"registry_id"`
+	Repository types.String `tfsdk:"repository"`
+	Tag        types.String `tfsdk:"tag"`
+	Exists     types.Bool   `tfsdk:"exists"`
+	Digest     types.String `tfsdk:"digest"`
+}
+
+func (d *RegistryImageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_registry_image"
+}
+
+func (d *RegistryImageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to check whether an image tag exists in a container registry configured in
+Arcane, which queries the registry with its stored credentials. A missing tag is not an error:
+` + "`exists`" + ` is false, so deployments can use it as a precondition, e.g. to wait for CI to push
+the image before rolling it out.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_registry_image" "webapp" {
+  registry_id = arcane_container_registry.ghcr.id
+  repository  = "example/webapp"
+  tag         = var.webapp_version
+}
+
+resource "arcane_project_deployment" "webapp" {
+  environment_id = arcane_environment.production.id
+  project_id     = data.arcane_project.webapp.id
+
+  lifecycle {
+    precondition {
+      condition     = data.arcane_registry_image.webapp.exists
+      error_message = "example/webapp:${var.webapp_version} has not been pushed yet."
+    }
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"registry_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the container registry to query.",
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "The image repository within the registry, e.g. `example/webapp`.",
+				Required:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "The tag to look up. Defaults to `latest`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"exists": schema.BoolAttribute{
+				MarkdownDescription: "Whether the tag exists in the registry.",
+				Computed:            true,
+			},
+			"digest": schema.StringAttribute{
+				MarkdownDescription: "The content digest the tag points to, e.g. `sha256:...`. Null when the tag doesn't exist.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *RegistryImageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *RegistryImageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data RegistryImageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Tag.IsNull() {
+		data.Tag = types.StringValue(defaultImageTag)
+	}
+
+	// Look the registry up first, so a wrong registry_id isn't mistaken for
+	// a missing image.
+	registryID := data.RegistryID.ValueString()
+	if _, err := d.client.GetContainerRegistry(ctx, registryID); err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read container registry")
+		return
+	}
+
+	image, err := d.client.GetRegistryImage(ctx, registryID, data.Repository.ValueString(), data.Tag.ValueString())
+	switch {
+	case arcane.IsNotFound(err):
+		data.Exists = types.BoolValue(false)
+		data.Digest = types.StringNull()
+	case err != nil:
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to look up registry image")
+		return
+	default:
+		data.Exists = types.BoolValue(true)
+		data.Digest = optionalString(image.Digest)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
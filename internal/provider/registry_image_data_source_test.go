@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestRegistryImageDataSource_GivenPushedTag_WhenRead_ThenExistsWithDigest
+// validates that a tag present in the registry is reported with its digest.
+func TestRegistryImageDataSource_GivenPushedTag_WhenRead_ThenExistsWithDigest(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.ContainerRegistries["reg-ghcr"] = &arcane.ContainerRegistry{ID: "reg-ghcr", Name: "ghcr", URL: "https://ghcr.io"}
+	mockServer.RegistryImages["reg-ghcr/example/web:1.2.3"] = arcane.RegistryImage{
+		Repository: "example/web", Tag: "1.2.3", Digest: "sha256:0123abcd",
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testRegistryImageDataSourceConfig(mockServer.URL, "reg-ghcr", `tag = "1.2.3"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_registry_image.test", "exists", "true"),
+					resource.TestCheckResourceAttr("data.arcane_registry_image.test", "digest", "sha256:0123abcd"),
+				),
+			},
+		},
+	})
+}
+
+// TestRegistryImageDataSource_GivenMissingTag_WhenRead_ThenNotExists
+// validates that a missing tag is reported rather than failing, and that the
+// tag defaults to latest.
+func TestRegistryImageDataSource_GivenMissingTag_WhenRead_ThenNotExists(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.ContainerRegistries["reg-ghcr"] = &arcane.ContainerRegistry{ID: "reg-ghcr", Name: "ghcr", URL: "https://ghcr.io"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testRegistryImageDataSourceConfig(mockServer.URL, "reg-ghcr", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_registry_image.test", "tag", "latest"),
+					resource.TestCheckResourceAttr("data.arcane_registry_image.test", "exists", "false"),
+					resource.TestCheckNoResourceAttr("data.arcane_registry_image.test", "digest"),
+				),
+			},
+		},
+	})
+}
+
+// TestRegistryImageDataSource_GivenUnknownRegistry_WhenRead_ThenError
+// validates that a wrong registry_id fails instead of reporting a missing image.
+func TestRegistryImageDataSource_GivenUnknownRegistry_WhenRead_ThenError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testRegistryImageDataSourceConfig(mockServer.URL, "reg-missing", ""),
+				ExpectError: regexp.MustCompile(`Failed to read container registry`),
+			},
+		},
+	})
+}
+
+func testRegistryImageDataSourceConfig(url, registryID, extra string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_registry_image" "test" {
+  registry_id = %[2]q
+  repository  = "example/web"
+  %[3]s
+}
+`, url, registryID, extra)
+}
@@ -0,0 +1,21 @@
+package provider
+
+import "strings"
+
+// Arcane agents run on Linux, while Terraform may run on Windows. Paths and
+// file contents sent to an agent are normalized here so that the same
+// configuration produces the same request on every platform.
+
+// remoteComposePath converts a compose file path relative to the project
+// directory to the agent's forward-slash form, e.g. `overrides\prod.yml` to
+// `overrides/prod.yml`. filepath.ToSlash is not used since it only converts
+// separators of the OS the provider runs on.
+func remoteComposePath(p string) string {
+	return strings.ReplaceAll(p, `\`, "/")
+}
+
+// normalizeLineEndings converts CRLF line endings, as produced by file() on a
+// Windows checkout, to LF.
+func normalizeLineEndings(s string) string {
+	return strings.ReplaceAll(s, "\r\n", "\n")
+}
@@ -0,0 +1,44 @@
+package provider
+
+import "testing"
+
+func TestRemoteComposePath(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{in: "docker-compose.prod.yml", want: "docker-compose.prod.yml"},
+		{in: "overrides/prod.yml", want: "overrides/prod.yml"},
+		{in: `overrides\prod.yml`, want: "overrides/prod.yml"},
+		{in: `deploy\overrides\prod.yml`, want: "deploy/overrides/prod.yml"},
+	}
+
+	for _, tc := range cases {
+		if got := remoteComposePath(tc.in); got != tc.want {
+			t.Errorf("remoteComposePath(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizeLineEndings(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{in: "", want: ""},
+		{in: "A=1\nB=2\n", want: "A=1\nB=2\n"},
+		{in: "A=1\r\nB=2\r\n", want: "A=1\nB=2\n"},
+		{in: "services:\r\n  web:\n    image: nginx\r\n", want: "services:\n  web:\n    image: nginx\n"},
+		{in: "KEEP=a\rb", want: "KEEP=a\rb"},
+	}
+
+	for _, tc := range cases {
+		if got := normalizeLineEndings(tc.in); got != tc.want {
+			t.Errorf("normalizeLineEndings(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &RestartPolicyCheckDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &RestartPolicyCheckDataSource{}
+)
+
+// restartPolicies are Docker's restart policy names.
+var restartPolicies = []string{"no", "always", "unless-stopped", "on-failure"}
+
+// NewRestartPolicyCheckDataSource returns a new restart policy check data source.
+func NewRestartPolicyCheckDataSource() datasource.DataSource {
+	return &RestartPolicyCheckDataSource{}
+}
+
+// RestartPolicyCheckDataSource defines the restart policy check data source implementation.
+type RestartPolicyCheckDataSource struct {
+	client *Client
+}
+
+// RestartPolicyCheckDataSourceModel describes the restart policy check data source data model.
+type RestartPolicyCheckDataSourceModel struct {
+	EnvironmentID      types.String             `tfsdk:"environment_id"`
+	ProjectID          types.String             `tfsdk:"project_id"`
+	DisallowedPolicies []types.String           `tfsdk:"disallowed_policies"`
+	FailOnViolation    types.Bool               `tfsdk:"fail_on_violation"`
+	OK                 types.Bool               `tfsdk:"ok"`
+	Violations         []restartPolicyViolation `tfsdk:"violations"`
+}
+
+// restartPolicyViolation describes an element of violations.
+type restartPolicyViolation struct {
+	ProjectID     types.String `tfsdk:"project_id"`
+	Project       types.String `tfsdk:"project"`
+	Container     types.String `tfsdk:"container"`
+	RestartPolicy types.String `tfsdk:"restart_policy"`
+}
+
+func (d *RestartPolicyCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restart_policy_check"
+}
+
+func (d *RestartPolicyCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to find containers whose restart policy breaks operational standards, by
+default containers with the ` + "`no`" + ` policy, which stay down after a crash or a host reboot.
+
+All projects of the environment are checked, or only ` + "`project_id`" + `. Use ` + "`ok`" + ` in a
+precondition or check block, or set ` + "`fail_on_violation`" + ` to fail the plan.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_restart_policy_check" "production" {
+  environment_id = arcane_environment.production.id
+}
+
+check "restart_policies" {
+  assert {
+    condition     = data.arcane_restart_policy_check.production.ok
+    error_message = "Containers without a restart policy: ${join(", ", data.arcane_restart_policy_check.production.violations[*].container)}"
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment to check.",
+				Required:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Only check this project. Defaults to every project in the environment.",
+				Optional:            true,
+			},
+			"disallowed_policies": schema.ListAttribute{
+				MarkdownDescription: "Restart policies that count as violations: `no`, `always`, `unless-stopped` or `on-failure` " +
+					"(whatever its retry count). Defaults to `[\"no\"]`. A container without a policy has `no`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"fail_on_violation": schema.BoolAttribute{
+				MarkdownDescription: "Fail the plan, listing the violations, when any container has a disallowed policy. Defaults to `false`.",
+				Optional:            true,
+			},
+			"ok": schema.BoolAttribute{
+				MarkdownDescription: "Whether no container has a disallowed policy.",
+				Computed:            true,
+			},
+			"violations": schema.ListNestedAttribute{
+				MarkdownDescription: "The containers with a disallowed policy, by project and container name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project of the container.",
+							Computed:            true,
+						},
+						"project": schema.StringAttribute{
+							MarkdownDescription: "The name of the project of the container.",
+							Computed:            true,
+						},
+						"container": schema.StringAttribute{
+							MarkdownDescription: "The container name.",
+							Computed:            true,
+						},
+						"restart_policy": schema.StringAttribute{
+							MarkdownDescription: "The container's restart policy, e.g. `no` or `on-failure:3`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RestartPolicyCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *RestartPolicyCheckDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var disallowed []types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("disallowed_policies"), &disallowed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, policy := range disallowed {
+		if policy.IsNull() || policy.IsUnknown() || slices.Contains(restartPolicies, policy.ValueString()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("disallowed_policies").AtListIndex(i),
+			"Invalid restart policy",
+			fmt.Sprintf("Expected one of %s, got %q.", strings.Join(restartPolicies, ", "), policy.ValueString()),
+		)
+	}
+}
+
+func (d *RestartPolicyCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data RestartPolicyCheckDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disallowed := []string{"no"}
+	if data.DisallowedPolicies != nil {
+		disallowed = make([]string, 0, len(data.DisallowedPolicies))
+		for _, policy := range data.DisallowedPolicies {
+			disallowed = append(disallowed, policy.ValueString())
+		}
+	}
+
+	envClient := d.client.ForEnvironment(data.EnvironmentID.ValueString())
+
+	var projects []arcane.Project
+	if !data.ProjectID.IsNull() {
+		project, err := envClient.GetProject(ctx, data.ProjectID.ValueString())
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read project")
+			return
+		}
+		projects = []arcane.Project{*project}
+	} else {
+		var err error
+		projects, err = envClient.ListProjects(ctx)
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list projects")
+			return
+		}
+	}
+
+	data.Violations = []restartPolicyViolation{}
+	var lines []string
+	for _, p := range projects {
+		containers, err := envClient.GetProjectContainers(ctx, p.ID)
+		if err != nil {
+			diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, fmt.Sprintf("Failed to read containers of project %q", p.Name))
+			return
+		}
+		for _, c := range containers {
+			if !slices.Contains(disallowed, restartPolicyName(c.RestartPolicy)) {
+				continue
+			}
+			policy := c.RestartPolicy
+			if policy == "" {
+				policy = "no"
+			}
+			data.Violations = append(data.Violations, restartPolicyViolation{
+				ProjectID:     types.StringValue(p.ID),
+				Project:       types.StringValue(p.Name),
+				Container:     types.StringValue(c.Name),
+				RestartPolicy: types.StringValue(policy),
+			})
+			lines = append(lines, fmt.Sprintf("%s/%s: %s", p.Name, c.Name, policy))
+		}
+	}
+	data.OK = types.BoolValue(len(data.Violations) == 0)
+
+	if len(lines) > 0 && data.FailOnViolation.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Disallowed restart policies",
+			fmt.Sprintf("These containers have a restart policy in %s:\n%s",
+				strings.Join(disallowed, ", "), strings.Join(lines, "\n")),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// restartPolicyName returns the name of a Docker restart policy without its
+// retry count, e.g. "on-failure" for "on-failure:3". An empty policy is
+// Docker's default, "no".
+func restartPolicyName(policy string) string {
+	name, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(policy)), ":")
+	if name == "" {
+		return "no"
+	}
+	return name
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestRestartPolicyCheckDataSource_GivenContainerWithoutPolicy_WhenRead_ThenViolationReported
+// validates that containers with the default "no" policy are reported across
+// the projects of the environment.
+func TestRestartPolicyCheckDataSource_GivenContainerWithoutPolicy_WhenRead_ThenViolationReported(t *testing.T) {
+	mockServer := newRestartPolicyMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testRestartPolicyCheckDataSourceConfig(mockServer.URL, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_restart_policy_check.test", "ok", "false"),
+					resource.TestCheckResourceAttr("data.arcane_restart_policy_check.test", "violations.#", "1"),
+					resource.TestCheckResourceAttr("data.arcane_restart_policy_check.test", "violations.0.project", "jobs"),
+					resource.TestCheckResourceAttr("data.arcane_restart_policy_check.test", "violations.0.container", "jobs-cron-1"),
+					resource.TestCheckResourceAttr("data.arcane_restart_policy_check.test", "violations.0.restart_policy", "no"),
+				),
+			},
+			{
+				Config: testRestartPolicyCheckDataSourceConfig(mockServer.URL, `disallowed_policies = ["no", "on-failure"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_restart_policy_check.test", "violations.#", "2"),
+				),
+			},
+			{
+				Config: testRestartPolicyCheckDataSourceConfig(mockServer.URL, `project_id = "proj-web"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_restart_policy_check.test", "ok", "true"),
+					resource.TestCheckResourceAttr("data.arcane_restart_policy_check.test", "violations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// TestRestartPolicyCheckDataSource_GivenFailOnViolation_WhenViolated_ThenError
+// validates that fail_on_violation fails the plan listing the containers.
+func TestRestartPolicyCheckDataSource_GivenFailOnViolation_WhenViolated_ThenError(t *testing.T) {
+	mockServer := newRestartPolicyMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testRestartPolicyCheckDataSourceConfig(mockServer.URL, `fail_on_violation = true`),
+				ExpectError: regexp.MustCompile(`jobs/jobs-cron-1: no`),
+			},
+		},
+	})
+}
+
+// TestRestartPolicyCheckDataSource_GivenUnknownPolicy_WhenValidated_ThenError
+// validates that disallowed_policies only accepts Docker policy names.
+func TestRestartPolicyCheckDataSource_GivenUnknownPolicy_WhenValidated_ThenError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testRestartPolicyCheckDataSourceConfig("http://localhost:1", `disallowed_policies = ["never"]`),
+				ExpectError: regexp.MustCompile(`Invalid restart policy`),
+			},
+		},
+	})
+}
+
+func TestRestartPolicyName(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"":               "no",
+		"no":             "no",
+		"always":         "always",
+		"Unless-Stopped": "unless-stopped",
+		"on-failure:3":   "on-failure",
+	}
+	for policy, want := range cases {
+		if got := restartPolicyName(policy); got != want {
+			t.Errorf("restartPolicyName(%q) = %q, want %q", policy, got, want)
+		}
+	}
+}
+
+// newRestartPolicyMockServer returns a mock server with an environment
+// "env-rp" holding a web project whose containers restart and a jobs project
+// with one container without a restart policy.
+func newRestartPolicyMockServer() *MockServer {
+	ms := NewMockServer()
+	ms.Environments["env-rp"] = &arcane.Environment{ID: "env-rp", Name: "rp-env"}
+	ms.AddProject("env-rp", &arcane.Project{ID: "proj-web", Name: "web", Status: "running", EnvironmentID: "env-rp"})
+	ms.AddContainers("env-rp", "proj-web", []arcane.ContainerDetail{
+		{ID: "c1", Name: "web-app-1", Status: "running", RestartPolicy: "unless-stopped"},
+	})
+	ms.AddProject("env-rp", &arcane.Project{ID: "proj-jobs", Name: "jobs", Status: "running", EnvironmentID: "env-rp"})
+	ms.AddContainers("env-rp", "proj-jobs", []arcane.ContainerDetail{
+		{ID: "c2", Name: "jobs-cron-1", Status: "running"},
+		{ID: "c3", Name: "jobs-worker-1", Status: "running", RestartPolicy: "on-failure:5"},
+	})
+	return ms
+}
+
+func testRestartPolicyCheckDataSourceConfig(url, extra string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_restart_policy_check" "test" {
+  environment_id = "env-rp"
+  %[2]s
+}
+`, url, extra)
+}
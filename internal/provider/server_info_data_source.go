@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ServerInfoDataSource{}
+
+// NewServerInfoDataSource returns a new server info data source.
+func NewServerInfoDataSource() datasource.DataSource {
+	return &ServerInfoDataSource{}
+}
+
+// ServerInfoDataSource defines the server info data source implementation.
+type ServerInfoDataSource struct {
+	client *Client
+}
+
+// ServerInfoDataSourceModel describes the server info data source data model.
+type ServerInfoDataSourceModel struct {
+	Version            types.String `tfsdk:"version"`
+	RateLimitLimit     types.Int64  `tfsdk:"ratelimit_limit"`
+	RateLimitRemaining types.Int64  `tfsdk:"ratelimit_remaining"`
+	RateLimitReset     types.Int64  `tfsdk:"ratelimit_reset_seconds"`
+}
+
+func (d *ServerInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_info"
+}
+
+func (d *ServerInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to read the Arcane server version and the API rate limit state of the
+provider's API key, as reported in the ` + "`X-Arcane-Version`" + ` and ` + "`RateLimit-*`" + ` response headers.
+
+Attributes are null when Arcane, or a proxy in front of it, doesn't send the corresponding
+header. The same headers are logged for every request at the DEBUG level (` + "`TF_LOG=DEBUG`" + `).
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_server_info" "current" {}
+
+check "arcane_version" {
+  assert {
+    condition     = data.arcane_server_info.current.version != "1.15.0"
+    error_message = "Arcane 1.15.0 is not supported by this configuration."
+  }
+}
+
+output "arcane_requests_remaining" {
+  value = data.arcane_server_info.current.ratelimit_remaining
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The Arcane server version (`X-Arcane-Version`).",
+				Computed:            true,
+			},
+			"ratelimit_limit": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests allowed in the current window (`RateLimit-Limit`).",
+				Computed:            true,
+			},
+			"ratelimit_remaining": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests left in the current window (`RateLimit-Remaining`).",
+				Computed:            true,
+			},
+			"ratelimit_reset_seconds": schema.Int64Attribute{
+				MarkdownDescription: "The number of seconds until the window resets (`RateLimit-Reset`).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ServerInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ServerInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data ServerInfoDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := d.client.GetServerInfo(ctx)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read server info")
+		return
+	}
+
+	data.Version = optionalString(info.Version)
+	data.RateLimitLimit = types.Int64Null()
+	data.RateLimitRemaining = types.Int64Null()
+	data.RateLimitReset = types.Int64Null()
+	if rl := info.RateLimit; rl != nil {
+		data.RateLimitLimit = types.Int64Value(int64(rl.Limit))
+		data.RateLimitRemaining = types.Int64Value(int64(rl.Remaining))
+		data.RateLimitReset = types.Int64Value(int64(rl.Reset.Seconds()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestServerInfoDataSource_GivenHeaders_WhenRead_ThenVersionAndRateLimitSet
+// validates that the version and rate limit headers are exposed.
+func TestServerInfoDataSource_GivenHeaders_WhenRead_ThenVersionAndRateLimitSet(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.ResponseHeaders = http.Header{
+		"X-Arcane-Version":    {"1.16.2"},
+		"Ratelimit-Limit":     {"600"},
+		"Ratelimit-Remaining": {"599"},
+		"Ratelimit-Reset":     {"60"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testServerInfoDataSourceConfig(mockServer.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_server_info.test", "version", "1.16.2"),
+					resource.TestCheckResourceAttr("data.arcane_server_info.test", "ratelimit_limit", "600"),
+					resource.TestCheckResourceAttr("data.arcane_server_info.test", "ratelimit_remaining", "599"),
+					resource.TestCheckResourceAttr("data.arcane_server_info.test", "ratelimit_reset_seconds", "60"),
+				),
+			},
+		},
+	})
+}
+
+// TestServerInfoDataSource_GivenNoHeaders_WhenRead_ThenAttributesNull
+// validates that attributes are null when Arcane sends no such headers.
+func TestServerInfoDataSource_GivenNoHeaders_WhenRead_ThenAttributesNull(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testServerInfoDataSourceConfig(mockServer.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("data.arcane_server_info.test", "version"),
+					resource.TestCheckNoResourceAttr("data.arcane_server_info.test", "ratelimit_remaining"),
+				),
+			},
+		},
+	})
+}
+
+func testServerInfoDataSourceConfig(url string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_server_info" "test" {}
+`, url)
+}
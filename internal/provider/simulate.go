@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// warnSimulated adds a warning when the provider's simulate mode is set, so
+// an apply whose deploys were simulated can't be mistaken for a real one.
+func warnSimulated(c *Client, diags *diag.Diagnostics) {
+	if c == nil || c.Simulate() == "" {
+		return
+	}
+	diags.AddWarning(
+		"Simulated deployment",
+		fmt.Sprintf("The provider's simulate mode is %q: deploys and redeploys in this operation fail without contacting Arcane. Unset simulate (or ARCANE_SIMULATE) for real deployments.", c.Simulate()),
+	)
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestWarnSimulated(t *testing.T) {
+	t.Parallel()
+
+	for mode, wantWarning := range map[string]bool{
+		"":                      false,
+		simulateFailDeploys:     true,
+		simulateConflictDeploys: true,
+	} {
+		c := newTestClient(t, "http://localhost", ClientSettings{Simulate: mode})
+		var diags diag.Diagnostics
+		warnSimulated(c, &diags)
+		if got := diags.WarningsCount() == 1; got != wantWarning {
+			t.Errorf("simulate %q: warning = %v, want %v", mode, got, wantWarning)
+		}
+		if diags.HasError() {
+			t.Errorf("simulate %q: unexpected error: %v", mode, diags)
+		}
+	}
+}
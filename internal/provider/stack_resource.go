@@ -0,0 +1,534 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &StackResource{}
+	_ resource.ResourceWithImportState    = &StackResource{}
+	_ resource.ResourceWithModifyPlan     = &StackResource{}
+	_ resource.ResourceWithValidateConfig = &StackResource{}
+)
+
+// NewStackResource returns a new stack resource.
+func NewStackResource() resource.Resource {
+	return &StackResource{}
+}
+
+// StackResource defines the stack resource implementation. It manages a
+// project and its deployment together, reusing the deployment resource's
+// wait logic.
+type StackResource struct {
+	client *Client
+}
+
+// StackResourceModel describes the stack resource data model.
+type StackResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	EnvironmentID  types.String `tfsdk:"environment_id"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	Name           types.String `tfsdk:"name"`
+	ComposeContent types.String `tfsdk:"compose_content"`
+	EnvContent     types.String `tfsdk:"env_content"`
+	Pull           types.Bool   `tfsdk:"pull"`
+	ForceRecreate  types.Bool   `tfsdk:"force_recreate"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+	WaitTimeout    types.String `tfsdk:"wait_timeout"`
+	ComposeSHA256  types.String `tfsdk:"compose_sha256"`
+	Status         types.String `tfsdk:"status"`
+	LastDeployedAt types.String `tfsdk:"last_deployed_at"`
+	ConfirmDestroy types.String `tfsdk:"confirm_destroy"`
+	APIKeyAlias    types.String `tfsdk:"api_key_alias"`
+}
+
+// needsRedeploy reports whether applying m over state changes what is
+// deployed.
+func (m *StackResourceModel) needsRedeploy(state *StackResourceModel) bool {
+	return !m.ComposeSHA256.Equal(state.ComposeSHA256) ||
+		!m.EnvContent.Equal(state.EnvContent) ||
+		!m.Name.Equal(state.Name) ||
+		!m.Triggers.Equal(state.Triggers) ||
+		!m.Pull.Equal(state.Pull) ||
+		!m.ForceRecreate.Equal(state.ForceRecreate)
+}
+
+func (r *StackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack"
+}
+
+func (r *StackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages a compose project and its deployment as one resource: creating it uploads the compose
+and ` + "`.env`" + ` content and deploys the project, and any change to the content, the name or the
+deploy options redeploys it. Destroying it stops the project and deletes it.
+
+It replaces an ` + "`arcane_project`" + ` and an ` + "`arcane_project_deployment`" + ` wired together with
+triggers, for stacks that don't need the granular options of those resources, such as override
+files, health checks or blue/green deployments.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "arcane_stack" "webapp" {
+  environment_id  = arcane_environment.production.id
+  name            = "webapp"
+  compose_content = file("${path.module}/docker-compose.yml")
+  env_content     = provider::arcane::env_file_encode({ TAG = var.image_tag })
+  pull            = true
+}
+` + "```" + `
+
+## Import
+
+Stacks can be imported using ` + "`environment_id/project_id`" + `:
+
+` + "```shell" + `
+terraform import arcane_stack.webapp <environment-id>/<project-id>
+` + "```" + `
+
+**Note:** The ` + "`.env`" + ` content of a project is not retrieved from the API, so the first apply
+after import uploads it again and redeploys the stack.
+`,
+		Attributes: map[string]schema.Attribute{
+			"api_key_alias": apiKeyAliasAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the stack, `environment_id/project_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment to deploy the stack in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the stack's project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the project, unique within the environment. Docker Compose uses it as the project name, so renaming the stack recreates its containers.",
+				Required:            true,
+			},
+			"compose_content": schema.StringAttribute{
+				MarkdownDescription: "The content of the project's compose file. Changing it redeploys the stack.",
+				Required:            true,
+			},
+			"env_content": schema.StringAttribute{
+				MarkdownDescription: "The content of the project's `.env` file, e.g. from `provider::arcane::env_file_encode`. Left empty when unset. Changing it redeploys the stack.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"pull": schema.BoolAttribute{
+				MarkdownDescription: "Always pull images before deploying. Defaults to the provider's `default_deploy_options`, or `false`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"force_recreate": schema.BoolAttribute{
+				MarkdownDescription: "Force recreate containers even if configuration hasn't changed. Defaults to the provider's `default_deploy_options`, or `false`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "A map of arbitrary strings that, when changed, will trigger a redeployment, e.g. the digest of an image tag that is reused. Changes to the compose and `.env` content already redeploy the stack.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"wait_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for a deployment to finish and the project to reach a settled status (`running`, `degraded` or `exited`). Accepts Go duration strings (e.g. `30s`, `2m`, `5m`). Defaults to `2m`.",
+				Optional:            true,
+			},
+			"compose_sha256": schema.StringAttribute{
+				MarkdownDescription: "The hex-encoded SHA-256 hash of the uploaded compose content, with line endings normalized to LF.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the project after the last deployment, refreshed on every read.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_deployed_at": schema.StringAttribute{
+				MarkdownDescription: "When the stack was last deployed, as an RFC 3339 timestamp.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"confirm_destroy": confirmDestroyAttribute("project"),
+		},
+	}
+}
+
+func (r *StackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *StackResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data StackResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ComposeContent.IsUnknown() && strings.TrimSpace(data.ComposeContent.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("compose_content"),
+			"Invalid compose content",
+			"The compose content must not be empty.",
+		)
+	}
+	if !data.WaitTimeout.IsNull() && !data.WaitTimeout.IsUnknown() {
+		if d, err := time.ParseDuration(data.WaitTimeout.ValueString()); err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_timeout"),
+				"Invalid wait_timeout",
+				fmt.Sprintf("Expected a positive Go duration such as 30s or 2m, got %q.", data.WaitTimeout.ValueString()),
+			)
+		}
+	}
+}
+
+// ModifyPlan rejects environments excluded by the provider's
+// allowed_environments or denied_environments, fills pull and force_recreate
+// from the provider's default_deploy_options, plans compose_sha256 from the
+// configured content and fails the plan of a stack whose name is taken. When
+// the update redeploys the stack, status and last_deployed_at are unknown.
+func (r *StackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkPlannedEnvironmentAllowed(ctx, r.client, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state StackResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var defaults DeployDefaults
+	if r.client != nil {
+		defaults = r.client.DeployDefaults()
+	}
+	// The plan holds unknown rather than null for unset computed attributes
+	if config.Pull.IsNull() {
+		plan.Pull = types.BoolValue(defaults.Pull)
+	}
+	if config.ForceRecreate.IsNull() {
+		plan.ForceRecreate = types.BoolValue(defaults.ForceRecreate)
+	}
+	plan.ComposeSHA256 = types.StringUnknown()
+	if !plan.ComposeContent.IsUnknown() {
+		plan.ComposeSHA256 = types.StringValue(sha256Hex([]byte(normalizeLineEndings(plan.ComposeContent.ValueString()))))
+	}
+	if !req.State.Raw.IsNull() && plan.needsRedeploy(&state) {
+		plan.Status = types.StringUnknown()
+		plan.LastDeployedAt = types.StringUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || r.client == nil {
+		return
+	}
+
+	// Check the name of new and renamed stacks
+	if plan.EnvironmentID.IsUnknown() || plan.Name.IsUnknown() || plan.Name.Equal(state.Name) {
+		return
+	}
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+	envClient := r.client.ForEnvironment(plan.EnvironmentID.ValueString())
+	checkProjectNameAvailable(ctx, envClient, plan.EnvironmentID.ValueString(), plan.Name.ValueString(), false, &resp.Diagnostics)
+}
+
+func (r *StackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+	warnSimulated(r.client, &resp.Diagnostics)
+
+	var data StackResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content := normalizeLineEndings(data.ComposeContent.ValueString())
+	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
+	project, err := envClient.CreateProject(ctx, &arcane.ProjectCreateRequest{
+		Name:           data.Name.ValueString(),
+		ComposeContent: content,
+		EnvContent:     normalizeLineEndings(data.EnvContent.ValueString()),
+	})
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to create project")
+		return
+	}
+
+	data.ProjectID = types.StringValue(project.ID)
+	data.ID = types.StringValue(formatCompositeID(data.EnvironmentID.ValueString(), project.ID))
+	data.ComposeSHA256 = types.StringValue(sha256Hex([]byte(content)))
+	data.Status = types.StringValue(project.Status)
+	data.LastDeployedAt = types.StringNull()
+
+	// A stack whose deploy failed is recorded, and so tainted, rather than
+	// leaving an untracked project behind
+	r.deploy(ctx, envClient, &data, false, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+
+	var data StackResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	prior := data
+
+	checkCompositeID("arcane_stack", &data.ID, &data.EnvironmentID, &data.ProjectID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
+	project, err := envClient.GetProject(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		if arcane.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to read project")
+		return
+	}
+
+	data.Name = types.StringValue(project.Name)
+	data.Status = types.StringValue(project.Status)
+	// Arcane versions that don't return the compose content keep the hash
+	// of the last upload
+	if project.ComposeContent != "" {
+		data.ComposeSHA256 = types.StringValue(sha256Hex([]byte(normalizeLineEndings(project.ComposeContent))))
+	}
+	if data.Pull.IsNull() {
+		data.Pull = types.BoolValue(false)
+	}
+	if data.ForceRecreate.IsNull() {
+		data.ForceRecreate = types.BoolValue(false)
+	}
+
+	drift := newDriftReport("arcane_stack", data.ID.ValueString())
+	drift.compare("name", prior.Name, data.Name)
+	drift.compare("compose_sha256", prior.ComposeSHA256, data.ComposeSHA256)
+	drift.addTo(&resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.Plan)
+	warnSimulated(r.client, &resp.Diagnostics)
+
+	var data, state StackResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
+	data.ID = types.StringValue(formatCompositeID(data.EnvironmentID.ValueString(), data.ProjectID.ValueString()))
+
+	if !data.needsRedeploy(&state) {
+		tflog.Debug(ctx, "No deployment-affecting attributes changed, skipping redeploy", map[string]interface{}{
+			"project_id": data.ProjectID.ValueString(),
+		})
+		data.Status = state.Status
+		data.LastDeployedAt = state.LastDeployedAt
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	content := normalizeLineEndings(data.ComposeContent.ValueString())
+	updateReq := &arcane.ProjectUpdateRequest{ComposeContent: content}
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueString()
+	}
+	if !data.EnvContent.Equal(state.EnvContent) {
+		envContent := normalizeLineEndings(data.EnvContent.ValueString())
+		updateReq.EnvContent = &envContent
+	}
+	project, err := envClient.UpdateProject(ctx, data.ProjectID.ValueString(), updateReq)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to update project")
+		return
+	}
+
+	data.ComposeSHA256 = types.StringValue(sha256Hex([]byte(content)))
+	data.Status = types.StringValue(project.Status)
+	data.LastDeployedAt = state.LastDeployedAt
+	r.deploy(ctx, envClient, &data, true, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+	ctx = withAPIKeyAlias(ctx, req.State)
+	warnSimulated(r.client, &resp.Diagnostics)
+
+	var data StackResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	if !checkDestroyConfirmed(r.client, "project", name, data.ConfirmDestroy, []string{name}, &resp.Diagnostics) {
+		return
+	}
+
+	envClient := r.client.ForEnvironment(data.EnvironmentID.ValueString())
+	projectID := data.ProjectID.ValueString()
+
+	unlock := lockProjectForDeploy(ctx, envClient, projectID, &resp.Diagnostics)
+	if unlock == nil {
+		return
+	}
+	defer unlock()
+
+	if err := envClient.StopProject(ctx, projectID); err != nil {
+		if arcane.IsNotFound(err) {
+			return
+		}
+		addDeployError(ctx, &resp.Diagnostics, "Failed to stop project", projectID, err)
+		return
+	}
+	if err := envClient.DeleteProject(ctx, projectID); err != nil && !arcane.IsNotFound(err) {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to delete project")
+	}
+}
+
+func (r *StackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	environmentID, projectID, ok := parseCompositeID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected format: environment_id/project_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+}
+
+// deploy deploys the stack's project, or redeploys it, and waits for the
+// deployment to finish and the project status to settle. status and
+// last_deployed_at are only updated when the deployment succeeds.
+func (r *StackResource) deploy(ctx context.Context, envClient *EnvironmentClient, data *StackResourceModel, redeploy bool, diags *diag.Diagnostics) {
+	projectID := data.ProjectID.ValueString()
+	timeout := 2 * time.Minute
+	if d, err := time.ParseDuration(data.WaitTimeout.ValueString()); err == nil {
+		timeout = d
+	}
+
+	unlock := lockProjectForDeploy(ctx, envClient, projectID, diags)
+	if unlock == nil {
+		return
+	}
+	defer unlock()
+
+	deployReq := &arcane.ProjectDeployRequest{ForceRecreate: data.ForceRecreate.ValueBool()}
+	if data.Pull.ValueBool() {
+		deployReq.PullPolicy = "always"
+	}
+	deployStart := time.Now()
+
+	tflog.Debug(ctx, "Deploying stack", map[string]interface{}{
+		"environment_id": data.EnvironmentID.ValueString(),
+		"project_id":     projectID,
+		"redeploy":       redeploy,
+	})
+
+	deployProject := envClient.DeployProject
+	if redeploy {
+		deployProject = envClient.RedeployProject
+	}
+	deploymentID, err := deployProject(ctx, projectID, deployReq)
+	if err != nil {
+		addDeployError(ctx, diags, "Failed to deploy project", projectID, err)
+		return
+	}
+	envClient.MarkProjectDeployed(projectID, deployReq)
+
+	deployer := &ProjectDeploymentResource{client: r.client}
+	if _, err := deployer.waitForDeployment(ctx, envClient, deploymentID, timeout, diags); err != nil {
+		diagnostics.AddAPIError(ctx, diags, err, "Failed to get deployment status")
+		return
+	}
+	if diags.HasError() {
+		return
+	}
+	project, status, notRunning, err := deployer.waitForDeployedStatus(ctx, envClient, projectID, max(timeout-time.Since(deployStart), 0), diags)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, diags, err, "Failed to get project status")
+		return
+	}
+	addPartialDeployWarning(diags, project, notRunning)
+
+	data.Status = types.StringValue(status)
+	data.LastDeployedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestStackResource_GivenComposeContent_WhenCreated_ThenProjectDeployed
+// validates that creating a stack creates the project with its compose and
+// .env content and deploys it.
+func TestStackResource_GivenComposeContent_WhenCreated_ThenProjectDeployed(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testStackConfig(mockServer.URL, "webapp", "services:\n  web:\n    image: nginx\n", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_stack.test", "id", "env-projects/proj-webapp"),
+					resource.TestCheckResourceAttr("arcane_stack.test", "project_id", "proj-webapp"),
+					resource.TestCheckResourceAttr("arcane_stack.test", "status", "running"),
+					resource.TestCheckResourceAttr("arcane_stack.test", "pull", "false"),
+					resource.TestCheckResourceAttr("arcane_stack.test", "compose_sha256", sha256Hex([]byte("services:\n  web:\n    image: nginx\n"))),
+					resource.TestCheckResourceAttrSet("arcane_stack.test", "last_deployed_at"),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-projects/projects/proj-webapp/up", 1),
+					func(*terraform.State) error {
+						if got := mockServer.ProjectEnvContent["proj-webapp"]; got != "TAG=1\n" {
+							return fmt.Errorf("env content = %q, want %q", got, "TAG=1\n")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            "arcane_stack.test",
+				ImportState:             true,
+				ImportStateId:           "env-projects/proj-webapp",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"compose_content", "env_content", "last_deployed_at"},
+			},
+		},
+	})
+}
+
+// TestStackResource_GivenContentChanged_WhenApplied_ThenStackRedeployed
+// validates that changing the compose or .env content uploads it and
+// redeploys the stack in place.
+func TestStackResource_GivenContentChanged_WhenApplied_ThenStackRedeployed(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testStackConfig(mockServer.URL, "webapp", "services: {}\n", "1"),
+			},
+			{
+				Config: testStackConfig(mockServer.URL, "webapp", "services: {}\n", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_stack.test", "id", "env-projects/proj-webapp"),
+					mockServer.CheckRequestCount(http.MethodPut, "/api/environments/env-projects/projects/proj-webapp", 1),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-projects/projects/proj-webapp/redeploy", 1),
+					func(*terraform.State) error {
+						if got := mockServer.ProjectEnvContent["proj-webapp"]; got != "TAG=2\n" {
+							return fmt.Errorf("env content = %q, want %q", got, "TAG=2\n")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testStackConfig(mockServer.URL, "webapp", "services:\n  web:\n    image: nginx\n", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_stack.test", "compose_sha256", sha256Hex([]byte("services:\n  web:\n    image: nginx\n"))),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-projects/projects/proj-webapp/redeploy", 2),
+				),
+			},
+		},
+	})
+}
+
+// TestStackResource_GivenWaitTimeoutChanged_WhenApplied_ThenNotRedeployed
+// validates that changing an attribute that doesn't affect the deployment
+// neither uploads nor redeploys the stack.
+func TestStackResource_GivenWaitTimeoutChanged_WhenApplied_ThenNotRedeployed(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testStackConfig(mockServer.URL, "webapp", "services: {}\n", "1"),
+			},
+			{
+				Config: testStackConfigWaitTimeout(mockServer.URL, "5m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arcane_stack.test", "wait_timeout", "5m"),
+					mockServer.CheckRequestCount(http.MethodPut, "/api/environments/env-projects/projects/proj-webapp", 0),
+					mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-projects/projects/proj-webapp/redeploy", 0),
+				),
+			},
+		},
+	})
+}
+
+// TestStackResource_GivenStack_WhenDestroyed_ThenProjectDeleted validates
+// that destroying the stack stops and deletes its project.
+func TestStackResource_GivenStack_WhenDestroyed_ThenProjectDeleted(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if _, ok := mockServer.Projects["env-projects"]["proj-webapp"]; ok {
+				return fmt.Errorf("project proj-webapp was not deleted")
+			}
+			return mockServer.CheckRequestCount(http.MethodPost, "/api/environments/env-projects/projects/proj-webapp/down", 1)(nil)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testStackConfig(mockServer.URL, "webapp", "services: {}\n", "1"),
+			},
+		},
+	})
+}
+
+// TestStackResource_GivenInvalidConfig_WhenValidated_ThenError validates
+// that empty compose content and invalid wait timeouts are rejected.
+func TestStackResource_GivenInvalidConfig_WhenValidated_ThenError(t *testing.T) {
+	mockServer := newProjectMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testStackConfig(mockServer.URL, "webapp", "  \n", "1"),
+				ExpectError: regexp.MustCompile(`must not be empty`),
+			},
+			{
+				Config:      testStackConfigWaitTimeout(mockServer.URL, "soon"),
+				ExpectError: regexp.MustCompile(`Invalid wait_timeout`),
+			},
+		},
+	})
+}
+
+func testStackConfig(url, name, compose, tag string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_stack" "test" {
+  environment_id  = "env-projects"
+  name            = %[2]q
+  compose_content = %[3]q
+  env_content     = "TAG=%[4]s\n"
+}
+`, url, name, compose, tag)
+}
+
+func testStackConfigWaitTimeout(url, waitTimeout string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+resource "arcane_stack" "test" {
+  environment_id  = "env-projects"
+  name            = "webapp"
+  compose_content = "services: {}\n"
+  env_content     = "TAG=1\n"
+  wait_timeout    = %[2]q
+}
+`, url, waitTimeout)
+}
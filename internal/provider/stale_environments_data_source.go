@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/internal/diagnostics"
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &StaleEnvironmentsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &StaleEnvironmentsDataSource{}
+)
+
+// Reasons an environment is stale.
+const (
+	staleReasonExpired = "expired"
+	staleReasonMaxAge  = "max_age"
+)
+
+// NewStaleEnvironmentsDataSource returns a new stale environments data source.
+func NewStaleEnvironmentsDataSource() datasource.DataSource {
+	return &StaleEnvironmentsDataSource{}
+}
+
+// StaleEnvironmentsDataSource defines the stale environments data source implementation.
+type StaleEnvironmentsDataSource struct {
+	client *Client
+}
+
+// StaleEnvironmentsDataSourceModel describes the stale environments data source data model.
+type StaleEnvironmentsDataSourceModel struct {
+	NamePrefix   types.String       `tfsdk:"name_prefix"`
+	MaxAge       types.String       `tfsdk:"max_age"`
+	Environments []staleEnvironment `tfsdk:"environments"`
+	IDs          []types.String     `tfsdk:"ids"`
+}
+
+// staleEnvironment describes an element of environments.
+type staleEnvironment struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Reason    types.String `tfsdk:"reason"`
+}
+
+func (d *StaleEnvironmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stale_environments"
+}
+
+func (d *StaleEnvironmentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Use this data source to find environments that are due for cleanup, such as preview environments
+created by CI: those past the ` + "`expires_at`" + ` set from an ` + "`arcane_environment`" + `'s
+` + "`ttl`" + `, and, with ` + "`max_age`" + `, those created longer ago than that.
+
+## Example Usage
+
+` + "```hcl" + `
+data "arcane_stale_environments" "previews" {
+  name_prefix = "pr-"
+  max_age     = "168h"
+}
+
+output "stale_preview_ids" {
+  value = data.arcane_stale_environments.previews.ids
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only consider environments whose name in Arcane starts with this, e.g. `pr-`. Includes the provider's `name_prefix`, if any.",
+				Optional:            true,
+			},
+			"max_age": schema.StringAttribute{
+				MarkdownDescription: "Also list environments created longer ago than this Go duration (e.g. `168h`), whether or not they have an expiry.",
+				Optional:            true,
+			},
+			"environments": schema.ListNestedAttribute{
+				MarkdownDescription: "The stale environments, ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the environment.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the environment in Arcane.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the environment was created, as reported by Arcane.",
+							Computed:            true,
+						},
+						"expires_at": schema.StringAttribute{
+							MarkdownDescription: "When the environment expires. Unset when it has no expiry.",
+							Computed:            true,
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "Why the environment is stale: `expired` when past `expires_at`, otherwise `max_age`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the stale environments, in the same order as `environments`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *StaleEnvironmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *StaleEnvironmentsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var maxAge types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_age"), &maxAge)...)
+	if resp.Diagnostics.HasError() || maxAge.IsNull() || maxAge.IsUnknown() {
+		return
+	}
+	if dur, err := time.ParseDuration(maxAge.ValueString()); err != nil || dur <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_age"),
+			"Invalid max_age",
+			fmt.Sprintf("Expected a positive Go duration such as 168h, got %q.", maxAge.ValueString()),
+		)
+	}
+}
+
+func (d *StaleEnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, flushWarnings := diagnostics.CollectServerWarnings(ctx, &resp.Diagnostics)
+	defer flushWarnings()
+
+	var data StaleEnvironmentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var maxAge time.Duration
+	if !data.MaxAge.IsNull() {
+		maxAge, _ = time.ParseDuration(data.MaxAge.ValueString())
+	}
+
+	envs, err := d.client.ListEnvironments(ctx)
+	if err != nil {
+		diagnostics.AddAPIError(ctx, &resp.Diagnostics, err, "Failed to list environments")
+		return
+	}
+
+	now := time.Now()
+	data.Environments = []staleEnvironment{}
+	data.IDs = []types.String{}
+	for _, env := range sortedEnvironments(envs) {
+		if !strings.HasPrefix(env.Name, data.NamePrefix.ValueString()) {
+			continue
+		}
+		reason := environmentStaleReason(env, maxAge, now)
+		if reason == "" {
+			continue
+		}
+		data.Environments = append(data.Environments, staleEnvironment{
+			ID:        types.StringValue(env.ID),
+			Name:      types.StringValue(env.Name),
+			CreatedAt: optionalString(env.CreatedAt),
+			ExpiresAt: optionalString(env.ExpiresAt),
+			Reason:    types.StringValue(reason),
+		})
+		data.IDs = append(data.IDs, types.StringValue(env.ID))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// environmentStaleReason returns why env is stale at now, or "" when it
+// isn't. An expiry takes precedence over maxAge, which is ignored when zero.
+// Timestamps that don't parse as RFC 3339 are treated as unset.
+func environmentStaleReason(env arcane.Environment, maxAge time.Duration, now time.Time) string {
+	if expiresAt, err := time.Parse(time.RFC3339, env.ExpiresAt); err == nil && !now.Before(expiresAt) {
+		return staleReasonExpired
+	}
+	if maxAge > 0 {
+		if createdAt, err := time.Parse(time.RFC3339, env.CreatedAt); err == nil && now.Sub(createdAt) > maxAge {
+			return staleReasonMaxAge
+		}
+	}
+	return ""
+}
+
+// sortedEnvironments returns envs ordered by name, then ID.
+func sortedEnvironments(envs []arcane.Environment) []arcane.Environment {
+	sorted := slices.Clone(envs)
+	slices.SortFunc(sorted, func(a, b arcane.Environment) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return sorted
+}
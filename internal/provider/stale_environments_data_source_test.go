@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// TestStaleEnvironmentsDataSource_GivenExpiredAndOldEnvironments_WhenRead_ThenListed
+// validates that expired environments are listed, old ones only with max_age,
+// and that name_prefix narrows the search.
+func TestStaleEnvironmentsDataSource_GivenExpiredAndOldEnvironments_WhenRead_ThenListed(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	now := time.Now().UTC()
+	mockServer.Environments["env-pr-1"] = &arcane.Environment{
+		ID: "env-pr-1", Name: "pr-1",
+		CreatedAt: now.Add(-96 * time.Hour).Format(time.RFC3339),
+		ExpiresAt: now.Add(-24 * time.Hour).Format(time.RFC3339),
+	}
+	mockServer.Environments["env-pr-2"] = &arcane.Environment{
+		ID: "env-pr-2", Name: "pr-2",
+		CreatedAt: now.Add(-240 * time.Hour).Format(time.RFC3339),
+	}
+	mockServer.Environments["env-pr-3"] = &arcane.Environment{
+		ID: "env-pr-3", Name: "pr-3",
+		CreatedAt: now.Add(-time.Hour).Format(time.RFC3339),
+		ExpiresAt: now.Add(71 * time.Hour).Format(time.RFC3339),
+	}
+	mockServer.Environments["env-prod"] = &arcane.Environment{
+		ID: "env-prod", Name: "production",
+		CreatedAt: now.Add(-8760 * time.Hour).Format(time.RFC3339),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testStaleEnvironmentsDataSourceConfig(mockServer.URL, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_stale_environments.test", "environments.#", "1"),
+					resource.TestCheckResourceAttr("data.arcane_stale_environments.test", "environments.0.name", "pr-1"),
+					resource.TestCheckResourceAttr("data.arcane_stale_environments.test", "environments.0.reason", "expired"),
+					resource.TestCheckResourceAttr("data.arcane_stale_environments.test", "ids.0", "env-pr-1"),
+				),
+			},
+			{
+				Config: testStaleEnvironmentsDataSourceConfig(mockServer.URL, `
+  name_prefix = "pr-"
+  max_age     = "168h"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.arcane_stale_environments.test", "environments.#", "2"),
+					resource.TestCheckResourceAttr("data.arcane_stale_environments.test", "environments.1.name", "pr-2"),
+					resource.TestCheckResourceAttr("data.arcane_stale_environments.test", "environments.1.reason", "max_age"),
+					resource.TestCheckNoResourceAttr("data.arcane_stale_environments.test", "environments.1.expires_at"),
+				),
+			},
+		},
+	})
+}
+
+// TestStaleEnvironmentsDataSource_GivenInvalidMaxAge_WhenValidated_ThenError
+// validates that max_age only accepts positive durations.
+func TestStaleEnvironmentsDataSource_GivenInvalidMaxAge_WhenValidated_ThenError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testStaleEnvironmentsDataSourceConfig("http://localhost:1", `max_age = "1w"`),
+				ExpectError: regexp.MustCompile(`Invalid max_age`),
+			},
+		},
+	})
+}
+
+// TestEnvironmentStaleReason validates when an environment counts as stale.
+func TestEnvironmentStaleReason(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		env    arcane.Environment
+		maxAge time.Duration
+		want   string
+	}{
+		"no timestamps":             {env: arcane.Environment{}, maxAge: time.Hour, want: ""},
+		"expired":                   {env: arcane.Environment{ExpiresAt: "2026-03-10T11:00:00Z"}, want: staleReasonExpired},
+		"expires now":               {env: arcane.Environment{ExpiresAt: "2026-03-10T12:00:00Z"}, want: staleReasonExpired},
+		"not yet expired":           {env: arcane.Environment{ExpiresAt: "2026-03-10T13:00:00Z"}, want: ""},
+		"expired in another zone":   {env: arcane.Environment{ExpiresAt: "2026-03-10T12:30:00+01:00"}, want: staleReasonExpired},
+		"older than max_age":        {env: arcane.Environment{CreatedAt: "2026-03-01T12:00:00Z"}, maxAge: 168 * time.Hour, want: staleReasonMaxAge},
+		"younger than max_age":      {env: arcane.Environment{CreatedAt: "2026-03-09T12:00:00Z"}, maxAge: 168 * time.Hour, want: ""},
+		"old without max_age":       {env: arcane.Environment{CreatedAt: "2026-01-01T12:00:00Z"}, want: ""},
+		"expiry wins over max_age":  {env: arcane.Environment{CreatedAt: "2026-01-01T12:00:00Z", ExpiresAt: "2026-01-02T12:00:00Z"}, maxAge: time.Hour, want: staleReasonExpired},
+		"unparseable created_at":    {env: arcane.Environment{CreatedAt: "last week"}, maxAge: time.Hour, want: ""},
+		"fractional second created": {env: arcane.Environment{CreatedAt: "2026-03-01T12:00:00.123456Z"}, maxAge: time.Hour, want: staleReasonMaxAge},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := environmentStaleReason(tc.env, tc.maxAge, now); got != tc.want {
+				t.Errorf("environmentStaleReason() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func testStaleEnvironmentsDataSourceConfig(url, extra string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url = %[1]q
+}
+
+data "arcane_stale_environments" "test" {
+  %[2]s
+}
+`, url, extra)
+}
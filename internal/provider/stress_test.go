@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+const (
+	stressEnvironments           = 5
+	stressProjectsPerEnvironment = 10
+)
+
+// TestProjectDeploymentResource_GivenManyDeployments_WhenAppliedInParallel_ThenAllSucceed
+// deploys and redeploys stressEnvironments*stressProjectsPerEnvironment
+// projects in one apply, which Terraform runs in parallel, to catch data
+// races in the client and the MockServer when run with -race. It also runs
+// the per-environment operation limit, project locks and retries under
+// contention.
+func TestProjectDeploymentResource_GivenManyDeployments_WhenAppliedInParallel_ThenAllSucceed(t *testing.T) {
+	if testing.Short() {
+		t.Skip("stress test skipped in short mode")
+	}
+
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	for e := range stressEnvironments {
+		envID := fmt.Sprintf("env-stress-%d", e)
+		mockServer.Environments[envID] = &arcane.Environment{ID: envID, Name: envID}
+		mockServer.HealthyEnvs[envID] = true
+		for p := range stressProjectsPerEnvironment {
+			projectID := fmt.Sprintf("proj-%d", p)
+			mockServer.AddProject(envID, &arcane.Project{ID: projectID, Name: projectID, Status: "stopped", EnvironmentID: envID})
+		}
+	}
+	// Rate limit some reads so that retries run concurrently too
+	mockServer.InjectFault(MockFault{
+		Method:     http.MethodGet,
+		Path:       "/api/environments/env-stress-0/projects/",
+		Status:     http.StatusTooManyRequests,
+		RetryAfter: "0",
+		Times:      3,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testStressConfig(mockServer.URL, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("arcane_project_deployment.stress.%d", stressEnvironments*stressProjectsPerEnvironment-1), "status", "running"),
+					mockServer.checkStressDeploys("/up"),
+				),
+			},
+			{
+				Config: testStressConfig(mockServer.URL, "v2"),
+				Check:  mockServer.checkStressDeploys("/redeploy"),
+			},
+		},
+	})
+}
+
+// checkStressDeploys returns a TestCheckFunc asserting that every stress
+// project is running and received exactly one POST request to action.
+func (ms *MockServer) checkStressDeploys(action string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		calls := map[string]int{}
+		for _, req := range ms.Requests() {
+			if req.Method == http.MethodPost && strings.HasSuffix(req.Path, action) {
+				calls[strings.TrimSuffix(req.Path, action)]++
+			}
+		}
+
+		ms.mu.Lock()
+		defer ms.mu.Unlock()
+		for e := range stressEnvironments {
+			envID := fmt.Sprintf("env-stress-%d", e)
+			for p := range stressProjectsPerEnvironment {
+				projectID := fmt.Sprintf("proj-%d", p)
+				if status := ms.Projects[envID][projectID].Status; status != "running" {
+					return fmt.Errorf("project %s/%s is %q, want running", envID, projectID, status)
+				}
+				projectPath := fmt.Sprintf("/api/environments/%s/projects/%s", envID, projectID)
+				if n := calls[projectPath]; n != 1 {
+					return fmt.Errorf("project %s/%s received %d POST %s requests, want 1", envID, projectID, n, action)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func testStressConfig(url, version string) string {
+	return fmt.Sprintf(`
+provider "arcane" {
+  url                                       = %[1]q
+  max_concurrent_operations_per_environment = 3
+  retry_wait_max                            = "1s"
+}
+
+resource "arcane_project_deployment" "stress" {
+  count          = %[2]d * %[3]d
+  environment_id = "env-stress-${floor(count.index / %[3]d)}"
+  project_id     = "proj-${count.index %% %[3]d}"
+
+  triggers = {
+    version = %[4]q
+  }
+}
+`, url, stressEnvironments, stressProjectsPerEnvironment, version)
+}
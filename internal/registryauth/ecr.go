@@ -0,0 +1,75 @@
+package registryauth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+)
+
+// ecrHostPattern matches private ECR registry hosts, capturing the region.
+var ecrHostPattern = regexp.MustCompile(`^\d{12}\.dkr\.ecr(?:-fips)?\.([a-z0-9-]+)\.amazonaws\.com(?:\.cn)?$`)
+
+// ecrHelper calls the ECR GetAuthorizationToken API with the AWS SDK's
+// default credential chain: environment variables, shared config and
+// credentials files (including SSO and assumed-role profiles), web identity
+// and container or instance roles. The API endpoint can be overridden with
+// AWS_ENDPOINT_URL_ECR, as with the AWS CLI.
+type ecrHelper struct {
+	// loadConfig loads the SDK configuration; config.LoadDefaultConfig
+	// outside tests.
+	loadConfig func(ctx context.Context, optFns ...func(*config.LoadOptions) error) (aws.Config, error)
+}
+
+func (h *ecrHelper) Token(ctx context.Context, registryURL string) (*Token, error) {
+	var opts []func(*config.LoadOptions) error
+	if region := ecrRegion(registryURL); region != "" {
+		opts = append(opts, config.WithRegion(region))
+	}
+	cfg, err := h.loadConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("loading AWS configuration: %w", err)
+	}
+	if cfg.Region == "" {
+		return nil, fmt.Errorf("%q is not an ECR registry URL (<account>.dkr.ecr.<region>.amazonaws.com); set AWS_REGION to use it with the ecr auth helper", registryURL)
+	}
+
+	out, err := ecr.NewFromConfig(cfg).GetAuthorizationToken(ctx, &ecr.GetAuthorizationTokenInput{})
+	if err != nil {
+		return nil, fmt.Errorf("ECR GetAuthorizationToken failed: %w", err)
+	}
+	if len(out.AuthorizationData) == 0 {
+		return nil, fmt.Errorf("ECR returned no authorization data")
+	}
+
+	data := out.AuthorizationData[0]
+	decoded, err := base64.StdEncoding.DecodeString(aws.ToString(data.AuthorizationToken))
+	if err != nil {
+		return nil, fmt.Errorf("decoding ECR authorization token: %w", err)
+	}
+	username, password, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return nil, fmt.Errorf("ECR authorization token is not username:password")
+	}
+
+	return &Token{
+		Username:  username,
+		Password:  password,
+		ExpiresAt: aws.ToTime(data.ExpiresAt).UTC(),
+	}, nil
+}
+
+// ecrRegion returns the region of an ECR registry URL, or "" for hosts that
+// don't include it (e.g. a custom domain in front of ECR), which use the
+// SDK's default region instead.
+func ecrRegion(registryURL string) string {
+	if m := ecrHostPattern.FindStringSubmatch(registryHost(registryURL)); m != nil {
+		return m[1]
+	}
+	return ""
+}
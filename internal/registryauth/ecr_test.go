@@ -0,0 +1,140 @@
+package registryauth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+)
+
+// testECRLoadConfig returns a loadConfig for ecrHelper that uses static
+// credentials and sends requests to endpoint, ignoring the shared config
+// files and instance metadata of the machine running the tests.
+func testECRLoadConfig(endpoint, region string) func(context.Context, ...func(*config.LoadOptions) error) (aws.Config, error) {
+	return func(ctx context.Context, optFns ...func(*config.LoadOptions) error) (aws.Config, error) {
+		opts := []func(*config.LoadOptions) error{
+			config.WithSharedConfigFiles([]string{}),
+			config.WithSharedCredentialsFiles([]string{}),
+			config.WithEC2IMDSClientEnableState(imds.ClientDisabled),
+			config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "secret", "session")),
+			config.WithBaseEndpoint(endpoint),
+			config.WithRegion(region),
+			config.WithRetryMaxAttempts(1),
+		}
+		return config.LoadDefaultConfig(ctx, append(opts, optFns...)...)
+	}
+}
+
+func TestECRRegion(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"https://123456789012.dkr.ecr.eu-west-1.amazonaws.com":      "eu-west-1",
+		"123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn/team":     "cn-north-1",
+		"123456789012.dkr.ecr-fips.us-gov-west-1.amazonaws.com":     "us-gov-west-1",
+		"https://registry.example.com":                              "",
+		"https://ghcr.io":                                           "",
+		"https://123456789012.dkr.ecr.eu-west-1.amazonaws.com.evil": "",
+	}
+	for in, want := range cases {
+		if got := ecrRegion(in); got != want {
+			t.Errorf("ecrRegion(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestECRHelper_Token(t *testing.T) {
+	t.Parallel()
+
+	var gotAuth, gotTarget, gotSession string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		gotTarget = r.Header.Get("X-Amz-Target")
+		gotSession = r.Header.Get("X-Amz-Security-Token")
+		_, _ = io.Copy(io.Discard, r.Body)
+		token := base64.StdEncoding.EncodeToString([]byte("AWS:ecr-password"))
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		fmt.Fprintf(w, `{"authorizationData":[{"authorizationToken":%q,"expiresAt":1.7e9,"proxyEndpoint":"https://123456789012.dkr.ecr.us-east-1.amazonaws.com"}]}`, token)
+	}))
+	defer srv.Close()
+
+	h := &ecrHelper{loadConfig: testECRLoadConfig(srv.URL, "")}
+
+	token, err := h.Token(context.Background(), "https://123456789012.dkr.ecr.us-east-1.amazonaws.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.Username != "AWS" || token.Password != "ecr-password" {
+		t.Errorf("got credentials %q/%q", token.Username, token.Password)
+	}
+	if want := time.Unix(1700000000, 0).UTC(); !token.ExpiresAt.Equal(want) {
+		t.Errorf("ExpiresAt = %s, want %s", token.ExpiresAt, want)
+	}
+	if !strings.HasPrefix(gotAuth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") ||
+		!strings.Contains(gotAuth, "/us-east-1/ecr/aws4_request") ||
+		!strings.Contains(gotAuth, "x-amz-security-token") {
+		t.Errorf("unexpected Authorization header %q", gotAuth)
+	}
+	if gotTarget != "AmazonEC2ContainerRegistry_V20150921.GetAuthorizationToken" || gotSession != "session" {
+		t.Errorf("unexpected target %q or session token %q", gotTarget, gotSession)
+	}
+}
+
+func TestECRHelper_Token_GivenCustomDomain_UsesDefaultRegion(t *testing.T) {
+	t.Parallel()
+
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		token := base64.StdEncoding.EncodeToString([]byte("AWS:ecr-password"))
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		fmt.Fprintf(w, `{"authorizationData":[{"authorizationToken":%q,"expiresAt":1.7e9}]}`, token)
+	}))
+	defer srv.Close()
+
+	h := &ecrHelper{loadConfig: testECRLoadConfig(srv.URL, "us-east-2")}
+	if _, err := h.Token(context.Background(), "https://registry.example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(gotAuth, "/us-east-2/ecr/aws4_request") {
+		t.Errorf("expected a request signed for us-east-2, got %q", gotAuth)
+	}
+}
+
+func TestECRHelper_Token_GivenNoRegion_ReturnsError(t *testing.T) {
+	t.Parallel()
+
+	h := &ecrHelper{loadConfig: testECRLoadConfig("http://localhost:1", "")}
+	_, err := h.Token(context.Background(), "https://registry.example.com")
+	if err == nil || !strings.Contains(err.Error(), "AWS_REGION") {
+		t.Errorf("expected an error asking for AWS_REGION, got %v", err)
+	}
+}
+
+func TestECRHelper_Token_GivenAPIError_ReturnsMessage(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"__type":"UnrecognizedClientException","message":"The security token included in the request is invalid."}`)
+	}))
+	defer srv.Close()
+
+	h := &ecrHelper{loadConfig: testECRLoadConfig(srv.URL, "")}
+
+	_, err := h.Token(context.Background(), "123456789012.dkr.ecr.us-east-1.amazonaws.com")
+	if err == nil || !strings.Contains(err.Error(), "UnrecognizedClientException") {
+		t.Errorf("expected API error, got %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package registryauth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/oauth2/google"
+)
+
+// gcrUsername is the username Container Registry and Artifact Registry accept
+// with an OAuth access token as password.
+const gcrUsername = "oauth2accesstoken"
+
+// gcrStaticTokenLifetime is assumed for tokens from GOOGLE_OAUTH_ACCESS_TOKEN,
+// whose expiry isn't known. Google access tokens last one hour by default.
+const gcrStaticTokenLifetime = time.Hour
+
+// gcrScope is the OAuth scope requested for registry access tokens.
+const gcrScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// gcrHelper uses the access token in GOOGLE_OAUTH_ACCESS_TOKEN (e.g. from
+// `gcloud auth print-access-token` in CI), or else Application Default
+// Credentials: GOOGLE_APPLICATION_CREDENTIALS (service account keys and
+// workload identity federation), the gcloud user credentials, or the GCE
+// metadata server, whose host can be overridden with GCE_METADATA_HOST.
+type gcrHelper struct {
+	getenv func(string) string
+	// findCredentials looks up Application Default Credentials;
+	// google.FindDefaultCredentials outside tests.
+	findCredentials func(ctx context.Context, scopes ...string) (*google.Credentials, error)
+	now             func() time.Time
+}
+
+func (h *gcrHelper) Token(ctx context.Context, registryURL string) (*Token, error) {
+	if token := h.getenv("GOOGLE_OAUTH_ACCESS_TOKEN"); token != "" {
+		return &Token{
+			Username:  gcrUsername,
+			Password:  token,
+			ExpiresAt: h.now().Add(gcrStaticTokenLifetime).UTC(),
+		}, nil
+	}
+
+	creds, err := h.findCredentials(ctx, gcrScope)
+	if err != nil {
+		return nil, fmt.Errorf("gcr auth helper requires GOOGLE_OAUTH_ACCESS_TOKEN or Application Default Credentials: %w", err)
+	}
+	token, err := creds.TokenSource.Token()
+	if err != nil {
+		return nil, fmt.Errorf("getting a Google access token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("google returned an empty access token")
+	}
+
+	expiresAt := token.Expiry
+	if expiresAt.IsZero() {
+		expiresAt = h.now().Add(gcrStaticTokenLifetime)
+	}
+	return &Token{
+		Username:  gcrUsername,
+		Password:  token.AccessToken,
+		ExpiresAt: expiresAt.UTC(),
+	}, nil
+}
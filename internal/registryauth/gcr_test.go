@@ -0,0 +1,117 @@
+package registryauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+func TestGCRHelper_Token(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("access token from environment", func(t *testing.T) {
+		t.Parallel()
+		h := &gcrHelper{
+			getenv: func(k string) string {
+				return map[string]string{"GOOGLE_OAUTH_ACCESS_TOKEN": "ya29.static"}[k]
+			},
+			findCredentials: func(context.Context, ...string) (*google.Credentials, error) {
+				return nil, errors.New("unexpected lookup of default credentials")
+			},
+			now: func() time.Time { return now },
+		}
+
+		token, err := h.Token(context.Background(), "https://europe-docker.pkg.dev")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if token.Username != "oauth2accesstoken" || token.Password != "ya29.static" || !token.ExpiresAt.Equal(now.Add(time.Hour)) {
+			t.Errorf("unexpected token %+v", token)
+		}
+	})
+
+	t.Run("application default credentials", func(t *testing.T) {
+		t.Parallel()
+		var gotScopes []string
+		h := &gcrHelper{
+			getenv: func(string) string { return "" },
+			findCredentials: func(_ context.Context, scopes ...string) (*google.Credentials, error) {
+				gotScopes = scopes
+				return &google.Credentials{TokenSource: oauth2.StaticTokenSource(&oauth2.Token{
+					AccessToken: "ya29.adc",
+					Expiry:      now.Add(45 * time.Minute),
+				})}, nil
+			},
+			now: func() time.Time { return now },
+		}
+
+		token, err := h.Token(context.Background(), "https://gcr.io")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if token.Password != "ya29.adc" || !token.ExpiresAt.Equal(now.Add(45*time.Minute)) {
+			t.Errorf("unexpected token %+v", token)
+		}
+		if len(gotScopes) != 1 || gotScopes[0] != gcrScope {
+			t.Errorf("scopes = %v, want [%s]", gotScopes, gcrScope)
+		}
+	})
+
+	t.Run("no credentials", func(t *testing.T) {
+		t.Parallel()
+		h := &gcrHelper{
+			getenv: func(string) string { return "" },
+			findCredentials: func(context.Context, ...string) (*google.Credentials, error) {
+				return nil, errors.New("could not find default credentials")
+			},
+			now: time.Now,
+		}
+
+		if _, err := h.Token(context.Background(), "https://gcr.io"); err == nil || !strings.Contains(err.Error(), "Application Default Credentials") {
+			t.Errorf("expected a missing credentials error, got %v", err)
+		}
+	})
+}
+
+// TestGCRHelper_Token_GivenMetadataServer_UsesDefaultServiceAccount validates
+// that Application Default Credentials fall back to the GCE metadata server.
+func TestGCRHelper_Token_GivenMetadataServer_UsesDefaultServiceAccount(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata-Flavor") != "Google" || !strings.HasSuffix(r.URL.Path, "/service-accounts/default/token") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"ya29.metadata","expires_in":3599,"token_type":"Bearer"}`)
+	}))
+	defer srv.Close()
+
+	t.Setenv("GCE_METADATA_HOST", strings.TrimPrefix(srv.URL, "http://"))
+	t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", "")
+	t.Setenv("CLOUDSDK_CONFIG", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	h := &gcrHelper{getenv: func(string) string { return "" }, findCredentials: google.FindDefaultCredentials, now: time.Now}
+
+	before := time.Now()
+	token, err := h.Token(context.Background(), "https://gcr.io")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.Username != "oauth2accesstoken" || token.Password != "ya29.metadata" {
+		t.Errorf("unexpected token %+v", token)
+	}
+	if token.ExpiresAt.Before(before.Add(50*time.Minute)) || token.ExpiresAt.After(time.Now().Add(time.Hour)) {
+		t.Errorf("ExpiresAt = %s, want about an hour from now", token.ExpiresAt)
+	}
+}
@@ -0,0 +1,57 @@
+// Package registryauth exchanges cloud credentials for short-lived container
+// registry credentials, like the docker credential helpers for ECR and GCR,
+// so that arcane_container_registry can keep Arcane supplied with a valid
+// token without a long-lived registry password.
+package registryauth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"golang.org/x/oauth2/google"
+)
+
+// Token is a registry username and password valid until ExpiresAt.
+type Token struct {
+	Username  string
+	Password  string
+	ExpiresAt time.Time
+}
+
+// Helper obtains registry credentials for a registry URL.
+type Helper interface {
+	Token(ctx context.Context, registryURL string) (*Token, error)
+}
+
+// Names lists the supported helpers.
+var Names = []string{"ecr", "gcr"}
+
+// New returns the helper called name, which finds cloud credentials the way
+// the cloud's own SDK and CLI do in the provider's process.
+func New(name string) (Helper, error) {
+	switch name {
+	case "ecr":
+		return &ecrHelper{loadConfig: config.LoadDefaultConfig}, nil
+	case "gcr":
+		return &gcrHelper{getenv: os.Getenv, findCredentials: google.FindDefaultCredentials, now: time.Now}, nil
+	default:
+		return nil, fmt.Errorf("unknown auth helper %q, expected one of %s", name, strings.Join(Names, ", "))
+	}
+}
+
+// registryHost returns the host of a registry URL, which may be given with
+// or without a scheme and path (e.g. "https://ghcr.io" or "ghcr.io/org").
+func registryHost(registryURL string) string {
+	host := registryURL
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+3:]
+	}
+	if i := strings.IndexByte(host, '/'); i >= 0 {
+		host = host[:i]
+	}
+	return strings.ToLower(host)
+}
@@ -0,0 +1,33 @@
+package registryauth
+
+import "testing"
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range Names {
+		if _, err := New(name); err != nil {
+			t.Errorf("New(%q): %v", name, err)
+		}
+	}
+	if _, err := New("acr"); err == nil {
+		t.Error("expected error for unknown helper")
+	}
+}
+
+func TestRegistryHost(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"https://ghcr.io":             "ghcr.io",
+		"ghcr.io/org/image":           "ghcr.io",
+		"https://GCR.io/v2/":          "gcr.io",
+		"registry.example.com:5000":   "registry.example.com:5000",
+		"http://localhost:5000/path/": "localhost:5000",
+	}
+	for in, want := range cases {
+		if got := registryHost(in); got != want {
+			t.Errorf("registryHost(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -6,6 +6,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
@@ -16,12 +20,20 @@ var (
 	version string = "dev"
 )
 
+// pprofAddrEnv names the environment variable holding the address of the
+// pprof endpoint served in debug mode, e.g. "localhost:6060".
+const pprofAddrEnv = "ARCANE_PPROF_ADDR"
+
 func main() {
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	if addr := os.Getenv(pprofAddrEnv); debug && addr != "" {
+		go servePprof(addr)
+	}
+
 	opts := providerserver.ServeOpts{
 		Address: "registry.terraform.io/darshan-rambhia/arcane",
 		Debug:   debug,
@@ -32,3 +44,23 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// servePprof serves the net/http/pprof handlers on addr, for profiling the
+// provider while Terraform runs against it in debug mode:
+//
+//	ARCANE_PPROF_ADDR=localhost:6060 terraform-provider-arcane -debug
+//	go tool pprof http://localhost:6060/debug/pprof/profile?seconds=30
+func servePprof(addr string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	log.Printf("Serving pprof on http://%s/debug/pprof/", addr)
+	srv := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	if err := srv.ListenAndServe(); err != nil {
+		log.Printf("pprof server stopped: %s", err)
+	}
+}
@@ -0,0 +1,37 @@
+package arcane
+
+import (
+	"context"
+	"fmt"
+)
+
+type apiKeyAliasKey struct{}
+
+// WithAPIKeyAlias returns a context whose requests authenticate with the key
+// registered under alias in Config.APIKeys instead of Config.APIKey. An
+// empty alias keeps the default key.
+func WithAPIKeyAlias(ctx context.Context, alias string) context.Context {
+	if alias == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, apiKeyAliasKey{}, alias)
+}
+
+// HasAPIKeyAlias reports whether alias is one of Config.APIKeys.
+func (c *Client) HasAPIKeyAlias(alias string) bool {
+	_, ok := c.apiKeys[alias]
+	return ok
+}
+
+// apiKey returns the API key for requests made with ctx.
+func (c *Client) apiKey(ctx context.Context) (string, error) {
+	alias, ok := ctx.Value(apiKeyAliasKey{}).(string)
+	if !ok {
+		return c.APIKey, nil
+	}
+	key, ok := c.apiKeys[alias]
+	if !ok {
+		return "", fmt.Errorf("unknown API key alias %q, it must be one of the provider's api_keys", alias)
+	}
+	return key, nil
+}
@@ -0,0 +1,55 @@
+package arcane
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// ─── API key aliases ──────────────────────────────────────────────────────────
+
+func TestDo_GivenAPIKeyAlias_SendsAliasedKey(t *testing.T) {
+	t.Parallel()
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("X-API-Key"))
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c, err := New(Config{URL: srv.URL, APIKey: "read-key", APIKeys: map[string]string{"deploy": "write-key"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctxs := []context.Context{
+		context.Background(),
+		WithAPIKeyAlias(context.Background(), "deploy"),
+		WithAPIKeyAlias(context.Background(), ""),
+	}
+	for _, ctx := range ctxs {
+		if err := c.Do(ctx, &Request{Method: http.MethodGet, Path: "/api/environments"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	want := []string{"read-key", "write-key", "read-key"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("keys = %v, want %v", got, want)
+	}
+}
+
+func TestDo_GivenUnknownAPIKeyAlias_ReturnsErrorWithoutRequest(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	err := c.Do(WithAPIKeyAlias(context.Background(), "deploy"), &Request{Method: http.MethodGet, Path: "/api/environments"})
+	if err == nil || !strings.Contains(err.Error(), `unknown API key alias "deploy"`) {
+		t.Errorf("expected an unknown alias error, got %v", err)
+	}
+}
@@ -0,0 +1,119 @@
+package arcane
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// ─── Benchmarks ───────────────────────────────────────────────────────────────
+//
+// Run with: go test -run '^$' -bench . -benchmem ./pkg/arcane/
+
+// newBenchmarkClient returns a client for srv, failing b on error.
+func newBenchmarkClient(b *testing.B, srv *httptest.Server) *Client {
+	b.Helper()
+	c, err := New(Config{URL: srv.URL, APIKey: "bench-key"})
+	if err != nil {
+		b.Fatalf("New() error: %v", err)
+	}
+	return c
+}
+
+func BenchmarkClient_Do(b *testing.B) {
+	body, _ := json.Marshal(SingleResponse[Environment]{
+		Success: true,
+		Data:    Environment{ID: "env-1", Name: "production", APIURL: "http://agent:3552", Description: "Production environment"},
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body)
+	}))
+	defer srv.Close()
+	c := newBenchmarkClient(b, srv)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := c.GetEnvironment(ctx, "env-1"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkClient_ListPagination(b *testing.B) {
+	const total, perPage = 500, 50
+	pages := make([][]byte, total/perPage)
+	for i := range pages {
+		projects := make([]Project, perPage)
+		for j := range projects {
+			n := i*perPage + j
+			projects[j] = Project{ID: fmt.Sprintf("proj-%d", n), Name: fmt.Sprintf("project-%d", n), Status: "running"}
+		}
+		pages[i], _ = json.Marshal(PaginatedResponse[Project]{
+			Success:    true,
+			Data:       projects,
+			Pagination: Pagination{TotalPages: len(pages), TotalItems: total, CurrentPage: i + 1, ItemsPerPage: perPage},
+		})
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+		w.Write(pages[start/perPage])
+	}))
+	defer srv.Close()
+	ec := newBenchmarkClient(b, srv).ForEnvironment("env-1")
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		projects, err := ec.ListProjects(ctx)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(projects) != total {
+			b.Fatalf("got %d projects, want %d", len(projects), total)
+		}
+	}
+}
+
+func BenchmarkEnvironmentClient_GetContainerByName(b *testing.B) {
+	const projects, containersPerProject = 20, 10
+	projectList := make([]Project, projects)
+	containers := map[string][]byte{}
+	for i := range projectList {
+		projectList[i] = Project{ID: fmt.Sprintf("proj-%d", i), Name: fmt.Sprintf("project-%d", i)}
+		list := make([]ContainerDetail, containersPerProject)
+		for j := range list {
+			list[j] = ContainerDetail{ID: fmt.Sprintf("ctr-%d-%d", i, j), Name: fmt.Sprintf("project-%d-web-%d", i, j), Status: "running"}
+		}
+		containers[projectList[i].ID], _ = json.Marshal(PaginatedResponse[ContainerDetail]{Success: true, Data: list})
+	}
+	projectBody, _ := json.Marshal(PaginatedResponse[Project]{Success: true, Data: projectList})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rest, ok := strings.CutPrefix(r.URL.Path, "/api/environments/env-1/projects")
+		switch {
+		case ok && rest == "":
+			w.Write(projectBody)
+		case ok && strings.HasSuffix(rest, "/containers"):
+			w.Write(containers[strings.TrimSuffix(strings.TrimPrefix(rest, "/"), "/containers")])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	ec := newBenchmarkClient(b, srv).ForEnvironment("env-1")
+	ctx := context.Background()
+	// The last container of the last project, the worst case of the scan
+	name := fmt.Sprintf("project-%d-web-%d", projects-1, containersPerProject-1)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := ec.GetContainerByName(ctx, name); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
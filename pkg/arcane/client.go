@@ -0,0 +1,1244 @@
+package arcane
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"golang.org/x/time/rate"
+)
+
+// Client is the Arcane API client.
+//
+// A Client is safe for concurrent use by multiple goroutines, as are the
+// EnvironmentClients ForEnvironment returns, which only add an environment ID
+// to it: Terraform runs resource and data source operations in parallel and
+// they all share the provider's Client. State kept between requests, such as
+// the session token, ETag cache, project locks and detected server version,
+// is guarded by a mutex or updated atomically, and New copies the maps, slices
+// and keys of Config so that callers may reuse it. The exported fields must
+// not be changed once the Client is in use.
+type Client struct {
+	BaseURL    string
+	APIKey     string
+	HTTPClient *http.Client
+
+	projectLocks      keyedSemaphore
+	environmentOps    *keyedSemaphore
+	logger            Logger
+	keepalive         *keepalive
+	session           *session
+	signer            *requestSigner
+	serverVersion     atomic.Pointer[version.Version] // see routePath
+	etags             etagCache
+	apiKeys           map[string]string
+	maxClockSkew      time.Duration
+	clockSkew         atomic.Int64 // see LastClockSkew
+	clockSkewMeasured atomic.Bool
+	clockSkewWarned   atomic.Bool
+	retry             retryPolicy
+	limiter           *rate.Limiter
+	stats             statsCollector
+}
+
+// Config holds the client configuration.
+type Config struct {
+	URL    string
+	APIKey string
+	// MaxConcurrentOperationsPerEnvironment limits how many deploy, redeploy
+	// and stop calls may run at once against a single environment. Zero means
+	// unlimited.
+	MaxConcurrentOperationsPerEnvironment int
+	// Logger, if set, receives the client's log entries. By default they are
+	// discarded.
+	Logger Logger
+	// KeepaliveInterval, when positive, pings the manager at this interval
+	// while requests are in flight and fails them with an UnreachableError as
+	// soon as a ping fails, instead of waiting for the request timeout.
+	KeepaliveInterval time.Duration
+	// Login, if set, enables session authentication: requests carry the
+	// token it returns as a bearer token, and a request rejected with 401 is
+	// retried once with a new token.
+	Login LoginFunc
+	// Username and Password, if set, enable session authentication with a
+	// Login that logs in to Arcane with them. They can't be combined with
+	// Login.
+	Username string
+	Password string
+	// APIKeys maps aliases to additional API keys, selected per request with
+	// WithAPIKeyAlias.
+	APIKeys map[string]string
+	// RequestSigning, if set, adds an HMAC signature to every request.
+	RequestSigning *RequestSigning
+	// MaxClockSkew, when positive, is the largest difference between the
+	// local clock and the Date header of Arcane's responses tolerated before
+	// a WarningClockSkew warning is added, once per client.
+	MaxClockSkew time.Duration
+	// RetryMax is the number of times a request failing with a transient
+	// error is sent again: a 429 response for any request, and a 502, 503 or
+	// 504 response or a network error for GET, HEAD, OPTIONS, PUT and DELETE
+	// requests. Zero disables retries.
+	RetryMax int
+	// RetryWaitMin is the wait before the first retry, doubled for every
+	// further retry and randomized. Defaults to DefaultRetryWaitMin.
+	RetryWaitMin time.Duration
+	// RetryWaitMax caps the wait between retries, including waits requested
+	// by a Retry-After header. Defaults to DefaultRetryWaitMax.
+	RetryWaitMax time.Duration
+	// TLS, if set, configures the verification of Arcane's certificate and
+	// the client certificate presented to it.
+	TLS *TLSConfig
+	// RequestsPerSecond, when positive, limits the rate of requests sent by
+	// the client, across all goroutines, so that parallel resources don't
+	// overwhelm the manager. Retries count against the limit. Zero means
+	// unlimited.
+	RequestsPerSecond float64
+	// Burst is the number of requests that may be sent at once before
+	// RequestsPerSecond applies. Defaults to RequestsPerSecond rounded up.
+	Burst int
+	// FollowRedirects makes requests follow redirects. By default a redirect
+	// fails the request with a RedirectError, since the API never redirects
+	// and a proxy redirecting to a login page would otherwise surface as an
+	// unparseable response. A redirect to an HTML page fails either way.
+	FollowRedirects bool
+}
+
+// New creates a new Arcane API client.
+func New(cfg Config) (*Client, error) {
+	baseURL := strings.TrimSuffix(cfg.URL, "/")
+	if baseURL == "" {
+		return nil, fmt.Errorf("arcane URL is required")
+	}
+
+	if cfg.MaxConcurrentOperationsPerEnvironment < 0 {
+		return nil, fmt.Errorf("max concurrent operations per environment must not be negative, got %d", cfg.MaxConcurrentOperationsPerEnvironment)
+	}
+
+	c := &Client{
+		BaseURL: baseURL,
+		APIKey:  cfg.APIKey,
+		HTTPClient: &http.Client{
+			Timeout: 120 * time.Second,
+		},
+		logger:       cfg.Logger,
+		apiKeys:      maps.Clone(cfg.APIKeys),
+		maxClockSkew: cfg.MaxClockSkew,
+	}
+	if !cfg.FollowRedirects {
+		c.HTTPClient.CheckRedirect = noRedirects
+	}
+	if cfg.TLS != nil {
+		transport, err := newTransport(*cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+		c.HTTPClient.Transport = transport
+	}
+	if cfg.RequestSigning != nil {
+		signer, err := newRequestSigner(*cfg.RequestSigning)
+		if err != nil {
+			return nil, err
+		}
+		c.signer = signer
+	}
+	if (cfg.Username == "") != (cfg.Password == "") {
+		return nil, fmt.Errorf("username and password must be set together")
+	}
+	if cfg.Login != nil && cfg.Username != "" {
+		return nil, fmt.Errorf("login and username/password can't be combined")
+	}
+	if cfg.Login != nil {
+		c.session = &session{login: cfg.Login}
+	}
+	if cfg.Username != "" {
+		c.session = &session{login: c.passwordLogin(cfg.Username, cfg.Password)}
+	}
+	if cfg.MaxConcurrentOperationsPerEnvironment > 0 {
+		c.environmentOps = &keyedSemaphore{size: cfg.MaxConcurrentOperationsPerEnvironment}
+	}
+	if cfg.KeepaliveInterval < 0 {
+		return nil, fmt.Errorf("keepalive interval must not be negative, got %s", cfg.KeepaliveInterval)
+	}
+	if cfg.KeepaliveInterval > 0 {
+		c.keepalive = newKeepalive(c, cfg.KeepaliveInterval)
+	}
+	if cfg.MaxClockSkew < 0 {
+		return nil, fmt.Errorf("max clock skew must not be negative, got %s", cfg.MaxClockSkew)
+	}
+	if cfg.RetryMax < 0 || cfg.RetryWaitMin < 0 || cfg.RetryWaitMax < 0 {
+		return nil, fmt.Errorf("retry settings must not be negative, got %d retries waiting %s to %s", cfg.RetryMax, cfg.RetryWaitMin, cfg.RetryWaitMax)
+	}
+	c.retry = retryPolicy{max: cfg.RetryMax, waitMin: cfg.RetryWaitMin, waitMax: cfg.RetryWaitMax}
+	if c.retry.waitMin == 0 {
+		c.retry.waitMin = DefaultRetryWaitMin
+	}
+	if c.retry.waitMax == 0 {
+		c.retry.waitMax = DefaultRetryWaitMax
+	}
+	if c.retry.waitMin > c.retry.waitMax {
+		return nil, fmt.Errorf("retry wait min %s must not exceed retry wait max %s", c.retry.waitMin, c.retry.waitMax)
+	}
+	limiter, err := newRateLimiter(cfg.RequestsPerSecond, cfg.Burst)
+	if err != nil {
+		return nil, err
+	}
+	c.limiter = limiter
+	return c, nil
+}
+
+// Request represents an API request.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   interface{}
+	Result interface{}
+	// Output, if set, receives the raw response body of a successful request
+	// instead of it being decoded into Result. Use it for binary downloads.
+	Output io.Writer
+	// ResponseHeader, if set, receives the headers of the response.
+	ResponseHeader *http.Header
+}
+
+// Do executes an API request.
+func (c *Client) Do(ctx context.Context, req *Request) error {
+	p := c.routePath(ctx, req.Path)
+	err := c.do(ctx, req, p)
+	// The first requests to an older server go to current paths, since its
+	// version is only known from its responses; retry those it didn't find.
+	if IsNotFound(err) {
+		if legacy := c.routePath(ctx, req.Path); legacy != p {
+			return c.do(ctx, req, legacy)
+		}
+	}
+	return err
+}
+
+// do executes req against path p.
+func (c *Client) do(ctx context.Context, req *Request, p string) error {
+	// Build URL
+	fullURL := c.BaseURL + p
+	if len(req.Query) > 0 {
+		fullURL += "?" + req.Query.Encode()
+	}
+
+	// Build request body
+	var bodyBytes []byte
+	if req.Body != nil {
+		var err error
+		bodyBytes, err = json.Marshal(req.Body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+	}
+
+	// Fail fast while the manager is known to be unreachable
+	if c.keepalive != nil {
+		if err := c.keepalive.check(); err != nil {
+			return err
+		}
+		var done func()
+		ctx, done = c.keepalive.track(ctx)
+		defer done()
+	}
+
+	apiKey, err := c.apiKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Revalidate cached GET responses instead of downloading them again
+	var cached etagEntry
+	cacheable := req.Method == http.MethodGet && req.Output == nil
+	if cacheable {
+		cached, _ = c.etags.get(apiKey, fullURL)
+	}
+
+	// Execute request, building it afresh for each retry
+	requestStart := time.Now()
+	resp, err := c.sendWithRetry(ctx, req.Method, bodyBytes, func() (*http.Request, error) {
+		var bodyReader io.Reader
+		if bodyBytes != nil {
+			bodyReader = bytes.NewReader(bodyBytes)
+		}
+		httpReq, err := http.NewRequestWithContext(ctx, req.Method, fullURL, bodyReader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		httpReq.Header.Set("Content-Type", "application/json")
+		httpReq.Header.Set("Accept", "application/json")
+		if apiKey != "" {
+			httpReq.Header.Set("X-API-Key", apiKey)
+		}
+		if cached.etag != "" {
+			httpReq.Header.Set("If-None-Match", cached.etag)
+		}
+		return httpReq, nil
+	})
+	if err != nil {
+		if cause := unreachableCause(ctx); cause != nil {
+			return cause
+		}
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := redirectError(resp, fullURL); err != nil {
+		return err
+	}
+
+	info := serverInfoFromHeader(resp.Header)
+	c.logServerInfo(ctx, info)
+	c.observeServerVersion(info)
+	c.observeClockSkew(ctx, requestStart, time.Now(), info.Date)
+	if req.ResponseHeader != nil {
+		*req.ResponseHeader = resp.Header.Clone()
+	}
+
+	// Stream successful downloads straight to the caller
+	if req.Output != nil && resp.StatusCode < 400 {
+		if _, err := io.Copy(req.Output, resp.Body); err != nil {
+			if cause := unreachableCause(ctx); cause != nil {
+				return cause
+			}
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+		return nil
+	}
+
+	// Read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		if cause := unreachableCause(ctx); cause != nil {
+			return cause
+		}
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if cacheable {
+		switch {
+		case resp.StatusCode == http.StatusNotModified && cached.etag != "":
+			respBody = cached.body
+			c.stats.cached.Add(1)
+		case resp.StatusCode == http.StatusOK:
+			c.etags.put(apiKey, fullURL, resp.Header.Get("ETag"), respBody)
+		}
+	}
+
+	c.logResponseBody(ctx, req.Method, p, resp.StatusCode, respBody)
+	collectWarnings(ctx, respBody)
+
+	// Check for errors
+	if resp.StatusCode >= 400 {
+		var apiErr APIError
+		if err := json.Unmarshal(respBody, &apiErr); err != nil {
+			return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+		}
+		apiErr.StatusCode = resp.StatusCode
+		apiErr.RequestID = resp.Header.Get("X-Request-Id")
+		return &apiErr
+	}
+
+	// Parse response
+	if req.Result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, req.Result); err != nil {
+			return fmt.Errorf("failed to parse response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// send executes an HTTP request with the given body built by newReq, adding
+// session auth and signature headers. With session auth, a 401 response is
+// retried once after refreshing the token, since it usually means the token
+// expired during a long apply.
+func (c *Client) send(ctx context.Context, body []byte, newReq func() (*http.Request, error)) (*http.Response, error) {
+	var token string
+	for attempt := 0; ; attempt++ {
+		// Wait before logging in and signing, so that neither the session
+		// token nor the signature timestamp is spent waiting for the limit.
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return nil, err
+		}
+		httpReq, err := newReq()
+		if err != nil {
+			return nil, err
+		}
+		if c.session != nil {
+			if attempt == 0 {
+				token, err = c.session.get(ctx)
+			} else {
+				token, err = c.session.refresh(ctx, token)
+			}
+			if err != nil {
+				return nil, err
+			}
+			httpReq.Header.Set("Authorization", "Bearer "+token)
+		}
+		if c.signer != nil {
+			c.signer.sign(httpReq, body, time.Now())
+		}
+
+		start := time.Now()
+		resp, err := c.HTTPClient.Do(httpReq)
+		elapsed := time.Since(start)
+		c.stats.request(httpReq.Method, elapsed)
+		c.logRequest(ctx, httpReq, body, resp, err, elapsed)
+		if err != nil || resp.StatusCode != http.StatusUnauthorized || c.session == nil || attempt > 0 {
+			return resp, err
+		}
+		_ = resp.Body.Close()
+	}
+}
+
+// APIError represents an API error response.
+type APIError struct {
+	StatusCode int    `json:"-"`
+	Message    string `json:"message"`
+	Detail     string `json:"detail"`
+	// RequestID is the X-Request-Id response header, if Arcane sent one.
+	// It identifies the request in the Arcane server logs.
+	RequestID string `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	if e.Detail != "" {
+		return fmt.Sprintf("API error (status %d): %s - %s", e.StatusCode, e.Message, e.Detail)
+	}
+	if e.Message != "" {
+		return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("API error (status %d)", e.StatusCode)
+}
+
+// IsNotFound returns true if the error is a 404 Not Found.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == 404
+	}
+	return false
+}
+
+// IsConflict returns true if the error is a 409 Conflict, which Arcane returns
+// when an operation (e.g. a deployment) is already in progress for the target.
+func IsConflict(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusConflict
+	}
+	return false
+}
+
+// IsTransient returns true if the error is likely temporary: a reset or
+// refused connection, an unexpected EOF, a network timeout, or a 502/503/504
+// returned by a proxy while the manager or agent restarts. Other network
+// errors, such as TLS certificate verification failures, unknown hosts or
+// unsupported URL schemes, point at a misconfiguration and are not transient.
+// Context cancellation and UnreachableError, which already reflect waiting for
+// the manager, are never considered transient either.
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || IsUnreachable(err) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// esc escapes a string for safe inclusion in URL path segments.
+func esc(s string) string {
+	return url.PathEscape(s)
+}
+
+// PaginatedResponse wraps paginated API responses.
+type PaginatedResponse[T any] struct {
+	Success    bool       `json:"success"`
+	Data       []T        `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// Pagination contains pagination metadata.
+type Pagination struct {
+	TotalPages   int `json:"totalPages"`
+	TotalItems   int `json:"totalItems"`
+	CurrentPage  int `json:"currentPage"`
+	ItemsPerPage int `json:"itemsPerPage"`
+}
+
+// SingleResponse wraps single-item API responses.
+type SingleResponse[T any] struct {
+	Success bool `json:"success"`
+	Data    T    `json:"data"`
+}
+
+// EnvironmentClient provides environment-scoped operations.
+type EnvironmentClient struct {
+	client        *Client
+	environmentID string
+}
+
+// ForEnvironment returns a client scoped to a specific environment. It is
+// cheap to create and shares all state with c, so it may be created per call.
+func (c *Client) ForEnvironment(envID string) *EnvironmentClient {
+	return &EnvironmentClient{
+		client:        c,
+		environmentID: envID,
+	}
+}
+
+// Environment represents an Arcane environment.
+type Environment struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	APIURL      string `json:"apiUrl,omitempty"`
+	Description string `json:"description,omitempty"`
+	UseAPIKey   bool   `json:"use_api_key"`
+	AccessToken string `json:"access_token,omitempty"`
+	APIKey      string `json:"apiKey,omitempty"` // Returned when regenerating API key
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+	// ExpiresAt is when the environment is due for cleanup, as an RFC 3339
+	// timestamp. Empty when none is set or Arcane doesn't store expiries.
+	ExpiresAt string `json:"expiresAt,omitempty"`
+}
+
+// EnvironmentCreateRequest represents a request to create an environment.
+type EnvironmentCreateRequest struct {
+	Name        string `json:"name"`
+	APIURL      string `json:"apiUrl"`
+	Description string `json:"description,omitempty"`
+	UseAPIKey   bool   `json:"use_api_key,omitempty"`
+	ExpiresAt   string `json:"expiresAt,omitempty"`
+}
+
+// EnvironmentUpdateRequest represents a request to update an environment.
+type EnvironmentUpdateRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	UseAPIKey   *bool  `json:"use_api_key,omitempty"`
+	// ExpiresAt changes the expiry when set; an empty string clears it
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+}
+
+// ListEnvironments returns all environments.
+func (c *Client) ListEnvironments(ctx context.Context) ([]Environment, error) {
+	return getList[Environment](ctx, c, "/api/environments")
+}
+
+// GetEnvironment returns an environment by ID.
+func (c *Client) GetEnvironment(ctx context.Context, id string) (*Environment, error) {
+	return getSingle[Environment](ctx, c, "/api/environments/"+esc(id))
+}
+
+// GetEnvironmentByName returns an environment by name.
+func (c *Client) GetEnvironmentByName(ctx context.Context, name string) (*Environment, error) {
+	envs, err := c.ListEnvironments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, env := range envs {
+		if env.Name == name {
+			return &env, nil
+		}
+	}
+	return nil, &APIError{StatusCode: 404, Message: "environment not found"}
+}
+
+// CreateEnvironment creates a new environment.
+func (c *Client) CreateEnvironment(ctx context.Context, req *EnvironmentCreateRequest) (*Environment, error) {
+	return postSingle[Environment](ctx, c, "/api/environments", req)
+}
+
+// UpdateEnvironment updates an environment.
+func (c *Client) UpdateEnvironment(ctx context.Context, id string, req *EnvironmentUpdateRequest) (*Environment, error) {
+	return putSingle[Environment](ctx, c, "/api/environments/"+esc(id), req)
+}
+
+// DeleteEnvironment deletes an environment.
+func (c *Client) DeleteEnvironment(ctx context.Context, id string) error {
+	return c.Do(ctx, &Request{
+		Method: http.MethodDelete,
+		Path:   "/api/environments/" + esc(id),
+	})
+}
+
+// RegenerateEnvironmentAPIKey regenerates the API key for an environment.
+// This returns a new API key with the arc_ prefix that agents use for authentication.
+func (c *Client) RegenerateEnvironmentAPIKey(ctx context.Context, id string) (*Environment, error) {
+	return putSingle[Environment](ctx, c, "/api/environments/"+esc(id), map[string]bool{"regenerateApiKey": true})
+}
+
+// EnvironmentTokenValidation is the result of validating an access token
+// against an environment.
+type EnvironmentTokenValidation struct {
+	Valid bool `json:"valid"`
+	// ExpiresAt is when the token expires, as an RFC 3339 timestamp. Empty
+	// when the token is invalid or does not expire.
+	ExpiresAt string `json:"expiresAt,omitempty"`
+}
+
+// EnvironmentTokenValidateRequest represents a request to validate an access
+// token.
+type EnvironmentTokenValidateRequest struct {
+	Token string `json:"token"`
+}
+
+// ValidateEnvironmentToken checks whether token authenticates agents of the
+// environment, without storing or rotating it.
+func (c *Client) ValidateEnvironmentToken(ctx context.Context, id, token string) (*EnvironmentTokenValidation, error) {
+	return postSingle[EnvironmentTokenValidation](ctx, c, "/api/environments/"+esc(id)+"/token/validate", EnvironmentTokenValidateRequest{Token: token})
+}
+
+// Project represents an Arcane project (docker compose stack).
+type Project struct {
+	ID            string            `json:"id"`
+	Name          string            `json:"name"`
+	Status        string            `json:"status"`
+	Path          string            `json:"path,omitempty"`
+	Services      []ProjectService  `json:"services,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+	EnvironmentID string            `json:"environment_id,omitempty"`
+	// ComposeContent is the project's compose file, when the server includes
+	// it
+	ComposeContent string `json:"composeContent,omitempty"`
+	// GitOpsSyncID is the ID of the GitOps sync that created the project,
+	// when the server reports it
+	GitOpsSyncID string `json:"gitops_sync_id,omitempty"`
+}
+
+// ProjectService represents a service within a project.
+type ProjectService struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Image  string `json:"image,omitempty"`
+}
+
+// ListProjects returns all projects in an environment.
+func (ec *EnvironmentClient) ListProjects(ctx context.Context) ([]Project, error) {
+	return getList[Project](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/projects")
+}
+
+// GetProject returns a project by ID.
+func (ec *EnvironmentClient) GetProject(ctx context.Context, projectID string) (*Project, error) {
+	return getSingle[Project](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/projects/"+esc(projectID))
+}
+
+// GetProjectByName returns a project by name.
+func (ec *EnvironmentClient) GetProjectByName(ctx context.Context, name string) (*Project, error) {
+	projects, err := ec.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		if p.Name == name {
+			return &p, nil
+		}
+	}
+	return nil, &APIError{StatusCode: 404, Message: "project not found"}
+}
+
+// ProjectCreateRequest represents a request to create a project.
+type ProjectCreateRequest struct {
+	Name           string `json:"name"`
+	ComposeContent string `json:"composeContent"`
+	// EnvContent is the content of the project's .env file
+	EnvContent string `json:"envContent,omitempty"`
+}
+
+// ProjectUpdateRequest represents a request to update a project. Only the
+// fields that are set are changed.
+type ProjectUpdateRequest struct {
+	Name           string `json:"name,omitempty"`
+	ComposeContent string `json:"composeContent,omitempty"`
+	// EnvContent replaces the .env file; an empty string empties it
+	EnvContent *string `json:"envContent,omitempty"`
+	// Labels replaces all labels of the project; an empty map removes them
+	Labels *map[string]string `json:"labels,omitempty"`
+}
+
+// CreateProject creates a project from compose content. It does not deploy
+// the project.
+func (ec *EnvironmentClient) CreateProject(ctx context.Context, req *ProjectCreateRequest) (*Project, error) {
+	return postSingle[Project](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/projects", req)
+}
+
+// UpdateProject updates a project's compose content or metadata. It does not
+// redeploy the project.
+func (ec *EnvironmentClient) UpdateProject(ctx context.Context, projectID string, req *ProjectUpdateRequest) (*Project, error) {
+	return putSingle[Project](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/projects/"+esc(projectID), req)
+}
+
+// DeleteProject deletes a project and its directory. Running containers of
+// the project should be stopped first.
+func (ec *EnvironmentClient) DeleteProject(ctx context.Context, projectID string) error {
+	return ec.client.Do(ctx, &Request{
+		Method: http.MethodDelete,
+		Path:   "/api/environments/" + esc(ec.environmentID) + "/projects/" + esc(projectID),
+	})
+}
+
+// Project archive formats accepted by GetProjectArchive.
+const (
+	ProjectArchiveTarGz = "tar.gz"
+	ProjectArchiveZip   = "zip"
+)
+
+// GetProjectArchive streams an archive of the project directory (compose
+// file, .env and override files) to w. format is ProjectArchiveTarGz or
+// ProjectArchiveZip. The download stops when ctx is done; w may then hold a
+// partial archive.
+func (ec *EnvironmentClient) GetProjectArchive(ctx context.Context, projectID, format string, w io.Writer) error {
+	return ec.client.Do(ctx, &Request{
+		Method: http.MethodGet,
+		Path:   "/api/environments/" + esc(ec.environmentID) + "/projects/" + esc(projectID) + "/archive",
+		Query:  url.Values{"format": {format}},
+		Output: w,
+	})
+}
+
+// ProjectDeployRequest represents a request to deploy a project.
+// Matches Arcane v1.16+ ProjectDeployOptions schema.
+type ProjectDeployRequest struct {
+	// Pull policy for images (e.g. "always", "missing", "never")
+	PullPolicy string `json:"pullPolicy,omitempty"`
+	// Force recreate containers even if configuration hasn't changed
+	ForceRecreate bool `json:"forceRecreate,omitempty"`
+	// Additional compose files layered over the project's compose file in
+	// order, like repeated `docker compose -f` flags
+	OverrideFiles []ComposeOverrideFile `json:"overrideFiles,omitempty"`
+	// Build images of services with a build section before starting them
+	Build bool `json:"build,omitempty"`
+	// Build without the image build cache; only used with Build
+	NoCache bool `json:"noCache,omitempty"`
+	// Only deploy these services, like `docker compose up <service>...`;
+	// all services when empty
+	Services []string `json:"services,omitempty"`
+}
+
+// ComposeOverrideFile is a compose file layered over a project's compose file.
+// Exactly one of Path (relative to the project directory) or Content is set.
+type ComposeOverrideFile struct {
+	Path    string `json:"path,omitempty"`
+	Content string `json:"content,omitempty"`
+}
+
+// DeployProject deploys (starts) a project. Arcane versions that deploy
+// asynchronously return the ID of the deployment job, which can be awaited
+// with WaitForJob; it is empty when the deploy completed in the request.
+func (ec *EnvironmentClient) DeployProject(ctx context.Context, projectID string, req *ProjectDeployRequest) (string, error) {
+	if req == nil {
+		req = &ProjectDeployRequest{}
+	}
+	release, err := ec.acquireOperationSlot(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	result, err := postSingle[jobStarted](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/projects/"+esc(projectID)+"/up", req)
+	if err != nil {
+		return "", err
+	}
+	return result.JobID, nil
+}
+
+// RedeployProject redeploys a project. Like DeployProject, it returns the ID
+// of the deployment job, if any.
+func (ec *EnvironmentClient) RedeployProject(ctx context.Context, projectID string, req *ProjectDeployRequest) (string, error) {
+	if req == nil {
+		req = &ProjectDeployRequest{}
+	}
+	release, err := ec.acquireOperationSlot(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	result, err := postSingle[jobStarted](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/projects/"+esc(projectID)+"/redeploy", req)
+	if err != nil {
+		return "", err
+	}
+	return result.JobID, nil
+}
+
+// StopProject stops a project.
+func (ec *EnvironmentClient) StopProject(ctx context.Context, projectID string) error {
+	release, err := ec.acquireOperationSlot(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return ec.client.Do(ctx, &Request{
+		Method: http.MethodPost,
+		Path:   "/api/environments/" + esc(ec.environmentID) + "/projects/" + esc(projectID) + "/down",
+	})
+}
+
+// ComposeValidateRequest represents a request to validate compose content.
+type ComposeValidateRequest struct {
+	ComposeContent string `json:"composeContent"`
+	EnvContent     string `json:"envContent,omitempty"`
+}
+
+// ComposeValidationResult is the outcome of validating compose content.
+type ComposeValidationResult struct {
+	Valid    bool     `json:"valid"`
+	Errors   []string `json:"errors,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ValidateCompose asks the environment to validate (lint) compose content
+// without creating or deploying anything.
+func (ec *EnvironmentClient) ValidateCompose(ctx context.Context, req *ComposeValidateRequest) (*ComposeValidationResult, error) {
+	return postSingle[ComposeValidationResult](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/projects/validate", req)
+}
+
+// ComposeRenderRequest represents a request to interpolate compose content.
+// Variables take precedence over values from EnvContent, as shell variables
+// do over a .env file in Docker Compose.
+type ComposeRenderRequest struct {
+	ComposeContent string            `json:"composeContent"`
+	EnvContent     string            `json:"envContent,omitempty"`
+	Variables      map[string]string `json:"variables,omitempty"`
+}
+
+// ComposeRenderResult is compose content with every variable interpolated.
+type ComposeRenderResult struct {
+	Content string `json:"content"`
+}
+
+// RenderCompose asks the environment to interpolate compose content the way
+// a deployment would, without creating or deploying anything.
+func (ec *EnvironmentClient) RenderCompose(ctx context.Context, req *ComposeRenderRequest) (*ComposeRenderResult, error) {
+	return postSingle[ComposeRenderResult](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/projects/render", req)
+}
+
+// ContainerDetail represents detailed container runtime information.
+type ContainerDetail struct {
+	ID     string            `json:"id"`
+	Name   string            `json:"name"`
+	Image  string            `json:"image,omitempty"`
+	Status string            `json:"status"`
+	Health string            `json:"health,omitempty"`
+	Ports  []ContainerPort   `json:"ports,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+	// Env holds the environment variables as Docker reports them, KEY=VALUE
+	Env          []string `json:"env,omitempty"`
+	RestartCount int      `json:"restartCount,omitempty"`
+	// RestartPolicy is Docker's restart policy name, e.g. "unless-stopped"
+	// or "on-failure:3"; empty means the default, "no"
+	RestartPolicy string `json:"restartPolicy,omitempty"`
+	// StartedAt is when the container last started, as an RFC 3339
+	// timestamp; empty or Docker's zero time when it never started
+	StartedAt string `json:"startedAt,omitempty"`
+}
+
+// EnvMap returns Env as a map. A variable without "=" maps to an empty
+// string, and a later duplicate wins, as in Docker.
+func (c ContainerDetail) EnvMap() map[string]string {
+	env := make(map[string]string, len(c.Env))
+	for _, kv := range c.Env {
+		key, value, _ := strings.Cut(kv, "=")
+		env[key] = value
+	}
+	return env
+}
+
+// StartedTime parses StartedAt, returning false when the container never
+// started or the timestamp can't be parsed.
+func (c ContainerDetail) StartedTime() (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339Nano, c.StartedAt)
+	if err != nil || t.IsZero() {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// ContainerPort represents a container port mapping.
+type ContainerPort struct {
+	HostPort      int    `json:"host_port"`
+	ContainerPort int    `json:"container_port"`
+	Protocol      string `json:"protocol"`
+}
+
+// GetProjectContainers returns detailed container information for a project.
+func (ec *EnvironmentClient) GetProjectContainers(ctx context.Context, projectID string) ([]ContainerDetail, error) {
+	return getList[ContainerDetail](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/projects/"+esc(projectID)+"/containers")
+}
+
+// TestEnvironment tests connectivity to an environment's agent.
+func (c *Client) TestEnvironment(ctx context.Context, id string) error {
+	return c.Do(ctx, &Request{
+		Method: http.MethodPost,
+		Path:   "/api/environments/" + esc(id) + "/test",
+	})
+}
+
+// AgentVersion describes the version reported by an environment's agent.
+type AgentVersion struct {
+	Version string `json:"version"`
+}
+
+// GetAgentVersion returns the version reported by the environment's agent.
+func (ec *EnvironmentClient) GetAgentVersion(ctx context.Context) (string, error) {
+	version, err := getSingle[AgentVersion](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/version")
+	if err != nil {
+		return "", err
+	}
+	return version.Version, nil
+}
+
+// GetContainer returns a single container by ID within an environment.
+func (ec *EnvironmentClient) GetContainer(ctx context.Context, containerID string) (*ContainerDetail, error) {
+	return getSingle[ContainerDetail](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/containers/"+esc(containerID))
+}
+
+// GetContainerByName returns a container by name within an environment.
+// Searches across all projects in the environment.
+func (ec *EnvironmentClient) GetContainerByName(ctx context.Context, name string) (*ContainerDetail, error) {
+	projects, err := ec.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		containers, err := ec.GetProjectContainers(ctx, p.ID)
+		if err != nil {
+			continue
+		}
+		for _, c := range containers {
+			if c.Name == name {
+				return &c, nil
+			}
+		}
+	}
+	return nil, &APIError{StatusCode: 404, Message: "container not found"}
+}
+
+// Container actions accepted by ContainerAction.
+const (
+	ContainerActionStart   = "start"
+	ContainerActionStop    = "stop"
+	ContainerActionRestart = "restart"
+)
+
+// ContainerActions lists the actions accepted by ContainerAction.
+var ContainerActions = []string{ContainerActionStart, ContainerActionStop, ContainerActionRestart}
+
+// ContainerAction starts, stops or restarts a single container. Like project
+// deployments, it counts against the environment's operation limit.
+func (ec *EnvironmentClient) ContainerAction(ctx context.Context, containerID, action string) error {
+	release, err := ec.acquireOperationSlot(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return ec.client.Do(ctx, &Request{
+		Method: http.MethodPost,
+		Path:   "/api/environments/" + esc(ec.environmentID) + "/containers/" + esc(containerID) + "/" + esc(action),
+	})
+}
+
+// StartContainer starts a container.
+func (ec *EnvironmentClient) StartContainer(ctx context.Context, containerID string) error {
+	return ec.ContainerAction(ctx, containerID, ContainerActionStart)
+}
+
+// StopContainer stops a container.
+func (ec *EnvironmentClient) StopContainer(ctx context.Context, containerID string) error {
+	return ec.ContainerAction(ctx, containerID, ContainerActionStop)
+}
+
+// RestartContainer restarts a container.
+func (ec *EnvironmentClient) RestartContainer(ctx context.Context, containerID string) error {
+	return ec.ContainerAction(ctx, containerID, ContainerActionRestart)
+}
+
+// ContainerRegistry represents a container registry configuration.
+type ContainerRegistry struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	AuthType string `json:"auth_type,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// UpdatedAt is when the registry, including its credentials, was last
+	// changed, when the server reports it
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// ContainerRegistryCreateRequest represents a request to create a container registry.
+type ContainerRegistryCreateRequest struct {
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	AuthType string `json:"auth_type,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// ContainerRegistryUpdateRequest represents a request to update a container registry.
+type ContainerRegistryUpdateRequest struct {
+	Name     string `json:"name,omitempty"`
+	URL      string `json:"url,omitempty"`
+	AuthType string `json:"auth_type,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// ListContainerRegistries returns all container registries.
+func (c *Client) ListContainerRegistries(ctx context.Context) ([]ContainerRegistry, error) {
+	return getList[ContainerRegistry](ctx, c, "/api/container-registries")
+}
+
+// GetContainerRegistry returns a container registry by ID.
+func (c *Client) GetContainerRegistry(ctx context.Context, id string) (*ContainerRegistry, error) {
+	return getSingle[ContainerRegistry](ctx, c, "/api/container-registries/"+esc(id))
+}
+
+// GetContainerRegistryByName returns a container registry by name.
+func (c *Client) GetContainerRegistryByName(ctx context.Context, name string) (*ContainerRegistry, error) {
+	registries, err := c.ListContainerRegistries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, registry := range registries {
+		if registry.Name == name {
+			return &registry, nil
+		}
+	}
+	return nil, &APIError{StatusCode: 404, Message: "container registry not found"}
+}
+
+// CreateContainerRegistry creates a new container registry.
+func (c *Client) CreateContainerRegistry(ctx context.Context, req *ContainerRegistryCreateRequest) (*ContainerRegistry, error) {
+	return postSingle[ContainerRegistry](ctx, c, "/api/container-registries", req)
+}
+
+// UpdateContainerRegistry updates a container registry.
+func (c *Client) UpdateContainerRegistry(ctx context.Context, id string, req *ContainerRegistryUpdateRequest) (*ContainerRegistry, error) {
+	return putSingle[ContainerRegistry](ctx, c, "/api/container-registries/"+esc(id), req)
+}
+
+// DeleteContainerRegistry deletes a container registry.
+func (c *Client) DeleteContainerRegistry(ctx context.Context, id string) error {
+	return c.Do(ctx, &Request{
+		Method: http.MethodDelete,
+		Path:   "/api/container-registries/" + esc(id),
+	})
+}
+
+// RegistryImage describes an image tag found in a container registry.
+type RegistryImage struct {
+	Repository string `json:"repository"`
+	Tag        string `json:"tag"`
+	Digest     string `json:"digest"`
+}
+
+// GetRegistryImage looks up repository:tag in a container registry through
+// Arcane, using the registry's stored credentials. A missing image is a 404.
+func (c *Client) GetRegistryImage(ctx context.Context, registryID, repository, tag string) (*RegistryImage, error) {
+	var resp SingleResponse[RegistryImage]
+	err := c.Do(ctx, &Request{
+		Method: http.MethodGet,
+		Path:   "/api/container-registries/" + esc(registryID) + "/images",
+		Query:  url.Values{"repository": {repository}, "tag": {tag}},
+		Result: &resp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// GitRepository represents a git repository configuration.
+type GitRepository struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Branch      string `json:"branch,omitempty"`
+	AuthType    string `json:"auth_type,omitempty"`
+	Credentials string `json:"credentials,omitempty"`
+}
+
+// GitRepositoryCreateRequest represents a request to create a git repository.
+type GitRepositoryCreateRequest struct {
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Branch      string `json:"branch,omitempty"`
+	AuthType    string `json:"auth_type,omitempty"`
+	Credentials string `json:"credentials,omitempty"`
+}
+
+// GitRepositoryUpdateRequest represents a request to update a git repository.
+type GitRepositoryUpdateRequest struct {
+	Name        string `json:"name,omitempty"`
+	URL         string `json:"url,omitempty"`
+	Branch      string `json:"branch,omitempty"`
+	AuthType    string `json:"auth_type,omitempty"`
+	Credentials string `json:"credentials,omitempty"`
+}
+
+// ListGitRepositories returns all git repositories.
+func (c *Client) ListGitRepositories(ctx context.Context) ([]GitRepository, error) {
+	return getList[GitRepository](ctx, c, "/api/gitops/repositories")
+}
+
+// GetGitRepository returns a git repository by ID.
+func (c *Client) GetGitRepository(ctx context.Context, id string) (*GitRepository, error) {
+	return getSingle[GitRepository](ctx, c, "/api/gitops/repositories/"+esc(id))
+}
+
+// GetGitRepositoryByName returns a git repository by name.
+func (c *Client) GetGitRepositoryByName(ctx context.Context, name string) (*GitRepository, error) {
+	repositories, err := c.ListGitRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, repository := range repositories {
+		if repository.Name == name {
+			return &repository, nil
+		}
+	}
+	return nil, &APIError{StatusCode: 404, Message: "git repository not found"}
+}
+
+// CreateGitRepository creates a new git repository.
+func (c *Client) CreateGitRepository(ctx context.Context, req *GitRepositoryCreateRequest) (*GitRepository, error) {
+	return postSingle[GitRepository](ctx, c, "/api/gitops/repositories", req)
+}
+
+// UpdateGitRepository updates a git repository.
+func (c *Client) UpdateGitRepository(ctx context.Context, id string, req *GitRepositoryUpdateRequest) (*GitRepository, error) {
+	return putSingle[GitRepository](ctx, c, "/api/gitops/repositories/"+esc(id), req)
+}
+
+// DeleteGitRepository deletes a git repository.
+func (c *Client) DeleteGitRepository(ctx context.Context, id string) error {
+	return c.Do(ctx, &Request{
+		Method: http.MethodDelete,
+		Path:   "/api/gitops/repositories/" + esc(id),
+	})
+}
+
+// GitOpsSync represents a GitOps sync configuration for an environment.
+type GitOpsSync struct {
+	ID             string `json:"id"`
+	EnvironmentID  string `json:"environment_id,omitempty"`
+	RepositoryID   string `json:"repository_id"`
+	Path           string `json:"path,omitempty"`
+	Branch         string `json:"branch,omitempty"`
+	ComposeFile    string `json:"compose_file,omitempty"`
+	SyncInterval   string `json:"sync_interval,omitempty"`
+	AutoSync       bool   `json:"auto_sync"`
+	LastSyncAt     string `json:"last_sync_at,omitempty"`
+	LastSyncCommit string `json:"last_sync_commit,omitempty"`
+}
+
+// GitOpsSyncCreateRequest represents a request to create a GitOps sync.
+type GitOpsSyncCreateRequest struct {
+	RepositoryID string `json:"repository_id"`
+	Path         string `json:"path,omitempty"`
+	Branch       string `json:"branch,omitempty"`
+	ComposeFile  string `json:"compose_file,omitempty"`
+	SyncInterval string `json:"sync_interval,omitempty"`
+	AutoSync     bool   `json:"auto_sync,omitempty"`
+}
+
+// GitOpsSyncUpdateRequest represents a request to update a GitOps sync.
+type GitOpsSyncUpdateRequest struct {
+	RepositoryID string `json:"repository_id,omitempty"`
+	Path         string `json:"path,omitempty"`
+	Branch       string `json:"branch,omitempty"`
+	ComposeFile  string `json:"compose_file,omitempty"`
+	SyncInterval string `json:"sync_interval,omitempty"`
+	AutoSync     *bool  `json:"auto_sync,omitempty"`
+}
+
+// ListGitOpsSyncs returns all GitOps syncs for an environment.
+func (ec *EnvironmentClient) ListGitOpsSyncs(ctx context.Context) ([]GitOpsSync, error) {
+	return getList[GitOpsSync](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/gitops-syncs")
+}
+
+// GetGitOpsSync returns a GitOps sync by ID.
+func (ec *EnvironmentClient) GetGitOpsSync(ctx context.Context, syncID string) (*GitOpsSync, error) {
+	return getSingle[GitOpsSync](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/gitops-syncs/"+esc(syncID))
+}
+
+// CreateGitOpsSync creates a new GitOps sync.
+func (ec *EnvironmentClient) CreateGitOpsSync(ctx context.Context, req *GitOpsSyncCreateRequest) (*GitOpsSync, error) {
+	return postSingle[GitOpsSync](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/gitops-syncs", req)
+}
+
+// UpdateGitOpsSync updates a GitOps sync.
+func (ec *EnvironmentClient) UpdateGitOpsSync(ctx context.Context, syncID string, req *GitOpsSyncUpdateRequest) (*GitOpsSync, error) {
+	return putSingle[GitOpsSync](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/gitops-syncs/"+esc(syncID), req)
+}
+
+// DeleteGitOpsSync deletes a GitOps sync.
+func (ec *EnvironmentClient) DeleteGitOpsSync(ctx context.Context, syncID string) error {
+	return ec.client.Do(ctx, &Request{
+		Method: http.MethodDelete,
+		Path:   "/api/environments/" + esc(ec.environmentID) + "/gitops-syncs/" + esc(syncID),
+	})
+}
+
+// GitOpsSyncRun represents a single execution of a GitOps sync.
+type GitOpsSyncRun struct {
+	ID         string `json:"id"`
+	SyncID     string `json:"sync_id,omitempty"`
+	Commit     string `json:"commit,omitempty"`
+	StartedAt  string `json:"started_at,omitempty"`
+	DurationMs int64  `json:"duration_ms,omitempty"`
+	Result     string `json:"result"`
+	Message    string `json:"message,omitempty"`
+}
+
+// ListGitOpsSyncRuns returns the most recent runs of a GitOps sync, newest
+// first. A limit of zero leaves the page size to the server.
+func (ec *EnvironmentClient) ListGitOpsSyncRuns(ctx context.Context, syncID string, limit int) ([]GitOpsSyncRun, error) {
+	var query url.Values
+	if limit > 0 {
+		query = url.Values{"limit": {strconv.Itoa(limit)}}
+	}
+	page, err := getPage[GitOpsSyncRun](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/gitops-syncs/"+esc(syncID)+"/runs", query)
+	if err != nil {
+		return nil, err
+	}
+	return page.Data, nil
+}
+
+// TriggerGitOpsSync manually triggers a sync operation. It returns the ID of
+// the sync job when Arcane runs the sync in the background, otherwise "".
+func (ec *EnvironmentClient) TriggerGitOpsSync(ctx context.Context, syncID string) (string, error) {
+	result, err := postSingle[jobStarted](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/gitops-syncs/"+esc(syncID)+"/trigger", nil)
+	if err != nil {
+		return "", err
+	}
+	return result.JobID, nil
+}
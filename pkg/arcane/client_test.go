@@ -1,14 +1,21 @@
-package client
+package arcane
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"slices"
 	"testing"
+	"time"
 )
 
 // ─── Client creation & validation ─────────────────────────────────────────────
@@ -276,6 +283,26 @@ func TestDo_Given500_ReturnsAPIError(t *testing.T) {
 	}
 }
 
+func TestDo_GivenRequestIDHeader_SetsAPIErrorRequestID(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(APIError{Message: "internal error"})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/test"})
+	var apiErr *APIError
+	if !isAPIError(err, &apiErr) {
+		t.Fatalf("expected APIError, got %T", err)
+	}
+	if apiErr.RequestID != "req-123" {
+		t.Errorf("expected request ID req-123, got %q", apiErr.RequestID)
+	}
+}
+
 func TestDo_GivenNonJSONError_ReturnsFallbackError(t *testing.T) {
 	t.Parallel()
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -346,6 +373,120 @@ func TestAPIError_Error_GivenNoMessageOrDetail(t *testing.T) {
 	}
 }
 
+func TestIsConflict_Given409APIError_ReturnsTrue(t *testing.T) {
+	t.Parallel()
+	if !IsConflict(&APIError{StatusCode: 409}) {
+		t.Error("expected IsConflict to be true for 409")
+	}
+	if IsConflict(&APIError{StatusCode: 400}) {
+		t.Error("expected IsConflict to be false for 400")
+	}
+}
+
+func TestIsTransient_GivenConnectionReset_ReturnsTrue(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Fatalf("hijack failed: %v", err)
+		}
+		conn.Close()
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	err := c.Do(context.Background(), &Request{Method: http.MethodPost, Path: "/test"})
+	if err == nil {
+		t.Fatal("expected error for dropped connection")
+	}
+	if !IsTransient(err) {
+		t.Errorf("expected dropped connection to be transient, got %v", err)
+	}
+}
+
+func TestIsTransient_GivenGatewayErrors_ReturnsTrue(t *testing.T) {
+	t.Parallel()
+	for _, code := range []int{502, 503, 504} {
+		if !IsTransient(&APIError{StatusCode: code}) {
+			t.Errorf("expected status %d to be transient", code)
+		}
+	}
+}
+
+func TestIsTransient_GivenClientErrorOrCancel_ReturnsFalse(t *testing.T) {
+	t.Parallel()
+	for _, err := range []error{
+		nil,
+		&APIError{StatusCode: 400},
+		&APIError{StatusCode: 404},
+		&APIError{StatusCode: 500},
+		context.Canceled,
+		fmt.Errorf("request failed: %w", context.DeadlineExceeded),
+		errors.New("boom"),
+	} {
+		if IsTransient(err) {
+			t.Errorf("expected %v not to be transient", err)
+		}
+	}
+}
+
+func TestIsTransient_GivenRefusedConnectionOrTimeout_ReturnsTrue(t *testing.T) {
+	t.Parallel()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	c := &Client{BaseURL: "http://" + addr, HTTPClient: &http.Client{}}
+	err = c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/test"})
+	if !IsTransient(err) {
+		t.Errorf("expected refused connection to be transient, got %v", err)
+	}
+
+	timeout := &url.Error{Op: "Get", URL: "http://arcane.local/api", Err: &net.DNSError{Err: "i/o timeout", Name: "arcane.local", IsTimeout: true}}
+	if !IsTransient(timeout) {
+		t.Errorf("expected timeout to be transient, got %v", timeout)
+	}
+}
+
+func TestIsTransient_GivenCertificateError_ReturnsFalse(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	// A client that doesn't trust the test server's certificate.
+	c := &Client{BaseURL: srv.URL, HTTPClient: &http.Client{}}
+	err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/test"})
+	if err == nil {
+		t.Fatal("expected certificate verification error")
+	}
+	var certErr *tls.CertificateVerificationError
+	if !errors.As(err, &certErr) {
+		t.Fatalf("expected certificate verification error, got %v", err)
+	}
+	if IsTransient(err) {
+		t.Errorf("expected certificate error not to be transient, got %v", err)
+	}
+}
+
+func TestIsTransient_GivenUnknownHostOrScheme_ReturnsFalse(t *testing.T) {
+	t.Parallel()
+	for _, err := range []error{
+		&url.Error{Op: "Get", URL: "http://arcane.invalid/api", Err: &net.OpError{
+			Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "arcane.invalid", IsNotFound: true},
+		}},
+		&url.Error{Op: "Get", URL: "ftp://arcane.local/api", Err: errors.New(`unsupported protocol scheme "ftp"`)},
+	} {
+		if IsTransient(err) {
+			t.Errorf("expected %v not to be transient", err)
+		}
+	}
+}
+
 // ─── Environment CRUD methods ─────────────────────────────────────────────────
 
 func TestListEnvironments_ReturnsAll(t *testing.T) {
@@ -539,6 +680,34 @@ func TestRegenerateEnvironmentAPIKey_ReturnsNewKey(t *testing.T) {
 	}
 }
 
+func TestValidateEnvironmentToken_PostsTokenAndReturnsResult(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/environments/env-1/token/validate" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body EnvironmentTokenValidateRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.Token != "arc_secret" {
+			t.Errorf("expected token arc_secret in body, got %q", body.Token)
+		}
+		json.NewEncoder(w).Encode(SingleResponse[EnvironmentTokenValidation]{
+			Success: true,
+			Data:    EnvironmentTokenValidation{Valid: true, ExpiresAt: "2027-01-01T00:00:00Z"},
+		})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	result, err := c.ValidateEnvironmentToken(context.Background(), "env-1", "arc_secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Valid || result.ExpiresAt != "2027-01-01T00:00:00Z" {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
+
 func TestTestEnvironment_GivenConnected_ReturnsNil(t *testing.T) {
 	t.Parallel()
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -606,6 +775,89 @@ func TestGetProject_ReturnsProject(t *testing.T) {
 	}
 }
 
+func TestUpdateProject_SendsLabels(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/environments/env-1/projects/proj-1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var req ProjectUpdateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		json.NewEncoder(w).Encode(SingleResponse[Project]{
+			Success: true,
+			Data:    Project{ID: "proj-1", Name: "webapp", Labels: *req.Labels},
+		})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	ec := c.ForEnvironment("env-1")
+	p, err := ec.UpdateProject(context.Background(), "proj-1", &ProjectUpdateRequest{Labels: &map[string]string{"tf_workspace": "prod"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.Labels["tf_workspace"] != "prod" {
+		t.Errorf("expected label tf_workspace=prod, got %v", p.Labels)
+	}
+}
+
+func TestCreateProject_SendsComposeContent(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/environments/env-1/projects" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var req ProjectCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if req.ComposeContent != "services: {}\n" || req.EnvContent != "TAG=1\n" {
+			t.Errorf("unexpected request: %+v", req)
+		}
+		json.NewEncoder(w).Encode(SingleResponse[Project]{
+			Success: true,
+			Data:    Project{ID: "proj-1", Name: req.Name, Status: "stopped"},
+		})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	ec := c.ForEnvironment("env-1")
+	p, err := ec.CreateProject(context.Background(), &ProjectCreateRequest{Name: "webapp", ComposeContent: "services: {}\n", EnvContent: "TAG=1\n"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.ID != "proj-1" || p.Name != "webapp" {
+		t.Errorf("unexpected project: %+v", p)
+	}
+}
+
+func TestDeleteProject_GivenMissingProject_ReturnsNotFound(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"success":false,"error":"project not found"}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	err := c.ForEnvironment("env-1").DeleteProject(context.Background(), "proj-1")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
 func TestGetProjectByName_GivenExistingName_ReturnsProject(t *testing.T) {
 	t.Parallel()
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -645,6 +897,50 @@ func TestGetProjectByName_GivenMissingName_Returns404(t *testing.T) {
 	}
 }
 
+func TestGetProjectArchive_StreamsBodyToWriter(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/environments/env-1/projects/proj-1/archive" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("format"); got != ProjectArchiveZip {
+			t.Errorf("expected format zip, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/zip")
+		w.Write([]byte("PK\x03\x04archive"))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	var buf bytes.Buffer
+	err := c.ForEnvironment("env-1").GetProjectArchive(context.Background(), "proj-1", ProjectArchiveZip, &buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "PK\x03\x04archive" {
+		t.Errorf("unexpected archive content %q", buf.String())
+	}
+}
+
+func TestGetProjectArchive_Given404_ReturnsNotFoundAndWritesNothing(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(APIError{Message: "project not found"})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	var buf bytes.Buffer
+	err := c.ForEnvironment("env-1").GetProjectArchive(context.Background(), "missing", ProjectArchiveTarGz, &buf)
+	if !IsNotFound(err) {
+		t.Fatalf("expected not-found error, got %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing written on error, got %d bytes", buf.Len())
+	}
+}
+
 func TestDeployProject_SendsPost(t *testing.T) {
 	t.Parallel()
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -662,7 +958,54 @@ func TestDeployProject_SendsPost(t *testing.T) {
 
 	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
 	ec := c.ForEnvironment("env-1")
-	err := ec.DeployProject(context.Background(), "proj-1", &ProjectDeployRequest{PullPolicy: "always"})
+	_, err := ec.DeployProject(context.Background(), "proj-1", &ProjectDeployRequest{PullPolicy: "always"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeployProject_GivenOverrideFiles_SendsThemInOrder(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]json.RawMessage
+		json.NewDecoder(r.Body).Decode(&body)
+		want := `[{"path":"docker-compose.prod.yml"},{"content":"services: {}"}]`
+		if got := string(body["overrideFiles"]); got != want {
+			t.Errorf("overrideFiles = %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	_, err := c.ForEnvironment("env-1").DeployProject(context.Background(), "proj-1", &ProjectDeployRequest{
+		OverrideFiles: []ComposeOverrideFile{
+			{Path: "docker-compose.prod.yml"},
+			{Content: "services: {}"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeployProject_GivenBuild_SendsBuildOptions(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["build"] != true || body["noCache"] != true {
+			t.Errorf("expected build and noCache, got %v", body)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	_, err := c.ForEnvironment("env-1").DeployProject(context.Background(), "proj-1", &ProjectDeployRequest{
+		Build:   true,
+		NoCache: true,
+	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -677,7 +1020,7 @@ func TestDeployProject_GivenNilRequest_UsesDefaults(t *testing.T) {
 
 	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
 	ec := c.ForEnvironment("env-1")
-	err := ec.DeployProject(context.Background(), "proj-1", nil)
+	_, err := ec.DeployProject(context.Background(), "proj-1", nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -695,12 +1038,29 @@ func TestRedeployProject_SendsPost(t *testing.T) {
 
 	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
 	ec := c.ForEnvironment("env-1")
-	err := ec.RedeployProject(context.Background(), "proj-1", nil)
+	_, err := ec.RedeployProject(context.Background(), "proj-1", nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
 
+func TestDeployProject_GivenAsyncDeploy_ReturnsJobID(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":true,"data":{"jobId":"dep-1"}}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	id, err := c.ForEnvironment("env-1").DeployProject(context.Background(), "proj-1", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "dep-1" {
+		t.Errorf("expected deployment ID dep-1, got %q", id)
+	}
+}
+
 func TestStopProject_SendsPost(t *testing.T) {
 	t.Parallel()
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -760,6 +1120,85 @@ func TestGetProjectContainers_ReturnsContainers(t *testing.T) {
 	}
 }
 
+func TestGetAgentVersion_ReturnsVersion(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/environments/env-1/version" {
+			t.Errorf("unexpected: %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(SingleResponse[AgentVersion]{Success: true, Data: AgentVersion{Version: "1.16.2"}})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	v, err := c.ForEnvironment("env-1").GetAgentVersion(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v != "1.16.2" {
+		t.Errorf("expected 1.16.2, got %q", v)
+	}
+}
+
+func TestValidateCompose_SendsContentAndDecodesResult(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/environments/env-1/projects/validate" {
+			t.Errorf("unexpected: %s %s", r.Method, r.URL.Path)
+		}
+		var req ComposeValidateRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.ComposeContent != "services: {}" {
+			t.Errorf("unexpected compose content: %q", req.ComposeContent)
+		}
+		json.NewEncoder(w).Encode(SingleResponse[ComposeValidationResult]{
+			Success: true,
+			Data:    ComposeValidationResult{Valid: false, Errors: []string{"no services defined"}},
+		})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	result, err := c.ForEnvironment("env-1").ValidateCompose(context.Background(), &ComposeValidateRequest{ComposeContent: "services: {}"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Valid || len(result.Errors) != 1 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestRenderCompose_SendsVariablesAndDecodesResult(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/environments/env-1/projects/render" {
+			t.Errorf("unexpected: %s %s", r.Method, r.URL.Path)
+		}
+		var req ComposeRenderRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.ComposeContent != "image: nginx:${TAG}" || req.Variables["TAG"] != "1.27" {
+			t.Errorf("unexpected request: %+v", req)
+		}
+		json.NewEncoder(w).Encode(SingleResponse[ComposeRenderResult]{
+			Success: true,
+			Data:    ComposeRenderResult{Content: "image: nginx:1.27\n"},
+		})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	result, err := c.ForEnvironment("env-1").RenderCompose(context.Background(), &ComposeRenderRequest{
+		ComposeContent: "image: nginx:${TAG}",
+		Variables:      map[string]string{"TAG": "1.27"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Content != "image: nginx:1.27\n" {
+		t.Errorf("unexpected content: %q", result.Content)
+	}
+}
+
 // ─── Container registry methods ───────────────────────────────────────────────
 
 func TestListContainerRegistries_ReturnsAll(t *testing.T) {
@@ -808,6 +1247,56 @@ func TestGetContainerRegistry_ReturnsRegistry(t *testing.T) {
 	}
 }
 
+func TestGetRegistryImage_SendsRepositoryAndTag(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/container-registries/reg-1/images" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query(); got.Get("repository") != "example/web" || got.Get("tag") != "1.2.3" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(SingleResponse[RegistryImage]{
+			Success: true,
+			Data:    RegistryImage{Repository: "example/web", Tag: "1.2.3", Digest: "sha256:abc"},
+		})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	img, err := c.GetRegistryImage(context.Background(), "reg-1", "example/web", "1.2.3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if img.Digest != "sha256:abc" {
+		t.Errorf("expected digest sha256:abc, got %s", img.Digest)
+	}
+}
+
+func TestGetContainerRegistryByName_GivenExistingName_ReturnsRegistry(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(PaginatedResponse[ContainerRegistry]{
+			Success: true,
+			Data:    []ContainerRegistry{{ID: "reg-1", Name: "ghcr"}, {ID: "reg-2", Name: "docker-hub"}},
+		})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	reg, err := c.GetContainerRegistryByName(context.Background(), "docker-hub")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reg.ID != "reg-2" {
+		t.Errorf("expected ID reg-2, got %s", reg.ID)
+	}
+
+	if _, err := c.GetContainerRegistryByName(context.Background(), "quay"); !IsNotFound(err) {
+		t.Errorf("expected not found for missing name, got %v", err)
+	}
+}
+
 func TestCreateContainerRegistry_ReturnsCreated(t *testing.T) {
 	t.Parallel()
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -901,6 +1390,30 @@ func TestListGitRepositories_ReturnsAll(t *testing.T) {
 	}
 }
 
+func TestGetGitRepositoryByName_GivenExistingName_ReturnsRepo(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(PaginatedResponse[GitRepository]{
+			Success: true,
+			Data:    []GitRepository{{ID: "repo-1", Name: "infra"}, {ID: "repo-2", Name: "apps"}},
+		})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	repo, err := c.GetGitRepositoryByName(context.Background(), "apps")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.ID != "repo-2" {
+		t.Errorf("expected ID repo-2, got %s", repo.ID)
+	}
+
+	if _, err := c.GetGitRepositoryByName(context.Background(), "missing"); !IsNotFound(err) {
+		t.Errorf("expected not found for missing name, got %v", err)
+	}
+}
+
 func TestGetGitRepository_ReturnsRepo(t *testing.T) {
 	t.Parallel()
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1123,6 +1636,29 @@ func TestDeleteGitOpsSync_SendsDelete(t *testing.T) {
 	}
 }
 
+func TestListGitOpsSyncRuns_SendsLimitAndDecodesRuns(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/environments/env-1/gitops-syncs/sync-1/runs" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("limit"); got != "5" {
+			t.Errorf("expected limit=5, got %q", got)
+		}
+		w.Write([]byte(`{"success": true, "data": [{"id": "run-1", "commit": "abc123", "startedAt": "2026-01-01T00:00:00Z", "durationMs": 1500, "result": "success"}]}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	runs, err := c.ForEnvironment("env-1").ListGitOpsSyncRuns(context.Background(), "sync-1", 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(runs) != 1 || runs[0].Commit != "abc123" || runs[0].DurationMs != 1500 || runs[0].StartedAt == "" {
+		t.Errorf("unexpected runs: %+v", runs)
+	}
+}
+
 func TestTriggerGitOpsSync_SendsPost(t *testing.T) {
 	t.Parallel()
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1135,12 +1671,29 @@ func TestTriggerGitOpsSync_SendsPost(t *testing.T) {
 
 	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
 	ec := c.ForEnvironment("env-1")
-	err := ec.TriggerGitOpsSync(context.Background(), "sync-1")
+	_, err := ec.TriggerGitOpsSync(context.Background(), "sync-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
 
+func TestTriggerGitOpsSync_GivenBackgroundSync_ReturnsJobID(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":true,"data":{"jobId":"job-7"}}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	id, err := c.ForEnvironment("env-1").TriggerGitOpsSync(context.Background(), "sync-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "job-7" {
+		t.Errorf("expected job ID job-7, got %q", id)
+	}
+}
+
 // ─── Container lookup methods ─────────────────────────────────────────────────
 
 func TestGetContainer_ReturnsContainer(t *testing.T) {
@@ -1167,6 +1720,66 @@ func TestGetContainer_ReturnsContainer(t *testing.T) {
 	}
 }
 
+func TestContainerActions_SendPost(t *testing.T) {
+	t.Parallel()
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		got = append(got, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	ec := c.ForEnvironment("env-1")
+	for _, action := range []func(context.Context, string) error{ec.StartContainer, ec.StopContainer, ec.RestartContainer} {
+		if err := action(context.Background(), "c1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	want := []string{
+		"/api/environments/env-1/containers/c1/start",
+		"/api/environments/env-1/containers/c1/stop",
+		"/api/environments/env-1/containers/c1/restart",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected paths %v, got %v", want, got)
+	}
+}
+
+func TestContainerDetail_EnvMap(t *testing.T) {
+	t.Parallel()
+	c := ContainerDetail{Env: []string{"A=1", "B=x=y", "FLAG", "A=2"}}
+	got := c.EnvMap()
+	want := map[string]string{"A": "2", "B": "x=y", "FLAG": ""}
+	if !maps.Equal(got, want) {
+		t.Errorf("EnvMap() = %v, want %v", got, want)
+	}
+}
+
+func TestContainerDetail_StartedTime(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		startedAt string
+		want      time.Time
+		ok        bool
+	}{
+		"nanoseconds": {"2026-03-01T10:20:30.123456789Z", time.Date(2026, 3, 1, 10, 20, 30, 123456789, time.UTC), true},
+		"offset":      {"2026-03-01T12:20:30+02:00", time.Date(2026, 3, 1, 10, 20, 30, 0, time.UTC), true},
+		"never":       {"0001-01-01T00:00:00Z", time.Time{}, false},
+		"empty":       {"", time.Time{}, false},
+		"malformed":   {"yesterday", time.Time{}, false},
+	}
+	for name, tc := range cases {
+		got, ok := ContainerDetail{StartedAt: tc.startedAt}.StartedTime()
+		if ok != tc.ok || !got.Equal(tc.want) {
+			t.Errorf("%s: StartedTime() = %s, %t, want %s, %t", name, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
 func TestGetContainerByName_ReturnsContainer(t *testing.T) {
 	t.Parallel()
 	callCount := 0
@@ -0,0 +1,69 @@
+package arcane
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WarningClockSkew is the code of the warning added when the local clock
+// differs from Arcane's by more than Config.MaxClockSkew.
+const WarningClockSkew = "clock_skew"
+
+// ClockSkew returns how far serverTime, from the Date header of a response to
+// a request made between requestStart and requestEnd, is ahead of the local
+// clock; it is negative when Arcane's clock is behind. The header has a
+// resolution of one second and was generated while the request was in
+// flight, so only the distance outside that window counts.
+func ClockSkew(requestStart, requestEnd, serverTime time.Time) time.Duration {
+	earliest := requestStart.Truncate(time.Second)
+	switch {
+	case serverTime.Before(earliest):
+		return serverTime.Sub(earliest)
+	case serverTime.After(requestEnd):
+		return serverTime.Sub(requestEnd)
+	}
+	return 0
+}
+
+// LastClockSkew returns the clock skew measured from the last response with
+// a Date header, as returned by ClockSkew, and false before there was one.
+func (c *Client) LastClockSkew() (time.Duration, bool) {
+	if !c.clockSkewMeasured.Load() {
+		return 0, false
+	}
+	return time.Duration(c.clockSkew.Load()), true
+}
+
+// observeClockSkew records the clock skew of a response with a Date header.
+// The first time it exceeds MaxClockSkew a warning is added to ctx: the
+// timestamps the provider records locally, such as last_deployed_at, and
+// those Arcane sets, such as expiries and sync times, are then compared
+// across clocks that disagree.
+func (c *Client) observeClockSkew(ctx context.Context, requestStart, requestEnd, serverTime time.Time) {
+	if serverTime.IsZero() {
+		return
+	}
+	skew := ClockSkew(requestStart, requestEnd, serverTime)
+	c.clockSkew.Store(int64(skew))
+	c.clockSkewMeasured.Store(true)
+
+	distance := skew.Abs()
+	if c.maxClockSkew <= 0 || distance <= c.maxClockSkew || !c.clockSkewWarned.CompareAndSwap(false, true) {
+		return
+	}
+	direction := "ahead of"
+	if skew < 0 {
+		direction = "behind"
+	}
+	c.log().Warn(ctx, "Clock skew with Arcane", map[string]interface{}{
+		"skew":     skew.String(),
+		"max_skew": c.maxClockSkew.String(),
+	})
+	addWarning(ctx, Warning{
+		Code: WarningClockSkew,
+		Message: fmt.Sprintf("Arcane's clock is %s %s the local clock, more than the %s tolerated. Timestamps recorded by "+
+			"the provider and by Arcane disagree, which breaks ttl expiries and time-based rotation; synchronize both clocks, e.g. with NTP.",
+			distance.Round(time.Second), direction, c.maxClockSkew),
+	})
+}
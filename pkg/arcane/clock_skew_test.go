@@ -0,0 +1,91 @@
+package arcane
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// ─── Clock skew ───────────────────────────────────────────────────────────────
+
+func TestClockSkew(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 12, 0, 0, 400_000_000, time.UTC)
+	end := start.Add(200 * time.Millisecond)
+
+	cases := map[string]struct {
+		serverTime time.Time
+		want       time.Duration
+	}{
+		"same second":   {serverTime: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC), want: 0},
+		"server behind": {serverTime: time.Date(2026, 1, 1, 11, 58, 0, 0, time.UTC), want: -2 * time.Minute},
+		"server ahead":  {serverTime: time.Date(2026, 1, 1, 12, 0, 30, 0, time.UTC), want: 30*time.Second - 600*time.Millisecond},
+	}
+	for name, tc := range cases {
+		if got := ClockSkew(start, end, tc.serverTime); got != tc.want {
+			t.Errorf("%s: ClockSkew() = %s, want %s", name, got, tc.want)
+		}
+	}
+}
+
+func TestClient_GivenSkewedServerClock_WarnsOnce(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		offset       time.Duration
+		maxSkew      time.Duration
+		wantWarnings int
+	}{
+		"behind":           {offset: -10 * time.Minute, maxSkew: time.Minute, wantWarnings: 1},
+		"ahead":            {offset: 10 * time.Minute, maxSkew: time.Minute, wantWarnings: 1},
+		"within tolerance": {offset: 30 * time.Second, maxSkew: time.Minute},
+		"check disabled":   {offset: -10 * time.Minute},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Date", time.Now().Add(tc.offset).UTC().Format(http.TimeFormat))
+				w.Write([]byte(`{"success":true}`))
+			}))
+			defer srv.Close()
+
+			c, err := New(Config{URL: srv.URL, MaxClockSkew: tc.maxSkew})
+			if err != nil {
+				t.Fatalf("New() error: %v", err)
+			}
+			if _, ok := c.LastClockSkew(); ok {
+				t.Fatal("LastClockSkew() reported a skew before any request")
+			}
+			ctx, warnings := WithWarnings(context.Background())
+			for range 2 {
+				if err := c.Do(ctx, &Request{Method: http.MethodGet, Path: "/api/health"}); err != nil {
+					t.Fatalf("Do() error: %v", err)
+				}
+			}
+
+			got := warnings.List()
+			if len(got) != tc.wantWarnings {
+				t.Fatalf("got %d warnings, want %d: %v", len(got), tc.wantWarnings, got)
+			}
+			if len(got) > 0 && got[0].Code != WarningClockSkew {
+				t.Errorf("warning code = %q, want %q", got[0].Code, WarningClockSkew)
+			}
+			skew, ok := c.LastClockSkew()
+			if !ok || (skew-tc.offset).Abs() > 2*time.Second {
+				t.Errorf("LastClockSkew() = %s, %t, want about %s", skew, ok, tc.offset)
+			}
+		})
+	}
+}
+
+func TestNew_GivenNegativeMaxClockSkew_ReturnsError(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(Config{URL: "http://arcane.local", MaxClockSkew: -time.Second}); err == nil {
+		t.Error("expected an error for a negative max clock skew")
+	}
+}
@@ -0,0 +1,125 @@
+package arcane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// ─── Concurrent use ───────────────────────────────────────────────────────────
+
+// TestClient_GivenConcurrentEnvironmentClients_WhenUsed_ThenConsistent uses one
+// Client from many goroutines, as parallel Terraform operations do, with every
+// feature that keeps state between requests enabled. Run with -race to check
+// that the state is synchronized.
+func TestClient_GivenConcurrentEnvironmentClients_WhenUsed_ThenConsistent(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Arcane-Version", "1.0.0")
+		etag := fmt.Sprintf("%q", r.URL.Path)
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Write([]byte(`{"success":true,"data":[{"id":"proj-1","name":"web"}]}`))
+	}))
+	defer srv.Close()
+
+	var logins atomic.Int32
+	c, err := New(Config{
+		URL:                                   srv.URL,
+		APIKeys:                               map[string]string{"deploy": "write-key"},
+		MaxConcurrentOperationsPerEnvironment: 2,
+		KeepaliveInterval:                     time.Minute,
+		MaxClockSkew:                          time.Hour,
+		RequestSigning:                        &RequestSigning{Key: []byte("secret")},
+		Login: func(ctx context.Context) (string, error) {
+			logins.Add(1)
+			return "token", nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	const workers = 16
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := context.Background()
+			if i%2 == 0 {
+				ctx = WithAPIKeyAlias(ctx, "deploy")
+			}
+			ec := c.ForEnvironment(fmt.Sprintf("env-%d", i%4))
+			for range 5 {
+				projects, err := ec.ListProjects(ctx)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if len(projects) != 1 || projects[0].ID != "proj-1" {
+					errs <- fmt.Errorf("unexpected projects %+v", projects)
+					return
+				}
+				unlock, _, err := ec.LockProject(ctx, "proj-1")
+				if err != nil {
+					errs <- err
+					return
+				}
+				unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+	if got := logins.Load(); got != 1 {
+		t.Errorf("expected 1 login shared by all goroutines, got %d", got)
+	}
+}
+
+// TestNew_GivenConfigChangedAfterwards_ThenClientUnaffected validates that the
+// Client does not share the maps, slices and keys of its Config with the caller.
+func TestNew_GivenConfigChangedAfterwards_ThenClientUnaffected(t *testing.T) {
+	t.Parallel()
+	var gotKey string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey = r.Header.Get("X-API-Key")
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	cfg := Config{
+		URL:            srv.URL,
+		APIKeys:        map[string]string{"deploy": "write-key"},
+		RequestSigning: &RequestSigning{Key: []byte("secret")},
+	}
+	c, err := New(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cfg.APIKeys["deploy"] = "changed"
+	cfg.RequestSigning.Key[0] = 'S'
+
+	if err := c.Do(WithAPIKeyAlias(context.Background(), "deploy"), &Request{Method: http.MethodGet, Path: "/api/environments"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotKey != "write-key" {
+		t.Errorf("expected the key configured at New, got %q", gotKey)
+	}
+	if string(c.signer.key) != "secret" {
+		t.Errorf("expected the signing key configured at New, got %q", c.signer.key)
+	}
+}
@@ -0,0 +1,34 @@
+// Package arcane is a Go client for the Arcane API. It is the client the
+// Terraform provider uses, so companion tooling such as CLIs and bots gets the
+// same behaviour: API key, session (see LoginFunc) and signed-request
+// authentication, a single retry with a fresh session token on 401, retries
+// with backoff of requests failing transiently (see Config.RetryMax), legacy
+// path rewriting for older servers, ETag revalidation of GET requests and
+// keepalive pings that fail requests early when the manager goes away.
+//
+// Create a Client with New, and use ForEnvironment for the endpoints scoped to
+// one environment:
+//
+//	c, err := arcane.New(arcane.Config{
+//		URL:    "https://arcane.example.com",
+//		APIKey: os.Getenv("ARCANE_API_KEY"),
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	projects, err := c.ForEnvironment(envID).ListProjects(ctx)
+//
+// Errors returned by the server are *APIError values; use IsNotFound,
+// IsConflict, IsTransient and IsUnreachable to classify them.
+//
+// The client logs requests, retries and conditions such as clock skew
+// through Config.Logger, which discards them when unset.
+//
+// # Compatibility
+//
+// The package is versioned with the provider module and follows semantic
+// versioning: exported identifiers are only removed or changed incompatibly
+// in a major release, and are deprecated with a "Deprecated:" comment at
+// least one minor release before. Fields added to request and response types
+// are not breaking changes.
+package arcane
@@ -0,0 +1,77 @@
+package arcane
+
+import (
+	"container/list"
+	"sync"
+)
+
+// etagCacheSize is the number of responses etagCache keeps before evicting
+// the least recently used one.
+const etagCacheSize = 512
+
+// etagCache remembers the ETag and body of GET responses by API key and URL,
+// so a refresh can revalidate them with If-None-Match and reuse the body when
+// Arcane answers 304 Not Modified. Responses are cached per key because
+// Arcane may show callers with different keys different data at the same URL.
+// The zero value is ready to use and safe for concurrent use.
+type etagCache struct {
+	mu      sync.Mutex
+	entries map[etagKey]*list.Element
+	// lru holds the *etagEntry values, most recently used first.
+	lru list.List
+}
+
+// etagKey identifies a cached response.
+type etagKey struct {
+	apiKey string
+	url    string
+}
+
+// etagEntry is a cached response body and its ETag.
+type etagEntry struct {
+	key  etagKey
+	etag string
+	body []byte
+}
+
+// get returns the entry cached for url with apiKey, if any.
+func (c *etagCache) get(apiKey, url string) (etagEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[etagKey{apiKey, url}]
+	if !ok {
+		return etagEntry{}, false
+	}
+	c.lru.MoveToFront(el)
+	return *el.Value.(*etagEntry), true
+}
+
+// put caches body for url with apiKey under etag, or forgets it if etag is
+// empty, evicting the least recently used entry when the cache is full.
+func (c *etagCache) put(apiKey, url, etag string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := etagKey{apiKey, url}
+	if el, ok := c.entries[key]; ok {
+		if etag == "" {
+			c.lru.Remove(el)
+			delete(c.entries, key)
+			return
+		}
+		*el.Value.(*etagEntry) = etagEntry{key: key, etag: etag, body: body}
+		c.lru.MoveToFront(el)
+		return
+	}
+	if etag == "" {
+		return
+	}
+	if c.entries == nil {
+		c.entries = make(map[etagKey]*list.Element)
+	}
+	c.entries[key] = c.lru.PushFront(&etagEntry{key: key, etag: etag, body: body})
+	if c.lru.Len() > etagCacheSize {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*etagEntry).key)
+	}
+}
@@ -0,0 +1,155 @@
+package arcane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// ─── ETag caching ─────────────────────────────────────────────────────────────
+
+// etagServer serves a single environment with ETag "v1", answering 304 to
+// matching If-None-Match headers, and counts full responses.
+func etagServer(t *testing.T, full *atomic.Int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full.Add(1)
+		w.Write([]byte(`{"data":{"id":"env-1","name":"prod"}}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestDo_GivenETag_ReusesBodyOnNotModified(t *testing.T) {
+	t.Parallel()
+	var full atomic.Int32
+	srv := etagServer(t, &full)
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+
+	for range 3 {
+		env, err := c.GetEnvironment(context.Background(), "env-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if env.Name != "prod" {
+			t.Fatalf("expected the cached environment, got %+v", env)
+		}
+	}
+	if got := full.Load(); got != 1 {
+		t.Errorf("expected 1 full response, got %d", got)
+	}
+}
+
+func TestDo_GivenETag_DoesNotRevalidateOtherMethods(t *testing.T) {
+	t.Parallel()
+	var full atomic.Int32
+	srv := etagServer(t, &full)
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+
+	if _, err := c.GetEnvironment(context.Background(), "env-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.UpdateEnvironment(context.Background(), "env-1", &EnvironmentUpdateRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := full.Load(); got != 2 {
+		t.Errorf("expected the update to get a full response, got %d full responses", got)
+	}
+}
+
+func TestDo_GivenResponseWithoutETag_ForgetsCachedBody(t *testing.T) {
+	t.Parallel()
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first response has an ETag, the second doesn't, so the third
+		// request has nothing to revalidate.
+		switch requests.Add(1) {
+		case 1:
+			w.Header().Set("ETag", `"v1"`)
+		case 3:
+			if inm := r.Header.Get("If-None-Match"); inm != "" {
+				t.Errorf("unexpected If-None-Match %q", inm)
+			}
+		}
+		w.Write([]byte(`{"data":{"id":"env-1"}}`))
+	}))
+	defer srv.Close()
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+
+	for range 3 {
+		if _, err := c.GetEnvironment(context.Background(), "env-1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestDo_GivenAPIKeyAlias_DoesNotReuseOtherKeysBody(t *testing.T) {
+	t.Parallel()
+	var full atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		etag := `"` + r.Header.Get("X-API-Key") + `"`
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full.Add(1)
+		fmt.Fprintf(w, `{"data":{"id":"env-1","name":%q}}`, r.Header.Get("X-API-Key"))
+	}))
+	defer srv.Close()
+	c, err := New(Config{URL: srv.URL, APIKey: "read-key", APIKeys: map[string]string{"admin": "admin-key"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, tc := range []struct{ alias, want string }{
+		{"", "read-key"}, {"admin", "admin-key"}, {"", "read-key"}, {"admin", "admin-key"},
+	} {
+		env, err := c.GetEnvironment(WithAPIKeyAlias(context.Background(), tc.alias), "env-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if env.Name != tc.want {
+			t.Errorf("alias %q: expected the body served to %q, got %q", tc.alias, tc.want, env.Name)
+		}
+	}
+	if got := full.Load(); got != 2 {
+		t.Errorf("expected 1 full response per key, got %d", got)
+	}
+}
+
+func TestETagCache_GivenFullCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+	var c etagCache
+	for i := range etagCacheSize {
+		c.put("key", fmt.Sprintf("/api/environments/%d", i), `"v1"`, nil)
+	}
+	// Touch the oldest entry so the second oldest is evicted instead.
+	if _, ok := c.get("key", "/api/environments/0"); !ok {
+		t.Fatal("expected the first entry to be cached")
+	}
+	c.put("key", "/api/environments/new", `"v1"`, nil)
+
+	if c.lru.Len() != etagCacheSize || len(c.entries) != etagCacheSize {
+		t.Errorf("expected %d entries, got %d in the list and %d in the map", etagCacheSize, c.lru.Len(), len(c.entries))
+	}
+	if _, ok := c.get("key", "/api/environments/1"); ok {
+		t.Error("expected the least recently used entry to be evicted")
+	}
+	for _, url := range []string{"/api/environments/0", "/api/environments/new"} {
+		if _, ok := c.get("key", url); !ok {
+			t.Errorf("expected %s to be cached", url)
+		}
+	}
+	if _, ok := c.get("other-key", "/api/environments/0"); ok {
+		t.Error("expected entries to be cached per API key")
+	}
+}
@@ -0,0 +1,132 @@
+package arcane_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/darshan-rambhia/terraform-provider-arcane/pkg/arcane"
+)
+
+// newExampleServer stands in for an Arcane manager with one environment.
+func newExampleServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/environments":
+			fmt.Fprint(w, `{"success":true,"data":[{"id":"env-1","name":"production","apiUrl":"http://10.0.0.5:3553"}]}`)
+		case "/api/environments/env-1/projects":
+			fmt.Fprint(w, `{"success":true,"data":[{"id":"proj-1","name":"web","status":"running"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, `{"message":"%s not found"}`, r.URL.Path)
+		}
+	}))
+}
+
+func ExampleNew() {
+	srv := newExampleServer()
+	defer srv.Close()
+
+	c, err := arcane.New(arcane.Config{URL: srv.URL, APIKey: "arc_example"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+	envs, err := c.ListEnvironments(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, env := range envs {
+		projects, err := c.ForEnvironment(env.ID).ListProjects(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, p := range projects {
+			fmt.Printf("%s/%s: %s\n", env.Name, p.Name, p.Status)
+		}
+	}
+	// Output: production/web: running
+}
+
+func ExampleIsNotFound() {
+	srv := newExampleServer()
+	defer srv.Close()
+
+	c, err := arcane.New(arcane.Config{URL: srv.URL, APIKey: "arc_example"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = c.GetEnvironment(context.Background(), "env-2")
+	if arcane.IsNotFound(err) {
+		fmt.Println("env-2 does not exist")
+	}
+	var apiErr *arcane.APIError
+	if errors.As(err, &apiErr) {
+		fmt.Println(apiErr.StatusCode)
+	}
+	// Output:
+	// env-2 does not exist
+	// 404
+}
+
+func ExampleWithAPIKeyAlias() {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Println(r.Header.Get("X-API-Key"))
+		fmt.Fprint(w, `{"success":true,"data":[]}`)
+	}))
+	defer srv.Close()
+
+	c, err := arcane.New(arcane.Config{
+		URL:     srv.URL,
+		APIKey:  "arc_read_only",
+		APIKeys: map[string]string{"deploy": "arc_deploy"},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if _, err := c.ListEnvironments(ctx); err != nil {
+		log.Fatal(err)
+	}
+	if _, err := c.ListEnvironments(arcane.WithAPIKeyAlias(ctx, "deploy")); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// arc_read_only
+	// arc_deploy
+}
+
+func ExampleLoginFunc() {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer session-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"success":true,"data":[]}`)
+	}))
+	defer srv.Close()
+
+	// The first token is rejected; the client logs in again and retries.
+	logins := 0
+	c, err := arcane.New(arcane.Config{
+		URL: srv.URL,
+		Login: func(ctx context.Context) (string, error) {
+			logins++
+			return fmt.Sprintf("session-%d", logins), nil
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	envs, err := c.ListEnvironments(context.Background())
+	fmt.Println(len(envs), err, logins)
+	// Output: 0 <nil> 2
+}
@@ -0,0 +1,85 @@
+package arcane
+
+import (
+	"context"
+	"time"
+)
+
+// Job statuses reported by Arcane.
+const (
+	JobQueued    = "queued"
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+	JobCancelled = "cancelled"
+)
+
+// Job is an operation Arcane runs in the background, such as a deployment, a
+// GitOps sync, a prune or an image pull.
+type Job struct {
+	ID         string `json:"id"`
+	Type       string `json:"type,omitempty"`
+	ResourceID string `json:"resourceId,omitempty"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	LogURL     string `json:"logUrl,omitempty"`
+	StartedAt  string `json:"startedAt,omitempty"`
+	FinishedAt string `json:"finishedAt,omitempty"`
+}
+
+// Done reports whether the job has finished, successfully or not.
+func (j *Job) Done() bool {
+	switch j.Status {
+	case JobSucceeded, JobFailed, JobCancelled:
+		return true
+	}
+	return false
+}
+
+// jobStarted is the response to a request that starts a job.
+type jobStarted struct {
+	JobID string `json:"jobId,omitempty"`
+}
+
+// Polling intervals of WaitForJob. The interval starts at jobPollInitial and
+// doubles after every poll up to jobPollMax, so short jobs are picked up
+// quickly without polling long ones every half second.
+var (
+	jobPollInitial = 500 * time.Millisecond
+	jobPollMax     = 5 * time.Second
+)
+
+// GetJob retrieves a job by ID.
+func (ec *EnvironmentClient) GetJob(ctx context.Context, jobID string) (*Job, error) {
+	return getSingle[Job](ctx, ec.client, "/api/environments/"+esc(ec.environmentID)+"/jobs/"+esc(jobID))
+}
+
+// WaitForJob polls a job with backoff until it is done and returns it. A
+// failed or cancelled job is returned without error; callers check its
+// Status. When timeout elapses first, the last observed job is returned, for
+// which Done is false.
+func (ec *EnvironmentClient) WaitForJob(ctx context.Context, jobID string, timeout time.Duration) (*Job, error) {
+	deadline := time.Now().Add(timeout)
+	delay := jobPollInitial
+
+	for {
+		job, err := ec.GetJob(ctx, jobID)
+		if err != nil {
+			return nil, err
+		}
+		remaining := time.Until(deadline)
+		if job.Done() || remaining <= 0 {
+			return job, nil
+		}
+
+		waitStart := time.Now()
+		select {
+		case <-ctx.Done():
+			ec.client.stats.waitedSince(waitStart)
+			return nil, ctx.Err()
+		case <-time.After(min(delay, remaining)):
+		}
+		ec.client.stats.waitedSince(waitStart)
+		delay = min(delay*2, jobPollMax)
+	}
+}
@@ -0,0 +1,110 @@
+package arcane
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// ─── Jobs ─────────────────────────────────────────────────────────────────────
+
+func TestGetJob_ReturnsJob(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/environments/env-1/jobs/job-1" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"success":true,"data":{"id":"job-1","type":"deploy","resourceId":"proj-1","status":"failed","error":"boom","logUrl":"https://x/log"}}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	job, err := c.ForEnvironment("env-1").GetJob(context.Background(), "job-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.Type != "deploy" || job.ResourceID != "proj-1" || job.Status != JobFailed || job.Error != "boom" || job.LogURL != "https://x/log" {
+		t.Errorf("unexpected job: %+v", job)
+	}
+}
+
+func TestJob_Done(t *testing.T) {
+	t.Parallel()
+	cases := map[string]bool{
+		JobQueued:    false,
+		JobRunning:   false,
+		JobSucceeded: true,
+		JobFailed:    true,
+		JobCancelled: true,
+		"":           false,
+	}
+	for status, want := range cases {
+		if got := (&Job{Status: status}).Done(); got != want {
+			t.Errorf("Done() for %q = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestWaitForJob_GivenJobFinishes_ReturnsFinishedJob(t *testing.T) {
+	t.Parallel()
+	var polls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if polls.Add(1) < 2 {
+			w.Write([]byte(`{"success":true,"data":{"id":"job-1","status":"running"}}`))
+			return
+		}
+		w.Write([]byte(`{"success":true,"data":{"id":"job-1","status":"succeeded"}}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	job, err := c.ForEnvironment("env-1").WaitForJob(context.Background(), "job-1", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.Status != JobSucceeded {
+		t.Errorf("expected succeeded job, got %+v", job)
+	}
+	if polls.Load() != 2 {
+		t.Errorf("expected 2 polls, got %d", polls.Load())
+	}
+}
+
+func TestWaitForJob_GivenTimeout_ReturnsRunningJob(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":true,"data":{"id":"job-1","status":"running"}}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	start := time.Now()
+	job, err := c.ForEnvironment("env-1").WaitForJob(context.Background(), "job-1", 50*time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.Done() {
+		t.Errorf("expected unfinished job, got %+v", job)
+	}
+	if elapsed := time.Since(start); elapsed > jobPollInitial {
+		t.Errorf("expected to stop polling at the timeout, took %s", elapsed)
+	}
+}
+
+func TestWaitForJob_GivenCancelledContext_ReturnsContextError(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":true,"data":{"id":"job-1","status":"queued"}}`))
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	if _, err := c.ForEnvironment("env-1").WaitForJob(ctx, "job-1", time.Minute); err == nil {
+		t.Error("expected context error")
+	}
+}
@@ -0,0 +1,123 @@
+package arcane
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Arcane servers have changed field casing between releases (e.g. `apiUrl` vs
+// `api_url`, `use_api_key` vs `useApiKey`). Decoding with the struct tags alone
+// silently drops values sent in the "other" casing, so the types most affected
+// implement UnmarshalJSON via unmarshalCaseTolerant.
+
+// UnmarshalJSON decodes an Environment, accepting camelCase and snake_case keys.
+func (e *Environment) UnmarshalJSON(data []byte) error {
+	type environment Environment
+	return unmarshalCaseTolerant(data, (*environment)(e))
+}
+
+// UnmarshalJSON decodes a Project, accepting camelCase and snake_case keys.
+func (p *Project) UnmarshalJSON(data []byte) error {
+	type project Project
+	return unmarshalCaseTolerant(data, (*project)(p))
+}
+
+// UnmarshalJSON decodes a GitOpsSync, accepting camelCase and snake_case keys.
+// Servers that normalize sync_interval report it as a number of seconds, which
+// is decoded as its decimal string, e.g. "300".
+func (s *GitOpsSync) UnmarshalJSON(data []byte) error {
+	type gitOpsSync GitOpsSync
+	return unmarshalCaseTolerant(quoteNumericField(data, "sync_interval"), (*gitOpsSync)(s))
+}
+
+// UnmarshalJSON decodes a GitOpsSyncRun, accepting camelCase and snake_case keys.
+func (r *GitOpsSyncRun) UnmarshalJSON(data []byte) error {
+	type gitOpsSyncRun GitOpsSyncRun
+	return unmarshalCaseTolerant(data, (*gitOpsSyncRun)(r))
+}
+
+// quoteNumericField returns data with a numeric value of field, in any casing,
+// replaced by the number as a JSON string. Data that is not an object or has
+// no numeric value of field is returned unchanged.
+func quoteNumericField(data []byte, field string) []byte {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil || raw == nil {
+		return data
+	}
+	changed := false
+	for key, val := range raw {
+		if normalizeJSONKey(key) != normalizeJSONKey(field) {
+			continue
+		}
+		var n json.Number
+		if len(val) == 0 || val[0] == '"' || json.Unmarshal(val, &n) != nil || n == "" {
+			continue
+		}
+		raw[key], _ = json.Marshal(n.String())
+		changed = true
+	}
+	if !changed {
+		return data
+	}
+	rewritten, err := json.Marshal(raw)
+	if err != nil {
+		return data
+	}
+	return rewritten
+}
+
+// unmarshalCaseTolerant decodes a JSON object into v (a pointer to a struct),
+// renaming incoming keys to the struct's json tag names when they differ only
+// in casing or underscores. A key that already matches a tag exactly always
+// wins over an alternative spelling of the same field.
+func unmarshalCaseTolerant(data []byte, v interface{}) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil || raw == nil {
+		// Not an object (or null) — let the standard decoder handle it.
+		return json.Unmarshal(data, v)
+	}
+
+	known := jsonFieldNames(reflect.TypeOf(v).Elem())
+	normalized := make(map[string]json.RawMessage, len(raw))
+	for key, val := range raw {
+		canonical, ok := known[normalizeJSONKey(key)]
+		if !ok {
+			normalized[key] = val
+			continue
+		}
+		if canonical != key {
+			if _, present := raw[canonical]; present {
+				continue
+			}
+		}
+		normalized[canonical] = val
+	}
+
+	rewritten, err := json.Marshal(normalized)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(rewritten, v)
+}
+
+// jsonFieldNames maps normalized json tag names of a struct type to the tag
+// names themselves.
+func jsonFieldNames(t reflect.Type) map[string]string {
+	names := make(map[string]string, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		names[normalizeJSONKey(name)] = name
+	}
+	return names
+}
+
+// normalizeJSONKey lowercases a key and strips `_` and `-` so that
+// `apiUrl`, `api_url` and `API-URL` compare equal.
+func normalizeJSONKey(key string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+}
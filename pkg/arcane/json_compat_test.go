@@ -0,0 +1,143 @@
+package arcane
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// ─── Field casing compatibility ───────────────────────────────────────────────
+
+func TestEnvironment_UnmarshalJSON_GivenCamelCase_DecodesAllFields(t *testing.T) {
+	t.Parallel()
+	var env Environment
+	err := json.Unmarshal([]byte(`{
+		"id": "env-1",
+		"name": "prod",
+		"apiUrl": "http://agent:3553",
+		"useApiKey": true,
+		"accessToken": "tok",
+		"createdAt": "2026-01-01T00:00:00Z"
+	}`), &env)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if env.APIURL != "http://agent:3553" {
+		t.Errorf("expected APIURL http://agent:3553, got %q", env.APIURL)
+	}
+	if !env.UseAPIKey {
+		t.Error("expected UseAPIKey true")
+	}
+	if env.AccessToken != "tok" {
+		t.Errorf("expected AccessToken tok, got %q", env.AccessToken)
+	}
+	if env.CreatedAt != "2026-01-01T00:00:00Z" {
+		t.Errorf("expected CreatedAt to be decoded, got %q", env.CreatedAt)
+	}
+}
+
+func TestEnvironment_UnmarshalJSON_GivenSnakeCase_DecodesAllFields(t *testing.T) {
+	t.Parallel()
+	var env Environment
+	err := json.Unmarshal([]byte(`{
+		"id": "env-1",
+		"name": "prod",
+		"api_url": "http://agent:3553",
+		"use_api_key": true,
+		"api_key": "arc_abc"
+	}`), &env)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if env.APIURL != "http://agent:3553" {
+		t.Errorf("expected APIURL http://agent:3553, got %q", env.APIURL)
+	}
+	if !env.UseAPIKey {
+		t.Error("expected UseAPIKey true")
+	}
+	if env.APIKey != "arc_abc" {
+		t.Errorf("expected APIKey arc_abc, got %q", env.APIKey)
+	}
+}
+
+func TestEnvironment_UnmarshalJSON_GivenBothCasings_PrefersTagName(t *testing.T) {
+	t.Parallel()
+	var env Environment
+	err := json.Unmarshal([]byte(`{"apiUrl": "http://tagged", "api_url": "http://other"}`), &env)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if env.APIURL != "http://tagged" {
+		t.Errorf("expected tag-named key to win, got %q", env.APIURL)
+	}
+}
+
+func TestProject_UnmarshalJSON_GivenEitherCasing_DecodesEnvironmentID(t *testing.T) {
+	t.Parallel()
+	for _, body := range []string{
+		`{"id": "p1", "environment_id": "env-1", "services": [{"name": "web", "status": "running"}]}`,
+		`{"id": "p1", "environmentId": "env-1", "services": [{"name": "web", "status": "running"}]}`,
+	} {
+		var p Project
+		if err := json.Unmarshal([]byte(body), &p); err != nil {
+			t.Fatalf("unexpected error for %s: %v", body, err)
+		}
+		if p.EnvironmentID != "env-1" {
+			t.Errorf("expected EnvironmentID env-1 for %s, got %q", body, p.EnvironmentID)
+		}
+		if len(p.Services) != 1 || p.Services[0].Name != "web" {
+			t.Errorf("expected nested services to decode for %s, got %+v", body, p.Services)
+		}
+	}
+}
+
+func TestGitOpsSync_UnmarshalJSON_GivenEitherCasing_DecodesAllFields(t *testing.T) {
+	t.Parallel()
+	for _, body := range []string{
+		`{"id": "s1", "repository_id": "r1", "compose_file": "c.yml", "sync_interval": "5m", "auto_sync": true, "last_sync_commit": "abc"}`,
+		`{"id": "s1", "repositoryId": "r1", "composeFile": "c.yml", "syncInterval": "5m", "autoSync": true, "lastSyncCommit": "abc"}`,
+	} {
+		var s GitOpsSync
+		if err := json.Unmarshal([]byte(body), &s); err != nil {
+			t.Fatalf("unexpected error for %s: %v", body, err)
+		}
+		if s.RepositoryID != "r1" || s.ComposeFile != "c.yml" || s.SyncInterval != "5m" || !s.AutoSync || s.LastSyncCommit != "abc" {
+			t.Errorf("unexpected decode for %s: %+v", body, s)
+		}
+	}
+}
+
+func TestGitOpsSync_UnmarshalJSON_GivenNumericSyncInterval_DecodesSeconds(t *testing.T) {
+	t.Parallel()
+	for _, body := range []string{
+		`{"id": "s1", "sync_interval": 300}`,
+		`{"id": "s1", "syncInterval": 300}`,
+	} {
+		var s GitOpsSync
+		if err := json.Unmarshal([]byte(body), &s); err != nil {
+			t.Fatalf("unexpected error for %s: %v", body, err)
+		}
+		if s.SyncInterval != "300" {
+			t.Errorf("expected SyncInterval 300 for %s, got %q", body, s.SyncInterval)
+		}
+	}
+}
+
+func TestPaginatedResponse_GivenSnakeCaseItems_DecodesEachItem(t *testing.T) {
+	t.Parallel()
+	var result PaginatedResponse[Environment]
+	err := json.Unmarshal([]byte(`{"success": true, "data": [{"id": "env-1", "api_url": "http://a"}, {"id": "env-2", "apiUrl": "http://b"}]}`), &result)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Data[0].APIURL != "http://a" || result.Data[1].APIURL != "http://b" {
+		t.Errorf("expected both items decoded, got %+v", result.Data)
+	}
+}
+
+func TestEnvironment_UnmarshalJSON_GivenNonObject_ReturnsError(t *testing.T) {
+	t.Parallel()
+	var env Environment
+	if err := json.Unmarshal([]byte(`"not an object"`), &env); err == nil {
+		t.Fatal("expected error for non-object JSON")
+	}
+}
@@ -0,0 +1,165 @@
+package arcane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// UnreachableError is returned for requests failed by the keep-alive check
+// because the manager stopped answering.
+type UnreachableError struct {
+	// Since is when the first failed ping was sent.
+	Since time.Time
+	// Err is the error of the last failed ping.
+	Err error
+}
+
+func (e *UnreachableError) Error() string {
+	return fmt.Sprintf("manager unreachable since %s: %v", e.Since.UTC().Format(time.RFC3339), e.Err)
+}
+
+func (e *UnreachableError) Unwrap() error {
+	return e.Err
+}
+
+// IsUnreachable returns true if the error is an UnreachableError.
+func IsUnreachable(err error) bool {
+	var unreachable *UnreachableError
+	return errors.As(err, &unreachable)
+}
+
+// keepalive pings the manager while requests are in flight and, once a ping
+// fails, cancels them with an UnreachableError instead of leaving each to run
+// into the HTTP client timeout. Requests started shortly after a failed ping
+// fail immediately; later ones are attempted again, so a manager that comes
+// back is picked up.
+type keepalive struct {
+	interval time.Duration
+	ping     func(ctx context.Context) error
+
+	mu        sync.Mutex
+	running   bool
+	nextID    int
+	inflight  map[int]context.CancelCauseFunc
+	downSince time.Time
+	lastErr   error
+	lastPing  time.Time
+}
+
+// newKeepalive returns a keepalive pinging c's manager every interval.
+func newKeepalive(c *Client, interval time.Duration) *keepalive {
+	return &keepalive{
+		interval: interval,
+		ping:     c.ping,
+		inflight: make(map[int]context.CancelCauseFunc),
+	}
+}
+
+// check returns an UnreachableError if the last ping, less than an interval
+// ago, failed.
+func (k *keepalive) check() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.downSince.IsZero() || time.Since(k.lastPing) >= k.interval {
+		return nil
+	}
+	return &UnreachableError{Since: k.downSince, Err: k.lastErr}
+}
+
+// track registers a request and starts pinging if needed. The returned
+// context is cancelled with an UnreachableError when a ping fails; the
+// returned function must be called once the request is done.
+func (k *keepalive) track(ctx context.Context) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	k.mu.Lock()
+	id := k.nextID
+	k.nextID++
+	k.inflight[id] = cancel
+	if !k.running {
+		k.running = true
+		go k.run()
+	}
+	k.mu.Unlock()
+
+	return ctx, func() {
+		k.mu.Lock()
+		delete(k.inflight, id)
+		k.mu.Unlock()
+		cancel(nil)
+	}
+}
+
+// run pings every interval until no request is in flight.
+func (k *keepalive) run() {
+	ticker := time.NewTicker(k.interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		k.mu.Lock()
+		if len(k.inflight) == 0 {
+			k.running = false
+			k.mu.Unlock()
+			return
+		}
+		k.mu.Unlock()
+
+		sent := time.Now()
+		ctx, cancel := context.WithTimeout(context.Background(), k.interval)
+		err := k.ping(ctx)
+		cancel()
+
+		k.mu.Lock()
+		k.lastPing = time.Now()
+		if err == nil {
+			k.downSince = time.Time{}
+			k.lastErr = nil
+		} else {
+			if k.downSince.IsZero() {
+				k.downSince = sent
+			}
+			k.lastErr = err
+			unreachable := &UnreachableError{Since: k.downSince, Err: err}
+			for id, cancelRequest := range k.inflight {
+				cancelRequest(unreachable)
+				delete(k.inflight, id)
+			}
+		}
+		k.mu.Unlock()
+	}
+}
+
+// ping checks that the manager answers. Any HTTP response counts, except the
+// 502/503/504 a reverse proxy returns while the manager is down.
+func (c *Client) ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"/api/health", nil)
+	if err != nil {
+		return err
+	}
+	if c.signer != nil {
+		c.signer.sign(req, nil, time.Now())
+	}
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return fmt.Errorf("health check returned HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// unreachableCause returns the UnreachableError ctx was cancelled with, if any.
+func unreachableCause(ctx context.Context) error {
+	if cause := context.Cause(ctx); IsUnreachable(cause) {
+		return cause
+	}
+	return nil
+}
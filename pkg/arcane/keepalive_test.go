@@ -0,0 +1,127 @@
+package arcane
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// ─── Keep-alive ───────────────────────────────────────────────────────────────
+
+// keepaliveServer serves /api/health with the status in health and blocks
+// /api/slow until the client disconnects or the test ends.
+func keepaliveServer(t *testing.T, health *atomic.Int32, slowCalls *atomic.Int32) *httptest.Server {
+	t.Helper()
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/health":
+			w.WriteHeader(int(health.Load()))
+		case "/api/slow":
+			slowCalls.Add(1)
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+	return srv
+}
+
+func TestDo_GivenKeepaliveAndManagerGone_FailsPendingRequestQuickly(t *testing.T) {
+	t.Parallel()
+	var health, slowCalls atomic.Int32
+	health.Store(http.StatusBadGateway)
+	srv := keepaliveServer(t, &health, &slowCalls)
+
+	c, err := New(Config{URL: srv.URL, KeepaliveInterval: 200 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	start := time.Now()
+	err = c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/slow"})
+	var unreachable *UnreachableError
+	if !errors.As(err, &unreachable) {
+		t.Fatalf("expected UnreachableError, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the request to fail within a few pings, took %s", elapsed)
+	}
+	if unreachable.Since.Before(start) {
+		t.Errorf("Since = %s, before the request started at %s", unreachable.Since, start)
+	}
+
+	// A request right after the failed ping fails without reaching Arcane.
+	err = c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/slow"})
+	if !IsUnreachable(err) {
+		t.Errorf("expected the next request to fail fast, got %v", err)
+	}
+	if got := slowCalls.Load(); got != 1 {
+		t.Errorf("expected 1 request to reach Arcane, got %d", got)
+	}
+}
+
+func TestDo_GivenKeepaliveAndManagerBack_RetriesAfterInterval(t *testing.T) {
+	t.Parallel()
+	var health, slowCalls atomic.Int32
+	health.Store(http.StatusServiceUnavailable)
+	srv := keepaliveServer(t, &health, &slowCalls)
+
+	c, err := New(Config{URL: srv.URL, KeepaliveInterval: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/slow"}); !IsUnreachable(err) {
+		t.Fatalf("expected UnreachableError, got %v", err)
+	}
+
+	health.Store(http.StatusOK)
+	time.Sleep(100 * time.Millisecond)
+	if err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/fast"}); err != nil {
+		t.Errorf("expected the request to reach Arcane again, got %v", err)
+	}
+}
+
+func TestDo_GivenKeepaliveAndHealthyManager_CompletesSlowRequest(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/slow" {
+			time.Sleep(150 * time.Millisecond)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c, err := New(Config{URL: srv.URL, KeepaliveInterval: 20 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/slow"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestIsTransient_GivenUnreachableError_ReturnsFalse(t *testing.T) {
+	t.Parallel()
+	err := &UnreachableError{Since: time.Now(), Err: &APIError{StatusCode: http.StatusBadGateway}}
+	if IsTransient(err) {
+		t.Error("expected UnreachableError not to be transient")
+	}
+}
+
+func TestNew_GivenNegativeKeepaliveInterval_ReturnsError(t *testing.T) {
+	t.Parallel()
+	if _, err := New(Config{URL: "http://localhost", KeepaliveInterval: -time.Second}); err == nil {
+		t.Error("expected error for negative keepalive interval")
+	}
+}
@@ -0,0 +1,89 @@
+package arcane
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// keyedSemaphore is a set of context-aware counting semaphores identified by
+// string keys. Each key admits up to size concurrent holders; a size of zero
+// behaves as one, making the zero value a ready-to-use keyed mutex.
+type keyedSemaphore struct {
+	size int
+
+	mu    sync.Mutex
+	slots map[string]chan struct{}
+}
+
+// slotsFor returns the semaphore channel for key, creating it on first use.
+func (s *keyedSemaphore) slotsFor(key string) chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.slots == nil {
+		s.slots = make(map[string]chan struct{})
+	}
+	ch, ok := s.slots[key]
+	if !ok {
+		ch = make(chan struct{}, max(s.size, 1))
+		s.slots[key] = ch
+	}
+	return ch
+}
+
+// acquire blocks until a slot for key is available or ctx is done. The
+// returned function releases the slot and must be called exactly once.
+func (s *keyedSemaphore) acquire(ctx context.Context, key string) (func(), error) {
+	ch := s.slotsFor(key)
+	select {
+	case ch <- struct{}{}:
+		return func() { <-ch }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// tryAcquire takes a slot for key without blocking. It reports false when all
+// slots are held.
+func (s *keyedSemaphore) tryAcquire(key string) (func(), bool) {
+	ch := s.slotsFor(key)
+	select {
+	case ch <- struct{}{}:
+		return func() { <-ch }, true
+	default:
+		return nil, false
+	}
+}
+
+// LockProject serializes deployment operations on a project within this
+// client. Terraform applies resources in parallel, so two resources targeting
+// the same project would otherwise interleave up/redeploy/down calls. The call
+// blocks until the lock is free or ctx is done; the returned function releases
+// it. The boolean reports whether the lock was contended, i.e. another
+// deployment of the same project was in progress when the call was made.
+func (ec *EnvironmentClient) LockProject(ctx context.Context, projectID string) (unlock func(), waited bool, err error) {
+	key := ec.environmentID + "/" + projectID
+	if unlock, ok := ec.client.projectLocks.tryAcquire(key); ok {
+		return unlock, false, nil
+	}
+	defer ec.client.stats.waitedSince(time.Now())
+	unlock, err = ec.client.projectLocks.acquire(ctx, key)
+	return unlock, true, err
+}
+
+// acquireOperationSlot blocks until the environment has a free slot for a
+// heavy agent operation (up, redeploy, down) when the client was configured
+// with MaxConcurrentOperationsPerEnvironment. Without a limit it returns
+// immediately.
+func (ec *EnvironmentClient) acquireOperationSlot(ctx context.Context) (func(), error) {
+	if ec.client.environmentOps == nil {
+		return func() {}, nil
+	}
+	defer ec.client.stats.waitedSince(time.Now())
+	release, err := ec.client.environmentOps.acquire(ctx, ec.environmentID)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for a free operation slot on environment %s: %w", ec.environmentID, err)
+	}
+	return release, nil
+}
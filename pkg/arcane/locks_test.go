@@ -0,0 +1,131 @@
+package arcane
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLockProject_GivenFreeLock_AcquiresWithoutWaiting(t *testing.T) {
+	t.Parallel()
+	c := &Client{}
+	unlock, waited, err := c.ForEnvironment("env-1").LockProject(context.Background(), "proj-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer unlock()
+	if waited {
+		t.Error("expected uncontended lock not to wait")
+	}
+}
+
+func TestLockProject_GivenHeldLock_WaitsUntilReleased(t *testing.T) {
+	t.Parallel()
+	c := &Client{}
+	first, _, err := c.ForEnvironment("env-1").LockProject(context.Background(), "proj-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	released := make(chan struct{})
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(released)
+		first()
+	}()
+
+	second, waited, err := c.ForEnvironment("env-1").LockProject(context.Background(), "proj-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer second()
+	if !waited {
+		t.Error("expected contended lock to report waiting")
+	}
+	select {
+	case <-released:
+	default:
+		t.Error("second lock acquired before the first was released")
+	}
+}
+
+func TestLockProject_GivenDifferentProjects_DoNotBlock(t *testing.T) {
+	t.Parallel()
+	c := &Client{}
+	a, _, _ := c.ForEnvironment("env-1").LockProject(context.Background(), "proj-1")
+	defer a()
+	b, waited, err := c.ForEnvironment("env-2").LockProject(context.Background(), "proj-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer b()
+	if waited {
+		t.Error("expected locks for different environments to be independent")
+	}
+}
+
+func TestLockProject_GivenCancelledContext_ReturnsError(t *testing.T) {
+	t.Parallel()
+	c := &Client{}
+	held, _, _ := c.ForEnvironment("env-1").LockProject(context.Background(), "proj-1")
+	defer held()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err := c.ForEnvironment("env-1").LockProject(ctx, "proj-1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestDeployProject_GivenOperationLimit_SerializesCallsPerEnvironment(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	inFlight, peak := map[string]int{}, map[string]int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		env := strings.Split(r.URL.Path, "/")[3]
+		mu.Lock()
+		inFlight[env]++
+		peak[env] = max(peak[env], inFlight[env])
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight[env]--
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	c, err := New(Config{URL: srv.URL, MaxConcurrentOperationsPerEnvironment: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, env := range []string{"env-1", "env-1", "env-1", "env-2", "env-2"} {
+		wg.Add(1)
+		go func(env string) {
+			defer wg.Done()
+			if _, err := c.ForEnvironment(env).DeployProject(context.Background(), "proj", nil); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}(env)
+	}
+	wg.Wait()
+
+	if peak["env-1"] != 1 || peak["env-2"] != 1 {
+		t.Errorf("expected at most one concurrent operation per environment, got %v", peak)
+	}
+}
+
+func TestNew_GivenNegativeOperationLimit_ReturnsError(t *testing.T) {
+	t.Parallel()
+	_, err := New(Config{URL: "http://localhost:8000", MaxConcurrentOperationsPerEnvironment: -1})
+	if err == nil {
+		t.Fatal("expected error for negative operation limit")
+	}
+}
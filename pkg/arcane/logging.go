@@ -0,0 +1,167 @@
+package arcane
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Logger receives the client's log entries: requests and their outcome at
+// the debug level, request and response details at the trace level, and
+// conditions worth a user's attention, such as clock skew, at the warn
+// level. fields carry the entry's structured data. A Logger must be safe for
+// concurrent use. The Terraform provider logs through tflog; other programs
+// can adapt the logger they use.
+type Logger interface {
+	Trace(ctx context.Context, msg string, fields map[string]interface{})
+	Debug(ctx context.Context, msg string, fields map[string]interface{})
+	Warn(ctx context.Context, msg string, fields map[string]interface{})
+}
+
+// discardLogger is the Logger of a client configured without one.
+type discardLogger struct{}
+
+func (discardLogger) Trace(context.Context, string, map[string]interface{}) {}
+func (discardLogger) Debug(context.Context, string, map[string]interface{}) {}
+func (discardLogger) Warn(context.Context, string, map[string]interface{})  {}
+
+// log returns the client's Logger, which discards entries unless
+// Config.Logger is set.
+func (c *Client) log() Logger {
+	if c.logger == nil {
+		return discardLogger{}
+	}
+	return c.logger
+}
+
+// redacted replaces secrets in logged headers and bodies.
+const redacted = "REDACTED"
+
+// maxLoggedBody is the largest body logged in full; longer ones are cut.
+const maxLoggedBody = 64 << 10
+
+// sensitiveHeaders are the request headers whose values are never logged.
+var sensitiveHeaders = []string{"X-API-Key", "Authorization", SignatureHeader}
+
+// sensitiveFields are the JSON fields whose values are never logged, compared
+// ignoring case and underscores so that e.g. access_token and accessToken
+// both match. Project .env and compose content, project file content and
+// container environment variables commonly hold secrets too.
+var sensitiveFields = map[string]bool{
+	"apikey":         true,
+	"accesstoken":    true,
+	"token":          true,
+	"password":       true,
+	"credentials":    true,
+	"secret":         true,
+	"envcontent":     true,
+	"composecontent": true,
+	"content":        true,
+	"env":            true,
+}
+
+// logRequest logs an HTTP request sent to Arcane and its outcome at the DEBUG
+// level, and its headers and body at the TRACE level, with secrets redacted.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, body []byte, resp *http.Response, err error, d time.Duration) {
+	fields := map[string]interface{}{
+		"method":      req.Method,
+		"path":        req.URL.RequestURI(),
+		"duration_ms": d.Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	} else {
+		fields["status"] = resp.StatusCode
+	}
+	c.log().Debug(ctx, "Arcane API request", fields)
+
+	c.log().Trace(ctx, "Arcane API request details", map[string]interface{}{
+		"method":          req.Method,
+		"path":            req.URL.RequestURI(),
+		"request_headers": redactHeaders(req.Header),
+		"request_body":    loggedBody(body),
+	})
+}
+
+// logResponseBody logs the body of a response at the TRACE level, with
+// secrets redacted.
+func (c *Client) logResponseBody(ctx context.Context, method, path string, status int, body []byte) {
+	c.log().Trace(ctx, "Arcane API response details", map[string]interface{}{
+		"method":        method,
+		"path":          path,
+		"status":        status,
+		"response_body": loggedBody(body),
+	})
+}
+
+// loggedBody is a body logged with redactBody. Redacting is deferred until
+// the log entry is written, so bodies cost nothing unless TRACE logging is on.
+type loggedBody []byte
+
+func (b loggedBody) String() string {
+	return redactBody(b)
+}
+
+func (b loggedBody) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redactBody(b))
+}
+
+// redactHeaders returns h as a map of single values, with sensitive headers
+// replaced by redacted.
+func redactHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for name := range h {
+		out[name] = h.Get(name)
+	}
+	for _, name := range sensitiveHeaders {
+		if h.Get(name) != "" {
+			out[http.CanonicalHeaderKey(name)] = redacted
+		}
+	}
+	return out
+}
+
+// redactBody returns a JSON body with the values of sensitive fields, at any
+// depth, replaced by redacted. Bodies that aren't JSON are returned as is.
+// Both are cut to maxLoggedBody.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err == nil {
+		if out, err := json.Marshal(redactValue(v)); err == nil {
+			body = out
+		}
+	}
+	if len(body) > maxLoggedBody {
+		return fmt.Sprintf("%s... (%d bytes)", body[:maxLoggedBody], len(body))
+	}
+	return string(body)
+}
+
+// redactValue replaces the values of sensitive fields within a decoded JSON
+// value.
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if sensitiveFields[strings.ToLower(strings.ReplaceAll(key, "_", ""))] && value != nil && value != "" {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+	}
+	return v
+}
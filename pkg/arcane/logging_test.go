@@ -0,0 +1,173 @@
+package arcane
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// ─── Request logging ──────────────────────────────────────────────────────────
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		body string
+		want string
+	}{
+		"empty":              {body: "", want: ""},
+		"no secrets":         {body: `{"name":"prod","count":3}`, want: `{"count":3,"name":"prod"}`},
+		"password":           {body: `{"name":"ghcr","password":"hunter2"}`, want: `{"name":"ghcr","password":"REDACTED"}`},
+		"snake and camel":    {body: `{"access_token":"arc_1","accessToken":"arc_2","apiKey":"arc_3"}`, want: `{"accessToken":"REDACTED","access_token":"REDACTED","apiKey":"REDACTED"}`},
+		"nested in envelope": {body: `{"success":true,"data":[{"id":"r1","credentials":"ssh-key"}]}`, want: `{"data":[{"credentials":"REDACTED","id":"r1"}],"success":true}`},
+		"env content":        {body: `{"name":"web","envContent":"DB_PASSWORD=x"}`, want: `{"envContent":"REDACTED","name":"web"}`},
+		"compose content":    {body: `{"name":"web","composeContent":"environment: {DB_PASSWORD: x}"}`, want: `{"composeContent":"REDACTED","name":"web"}`},
+		"file content":       {body: `{"path":"secrets.env","content":"REJfUEFTU1dPUkQ9eA=="}`, want: `{"content":"REDACTED","path":"secrets.env"}`},
+		"container env":      {body: `{"data":[{"id":"c1","env":["DB_PASSWORD=x","TZ=UTC"]}]}`, want: `{"data":[{"env":"REDACTED","id":"c1"}]}`},
+		"empty secret kept":  {body: `{"password":""}`, want: `{"password":""}`},
+		"use_api_key kept":   {body: `{"use_api_key":true}`, want: `{"use_api_key":true}`},
+		"large number kept":  {body: `{"size":12345678901234567890}`, want: `{"size":12345678901234567890}`},
+		"not json":           {body: "<html>login</html>", want: "<html>login</html>"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := redactBody([]byte(tc.body)); got != tc.want {
+				t.Errorf("redactBody(%s) = %s, want %s", tc.body, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRedactBody_GivenLargeBody_ThenCut(t *testing.T) {
+	t.Parallel()
+	body := strings.Repeat("x", maxLoggedBody+10)
+	got := redactBody([]byte(body))
+	if !strings.HasSuffix(got, "... (65546 bytes)") || len(got) > maxLoggedBody+20 {
+		t.Errorf("expected the body to be cut, got %d bytes ending %q", len(got), got[len(got)-20:])
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	t.Parallel()
+	h := http.Header{}
+	h.Set("X-API-Key", "arc_secret")
+	h.Set("Authorization", "Bearer token")
+	h.Set(SignatureHeader, "hmac-sha256=abc")
+	h.Set("Accept", "application/json")
+
+	got := redactHeaders(h)
+	for _, name := range []string{"X-Api-Key", "Authorization", SignatureHeader} {
+		if got[name] != redacted {
+			t.Errorf("expected %s to be redacted, got %q", name, got[name])
+		}
+	}
+	if got["Accept"] != "application/json" {
+		t.Errorf("expected Accept to be logged, got %q", got["Accept"])
+	}
+}
+
+// logEntry is a log entry recorded by recordingLogger.
+type logEntry struct {
+	Level   string
+	Message string
+	Fields  map[string]interface{}
+}
+
+// recordingLogger is a Logger that records its entries.
+type recordingLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+func (l *recordingLogger) record(level, msg string, fields map[string]interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, logEntry{Level: level, Message: msg, Fields: fields})
+}
+
+func (l *recordingLogger) Trace(_ context.Context, msg string, fields map[string]interface{}) {
+	l.record("trace", msg, fields)
+}
+
+func (l *recordingLogger) Debug(_ context.Context, msg string, fields map[string]interface{}) {
+	l.record("debug", msg, fields)
+}
+
+func (l *recordingLogger) Warn(_ context.Context, msg string, fields map[string]interface{}) {
+	l.record("warn", msg, fields)
+}
+
+// TestDo_GivenLogger_LogsRequestsWithoutSecrets validates that requests are
+// logged with their status and bodies, and that no secret reaches the log.
+func TestDo_GivenLogger_LogsRequestsWithoutSecrets(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message":"invalid registry"}`))
+	}))
+	defer srv.Close()
+
+	logger := &recordingLogger{}
+	c := &Client{BaseURL: srv.URL, APIKey: "arc_key", HTTPClient: srv.Client(), logger: logger}
+	_, err := c.CreateContainerRegistry(context.Background(), &ContainerRegistryCreateRequest{Name: "ghcr", URL: "ghcr.io", Password: "hunter2"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected a bad request error, got %v", err)
+	}
+
+	// Encode the entries as a JSON logger would, rendering redacted bodies.
+	out, err := json.Marshal(logger.entries)
+	if err != nil {
+		t.Fatalf("failed to encode log: %v", err)
+	}
+	var entries []logEntry
+	if err := json.Unmarshal(out, &entries); err != nil {
+		t.Fatalf("failed to decode log: %v", err)
+	}
+	byMessage := map[string]logEntry{}
+	for _, entry := range entries {
+		byMessage[entry.Message] = entry
+	}
+
+	summary := byMessage["Arcane API request"]
+	if summary.Level != "debug" || summary.Fields["method"] != "POST" || summary.Fields["path"] != "/api/container-registries" || summary.Fields["status"] != float64(400) {
+		t.Errorf("expected the request to be logged at debug level with its status, got %+v", summary)
+	}
+	if _, ok := summary.Fields["duration_ms"]; !ok {
+		t.Error("expected the request duration to be logged")
+	}
+	details := byMessage["Arcane API request details"]
+	if body, _ := details.Fields["request_body"].(string); details.Level != "trace" || !strings.Contains(body, `"name":"ghcr"`) {
+		t.Errorf("expected the request body to be logged at trace level, got %+v", details)
+	}
+	response := byMessage["Arcane API response details"]
+	if body, _ := response.Fields["response_body"].(string); !strings.Contains(body, "invalid registry") {
+		t.Errorf("expected the response body to be logged, got %+v", response)
+	}
+	if strings.Contains(string(out), "arc_key") || strings.Contains(string(out), "hunter2") {
+		t.Errorf("expected secrets to be redacted from the log, got:\n%s", out)
+	}
+}
+
+// TestDo_GivenNoLogger_ThenSucceeds validates that a client configured
+// without a Logger discards its log entries.
+func TestDo_GivenNoLogger_ThenSucceeds(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":true,"data":[]}`))
+	}))
+	defer srv.Close()
+
+	c, err := New(Config{URL: srv.URL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.ListEnvironments(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
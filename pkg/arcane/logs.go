@@ -0,0 +1,66 @@
+package arcane
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// LogsOptions selects the log lines returned by GetProjectLogs and
+// GetContainerLogs.
+type LogsOptions struct {
+	// Tail is the number of lines to return from the end of the logs; all
+	// lines when zero
+	Tail int
+	// Since only returns lines newer than this, as an RFC 3339 timestamp or a
+	// relative duration such as 10m; all lines when empty
+	Since string
+	// Timestamps prefixes every line with its RFC 3339 timestamp
+	Timestamps bool
+}
+
+// query returns the query parameters of o.
+func (o *LogsOptions) query() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if o.Tail > 0 {
+		q.Set("tail", strconv.Itoa(o.Tail))
+	}
+	if o.Since != "" {
+		q.Set("since", o.Since)
+	}
+	if o.Timestamps {
+		q.Set("timestamps", "true")
+	}
+	return q
+}
+
+// GetProjectLogs returns the recent logs of every container of a project, as
+// plain text with one line per log entry.
+func (ec *EnvironmentClient) GetProjectLogs(ctx context.Context, projectID string, opts *LogsOptions) (string, error) {
+	return ec.getLogs(ctx, "/api/environments/"+esc(ec.environmentID)+"/projects/"+esc(projectID)+"/logs", opts)
+}
+
+// GetContainerLogs returns the recent logs of a container, as plain text with
+// one line per log entry.
+func (ec *EnvironmentClient) GetContainerLogs(ctx context.Context, containerID string, opts *LogsOptions) (string, error) {
+	return ec.getLogs(ctx, "/api/environments/"+esc(ec.environmentID)+"/containers/"+esc(containerID)+"/logs", opts)
+}
+
+func (ec *EnvironmentClient) getLogs(ctx context.Context, path string, opts *LogsOptions) (string, error) {
+	var buf bytes.Buffer
+	err := ec.client.Do(ctx, &Request{
+		Method: http.MethodGet,
+		Path:   path,
+		Query:  opts.query(),
+		Output: &buf,
+	})
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,58 @@
+package arcane
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ─── Logs ─────────────────────────────────────────────────────────────────────
+
+func TestGetProjectLogs_SendsOptions(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/environments/env-1/projects/proj-1/logs" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("tail") != "50" || q.Get("since") != "10m" || q.Get("timestamps") != "true" {
+			t.Errorf("unexpected query %q", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("web-1  | started\nweb-1  | listening on :80\n"))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	logs, err := c.ForEnvironment("env-1").GetProjectLogs(context.Background(), "proj-1", &LogsOptions{Tail: 50, Since: "10m", Timestamps: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if logs != "web-1  | started\nweb-1  | listening on :80\n" {
+		t.Errorf("unexpected logs %q", logs)
+	}
+}
+
+func TestGetContainerLogs_WithoutOptions_SendsNoQuery(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/environments/env-1/containers/c1/logs" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.URL.RawQuery != "" {
+			t.Errorf("expected no query, got %q", r.URL.RawQuery)
+		}
+		w.Write([]byte("ready\n"))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	logs, err := c.ForEnvironment("env-1").GetContainerLogs(context.Background(), "c1", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if logs != "ready\n" {
+		t.Errorf("unexpected logs %q", logs)
+	}
+}
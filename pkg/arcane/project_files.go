@@ -0,0 +1,66 @@
+package arcane
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// ProjectFile describes a file in a project's directory on the agent. The
+// content itself is never returned; SHA256 identifies it. Owner and Group are
+// names where the agent can resolve them, and UID and GID the numeric IDs.
+type ProjectFile struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+	Mode   string `json:"mode"`
+	Owner  string `json:"owner,omitempty"`
+	Group  string `json:"group,omitempty"`
+	UID    *int64 `json:"uid,omitempty"`
+	GID    *int64 `json:"gid,omitempty"`
+}
+
+// ProjectFileWriteRequest creates or replaces a file in a project's
+// directory. Path is relative to the project directory, with forward
+// slashes. Content is base64-encoded so binary files survive the JSON body.
+type ProjectFileWriteRequest struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+	Mode    string `json:"mode,omitempty"`
+	Owner   string `json:"owner,omitempty"`
+	Group   string `json:"group,omitempty"`
+}
+
+func (ec *EnvironmentClient) projectFilesPath(projectID string) string {
+	return "/api/environments/" + esc(ec.environmentID) + "/projects/" + esc(projectID) + "/files"
+}
+
+// GetProjectFile retrieves the metadata of a file in a project's directory.
+func (ec *EnvironmentClient) GetProjectFile(ctx context.Context, projectID, filePath string) (*ProjectFile, error) {
+	var result SingleResponse[ProjectFile]
+	err := ec.client.Do(ctx, &Request{
+		Method: http.MethodGet,
+		Path:   ec.projectFilesPath(projectID),
+		Query:  url.Values{"path": {filePath}},
+		Result: &result,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// PutProjectFile creates or replaces a file in a project's directory and
+// returns its metadata.
+func (ec *EnvironmentClient) PutProjectFile(ctx context.Context, projectID string, req *ProjectFileWriteRequest) (*ProjectFile, error) {
+	return putSingle[ProjectFile](ctx, ec.client, ec.projectFilesPath(projectID), req)
+}
+
+// DeleteProjectFile removes a file from a project's directory.
+func (ec *EnvironmentClient) DeleteProjectFile(ctx context.Context, projectID, filePath string) error {
+	return ec.client.Do(ctx, &Request{
+		Method: http.MethodDelete,
+		Path:   ec.projectFilesPath(projectID),
+		Query:  url.Values{"path": {filePath}},
+	})
+}
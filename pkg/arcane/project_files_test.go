@@ -0,0 +1,84 @@
+package arcane
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ─── Project files ────────────────────────────────────────────────────────────
+
+func TestGetProjectFile_SendsPathQuery(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/environments/env-1/projects/proj-1/files" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("path"); got != "config/app.conf" {
+			t.Errorf("expected path query config/app.conf, got %q", got)
+		}
+		w.Write([]byte(`{"success":true,"data":{"path":"config/app.conf","sha256":"abc","size":3,"mode":"0640","owner":"1000"}}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	f, err := c.ForEnvironment("env-1").GetProjectFile(context.Background(), "proj-1", "config/app.conf")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f.SHA256 != "abc" || f.Mode != "0640" || f.Owner != "1000" || f.Size != 3 {
+		t.Errorf("unexpected file: %+v", f)
+	}
+}
+
+func TestPutProjectFile_SendsContent(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/environments/env-1/projects/proj-1/files" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var req ProjectFileWriteRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if req.Path != "certs/tls.crt" || req.Content != "Y2VydA==" || req.Mode != "0600" {
+			t.Errorf("unexpected body: %+v", req)
+		}
+		w.Write([]byte(`{"success":true,"data":{"path":"certs/tls.crt","sha256":"def","mode":"0600"}}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	f, err := c.ForEnvironment("env-1").PutProjectFile(context.Background(), "proj-1", &ProjectFileWriteRequest{
+		Path:    "certs/tls.crt",
+		Content: "Y2VydA==",
+		Mode:    "0600",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f.SHA256 != "def" {
+		t.Errorf("unexpected file: %+v", f)
+	}
+}
+
+func TestDeleteProjectFile_SendsPathQuery(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/environments/env-1/projects/proj-1/files" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("path"); got != "app.conf" {
+			t.Errorf("expected path query app.conf, got %q", got)
+		}
+		w.Write([]byte(`{"success":true}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	if err := c.ForEnvironment("env-1").DeleteProjectFile(context.Background(), "proj-1", "app.conf"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,40 @@
+package arcane
+
+import (
+	"context"
+	"net/http"
+)
+
+// ProjectUpdater is a project's image update schedule. Arcane checks the
+// project's images for newer versions every CheckInterval, e.g. "6h", and
+// redeploys the project when one is found if AutoApply is set; otherwise it
+// only reports the update.
+type ProjectUpdater struct {
+	Enabled       bool   `json:"enabled"`
+	CheckInterval string `json:"check_interval"`
+	AutoApply     bool   `json:"auto_apply"`
+}
+
+func (ec *EnvironmentClient) projectUpdaterPath(projectID string) string {
+	return "/api/environments/" + esc(ec.environmentID) + "/projects/" + esc(projectID) + "/updater"
+}
+
+// GetProjectUpdater retrieves a project's update schedule. It returns a not
+// found error when the project has none.
+func (ec *EnvironmentClient) GetProjectUpdater(ctx context.Context, projectID string) (*ProjectUpdater, error) {
+	return getSingle[ProjectUpdater](ctx, ec.client, ec.projectUpdaterPath(projectID))
+}
+
+// PutProjectUpdater creates or replaces a project's update schedule.
+func (ec *EnvironmentClient) PutProjectUpdater(ctx context.Context, projectID string, req *ProjectUpdater) (*ProjectUpdater, error) {
+	return putSingle[ProjectUpdater](ctx, ec.client, ec.projectUpdaterPath(projectID), req)
+}
+
+// DeleteProjectUpdater removes a project's update schedule, leaving its
+// images to Arcane's global update settings.
+func (ec *EnvironmentClient) DeleteProjectUpdater(ctx context.Context, projectID string) error {
+	return ec.client.Do(ctx, &Request{
+		Method: http.MethodDelete,
+		Path:   ec.projectUpdaterPath(projectID),
+	})
+}
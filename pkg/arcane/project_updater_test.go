@@ -0,0 +1,92 @@
+package arcane
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ─── Project updater ──────────────────────────────────────────────────────────
+
+func TestGetProjectUpdater_DecodesSchedule(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/environments/env-1/projects/proj-1/updater" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"success":true,"data":{"enabled":true,"check_interval":"21600","auto_apply":true}}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	u, err := c.ForEnvironment("env-1").GetProjectUpdater(context.Background(), "proj-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !u.Enabled || u.CheckInterval != "21600" || !u.AutoApply {
+		t.Errorf("unexpected updater: %+v", u)
+	}
+}
+
+func TestGetProjectUpdater_GivenNoSchedule_ReturnsNotFound(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"updater not found"}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	_, err := c.ForEnvironment("env-1").GetProjectUpdater(context.Background(), "proj-1")
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestPutProjectUpdater_SendsSchedule(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/environments/env-1/projects/proj-1/updater" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var req ProjectUpdater
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if !req.Enabled || req.CheckInterval != "6h" || req.AutoApply {
+			t.Errorf("unexpected body: %+v", req)
+		}
+		w.Write([]byte(`{"success":true,"data":{"enabled":true,"check_interval":"6h","auto_apply":false}}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	u, err := c.ForEnvironment("env-1").PutProjectUpdater(context.Background(), "proj-1", &ProjectUpdater{
+		Enabled:       true,
+		CheckInterval: "6h",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if u.CheckInterval != "6h" {
+		t.Errorf("unexpected updater: %+v", u)
+	}
+}
+
+func TestDeleteProjectUpdater_SendsDelete(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/environments/env-1/projects/proj-1/updater" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"success":true}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	if err := c.ForEnvironment("env-1").DeleteProjectUpdater(context.Background(), "proj-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
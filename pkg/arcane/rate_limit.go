@@ -0,0 +1,39 @@
+package arcane
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// newRateLimiter returns a limiter admitting requestsPerSecond requests per
+// second with the given burst, which defaults to requestsPerSecond rounded up,
+// or nil when requestsPerSecond is zero.
+func newRateLimiter(requestsPerSecond float64, burst int) (*rate.Limiter, error) {
+	if requestsPerSecond < 0 || math.IsInf(requestsPerSecond, 0) || math.IsNaN(requestsPerSecond) || burst < 0 {
+		return nil, fmt.Errorf("rate limit must be a finite, non-negative number of requests per second with a non-negative burst, got %g with a burst of %d", requestsPerSecond, burst)
+	}
+	if requestsPerSecond == 0 {
+		return nil, nil
+	}
+	if burst == 0 {
+		burst = int(math.Ceil(requestsPerSecond))
+	}
+	return rate.NewLimiter(rate.Limit(requestsPerSecond), burst), nil
+}
+
+// waitForRateLimit blocks until the client's rate limit admits another
+// request. The limiter is shared by all goroutines using the client.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.limiter == nil {
+		return nil
+	}
+	defer c.stats.waitedSince(time.Now())
+	if err := c.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("waiting for the request rate limit: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package arcane
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// ─── Rate limiting ────────────────────────────────────────────────────────────
+
+// TestDo_GivenRateLimit_SpacesRequestsAcrossGoroutines validates that requests
+// from parallel goroutines share one limit, as parallel resources do.
+func TestDo_GivenRateLimit_SpacesRequestsAcrossGoroutines(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c, err := New(Config{URL: srv.URL, RequestsPerSecond: 20, Burst: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/health"}); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The first request uses the burst, the other four wait 50ms each
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Errorf("expected 5 requests at 20 per second to take at least 200ms, took %s", elapsed)
+	}
+	if wait := c.Stats().WaitTime; wait < 180*time.Millisecond {
+		t.Errorf("expected the rate limit waits in the wait time, got %s", wait)
+	}
+}
+
+func TestDo_GivenRateLimitAndCancelledContext_ReturnsContextError(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c, err := New(Config{URL: srv.URL, RequestsPerSecond: 0.01, Burst: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/health"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = c.Do(ctx, &Request{Method: http.MethodGet, Path: "/api/health"})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Do() error = %v, want context.Canceled", err)
+	}
+}
+
+// TestDo_GivenRateLimitAndSession_WaitsBeforeLoggingIn validates that a
+// request blocked by the rate limit doesn't log in first.
+func TestDo_GivenRateLimitAndSession_WaitsBeforeLoggingIn(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	var logins atomic.Int32
+	login := func(context.Context) (string, error) {
+		logins.Add(1)
+		return "session", nil
+	}
+	c, err := New(Config{URL: srv.URL, RequestsPerSecond: 0.01, Burst: 1, Login: login})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := c.Do(ctx, &Request{Method: http.MethodGet, Path: "/api/health"}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Do() error = %v, want context.Canceled", err)
+	}
+	if got := logins.Load(); got != 0 {
+		t.Errorf("expected no login while waiting for the rate limit, got %d", got)
+	}
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	if l, err := newRateLimiter(0, 0); l != nil || err != nil {
+		t.Errorf("expected no limiter without a rate, got %v, %v", l, err)
+	}
+	if l, _ := newRateLimiter(2.5, 0); l == nil || l.Burst() != 3 || float64(l.Limit()) != 2.5 {
+		t.Errorf("expected 2.5 requests per second with a burst of 3, got %v", l)
+	}
+	if l, _ := newRateLimiter(10, 2); l == nil || l.Burst() != 2 {
+		t.Errorf("expected a burst of 2, got %v", l)
+	}
+	for name, settings := range map[string]struct {
+		rps   float64
+		burst int
+	}{
+		"negative rate":  {rps: -1},
+		"negative burst": {rps: 1, burst: -1},
+		"infinite rate":  {rps: math.Inf(1)},
+		"NaN rate":       {rps: math.NaN()},
+	} {
+		if _, err := newRateLimiter(settings.rps, settings.burst); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+		if _, err := New(Config{URL: "http://arcane.local", RequestsPerSecond: settings.rps, Burst: settings.burst}); err == nil {
+			t.Errorf("%s: expected New to return an error", name)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package arcane
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+)
+
+// RedirectError is returned when a request is redirected instead of being
+// answered, which the Arcane API never does. It usually means a reverse proxy
+// in front of Arcane sent the request to a login page, or that the URL is
+// that of another site.
+type RedirectError struct {
+	// StatusCode is the status of the redirect response, or of the HTML
+	// response it led to when redirects are followed.
+	StatusCode int
+	// Location is where the request was redirected to.
+	Location string
+}
+
+func (e *RedirectError) Error() string {
+	return fmt.Sprintf("received redirect to %s (status %d) instead of an API response, check the url and authentication settings", e.Location, e.StatusCode)
+}
+
+// IsRedirect returns true if the error is a RedirectError.
+func IsRedirect(err error) bool {
+	var redirect *RedirectError
+	return errors.As(err, &redirect)
+}
+
+// noRedirects is an http.Client CheckRedirect function that returns redirect
+// responses instead of following them.
+func noRedirects(*http.Request, []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+// redirectError returns a RedirectError if resp, the response to a request
+// for requestURL, is a redirect that wasn't followed, or an HTML page that a
+// followed redirect led to.
+func redirectError(resp *http.Response, requestURL string) error {
+	switch resp.StatusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		location := resp.Header.Get("Location")
+		if loc, err := resp.Location(); err == nil {
+			location = loc.String()
+		}
+		return &RedirectError{StatusCode: resp.StatusCode, Location: location}
+	}
+	if resp.Request == nil || resp.Request.URL.String() == requestURL {
+		return nil
+	}
+	if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mediaType == "text/html" {
+		return &RedirectError{StatusCode: resp.StatusCode, Location: resp.Request.URL.String()}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package arcane
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ─── Redirects ────────────────────────────────────────────────────────────────
+
+// redirectServer redirects /api/... requests to /login, which serves an HTML
+// login page, and /api/moved to /api/environments, which serves JSON.
+func redirectServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(`<html><body>Sign in</body></html>`))
+	})
+	mux.HandleFunc("/api/environments", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"success":true,"data":[]}`))
+	})
+	mux.HandleFunc("/api/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/api/environments", http.StatusFound)
+	})
+	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/login", http.StatusFound)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestDo_GivenRedirect_ReturnsRedirectErrorWithoutFollowing(t *testing.T) {
+	t.Parallel()
+	srv := redirectServer(t)
+	c, err := New(Config{URL: srv.URL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/moved", Result: &struct{}{}})
+	var redirect *RedirectError
+	if !errors.As(err, &redirect) {
+		t.Fatalf("expected a RedirectError, got %v", err)
+	}
+	if redirect.StatusCode != http.StatusFound || redirect.Location != srv.URL+"/api/environments" {
+		t.Errorf("unexpected redirect %+v", redirect)
+	}
+	if !IsRedirect(err) {
+		t.Error("expected IsRedirect to be true")
+	}
+}
+
+func TestDo_GivenFollowRedirects_FollowsRedirectToAPIResponse(t *testing.T) {
+	t.Parallel()
+	srv := redirectServer(t)
+	c, err := New(Config{URL: srv.URL, FollowRedirects: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/moved", Result: &struct{}{}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDo_GivenFollowRedirects_ReturnsRedirectErrorForLoginPage(t *testing.T) {
+	t.Parallel()
+	srv := redirectServer(t)
+	c, err := New(Config{URL: srv.URL, FollowRedirects: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/projects", Result: &struct{}{}})
+	var redirect *RedirectError
+	if !errors.As(err, &redirect) {
+		t.Fatalf("expected a RedirectError, got %v", err)
+	}
+	if redirect.StatusCode != http.StatusOK || redirect.Location != srv.URL+"/login" {
+		t.Errorf("unexpected redirect %+v", redirect)
+	}
+}
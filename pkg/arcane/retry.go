@@ -0,0 +1,126 @@
+package arcane
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultRetryWaitMin is the wait before the first retry when
+	// Config.RetryWaitMin is zero. It doubles with every further retry.
+	DefaultRetryWaitMin = time.Second
+	// DefaultRetryWaitMax is the longest wait between retries when
+	// Config.RetryWaitMax is zero.
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
+// retryPolicy decides which failed requests are sent again and how long to
+// wait before each retry.
+type retryPolicy struct {
+	max     int
+	waitMin time.Duration
+	waitMax time.Duration
+}
+
+// retryable reports whether a request with the given method that returned
+// resp or err may be sent again. 429 Too Many Requests means the request was
+// rejected before being processed, so it is retried for every method; 502,
+// 503 and 504 responses and network errors only for idempotent methods,
+// since the server may have acted on the request before failing.
+func retryable(ctx context.Context, method string, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	idempotent := false
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		idempotent = true
+	}
+	if err != nil {
+		return idempotent && IsTransient(err)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent
+	}
+	return false
+}
+
+// wait returns how long to wait before retry number attempt (starting at 0):
+// the Retry-After header of resp if it has one, otherwise an exponential
+// backoff with jitter, so that parallel resources don't retry in lockstep.
+// Both are capped at waitMax.
+func (p retryPolicy) wait(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return min(d, p.waitMax)
+		}
+	}
+	backoff := p.waitMin
+	for i := 0; i < attempt && backoff < p.waitMax; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, p.waitMax)
+	// Wait between half and all of the backoff
+	half := backoff / 2
+	return half + rand.N(backoff-half+1)
+}
+
+// parseRetryAfter parses a Retry-After header, either a number of seconds or
+// an HTTP date.
+func parseRetryAfter(header string, now time.Time) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		return max(t.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// sendWithRetry executes an HTTP request with send, sending it again up to
+// Config.RetryMax times while it fails with a retryable error.
+func (c *Client) sendWithRetry(ctx context.Context, method string, body []byte, newReq func() (*http.Request, error)) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, body, newReq)
+		if attempt >= c.retry.max || !retryable(ctx, method, resp, err) {
+			return resp, err
+		}
+
+		wait := c.retry.wait(attempt, resp)
+		fields := map[string]interface{}{
+			"method":  method,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		c.log().Debug(ctx, "Retrying request", fields)
+
+		c.stats.retries.Add(1)
+		waitStart := time.Now()
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			c.stats.waitedSince(waitStart)
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		c.stats.waitedSince(waitStart)
+	}
+}
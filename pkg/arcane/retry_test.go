@@ -0,0 +1,180 @@
+package arcane
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// ─── Retries ──────────────────────────────────────────────────────────────────
+
+func TestRetryable(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		method string
+		status int
+		err    error
+		want   bool
+	}{
+		"get 503":               {method: http.MethodGet, status: http.StatusServiceUnavailable, want: true},
+		"put 502":               {method: http.MethodPut, status: http.StatusBadGateway, want: true},
+		"delete 504":            {method: http.MethodDelete, status: http.StatusGatewayTimeout, want: true},
+		"post 503":              {method: http.MethodPost, status: http.StatusServiceUnavailable},
+		"post 429":              {method: http.MethodPost, status: http.StatusTooManyRequests, want: true},
+		"get 500":               {method: http.MethodGet, status: http.StatusInternalServerError},
+		"get 404":               {method: http.MethodGet, status: http.StatusNotFound},
+		"get connection reset":  {method: http.MethodGet, err: syscall.ECONNRESET, want: true},
+		"post connection reset": {method: http.MethodPost, err: syscall.ECONNRESET},
+		"get canceled":          {method: http.MethodGet, err: context.Canceled},
+	}
+	for name, tc := range cases {
+		var resp *http.Response
+		if tc.err == nil {
+			resp = &http.Response{StatusCode: tc.status}
+		}
+		if got := retryable(context.Background(), tc.method, resp, tc.err); got != tc.want {
+			t.Errorf("%s: retryable() = %t, want %t", name, got, tc.want)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		header string
+		want   time.Duration
+		wantOK bool
+	}{
+		"seconds":   {header: "7", want: 7 * time.Second, wantOK: true},
+		"http date": {header: "Thu, 01 Jan 2026 12:00:30 GMT", want: 30 * time.Second, wantOK: true},
+		"past date": {header: "Thu, 01 Jan 2026 11:00:00 GMT", want: 0, wantOK: true},
+		"missing":   {header: ""},
+		"invalid":   {header: "soon"},
+	}
+	for name, tc := range cases {
+		got, ok := parseRetryAfter(tc.header, now)
+		if got != tc.want || ok != tc.wantOK {
+			t.Errorf("%s: parseRetryAfter(%q) = %s, %t, want %s, %t", name, tc.header, got, ok, tc.want, tc.wantOK)
+		}
+	}
+}
+
+func TestRetryPolicy_Wait(t *testing.T) {
+	t.Parallel()
+
+	p := retryPolicy{max: 10, waitMin: time.Second, waitMax: 5 * time.Second}
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		for range 20 {
+			if got := p.wait(attempt, nil); got < want/2 || got > want {
+				t.Errorf("wait(%d) = %s, want between %s and %s", attempt, got, want/2, want)
+			}
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": {"2"}}}
+	if got := p.wait(0, resp); got != 2*time.Second {
+		t.Errorf("wait() with Retry-After 2 = %s, want 2s", got)
+	}
+	resp.Header.Set("Retry-After", "120")
+	if got := p.wait(0, resp); got != 5*time.Second {
+		t.Errorf("wait() with Retry-After 120 = %s, want the 5s maximum", got)
+	}
+}
+
+func TestClient_GivenTransientFailures_WhenRetried_ThenSucceeds(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		method       string
+		status       int
+		retryMax     int
+		wantRequests int32
+		wantErr      bool
+	}{
+		"get recovers":         {method: http.MethodGet, status: http.StatusServiceUnavailable, retryMax: 3, wantRequests: 3},
+		"post rate limited":    {method: http.MethodPost, status: http.StatusTooManyRequests, retryMax: 3, wantRequests: 3},
+		"post not retried":     {method: http.MethodPost, status: http.StatusBadGateway, retryMax: 3, wantRequests: 1, wantErr: true},
+		"retries exhausted":    {method: http.MethodGet, status: http.StatusServiceUnavailable, retryMax: 1, wantRequests: 2, wantErr: true},
+		"retries disabled":     {method: http.MethodGet, status: http.StatusServiceUnavailable, wantRequests: 1, wantErr: true},
+		"not retryable status": {method: http.MethodGet, status: http.StatusInternalServerError, retryMax: 3, wantRequests: 1, wantErr: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var requests atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) < 3 {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(tc.status)
+					w.Write([]byte(`{"message":"try again"}`))
+					return
+				}
+				w.Write([]byte(`{"success":true}`))
+			}))
+			defer srv.Close()
+
+			c, err := New(Config{URL: srv.URL, RetryMax: tc.retryMax, RetryWaitMin: time.Millisecond, RetryWaitMax: 10 * time.Millisecond})
+			if err != nil {
+				t.Fatalf("New() error: %v", err)
+			}
+			err = c.Do(context.Background(), &Request{Method: tc.method, Path: "/api/environments"})
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Do() error = %v, want error %t", err, tc.wantErr)
+			}
+			var apiErr *APIError
+			if tc.wantErr && !errors.As(err, &apiErr) {
+				t.Errorf("Do() error = %v, want an APIError", err)
+			}
+			if got := requests.Load(); got != tc.wantRequests {
+				t.Errorf("server received %d requests, want %d", got, tc.wantRequests)
+			}
+		})
+	}
+}
+
+func TestClient_GivenCanceledContext_WhenWaitingToRetry_ThenReturns(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c, err := New(Config{URL: srv.URL, RetryMax: 5, RetryWaitMin: time.Minute, RetryWaitMax: time.Minute})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := c.Do(ctx, &Request{Method: http.MethodGet, Path: "/api/environments"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Do() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("Do() returned after %s, want it to stop waiting when the context is done", elapsed)
+	}
+}
+
+func TestNew_GivenInvalidRetrySettings_ReturnsError(t *testing.T) {
+	t.Parallel()
+
+	for name, cfg := range map[string]Config{
+		"negative retries": {RetryMax: -1},
+		"negative wait":    {RetryWaitMax: -time.Second},
+		"min above max":    {RetryWaitMin: time.Minute, RetryWaitMax: time.Second},
+	} {
+		cfg.URL = "http://arcane.local"
+		if _, err := New(cfg); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
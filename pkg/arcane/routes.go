@@ -0,0 +1,99 @@
+package arcane
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-version"
+)
+
+// WarningDeprecatedAPIPath is the code of the warning added when requests are
+// rewritten to the paths of an older server generation.
+const WarningDeprecatedAPIPath = "deprecated_api_path"
+
+// routeTable rewrites the API paths the client uses to those of servers older
+// than Before, which served the same endpoints at other paths.
+type routeTable struct {
+	Before      *version.Version
+	Description string
+	Rewrites    []routeRewrite
+}
+
+// routeRewrite replaces the part of a path matched by Pattern.
+type routeRewrite struct {
+	Pattern     *regexp.Regexp
+	Replacement string
+}
+
+// legacyRouteTables lists the route tables of older server generations,
+// newest first. A server gets every table it predates, applied in order, so
+// each table only describes the step from the generation after it.
+var legacyRouteTables = []routeTable{
+	{
+		Before:      version.Must(version.NewVersion("1.0.0")),
+		Description: "projects under /api/environments/{id}/stacks instead of /projects",
+		Rewrites: []routeRewrite{
+			{Pattern: regexp.MustCompile(`^(/api/environments/[^/]+)/projects(/|$)`), Replacement: "${1}/stacks${2}"},
+		},
+	},
+}
+
+// matches reports whether any rewrite of t applies to p.
+func (t routeTable) matches(p string) bool {
+	for _, r := range t.Rewrites {
+		if r.Pattern.MatchString(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// rewrite applies the rewrites of t to p.
+func (t routeTable) rewrite(p string) string {
+	for _, r := range t.Rewrites {
+		p = r.Pattern.ReplaceAllString(p, r.Replacement)
+	}
+	return p
+}
+
+// routePath returns the path to request for p on the connected server, whose
+// version is learned from the X-Arcane-Version header of its responses. If
+// the server predates a route table, p is rewritten and a deprecation
+// warning is added to ctx. Before the version is known, p is returned
+// unchanged.
+func (c *Client) routePath(ctx context.Context, p string) string {
+	server := c.serverVersion.Load()
+	if server == nil {
+		return p
+	}
+	for _, t := range legacyRouteTables {
+		if !server.LessThan(t.Before) || !t.matches(p) {
+			continue
+		}
+		rewritten := t.rewrite(p)
+		c.log().Debug(ctx, "Rewriting API path for older Arcane server", map[string]interface{}{
+			"arcane_version": server.String(),
+			"path":           p,
+			"rewritten":      rewritten,
+		})
+		addWarning(ctx, Warning{
+			Code: WarningDeprecatedAPIPath,
+			Message: fmt.Sprintf("Arcane %s predates %s and is reached through deprecated API paths (%s). "+
+				"A future provider release will drop these paths; upgrade Arcane to %s or newer.", server, t.Before, t.Description, t.Before),
+		})
+		p = rewritten
+	}
+	return p
+}
+
+// observeServerVersion records the server version reported in a response, if
+// it is parseable.
+func (c *Client) observeServerVersion(info ServerInfo) {
+	if info.Version == "" {
+		return
+	}
+	if v, err := version.NewVersion(info.Version); err == nil {
+		c.serverVersion.Store(v)
+	}
+}
@@ -0,0 +1,119 @@
+package arcane
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// ─── Route tables ─────────────────────────────────────────────────────────────
+
+// versionedServer reports serverVersion and serves projects at
+// /api/environments/env-1/<collection>, counting requests per path.
+func versionedServer(t *testing.T, serverVersion, collection string, hits map[string]*atomic.Int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if counter, ok := hits[r.URL.Path]; ok {
+			counter.Add(1)
+		}
+		w.Header().Set("X-Arcane-Version", serverVersion)
+		if r.URL.Path != "/api/environments/env-1/"+collection {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+			return
+		}
+		w.Write([]byte(`{"data":[{"id":"p1","name":"web"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestDo_GivenLegacyServer_RewritesToLegacyPathsWithWarning(t *testing.T) {
+	t.Parallel()
+	hits := map[string]*atomic.Int32{
+		"/api/environments/env-1/projects": {},
+		"/api/environments/env-1/stacks":   {},
+	}
+	srv := versionedServer(t, "0.9.4", "stacks", hits)
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+
+	ctx, warnings := WithWarnings(context.Background())
+	for range 2 {
+		projects, err := c.ForEnvironment("env-1").ListProjects(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(projects) != 1 || projects[0].ID != "p1" {
+			t.Fatalf("unexpected projects: %+v", projects)
+		}
+	}
+
+	// Only the first request, made before the version was known, used the
+	// current path.
+	if got := hits["/api/environments/env-1/projects"].Load(); got != 1 {
+		t.Errorf("expected 1 request to the current path, got %d", got)
+	}
+	if got := hits["/api/environments/env-1/stacks"].Load(); got != 2 {
+		t.Errorf("expected 2 requests to the legacy path, got %d", got)
+	}
+	list := warnings.List()
+	if len(list) != 1 || list[0].Code != WarningDeprecatedAPIPath {
+		t.Errorf("expected one deprecation warning, got %+v", list)
+	}
+}
+
+func TestDo_GivenCurrentServer_KeepsPathsWithoutWarning(t *testing.T) {
+	t.Parallel()
+	hits := map[string]*atomic.Int32{"/api/environments/env-1/stacks": {}}
+	srv := versionedServer(t, "1.16.2", "projects", hits)
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+
+	ctx, warnings := WithWarnings(context.Background())
+	for range 2 {
+		if _, err := c.ForEnvironment("env-1").ListProjects(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := hits["/api/environments/env-1/stacks"].Load(); got != 0 {
+		t.Errorf("expected no legacy requests, got %d", got)
+	}
+	if list := warnings.List(); len(list) != 0 {
+		t.Errorf("expected no warnings, got %+v", list)
+	}
+}
+
+func TestDo_GivenLegacyServerMissingResource_ReturnsNotFound(t *testing.T) {
+	t.Parallel()
+	srv := versionedServer(t, "0.9.4", "stacks", nil)
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+
+	_, err := c.ForEnvironment("env-1").GetProject(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestRoutePath(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		version string
+		path    string
+		want    string
+	}{
+		{version: "", path: "/api/environments/e/projects/p", want: "/api/environments/e/projects/p"},
+		{version: "0.9.0", path: "/api/environments/e/projects", want: "/api/environments/e/stacks"},
+		{version: "0.9.0", path: "/api/environments/e/projects/p/up", want: "/api/environments/e/stacks/p/up"},
+		{version: "0.9.0", path: "/api/environments/e/projectsx", want: "/api/environments/e/projectsx"},
+		{version: "0.9.0", path: "/api/environments", want: "/api/environments"},
+		{version: "1.0.0", path: "/api/environments/e/projects", want: "/api/environments/e/projects"},
+	}
+	for _, tc := range cases {
+		c := &Client{}
+		c.observeServerVersion(ServerInfo{Version: tc.version})
+		if got := c.routePath(context.Background(), tc.path); got != tc.want {
+			t.Errorf("version %q: routePath(%q) = %q, want %q", tc.version, tc.path, got, tc.want)
+		}
+	}
+}
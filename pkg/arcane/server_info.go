@@ -0,0 +1,94 @@
+package arcane
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ServerInfo is what Arcane reports about itself in response headers.
+type ServerInfo struct {
+	// Version is the X-Arcane-Version header, empty if not sent.
+	Version string
+	// RateLimit is nil unless the response carried rate limit headers.
+	RateLimit *RateLimit
+	// Date is the server's clock according to the Date header, zero if not
+	// sent.
+	Date time.Time
+}
+
+// RateLimit is the API rate limit state reported by the RateLimit-Limit,
+// RateLimit-Remaining and RateLimit-Reset headers (or their X-RateLimit-
+// variants). Fields whose header is missing are zero.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Duration
+}
+
+// serverInfoFromHeader extracts the ServerInfo from response headers.
+func serverInfoFromHeader(h http.Header) ServerInfo {
+	info := ServerInfo{Version: strings.TrimSpace(h.Get("X-Arcane-Version"))}
+	if date, err := http.ParseTime(h.Get("Date")); err == nil {
+		info.Date = date
+	}
+
+	limit, hasLimit := rateLimitHeader(h, "Limit")
+	remaining, hasRemaining := rateLimitHeader(h, "Remaining")
+	reset, hasReset := rateLimitHeader(h, "Reset")
+	if hasLimit || hasRemaining || hasReset {
+		info.RateLimit = &RateLimit{
+			Limit:     limit,
+			Remaining: remaining,
+			Reset:     time.Duration(reset) * time.Second,
+		}
+	}
+	return info
+}
+
+// rateLimitHeader parses the RateLimit-<name> header, falling back to
+// X-RateLimit-<name>.
+func rateLimitHeader(h http.Header, name string) (int, bool) {
+	for _, key := range []string{"RateLimit-" + name, "X-RateLimit-" + name} {
+		if v, err := strconv.Atoi(strings.TrimSpace(h.Get(key))); err == nil {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// logServerInfo logs the server version and rate limit state of a response,
+// if it reported any.
+func (c *Client) logServerInfo(ctx context.Context, info ServerInfo) {
+	fields := map[string]interface{}{}
+	if info.Version != "" {
+		fields["arcane_version"] = info.Version
+	}
+	if rl := info.RateLimit; rl != nil {
+		fields["ratelimit_limit"] = rl.Limit
+		fields["ratelimit_remaining"] = rl.Remaining
+		fields["ratelimit_reset"] = rl.Reset.String()
+	}
+	if len(fields) == 0 {
+		return
+	}
+	c.log().Debug(ctx, "Arcane response headers", fields)
+}
+
+// GetServerInfo returns the server version and rate limit state Arcane
+// reports in the headers of its health endpoint.
+func (c *Client) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
+	var header http.Header
+	err := c.Do(ctx, &Request{
+		Method:         http.MethodGet,
+		Path:           "/api/health",
+		ResponseHeader: &header,
+	})
+	if err != nil {
+		return nil, err
+	}
+	info := serverInfoFromHeader(header)
+	return &info, nil
+}
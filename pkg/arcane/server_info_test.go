@@ -0,0 +1,84 @@
+package arcane
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// ─── Server info ──────────────────────────────────────────────────────────────
+
+func TestServerInfoFromHeader(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		header        http.Header
+		wantVersion   string
+		wantRateLimit *RateLimit
+	}{
+		"no headers": {
+			header: http.Header{},
+		},
+		"version only": {
+			header:      http.Header{"X-Arcane-Version": {"1.16.2"}},
+			wantVersion: "1.16.2",
+		},
+		"ratelimit headers": {
+			header: http.Header{
+				"Ratelimit-Limit":     {"100"},
+				"Ratelimit-Remaining": {"42"},
+				"Ratelimit-Reset":     {"30"},
+			},
+			wantRateLimit: &RateLimit{Limit: 100, Remaining: 42, Reset: 30 * time.Second},
+		},
+		"x-ratelimit fallback": {
+			header: http.Header{
+				"X-Ratelimit-Remaining": {"0"},
+			},
+			wantRateLimit: &RateLimit{Remaining: 0},
+		},
+		"malformed ratelimit ignored": {
+			header: http.Header{"Ratelimit-Remaining": {"many"}},
+		},
+	}
+
+	for name, tc := range cases {
+		info := serverInfoFromHeader(tc.header)
+		if info.Version != tc.wantVersion {
+			t.Errorf("%s: Version = %q, want %q", name, info.Version, tc.wantVersion)
+		}
+		switch {
+		case tc.wantRateLimit == nil && info.RateLimit != nil:
+			t.Errorf("%s: expected no rate limit, got %+v", name, info.RateLimit)
+		case tc.wantRateLimit != nil && (info.RateLimit == nil || *info.RateLimit != *tc.wantRateLimit):
+			t.Errorf("%s: RateLimit = %+v, want %+v", name, info.RateLimit, tc.wantRateLimit)
+		}
+	}
+}
+
+func TestGetServerInfo_ReadsHealthResponseHeaders(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/health" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("X-Arcane-Version", "1.16.2")
+		w.Header().Set("RateLimit-Remaining", "7")
+		w.Write([]byte("OK"))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	info, err := c.GetServerInfo(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Version != "1.16.2" || info.RateLimit == nil || info.RateLimit.Remaining != 7 {
+		t.Errorf("unexpected server info: %+v", info)
+	}
+	if time.Since(info.Date) > time.Minute {
+		t.Errorf("expected Date from the response, got %s", info.Date)
+	}
+}
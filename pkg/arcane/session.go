@@ -0,0 +1,111 @@
+package arcane
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// LoginFunc obtains a new session token, e.g. by logging in with a username
+// and password or by exchanging an OIDC token.
+type LoginFunc func(ctx context.Context) (string, error)
+
+// session caches the token of a session-authenticated client. It is safe for
+// concurrent use: when several requests see the same token expire, only the
+// first logs in again and the others reuse its token.
+type session struct {
+	login LoginFunc
+
+	mu    sync.Mutex
+	token string
+}
+
+// get returns the cached token, logging in if there is none.
+func (s *session) get(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == "" {
+		return s.loginLocked(ctx)
+	}
+	return s.token, nil
+}
+
+// refresh returns a token to replace stale, which the server rejected. If
+// another request has already replaced it, that token is returned instead of
+// logging in again.
+func (s *session) refresh(ctx context.Context, stale string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && s.token != stale {
+		return s.token, nil
+	}
+	return s.loginLocked(ctx)
+}
+
+func (s *session) loginLocked(ctx context.Context) (string, error) {
+	s.token = ""
+	token, err := s.login(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to log in: %w", err)
+	}
+	s.token = token
+	return token, nil
+}
+
+// loginRequest is the body of POST /api/auth/login.
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// loginResult is the response to a login request.
+type loginResult struct {
+	Token string `json:"token"`
+}
+
+// passwordLogin returns a LoginFunc that logs in to Arcane with username and
+// password. It sends the request with the client's HTTP client but bypasses
+// send, which would otherwise ask the session for a token while it is logging
+// in.
+func (c *Client) passwordLogin(username, password string) LoginFunc {
+	return func(ctx context.Context) (string, error) {
+		body, err := json.Marshal(loginRequest{Username: username, Password: password})
+		if err != nil {
+			return "", err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL+"/api/auth/login", bytes.NewReader(body))
+		if err != nil {
+			return "", fmt.Errorf("failed to create login request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			return "", fmt.Errorf("login request failed: %w", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", fmt.Errorf("failed to read login response: %w", err)
+		}
+
+		if resp.StatusCode >= 400 {
+			apiErr := APIError{StatusCode: resp.StatusCode, RequestID: resp.Header.Get("X-Request-Id")}
+			_ = json.Unmarshal(respBody, &apiErr)
+			return "", &apiErr
+		}
+		var result SingleResponse[loginResult]
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return "", fmt.Errorf("failed to parse login response: %w", err)
+		}
+		if result.Data.Token == "" {
+			return "", fmt.Errorf("login response contained no token")
+		}
+		return result.Data.Token, nil
+	}
+}
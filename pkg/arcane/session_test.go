@@ -0,0 +1,269 @@
+package arcane
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// ─── Session auth ─────────────────────────────────────────────────────────────
+
+// tokenServer accepts only the bearer token in valid and echoes request bodies.
+func tokenServer(t *testing.T, valid *atomic.Value, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("Authorization") != "Bearer "+valid.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message":"token expired"}`))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.Write([]byte(`{"data":` + cmp.Or(string(body), "null") + `}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// countingLogin returns tokens tok-1, tok-2, ... and counts the logins.
+func countingLogin(logins *atomic.Int32) LoginFunc {
+	return func(ctx context.Context) (string, error) {
+		return fmt.Sprintf("tok-%d", logins.Add(1)), nil
+	}
+}
+
+func TestDo_GivenSession_SendsBearerToken(t *testing.T) {
+	t.Parallel()
+	var valid atomic.Value
+	valid.Store("tok-1")
+	var requests, logins atomic.Int32
+	srv := tokenServer(t, &valid, &requests)
+
+	c, err := New(Config{URL: srv.URL, Login: countingLogin(&logins)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for range 3 {
+		if err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/environments"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := logins.Load(); got != 1 {
+		t.Errorf("expected the token to be reused, got %d logins", got)
+	}
+}
+
+func TestDo_GivenExpiredSessionToken_RefreshesAndRetriesOnce(t *testing.T) {
+	t.Parallel()
+	var valid atomic.Value
+	valid.Store("tok-1")
+	var requests, logins atomic.Int32
+	srv := tokenServer(t, &valid, &requests)
+
+	c, err := New(Config{URL: srv.URL, Login: countingLogin(&logins)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/environments"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The session expires mid-apply; the next login issues tok-2.
+	valid.Store("tok-2")
+	var result struct {
+		Data map[string]string `json:"data"`
+	}
+	err = c.Do(context.Background(), &Request{
+		Method: http.MethodPost,
+		Path:   "/api/environments",
+		Body:   map[string]string{"name": "prod"},
+		Result: &result,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Data["name"] != "prod" {
+		t.Errorf("expected the retried request to resend the body, got %+v", result.Data)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+	if got := logins.Load(); got != 2 {
+		t.Errorf("expected 2 logins, got %d", got)
+	}
+}
+
+func TestDo_GivenSessionRejectedAfterRefresh_ReturnsUnauthorized(t *testing.T) {
+	t.Parallel()
+	var valid atomic.Value
+	valid.Store("never-issued")
+	var requests, logins atomic.Int32
+	srv := tokenServer(t, &valid, &requests)
+
+	c, err := New(Config{URL: srv.URL, Login: countingLogin(&logins)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/environments"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected a 401 APIError, got %v", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected exactly one retry, got %d requests", got)
+	}
+}
+
+func TestDo_GivenAPIKeyAuth_DoesNotRetryUnauthorized(t *testing.T) {
+	t.Parallel()
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "bad", HTTPClient: srv.Client()}
+	if err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/environments"}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected no retry, got %d requests", got)
+	}
+}
+
+func TestDo_GivenLoginFailure_ReturnsError(t *testing.T) {
+	t.Parallel()
+	c, err := New(Config{URL: "http://127.0.0.1:1", Login: func(ctx context.Context) (string, error) {
+		return "", errors.New("invalid credentials")
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/environments"})
+	if err == nil || !strings.Contains(err.Error(), "failed to log in: invalid credentials") {
+		t.Errorf("expected a login error, got %v", err)
+	}
+}
+
+func TestSession_GivenConcurrentExpiry_LogsInOnce(t *testing.T) {
+	t.Parallel()
+	var logins atomic.Int32
+	s := &session{login: countingLogin(&logins)}
+
+	stale, err := s.get(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 8)
+	for i := range tokens {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tokens[i], _ = s.refresh(context.Background(), stale)
+		}()
+	}
+	wg.Wait()
+
+	if got := logins.Load(); got != 2 {
+		t.Errorf("expected one login for the refresh, got %d in total", got)
+	}
+	for _, tok := range tokens {
+		if tok != "tok-2" {
+			t.Errorf("expected every request to get tok-2, got %q", tok)
+		}
+	}
+}
+
+func TestDo_GivenUsernameAndPassword_LogsInAndRetriesWithNewSession(t *testing.T) {
+	t.Parallel()
+	var logins atomic.Int32
+	var expired atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/auth/login" {
+			var req loginRequest
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &req)
+			if r.Method != http.MethodPost || req.Username != "admin" || req.Password != "s3cret" || r.Header.Get("Authorization") != "" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"message":"invalid credentials"}`))
+				return
+			}
+			fmt.Fprintf(w, `{"success":true,"data":{"token":"session-%d"}}`, logins.Add(1))
+			return
+		}
+		want := fmt.Sprintf("Bearer session-%d", logins.Load())
+		if expired.CompareAndSwap(true, false) || r.Header.Get("Authorization") != want {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message":"token expired"}`))
+			return
+		}
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer srv.Close()
+
+	c, err := New(Config{URL: srv.URL, Username: "admin", Password: "s3cret"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for range 2 {
+		if err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/environments"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := logins.Load(); got != 1 {
+		t.Errorf("expected a single login, got %d", got)
+	}
+
+	expired.Store(true)
+	if err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/environments"}); err != nil {
+		t.Fatalf("unexpected error after the session expired: %v", err)
+	}
+	if got := logins.Load(); got != 2 {
+		t.Errorf("expected a second login after the session expired, got %d logins", got)
+	}
+}
+
+func TestDo_GivenWrongPassword_ReturnsLoginError(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message":"invalid credentials"}`))
+	}))
+	defer srv.Close()
+
+	c, err := New(Config{URL: srv.URL, Username: "admin", Password: "wrong"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/environments"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized || !strings.Contains(err.Error(), "invalid credentials") {
+		t.Errorf("expected a 401 login error, got %v", err)
+	}
+}
+
+func TestNew_GivenIncompleteOrConflictingSessionAuth_ReturnsError(t *testing.T) {
+	t.Parallel()
+	login := func(ctx context.Context) (string, error) { return "tok", nil }
+	for name, cfg := range map[string]Config{
+		"username only":         {URL: "http://localhost", Username: "admin"},
+		"password only":         {URL: "http://localhost", Password: "s3cret"},
+		"login and credentials": {URL: "http://localhost", Username: "admin", Password: "s3cret", Login: login},
+	} {
+		if _, err := New(cfg); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package arcane
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ─── Request signing ──────────────────────────────────────────────────────────
+
+// expectedSignature recomputes the signature of r the way a verifying proxy
+// would.
+func expectedSignature(t *testing.T, r *http.Request, body []byte, key string, newHash func() hash.Hash) string {
+	t.Helper()
+	bodyHash := sha256.Sum256(body)
+	lines := []string{r.Method, r.URL.RequestURI(), r.Header.Get(SignatureTimeHeader), hex.EncodeToString(bodyHash[:])}
+	if signed := r.Header.Get(SignedHeadersHeader); signed != "" {
+		for _, name := range strings.Split(signed, ";") {
+			lines = append(lines, name+":"+r.Header.Get(name))
+		}
+	}
+	mac := hmac.New(newHash, []byte(key))
+	mac.Write([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestDo_GivenRequestSigning_SignsRequest(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		want := "hmac-sha256=" + expectedSignature(t, r, body, "s3cret", sha256.New)
+		if got := r.Header.Get(SignatureHeader); got != want {
+			t.Errorf("signature = %q, want %q", got, want)
+		}
+		if got := r.Header.Get(SignedHeadersHeader); got != "content-type;x-api-key" {
+			t.Errorf("signed headers = %q", got)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c, err := New(Config{
+		URL:            srv.URL,
+		APIKey:         "key",
+		RequestSigning: &RequestSigning{Key: []byte("s3cret"), Headers: []string{"Content-Type", "X-API-Key"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = c.Do(context.Background(), &Request{
+		Method: http.MethodPost,
+		Path:   "/api/environments",
+		Query:  map[string][]string{"dry_run": {"true"}},
+		Body:   map[string]string{"name": "prod"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDo_GivenNoRequestSigning_OmitsSignature(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(SignatureHeader) != "" || r.Header.Get(SignatureTimeHeader) != "" {
+			t.Error("expected no signature headers")
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	if err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/api/environments"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRequestSigner_GivenSHA512_SignsWithSHA512(t *testing.T) {
+	t.Parallel()
+	s, err := newRequestSigner(RequestSigning{Key: []byte("k"), Algorithm: SigningHMACSHA512})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req := httptest.NewRequest(http.MethodGet, "/api/health", nil)
+	s.sign(req, nil, time.Unix(1700000000, 0))
+
+	if got := req.Header.Get(SignatureTimeHeader); got != "1700000000" {
+		t.Errorf("timestamp = %q", got)
+	}
+	if req.Header.Get(SignedHeadersHeader) != "" {
+		t.Error("expected no signed headers header")
+	}
+	want := "hmac-sha512=" + expectedSignature(t, req, nil, "k", sha512.New)
+	if got := req.Header.Get(SignatureHeader); got != want {
+		t.Errorf("signature = %q, want %q", got, want)
+	}
+}
+
+func TestNew_GivenInvalidRequestSigning_ReturnsError(t *testing.T) {
+	t.Parallel()
+	cases := map[string]RequestSigning{
+		"missing key":       {Algorithm: SigningHMACSHA256},
+		"unknown algorithm": {Key: []byte("k"), Algorithm: "md5"},
+	}
+	for name, cfg := range cases {
+		if _, err := New(Config{URL: "http://localhost", RequestSigning: &cfg}); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package arcane
+
+import (
+	"maps"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Stats summarizes the API use of a Client since it was created. A client
+// lives for a single Terraform operation, so these are the figures of the
+// current plan or apply.
+type Stats struct {
+	// Requests is the number of HTTP requests sent, including retries and
+	// requests revalidating cached responses.
+	Requests int64
+	// RequestsByMethod breaks Requests down by HTTP method.
+	RequestsByMethod map[string]int64
+	// Retries is the number of requests sent again after a transient error.
+	Retries int64
+	// CachedResponses is the number of GET requests answered from the ETag
+	// cache after the server reported them unchanged.
+	CachedResponses int64
+	// RequestTime is the total time spent waiting for responses. Concurrent
+	// requests each count in full.
+	RequestTime time.Duration
+	// WaitTime is the total time spent waiting between requests: for the
+	// request rate limit, backing off before retries, for free operation
+	// slots and project locks, and between polls of a job.
+	WaitTime time.Duration
+}
+
+// statsCollector accumulates Stats. It is safe for concurrent use.
+type statsCollector struct {
+	requests    atomic.Int64
+	retries     atomic.Int64
+	cached      atomic.Int64
+	requestTime atomic.Int64 // nanoseconds
+	waitTime    atomic.Int64 // nanoseconds
+
+	mu       sync.Mutex
+	byMethod map[string]int64
+}
+
+// request records a request sent with method that took d to answer.
+func (s *statsCollector) request(method string, d time.Duration) {
+	s.requests.Add(1)
+	s.requestTime.Add(int64(d))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.byMethod == nil {
+		s.byMethod = make(map[string]int64)
+	}
+	s.byMethod[method]++
+}
+
+// waitedSince records a wait that started at start.
+func (s *statsCollector) waitedSince(start time.Time) {
+	s.waitTime.Add(int64(time.Since(start)))
+}
+
+func (s *statsCollector) snapshot() Stats {
+	s.mu.Lock()
+	byMethod := maps.Clone(s.byMethod)
+	s.mu.Unlock()
+	if byMethod == nil {
+		byMethod = map[string]int64{}
+	}
+	return Stats{
+		Requests:         s.requests.Load(),
+		RequestsByMethod: byMethod,
+		Retries:          s.retries.Load(),
+		CachedResponses:  s.cached.Load(),
+		RequestTime:      time.Duration(s.requestTime.Load()),
+		WaitTime:         time.Duration(s.waitTime.Load()),
+	}
+}
+
+// Stats returns the API use of the client so far.
+func (c *Client) Stats() Stats {
+	return c.stats.snapshot()
+}
@@ -0,0 +1,116 @@
+package arcane
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// ─── Stats ────────────────────────────────────────────────────────────────────
+
+func TestStats_GivenNewClient_ThenZero(t *testing.T) {
+	t.Parallel()
+	c, err := New(Config{URL: "http://localhost:3552"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stats := c.Stats()
+	if stats.Requests != 0 || stats.Retries != 0 || stats.CachedResponses != 0 || stats.RequestTime != 0 || stats.WaitTime != 0 {
+		t.Errorf("expected zero stats, got %+v", stats)
+	}
+	if stats.RequestsByMethod == nil || len(stats.RequestsByMethod) != 0 {
+		t.Errorf("expected an empty RequestsByMethod, got %v", stats.RequestsByMethod)
+	}
+}
+
+func TestStats_GivenRetriesAndCachedResponses_ThenCounted(t *testing.T) {
+	t.Parallel()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Write([]byte(`{"data":{"id":"env-1","name":"prod"}}`))
+			return
+		}
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Write([]byte(`{"data":{"id":"env-1","name":"prod"}}`))
+	}))
+	defer srv.Close()
+
+	c, err := New(Config{URL: srv.URL, RetryMax: 1, RetryWaitMin: 10 * time.Millisecond, RetryWaitMax: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.Background()
+	for range 2 {
+		if _, err := c.GetEnvironment(ctx, "env-1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := c.CreateEnvironment(ctx, &EnvironmentCreateRequest{Name: "prod"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stats := c.Stats()
+	if stats.Requests != 4 {
+		t.Errorf("expected 4 requests, got %d", stats.Requests)
+	}
+	if stats.RequestsByMethod[http.MethodGet] != 3 || stats.RequestsByMethod[http.MethodPost] != 1 {
+		t.Errorf("expected 3 GET and 1 POST requests, got %v", stats.RequestsByMethod)
+	}
+	if stats.Retries != 1 {
+		t.Errorf("expected 1 retry, got %d", stats.Retries)
+	}
+	if stats.CachedResponses != 1 {
+		t.Errorf("expected 1 cached response, got %d", stats.CachedResponses)
+	}
+	if stats.RequestTime <= 0 {
+		t.Errorf("expected request time to be recorded, got %s", stats.RequestTime)
+	}
+	// The retry backoff waits between half and all of RetryWaitMin
+	if stats.WaitTime < 5*time.Millisecond {
+		t.Errorf("expected the retry backoff in the wait time, got %s", stats.WaitTime)
+	}
+
+	// Snapshots are copies
+	stats.RequestsByMethod[http.MethodGet] = 100
+	if c.Stats().RequestsByMethod[http.MethodGet] != 3 {
+		t.Error("expected Stats to return a copy of the per-method counts")
+	}
+}
+
+func TestStats_GivenOperationSlotTaken_ThenWaitCounted(t *testing.T) {
+	t.Parallel()
+	c, err := New(Config{URL: "http://localhost:3552", MaxConcurrentOperationsPerEnvironment: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ec := c.ForEnvironment("env-1")
+	release, err := ec.acquireOperationSlot(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		release()
+	}()
+	release2, err := ec.acquireOperationSlot(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	release2()
+
+	if wait := c.Stats().WaitTime; wait < 20*time.Millisecond {
+		t.Errorf("expected the slot wait in the wait time, got %s", wait)
+	}
+}
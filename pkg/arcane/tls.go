@@ -0,0 +1,58 @@
+package arcane
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+)
+
+// TLSConfig configures how the client verifies Arcane's certificate and
+// authenticates itself, for managers behind self-signed certificates or a
+// reverse proxy requiring client certificates.
+type TLSConfig struct {
+	// CACertPEM holds PEM-encoded certificates trusted in addition to the
+	// system roots when verifying Arcane's certificate.
+	CACertPEM []byte
+	// ClientCertPEM and ClientKeyPEM are a PEM-encoded certificate and
+	// private key presented to Arcane. Both or neither must be set.
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+	// InsecureSkipVerify disables the verification of Arcane's certificate.
+	// Connections are then open to interception, so prefer CACertPEM.
+	InsecureSkipVerify bool
+}
+
+// newTransport returns a copy of http.DefaultTransport using cfg.
+func newTransport(cfg TLSConfig) (*http.Transport, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // opt-in, documented as insecure
+	}
+
+	if len(cfg.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(cfg.CACertPEM) {
+			return nil, fmt.Errorf("CA certificate contains no PEM-encoded certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (len(cfg.ClientCertPEM) > 0) != (len(cfg.ClientKeyPEM) > 0) {
+		return nil, fmt.Errorf("client certificate and client key must be set together")
+	}
+	if len(cfg.ClientCertPEM) > 0 {
+		cert, err := tls.X509KeyPair(cfg.ClientCertPEM, cfg.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
@@ -0,0 +1,81 @@
+package arcane
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Typed helpers for the Arcane response envelopes. Endpoint wrappers use them
+// instead of calling Do directly, so that decoding and pagination are handled
+// the same way for every endpoint.
+
+// getSingle GETs path and returns the data of its single-item response.
+func getSingle[T any](ctx context.Context, c *Client, path string) (*T, error) {
+	return doSingle[T](ctx, c, http.MethodGet, path, nil)
+}
+
+// postSingle POSTs body to path and returns the data of its single-item
+// response.
+func postSingle[T any](ctx context.Context, c *Client, path string, body interface{}) (*T, error) {
+	return doSingle[T](ctx, c, http.MethodPost, path, body)
+}
+
+// putSingle PUTs body to path and returns the data of its single-item
+// response.
+func putSingle[T any](ctx context.Context, c *Client, path string, body interface{}) (*T, error) {
+	return doSingle[T](ctx, c, http.MethodPut, path, body)
+}
+
+func doSingle[T any](ctx context.Context, c *Client, method, path string, body interface{}) (*T, error) {
+	var result SingleResponse[T]
+	err := c.Do(ctx, &Request{
+		Method: method,
+		Path:   path,
+		Body:   body,
+		Result: &result,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result.Data, nil
+}
+
+// getPage GETs one page of the paginated list at path, selected by query.
+func getPage[T any](ctx context.Context, c *Client, path string, query url.Values) (*PaginatedResponse[T], error) {
+	var result PaginatedResponse[T]
+	err := c.Do(ctx, &Request{
+		Method: http.MethodGet,
+		Path:   path,
+		Query:  query,
+		Result: &result,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// getList GETs every item of the paginated list at path, requesting further
+// pages with the `start` offset until the server reports the last page.
+// Servers that return no pagination metadata are read in a single request.
+func getList[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	var items []T
+	query := url.Values{}
+	for {
+		if len(items) > 0 {
+			query.Set("start", strconv.Itoa(len(items)))
+		}
+		page, err := getPage[T](ctx, c, path, query)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Data...)
+
+		p := page.Pagination
+		if len(page.Data) == 0 || p.CurrentPage >= p.TotalPages || len(items) >= p.TotalItems {
+			return items, nil
+		}
+	}
+}
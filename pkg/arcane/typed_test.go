@@ -0,0 +1,90 @@
+package arcane
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// ─── Typed request helpers ────────────────────────────────────────────────────
+
+func TestGetList_GivenSeveralPages_ReturnsAllItems(t *testing.T) {
+	t.Parallel()
+	all := []Environment{{ID: "env-1"}, {ID: "env-2"}, {ID: "env-3"}, {ID: "env-4"}, {ID: "env-5"}}
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+		end := min(start+2, len(all))
+		json.NewEncoder(w).Encode(PaginatedResponse[Environment]{
+			Success: true,
+			Data:    all[start:end],
+			Pagination: Pagination{
+				TotalPages:   3,
+				TotalItems:   len(all),
+				CurrentPage:  start/2 + 1,
+				ItemsPerPage: 2,
+			},
+		})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	envs, err := c.ListEnvironments(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(envs) != len(all) || envs[4].ID != "env-5" {
+		t.Errorf("expected all %d environments, got %+v", len(all), envs)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 page requests, got %d", requests)
+	}
+}
+
+func TestGetList_GivenNoPaginationMetadata_RequestsOnce(t *testing.T) {
+	t.Parallel()
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"success":true,"data":[{"id":"reg-1"},{"id":"reg-2"}]}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	registries, err := c.ListContainerRegistries(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(registries) != 2 {
+		t.Errorf("expected 2 registries, got %d", len(registries))
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+}
+
+func TestPutSingle_SendsBodyAndDecodesData(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		json.NewEncoder(w).Encode(SingleResponse[GitRepository]{Success: true, Data: GitRepository{ID: "repo-1", Name: body["name"]}})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	repo, err := putSingle[GitRepository](context.Background(), c, "/api/gitops/repositories/repo-1", map[string]string{"name": "renamed"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.Name != "renamed" {
+		t.Errorf("expected name renamed, got %s", repo.Name)
+	}
+}
@@ -0,0 +1,93 @@
+package arcane
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// Warning is a warning Arcane returned alongside a response, e.g. about a
+// deprecated field or an agent version mismatch.
+type Warning struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message"`
+}
+
+// UnmarshalJSON decodes a Warning from an object or a plain message string.
+func (w *Warning) UnmarshalJSON(data []byte) error {
+	var message string
+	if err := json.Unmarshal(data, &message); err == nil {
+		*w = Warning{Message: message}
+		return nil
+	}
+	type warning Warning
+	return json.Unmarshal(data, (*warning)(w))
+}
+
+// Warnings collects the warnings of the responses to requests made with a
+// context returned by WithWarnings. It is safe for concurrent use.
+type Warnings struct {
+	mu   sync.Mutex
+	list []Warning
+}
+
+type warningsKey struct{}
+
+// WithWarnings returns a context whose requests add the warnings Arcane sends
+// to the returned collector.
+func WithWarnings(ctx context.Context) (context.Context, *Warnings) {
+	w := &Warnings{}
+	return context.WithValue(ctx, warningsKey{}, w), w
+}
+
+// List returns the collected warnings in the order received, without
+// duplicates.
+func (w *Warnings) List() []Warning {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]Warning(nil), w.list...)
+}
+
+func (w *Warnings) add(warnings []Warning) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, warning := range warnings {
+		if warning.Message == "" {
+			continue
+		}
+		duplicate := false
+		for _, seen := range w.list {
+			if seen == warning {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			w.list = append(w.list, warning)
+		}
+	}
+}
+
+// collectWarnings adds the warnings array of a JSON response body to the
+// collector of ctx, if any. Bodies without warnings are ignored.
+func collectWarnings(ctx context.Context, body []byte) {
+	w, ok := ctx.Value(warningsKey{}).(*Warnings)
+	if !ok || len(body) == 0 {
+		return
+	}
+	var envelope struct {
+		Warnings []Warning `json:"warnings"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return
+	}
+	w.add(envelope.Warnings)
+}
+
+// addWarning adds a warning raised by the client itself to the collector of
+// ctx, if any.
+func addWarning(ctx context.Context, warning Warning) {
+	if w, ok := ctx.Value(warningsKey{}).(*Warnings); ok {
+		w.add([]Warning{warning})
+	}
+}
@@ -0,0 +1,65 @@
+package arcane
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ─── Server warnings ──────────────────────────────────────────────────────────
+
+func TestDo_GivenWarningsInResponse_CollectsThem(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/ok":
+			_, _ = w.Write([]byte(`{"success":true,"data":{},"warnings":["field use_api_key is deprecated",{"code":"agent_version","message":"agent is older than the manager"}]}`))
+		case "/api/again":
+			_, _ = w.Write([]byte(`{"success":true,"warnings":["field use_api_key is deprecated"]}`))
+		case "/api/fail":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"message":"busy","warnings":[{"message":"deployment queued"}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	ctx, warnings := WithWarnings(context.Background())
+
+	for _, path := range []string{"/api/ok", "/api/again", "/api/fail"} {
+		_ = c.Do(ctx, &Request{Method: http.MethodGet, Path: path})
+	}
+
+	want := []Warning{
+		{Message: "field use_api_key is deprecated"},
+		{Code: "agent_version", Message: "agent is older than the manager"},
+		{Message: "deployment queued"},
+	}
+	got := warnings.List()
+	if len(got) != len(want) {
+		t.Fatalf("warnings = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("warning %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestDo_GivenWarningsWithoutCollector_Succeeds(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"success":true,"data":{"id":"env-1"},"warnings":["deprecated"]}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+	env, err := c.GetEnvironment(context.Background(), "env-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if env.ID != "env-1" {
+		t.Errorf("expected ID env-1, got %s", env.ID)
+	}
+}